@@ -21,6 +21,8 @@ import (
 	cloudtestworkflow "github.com/kubeshop/testkube/pkg/tcl/cloudtcl/data/testworkflow"
 	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
 	"github.com/kubeshop/testkube/pkg/tcl/schedulertcl"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowexecutor"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowscheduler"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
@@ -29,10 +31,13 @@ import (
 	cloudartifacts "github.com/kubeshop/testkube/pkg/cloud/data/artifact"
 
 	domainstorage "github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/storage/filesystem"
 	"github.com/kubeshop/testkube/pkg/storage/minio"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	flakylistener "github.com/kubeshop/testkube/pkg/event/kind/flaky"
 	"github.com/kubeshop/testkube/pkg/event/kind/slack"
+	"github.com/kubeshop/testkube/pkg/flaky"
 
 	cloudconfig "github.com/kubeshop/testkube/pkg/cloud/data/config"
 
@@ -48,13 +53,17 @@ import (
 
 	"github.com/kubeshop/testkube/pkg/cloud"
 	configrepository "github.com/kubeshop/testkube/pkg/repository/config"
+	"github.com/kubeshop/testkube/pkg/repository/executionqueue"
+	"github.com/kubeshop/testkube/pkg/repository/executionretry"
 	"github.com/kubeshop/testkube/pkg/repository/result"
 	"github.com/kubeshop/testkube/pkg/repository/storage"
 	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/repository/webhookdelivery"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/kubeshop/testkube/internal/app/api/debug"
 	"github.com/kubeshop/testkube/internal/app/api/metrics"
@@ -69,6 +78,7 @@ import (
 
 	testkubeclientset "github.com/kubeshop/testkube-operator/pkg/clientset/versioned"
 	"github.com/kubeshop/testkube/pkg/k8sclient"
+	"github.com/kubeshop/testkube/pkg/tracing"
 	"github.com/kubeshop/testkube/pkg/triggers"
 
 	kubeclient "github.com/kubeshop/testkube-operator/pkg/client"
@@ -81,6 +91,7 @@ import (
 	testsuiteexecutionsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testsuiteexecutions/v1"
 	testsuitesclientv2 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v2"
 	testsuitesclientv3 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v3"
+	testworkflowsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
 	apiv1 "github.com/kubeshop/testkube/internal/app/api/v1"
 	"github.com/kubeshop/testkube/internal/migrations"
 	"github.com/kubeshop/testkube/pkg/configmap"
@@ -88,6 +99,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/log"
 	"github.com/kubeshop/testkube/pkg/migrator"
 	"github.com/kubeshop/testkube/pkg/reconciler"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/secret"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
@@ -176,6 +188,10 @@ func main() {
 	_ = ln.Close()
 	log.DefaultLogger.Debugw("TCP Port is available", "port", cfg.GraphqlPort)
 
+	// Export the spans via OTLP, when it's configured
+	shutdownTracing, err := tracing.Init(ctx, "testkube-api-server", version.Version)
+	ui.ExitOnError("Initializing tracing", err)
+
 	kubeClient, err := kubeclient.GetClient()
 	ui.ExitOnError("Getting kubernetes client", err)
 
@@ -229,6 +245,8 @@ func main() {
 	testExecutionsClient := testexecutionsclientv1.NewClient(kubeClient, cfg.TestkubeNamespace)
 	testsuiteExecutionsClient := testsuiteexecutionsclientv1.NewClient(kubeClient, cfg.TestkubeNamespace)
 	templatesClient := templatesclientv1.NewClient(kubeClient, cfg.TestkubeNamespace)
+	testWorkflowsClient := testworkflowsclientv1.NewClient(kubeClient, cfg.TestkubeNamespace)
+	testWorkflowTemplatesClient := testworkflowsclientv1.NewTestWorkflowTemplatesClient(kubeClient, cfg.TestkubeNamespace)
 
 	clientset, err := k8sclient.ConnectToK8s()
 	if err != nil {
@@ -257,6 +275,9 @@ func main() {
 	var testWorkflowResultsRepository testworkflow.Repository
 	var testWorkflowOutputRepository testworkflow.OutputRepository
 	var configRepository configrepository.Repository
+	var webhookDeliveryRepository webhookdelivery.Repository
+	var executionQueueRepository executionqueue.Repository
+	var executionRetriesRepository executionretry.Repository
 	var triggerLeaseBackend triggers.LeaseBackend
 	var artifactStorage domainstorage.ArtifactsStorage
 	var storageClient domainstorage.Client
@@ -278,19 +299,36 @@ func main() {
 		testResultsRepository = testresult.NewMongoRepository(db, cfg.APIMongoAllowDiskUse, isDocDb)
 		testWorkflowResultsRepository = testworkflow.NewMongoRepository(db, cfg.APIMongoAllowDiskUse)
 		configRepository = configrepository.NewMongoRepository(db)
+		webhookDeliveryRepository = webhookdelivery.NewMongoRepository(db)
+		executionQueueRepository = executionqueue.NewMongoRepository(db)
+		executionRetriesRepository = executionretry.NewMongoRepository(db)
 		triggerLeaseBackend = triggers.NewMongoLeaseBackend(db)
-		minioClient := newStorageClient(cfg)
-		if err = minioClient.Connect(); err != nil {
-			ui.ExitOnError("Connecting to minio", err)
-		}
-		if expErr := minioClient.SetExpirationPolicy(cfg.StorageExpiration); expErr != nil {
-			log.DefaultLogger.Errorw("Error setting expiration policy", "error", expErr)
+		if cfg.StorageType == domainstorage.TypeFilesystem {
+			filesystemClient := newFilesystemStorageClient(cfg)
+			if err = filesystemClient.Connect(); err != nil {
+				ui.ExitOnError("Preparing filesystem storage", err)
+			}
+			if cfg.StorageExpiration != 0 {
+				log.DefaultLogger.Warn("storage expiration policy is not supported for the filesystem storage")
+			}
+			if cfg.StorageSecretAccessKey == "" {
+				log.DefaultLogger.Warn("STORAGE_SECRETACCESSKEY env var is not set, signed storage URLs are disabled")
+			}
+			storageClient = filesystemClient
+		} else {
+			minioClient := newStorageClient(cfg)
+			if err = minioClient.Connect(); err != nil {
+				ui.ExitOnError("Connecting to minio", err)
+			}
+			if expErr := minioClient.SetExpirationPolicy(cfg.StorageExpiration); expErr != nil {
+				log.DefaultLogger.Errorw("Error setting expiration policy", "error", expErr)
+			}
+			storageClient = minioClient
 		}
-		storageClient = minioClient
 		testWorkflowOutputRepository = testworkflow.NewMinioOutputRepository(storageClient, cfg.LogsBucket)
 		artifactStorage = minio.NewMinIOArtifactClient(storageClient)
 		// init storage
-		isMinioStorage := cfg.LogsStorage == "minio"
+		isMinioStorage := cfg.LogsStorage == "minio" || cfg.LogsStorage == domainstorage.TypeFilesystem
 		if isMinioStorage {
 			bucket := cfg.LogsBucket
 			if bucket == "" {
@@ -312,6 +350,10 @@ func main() {
 		}
 	}
 
+	// Mask the secrets used by the executions, before the outputs are persisted
+	secretResolver := redact.NewResolver(clientset, cfg.TestkubeNamespace)
+	resultsRepository = result.NewRedactedRepository(resultsRepository, secretResolver)
+
 	configName := fmt.Sprintf("testkube-api-server-config-%s", cfg.TestkubeNamespace)
 	if cfg.APIServerConfig != "" {
 		configName = cfg.APIServerConfig
@@ -380,6 +422,16 @@ func main() {
 	}
 	eventBus := bus.NewNATSBus(nc)
 	eventsEmitter := event.NewEmitter(eventBus, cfg.TestkubeClusterName, envs)
+	eventsEmitter.Loader.Register(flakylistener.NewFlakyLoader(eventsEmitter,
+		func(ctx context.Context, name string, limit int) ([]flaky.Run, error) {
+			executions, err := resultsRepository.GetExecutions(ctx, result.NewExecutionsFilter().WithTestName(name).WithPageSize(limit))
+			return flaky.RunsFromExecutions(executions), err
+		},
+		func(ctx context.Context, name string, limit int) ([]flaky.Run, error) {
+			executions, err := testWorkflowResultsRepository.GetExecutions(ctx, testworkflow.NewExecutionsFilter().WithName(name).WithPageSize(limit))
+			return flaky.RunsFromTestWorkflowExecutions(executions), err
+		},
+	))
 
 	var logsStream logsclient.Stream
 
@@ -390,7 +442,12 @@ func main() {
 		}
 	}
 
-	metrics := metrics.NewMetrics()
+	prometheus.MustRegister(metrics.NewRunningExecutionsCollector(map[string]metrics.RunningExecutionsFn{
+		metrics.ExecutionTypeTest:         metrics.RunningTests(resultsRepository),
+		metrics.ExecutionTypeTestSuite:    metrics.RunningTestSuites(testResultsRepository),
+		metrics.ExecutionTypeTestWorkflow: metrics.RunningTestWorkflows(testWorkflowResultsRepository),
+	}))
+	metrics := metrics.NewMetrics().WithHistogramLabels(strings.Split(cfg.TestkubeMetricsHistogramLabels, ",")...)
 
 	defaultExecutors, err := parseDefaultExecutors(cfg)
 	if err != nil {
@@ -534,7 +591,12 @@ func main() {
 		logsStream,
 		cfg.TestkubeNamespace,
 		cfg.TestkubeProTLSSecret,
-	)
+	).WithExecutionQueue(executionQueueRepository, scheduler.QueueLimits{
+		Global:    cfg.ExecutionQueueGlobalLimit,
+		Namespace: cfg.ExecutionQueueNamespaceLimit,
+		Test:      cfg.ExecutionQueueTestLimit,
+		Executor:  cfg.ExecutionQueueExecutorLimit,
+	}).WithExecutionRetries(executionRetriesRepository)
 
 	slackLoader, err := newSlackLoader(cfg, envs)
 	if err != nil {
@@ -550,6 +612,7 @@ func main() {
 		testsuitesClientV3,
 		secretClient,
 		webhooksClient,
+		webhookDeliveryRepository,
 		clientset,
 		testkubeClientset,
 		testsourcesClient,
@@ -578,14 +641,30 @@ func main() {
 		cfg.DisableSecretCreation,
 	)
 
+	testWorkflowExecutor := testworkflowexecutor.New(
+		eventsEmitter,
+		clientset,
+		testWorkflowResultsRepository,
+		testWorkflowOutputRepository,
+		testWorkflowTemplatesClient,
+		inspector,
+		resultsRepository,
+		metrics,
+		cfg.TestkubeNamespace,
+		"http://"+cfg.APIServerFullname+":"+cfg.APIServerPort,
+	)
+	go testWorkflowExecutor.Recover(context.Background())
+
 	// Apply Pro server enhancements
 	apiPro := apitclv1.NewApiTCL(
 		api,
 		&proContext,
-		kubeClient,
 		inspector,
 		testWorkflowResultsRepository,
 		testWorkflowOutputRepository,
+		testWorkflowsClient,
+		testWorkflowTemplatesClient,
+		testWorkflowExecutor,
 		"http://"+cfg.APIServerFullname+":"+cfg.APIServerPort,
 	)
 	apiPro.AppendRoutes()
@@ -626,13 +705,16 @@ func main() {
 			testkubeClientset,
 			testsuitesClientV3,
 			testsClientV3,
+			testWorkflowsClient,
 			resultsRepository,
 			testResultsRepository,
+			testWorkflowResultsRepository,
 			triggerLeaseBackend,
 			log.DefaultLogger,
 			configMapConfig,
 			executorsClient,
 			executor,
+			testWorkflowExecutor,
 			eventBus,
 			metrics,
 			triggers.WithHostnameIdentifier(),
@@ -645,6 +727,23 @@ func main() {
 		log.DefaultLogger.Info("test triggers are disabled")
 	}
 
+	if !cfg.DisableTestWorkflowScheduler {
+		testWorkflowScheduler := testworkflowscheduler.New(
+			testWorkflowsClient,
+			testWorkflowExecutor,
+			triggerLeaseBackend,
+			log.DefaultLogger,
+			testworkflowscheduler.WithHostnameIdentifier(),
+		)
+		log.DefaultLogger.Info("starting test workflow scheduler")
+		g.Go(func() error {
+			testWorkflowScheduler.Run(ctx)
+			return nil
+		})
+	} else {
+		log.DefaultLogger.Info("test workflow scheduler is disabled")
+	}
+
 	if !cfg.DisableReconciler {
 		reconcilerClient := reconciler.NewClient(clientset,
 			resultsRepository,
@@ -658,6 +757,20 @@ func main() {
 		log.DefaultLogger.Info("reconclier is disabled")
 	}
 
+	if sched.IsExecutionQueueEnabled() {
+		g.Go(func() error {
+			sched.RunExecutionQueue(ctx, cfg.ExecutionQueuePollInterval)
+			return nil
+		})
+	}
+
+	if sched.IsExecutionRetriesEnabled() {
+		g.Go(func() error {
+			sched.RunExecutionRetries(ctx, cfg.ExecutionQueuePollInterval)
+			return nil
+		})
+	}
+
 	// telemetry based functions
 	telemetryCh := make(chan struct{})
 	defer close(telemetryCh)
@@ -678,10 +791,19 @@ func main() {
 	})
 
 	g.Go(func() error {
-		return api.RunGraphQLServer(ctx, cfg.GraphqlPort)
+		<-ctx.Done()
+		// wait for the webhook retries in progress, the pending ones are resumed after the restart
+		api.WebhookRetrier.Stop()
+		return nil
 	})
 
-	if err := g.Wait(); err != nil {
+	g.Go(func() error {
+		return api.RunGraphQLServer(ctx, cfg.GraphqlPort, testWorkflowsClient, testWorkflowTemplatesClient, testWorkflowResultsRepository)
+	})
+
+	err = g.Wait()
+	_ = shutdownTracing(context.Background())
+	if err != nil {
 		log.DefaultLogger.Fatalf("Testkube is shutting down: %v", err)
 	}
 }
@@ -756,6 +878,11 @@ func newStorageClient(cfg *config.Config) *minio.Client {
 	)
 }
 
+func newFilesystemStorageClient(cfg *config.Config) *filesystem.Client {
+	signer := filesystem.NewSigner("http://"+cfg.APIServerFullname+":"+cfg.APIServerPort+"/v1/storage", []byte(cfg.StorageSecretAccessKey))
+	return filesystem.NewClient(cfg.StoragePath, cfg.StorageBucket, filesystem.WithSigner(signer))
+}
+
 func newSlackLoader(cfg *config.Config, envs map[string]string) (*slack.SlackLoader, error) {
 	slackTemplate, err := parser.LoadConfigFromStringOrFile(
 		cfg.SlackTemplate,
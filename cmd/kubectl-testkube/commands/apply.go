@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/apply"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/config"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+// NewApplyCmd creates the 'testkube apply' command
+func NewApplyCmd() *cobra.Command {
+	var (
+		files     []string
+		recursive bool
+		prune     bool
+		pruneAll  bool
+		selector  string
+		dryRun    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply test workflows and test workflow templates from files",
+		Long: `Apply test workflows and test workflow templates from files or directories declaratively.
+Resources are compared with the ones existing in the cluster, and only the differences are created or updated.`,
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Args:        cobra.NoArgs,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			cfg, err := config.Load()
+			ui.ExitOnError("loading config", err)
+			common.UiContextHeader(cmd, cfg)
+
+			validator.PersistentPreRunVersionCheck(cmd, common.Version)
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			if len(files) == 0 {
+				ui.Failf("pass at least one file or directory in --file flag")
+			}
+
+			if prune && selector == "" && !pruneAll {
+				ui.Failf("--prune requires --selector or --all flag to limit deleted resources")
+			}
+
+			namespace := cmd.Flag("namespace").Value.String()
+			resources, errs := apply.LoadResources(files, recursive, namespace)
+			if len(errs) > 0 {
+				for _, err := range errs {
+					ui.Errf("%s", err)
+				}
+				ui.Failf("found %d validation errors", len(errs))
+			}
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			workflows, err := client.ListTestWorkflows(selector)
+			ui.ExitOnError("getting test workflows in namespace "+namespace, err)
+
+			templates, err := client.ListTestWorkflowTemplates(selector)
+			ui.ExitOnError("getting test workflow templates in namespace "+namespace, err)
+
+			plan := apply.NewPlan(resources, workflows, templates, prune)
+			ui.Table(plan, os.Stdout)
+			ui.NL()
+
+			summary := plan.Summary()
+			ui.Info(fmt.Sprintf("Plan: %d to create, %d to update, %d to delete, %d unchanged",
+				summary[apply.ActionCreate], summary[apply.ActionUpdate], summary[apply.ActionDelete], summary[apply.ActionUnchanged]))
+
+			if dryRun || !plan.HasChanges() {
+				return
+			}
+
+			errs = plan.Apply(client)
+			if len(errs) > 0 {
+				for _, err := range errs {
+					ui.Errf("%s", err)
+				}
+				ui.Failf("failed to apply %d changes", len(errs))
+			}
+
+			ui.Success("Resources applied in namespace", namespace)
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&files, "file", "f", []string{}, "file or directory with the resources to apply")
+	cmd.Flags().BoolVarP(&recursive, "recursive", "R", false, "process the directories recursively")
+	cmd.Flags().BoolVar(&prune, "prune", false, "delete resources not defined in the files")
+	cmd.Flags().BoolVar(&pruneAll, "all", false, "allow pruning all resources, when no selector is passed")
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector limiting compared and pruned resources, e.g. app=tests")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only show the plan, without applying it")
+
+	return cmd
+}
@@ -0,0 +1,268 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiclient "github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// Action is an operation executed on the resource
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionUnchanged Action = "unchanged"
+	ActionDelete    Action = "delete"
+)
+
+// Change is a planned operation on the resource
+type Change struct {
+	Action   Action
+	Resource Resource
+}
+
+// serverManagedAnnotations are the annotation prefixes set on the resources by the server or kubectl,
+// they are not a part of the manifests, so they are ignored while comparing and kept while updating
+var serverManagedAnnotations = []string{
+	"kubectl.kubernetes.io/",
+}
+
+// Plan is a list of changes required to get from the current state to the desired one
+type Plan []Change
+
+// NewPlan compares desired resources with current workflows and templates,
+// resources not defined in manifests are deleted only in prune mode
+func NewPlan(resources []Resource, workflows []testkube.TestWorkflow, templates []testkube.TestWorkflowTemplate, prune bool) Plan {
+	current := make(map[string]Resource, len(workflows)+len(templates))
+	for i := range workflows {
+		current[KindTestWorkflow+"/"+workflows[i].Name] = Resource{Kind: KindTestWorkflow, Name: workflows[i].Name, Workflow: &workflows[i]}
+	}
+	for i := range templates {
+		current[KindTestWorkflowTemplate+"/"+templates[i].Name] = Resource{Kind: KindTestWorkflowTemplate, Name: templates[i].Name, Template: &templates[i]}
+	}
+
+	var plan Plan
+	desired := make(map[string]struct{}, len(resources))
+	for _, resource := range resources {
+		desired[resource.Key()] = struct{}{}
+		existing, ok := current[resource.Key()]
+		switch {
+		case !ok:
+			plan = append(plan, Change{Action: ActionCreate, Resource: resource})
+		case isEqual(resource, existing):
+			plan = append(plan, Change{Action: ActionUnchanged, Resource: resource})
+		default:
+			plan = append(plan, Change{Action: ActionUpdate, Resource: withServerAnnotations(resource, existing)})
+		}
+	}
+
+	if prune {
+		for key, resource := range current {
+			if _, ok := desired[key]; !ok {
+				plan = append(plan, Change{Action: ActionDelete, Resource: resource})
+			}
+		}
+	}
+
+	sort.SliceStable(plan, func(i, j int) bool {
+		return plan[i].order() < plan[j].order() ||
+			(plan[i].order() == plan[j].order() && plan[i].Resource.Name < plan[j].Resource.Name)
+	})
+
+	return plan
+}
+
+// order returns position of the change in the plan, templates are created before
+// workflows using them, and deleted after them
+func (c Change) order() int {
+	switch {
+	case c.Action == ActionDelete && c.Resource.Kind == KindTestWorkflow:
+		return 2
+	case c.Action == ActionDelete:
+		return 3
+	case c.Resource.Kind == KindTestWorkflowTemplate:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// HasChanges checks if applying the plan modifies any resource
+func (p Plan) HasChanges() bool {
+	for _, change := range p {
+		if change.Action != ActionUnchanged {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Summary returns number of changes per action
+func (p Plan) Summary() map[Action]int {
+	summary := make(map[Action]int)
+	for _, change := range p {
+		summary[change.Action]++
+	}
+
+	return summary
+}
+
+// Table returns plan as table data
+func (p Plan) Table() (header []string, output [][]string) {
+	header = []string{"Action", "Kind", "Name", "Source"}
+	for _, change := range p {
+		output = append(output, []string{
+			string(change.Action),
+			change.Resource.Kind,
+			change.Resource.Name,
+			change.Resource.Source,
+		})
+	}
+
+	return
+}
+
+type resourceDefinition struct {
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Spec        interface{}       `json:"spec,omitempty"`
+}
+
+func toResourceDefinition(resource Resource) resourceDefinition {
+	switch {
+	case resource.Workflow != nil:
+		return resourceDefinition{
+			Description: resource.Workflow.Description,
+			Labels:      resource.Workflow.Labels,
+			Annotations: userAnnotations(resource.Workflow.Annotations),
+			Spec:        resource.Workflow.Spec,
+		}
+	case resource.Template != nil:
+		return resourceDefinition{
+			Description: resource.Template.Description,
+			Labels:      resource.Template.Labels,
+			Annotations: userAnnotations(resource.Template.Annotations),
+			Spec:        resource.Template.Spec,
+		}
+	}
+
+	return resourceDefinition{}
+}
+
+// isServerManagedAnnotation checks if the annotation is set by the server or kubectl
+func isServerManagedAnnotation(key string) bool {
+	for _, prefix := range serverManagedAnnotations {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// userAnnotations returns the annotations without the server-managed ones
+func userAnnotations(annotations map[string]string) map[string]string {
+	result := make(map[string]string, len(annotations))
+	for key, value := range annotations {
+		if !isServerManagedAnnotation(key) {
+			result[key] = value
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+// withServerAnnotations returns the desired resource with the server-managed annotations of the current one
+func withServerAnnotations(desired, current Resource) Resource {
+	var currentAnnotations map[string]string
+	switch {
+	case current.Workflow != nil:
+		currentAnnotations = current.Workflow.Annotations
+	case current.Template != nil:
+		currentAnnotations = current.Template.Annotations
+	}
+
+	annotations := make(map[string]string)
+	for key, value := range currentAnnotations {
+		if isServerManagedAnnotation(key) {
+			annotations[key] = value
+		}
+	}
+	if len(annotations) == 0 {
+		return desired
+	}
+
+	switch {
+	case desired.Workflow != nil:
+		workflow := *desired.Workflow
+		for key, value := range userAnnotations(workflow.Annotations) {
+			annotations[key] = value
+		}
+		workflow.Annotations = annotations
+		desired.Workflow = &workflow
+	case desired.Template != nil:
+		template := *desired.Template
+		for key, value := range userAnnotations(template.Annotations) {
+			annotations[key] = value
+		}
+		template.Annotations = annotations
+		desired.Template = &template
+	}
+
+	return desired
+}
+
+// isEqual checks if the resources have the same definition, ignoring metadata set by the server
+func isEqual(desired, current Resource) bool {
+	desiredData, err := json.Marshal(toResourceDefinition(desired))
+	if err != nil {
+		return false
+	}
+
+	currentData, err := json.Marshal(toResourceDefinition(current))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(desiredData, currentData)
+}
+
+// Apply executes the plan changes, returning errors of the failed ones
+func (p Plan) Apply(client apiclient.Client) (errs []error) {
+	for _, change := range p {
+		var err error
+		resource := change.Resource
+		switch {
+		case change.Action == ActionCreate && resource.Workflow != nil:
+			_, err = client.CreateTestWorkflow(*resource.Workflow)
+		case change.Action == ActionCreate && resource.Template != nil:
+			_, err = client.CreateTestWorkflowTemplate(*resource.Template)
+		case change.Action == ActionUpdate && resource.Workflow != nil:
+			_, err = client.UpdateTestWorkflow(*resource.Workflow)
+		case change.Action == ActionUpdate && resource.Template != nil:
+			_, err = client.UpdateTestWorkflowTemplate(*resource.Template)
+		case change.Action == ActionDelete && resource.Workflow != nil:
+			err = client.DeleteTestWorkflow(resource.Name)
+		case change.Action == ActionDelete && resource.Template != nil:
+			err = client.DeleteTestWorkflowTemplate(resource.Name)
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", change.Action, resource.Key(), err))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,130 @@
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const manifests = `apiVersion: testworkflows.testkube.io/v1
+kind: TestWorkflowTemplate
+metadata:
+  name: shared
+spec:
+  steps:
+  - shell: echo shared
+---
+apiVersion: testworkflows.testkube.io/v1
+kind: TestWorkflow
+metadata:
+  name: changed
+spec:
+  steps:
+  - shell: echo new
+---
+apiVersion: testworkflows.testkube.io/v1
+kind: TestWorkflow
+metadata:
+  name: same
+spec:
+  steps:
+  - shell: echo same
+`
+
+func TestParseResources(t *testing.T) {
+	resources, errs := ParseResources([]byte(manifests), "file.yaml", "testkube")
+
+	assert.Empty(t, errs)
+	require.Len(t, resources, 3)
+	assert.Equal(t, "TestWorkflowTemplate/shared", resources[0].Key())
+	assert.Equal(t, "file.yaml#1", resources[1].Source)
+	assert.Equal(t, "testkube", resources[2].Workflow.Namespace)
+}
+
+func TestParseResources_ValidationErrors(t *testing.T) {
+	data := `kind: Test
+metadata:
+  name: test
+---
+kind: TestWorkflow
+metadata:
+  name: Invalid_Name
+---
+metadata:
+  name: no-kind
+`
+	resources, errs := ParseResources([]byte(data), "file.yaml", "testkube")
+
+	assert.Empty(t, resources)
+	assert.Len(t, errs, 3)
+}
+
+func TestLoadResources_Duplicates(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(manifests), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte(manifests), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# docs"), 0644))
+
+	resources, errs := LoadResources([]string{dir}, false, "testkube")
+
+	assert.Len(t, resources, 3)
+	assert.Len(t, errs, 3)
+}
+
+func TestNewPlan(t *testing.T) {
+	resources, errs := ParseResources([]byte(manifests), "file.yaml", "testkube")
+	require.Empty(t, errs)
+
+	same := *resources[2].Workflow
+	same.Created = same.Created.AddDate(0, 0, 1)
+	workflows := []testkube.TestWorkflow{
+		{Name: "changed", Spec: &testkube.TestWorkflowSpec{}},
+		same,
+		{Name: "removed", Spec: &testkube.TestWorkflowSpec{}},
+	}
+	templates := []testkube.TestWorkflowTemplate{{Name: "old"}}
+
+	plan := NewPlan(resources, workflows, templates, false)
+	assert.Equal(t, map[Action]int{ActionCreate: 1, ActionUpdate: 1, ActionUnchanged: 1}, plan.Summary())
+	assert.True(t, plan.HasChanges())
+
+	plan = NewPlan(resources, workflows, templates, true)
+	actions := make([]string, len(plan))
+	for i := range plan {
+		actions[i] = string(plan[i].Action) + " " + plan[i].Resource.Key()
+	}
+	assert.Equal(t, []string{
+		"create TestWorkflowTemplate/shared",
+		"update TestWorkflow/changed",
+		"unchanged TestWorkflow/same",
+		"delete TestWorkflow/removed",
+		"delete TestWorkflowTemplate/old",
+	}, actions)
+
+	assert.False(t, NewPlan(resources[2:], []testkube.TestWorkflow{same}, nil, false).HasChanges())
+}
+
+func TestNewPlan_ServerManagedAnnotations(t *testing.T) {
+	resources, errs := ParseResources([]byte(manifests), "file.yaml", "testkube")
+	require.Empty(t, errs)
+
+	same := *resources[2].Workflow
+	same.Annotations = map[string]string{
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+	}
+
+	plan := NewPlan(resources[2:3], []testkube.TestWorkflow{same}, nil, false)
+	assert.False(t, plan.HasChanges())
+
+	same.Labels = map[string]string{"changed": "true"}
+	plan = NewPlan(resources[2:3], []testkube.TestWorkflow{same}, nil, false)
+	require.Len(t, plan, 1)
+	assert.Equal(t, ActionUpdate, plan[0].Action)
+	assert.Equal(t, "{}", plan[0].Resource.Workflow.Annotations["kubectl.kubernetes.io/last-applied-configuration"])
+	assert.Nil(t, resources[2].Workflow.Annotations)
+}
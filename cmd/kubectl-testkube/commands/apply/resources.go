@@ -0,0 +1,203 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
+)
+
+const (
+	KindTestWorkflow         = "TestWorkflow"
+	KindTestWorkflowTemplate = "TestWorkflowTemplate"
+)
+
+// Resource is a single resource definition read from the manifests
+type Resource struct {
+	Kind     string
+	Name     string
+	Source   string
+	Workflow *testkube.TestWorkflow
+	Template *testkube.TestWorkflowTemplate
+}
+
+// Key returns unique identifier of the resource
+func (r Resource) Key() string {
+	return r.Kind + "/" + r.Name
+}
+
+// ValidationError describes invalid resource definition
+type ValidationError struct {
+	Source string
+	Err    error
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Source, e.Err)
+}
+
+// LoadResources reads resources from the files and directories,
+// returning all validation errors found in the manifests
+func LoadResources(paths []string, recursive bool, namespace string) (resources []Resource, errs []error) {
+	keys := make(map[string]string)
+	for _, path := range paths {
+		files, err := listManifests(path, recursive)
+		if err != nil {
+			errs = append(errs, ValidationError{Source: path, Err: err})
+			continue
+		}
+
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				errs = append(errs, ValidationError{Source: file, Err: err})
+				continue
+			}
+
+			fileResources, fileErrs := ParseResources(data, file, namespace)
+			errs = append(errs, fileErrs...)
+			for _, resource := range fileResources {
+				if source, ok := keys[resource.Key()]; ok {
+					errs = append(errs, ValidationError{
+						Source: resource.Source,
+						Err:    fmt.Errorf("%s is already defined in %s", resource.Key(), source),
+					})
+					continue
+				}
+
+				keys[resource.Key()] = resource.Source
+				resources = append(resources, resource)
+			}
+		}
+	}
+
+	return resources, errs
+}
+
+// ParseResources reads resources from the multi-document YAML or JSON manifest
+func ParseResources(data []byte, source string, namespace string) (resources []Resource, errs []error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewBuffer(data), len(data))
+	for index := 0; ; index++ {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if err == io.EOF {
+			break
+		}
+
+		documentSource := fmt.Sprintf("%s#%d", source, index)
+		if err != nil {
+			errs = append(errs, ValidationError{Source: documentSource, Err: err})
+			break
+		}
+
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		resource, err := parseResource(raw, namespace)
+		if err != nil {
+			errs = append(errs, ValidationError{Source: documentSource, Err: err})
+			continue
+		}
+
+		resource.Source = documentSource
+		resources = append(resources, resource)
+	}
+
+	return resources, errs
+}
+
+func parseResource(raw json.RawMessage, namespace string) (resource Resource, err error) {
+	var meta metav1.TypeMeta
+	if err = json.Unmarshal(raw, &meta); err != nil {
+		return resource, err
+	}
+
+	if meta.APIVersion != "" && meta.APIVersion != testworkflowsv1.GroupVersion.String() {
+		return resource, fmt.Errorf("unsupported api version: %s", meta.APIVersion)
+	}
+
+	resource.Kind = meta.Kind
+	switch meta.Kind {
+	case KindTestWorkflow:
+		var obj testworkflowsv1.TestWorkflow
+		if err = json.Unmarshal(raw, &obj); err != nil {
+			return resource, err
+		}
+
+		obj.Namespace = namespace
+		workflow := testworkflows.MapTestWorkflowKubeToAPI(obj)
+		resource.Name = workflow.Name
+		resource.Workflow = &workflow
+	case KindTestWorkflowTemplate:
+		var obj testworkflowsv1.TestWorkflowTemplate
+		if err = json.Unmarshal(raw, &obj); err != nil {
+			return resource, err
+		}
+
+		obj.Namespace = namespace
+		template := testworkflows.MapTestWorkflowTemplateKubeToAPI(obj)
+		resource.Name = template.Name
+		resource.Template = &template
+	case "":
+		return resource, fmt.Errorf("missing resource kind")
+	default:
+		return resource, fmt.Errorf("unsupported resource kind: %s", meta.Kind)
+	}
+
+	if resource.Name == "" {
+		return resource, fmt.Errorf("missing %s name", resource.Kind)
+	}
+
+	if msgs := validation.IsDNS1123Subdomain(resource.Name); len(msgs) > 0 {
+		return resource, fmt.Errorf("invalid %s name %s: %s", resource.Kind, resource.Name, strings.Join(msgs, ", "))
+	}
+
+	return resource, nil
+}
+
+// listManifests returns manifest files from the path
+func listManifests(path string, recursive bool) (files []string, err error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	err = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if file != path && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		switch filepath.Ext(file) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, file)
+		}
+
+		return nil
+	})
+
+	return files, err
+}
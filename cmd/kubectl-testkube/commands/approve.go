@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflows"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/config"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "approve <resourceName>",
+		Short:       "Approve or reject paused test workflow executions",
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Run: func(cmd *cobra.Command, args []string) {
+			err := cmd.Help()
+			ui.PrintOnError("Displaying help", err)
+		},
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			cfg, err := config.Load()
+			ui.ExitOnError("loading config", err)
+			common.UiContextHeader(cmd, cfg)
+
+			validator.PersistentPreRunVersionCheck(cmd, common.Version)
+		}}
+
+	cmd.AddCommand(testworkflows.NewApproveTestWorkflowExecutionCmd())
+
+	return cmd
+}
@@ -59,6 +59,45 @@ func CreateVariables(cmd *cobra.Command, ignoreSecretVariable bool) (vars map[st
 	return
 }
 
+// AddRetryPolicyFlags adds flags for the execution retry policy
+func AddRetryPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().Int32("retry-max-attempts", 0, "maximum number of execution attempts, including the first one")
+	cmd.Flags().String("retry-backoff", "", "delay before the next execution attempt, doubled after each retry, example: 10s")
+	cmd.Flags().StringArray("retry-on", []string{}, "execution statuses to retry, one of failed|timeout|aborted, failed and timeout by default")
+}
+
+// CreateRetryPolicy creates execution retry policy from the command flags
+func CreateRetryPolicy(cmd *cobra.Command) (*testkube.ExecutionRetryPolicy, error) {
+	maxAttempts, err := cmd.Flags().GetInt32("retry-max-attempts")
+	if err != nil {
+		return nil, err
+	}
+
+	if maxAttempts == 0 {
+		return nil, nil
+	}
+
+	backoff, err := cmd.Flags().GetString("retry-backoff")
+	if err != nil {
+		return nil, err
+	}
+
+	retryOn, err := cmd.Flags().GetStringArray("retry-on")
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &testkube.ExecutionRetryPolicy{
+		MaxAttempts: maxAttempts,
+		Backoff:     backoff,
+	}
+	for _, status := range retryOn {
+		policy.RetryOn = append(policy.RetryOn, testkube.ExecutionStatus(status))
+	}
+
+	return policy, policy.Validate()
+}
+
 func PopulateMasterFlags(cmd *cobra.Command, opts *HelmOptions) {
 	var (
 		apiURIPrefix, uiURIPrefix, agentURIPrefix, cloudRootDomain, proRootDomain string
@@ -0,0 +1,107 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+// ExecutionComparisonRenderer renders the comparison of two test, test suite or test workflow executions
+func ExecutionComparisonRenderer(client client.Client, ui *ui.UI, obj interface{}) error {
+	comparison, ok := obj.(testkube.ExecutionComparison)
+	if !ok {
+		return fmt.Errorf("can't use '%T' as testkube.ExecutionComparison in RenderObj for execution comparison", obj)
+	}
+
+	ui.Info("Execution comparison:")
+	ui.Warn("Base:       ", comparison.BaseId, comparison.BaseStatus, formatDurationMs(comparison.BaseDurationMs))
+	ui.Warn("Head:       ", comparison.HeadId, comparison.HeadStatus, formatDurationMs(comparison.HeadDurationMs))
+	ui.Warn("Threshold:  ", fmt.Sprintf("%.0f%%", comparison.Threshold*100))
+	if comparison.Regression {
+		ui.Alert("Execution is slower than the base execution beyond the threshold")
+	}
+
+	if len(comparison.NewlyFailing) > 0 {
+		ui.NL()
+		ui.Alert("Newly failing:")
+		for _, name := range comparison.NewlyFailing {
+			ui.Print("  - " + name)
+		}
+	}
+	if len(comparison.PerformanceRegressions) > 0 {
+		ui.NL()
+		ui.Alert("Performance regressions:")
+		for _, name := range comparison.PerformanceRegressions {
+			ui.Print("  - " + name)
+		}
+	}
+
+	renderComparisonEntries(ui, "Steps:", comparison.Steps)
+	renderComparisonEntries(ui, "Test cases:", comparison.Cases)
+	renderComparisonValues(ui, "Variables:", comparison.Variables)
+	renderComparisonValues(ui, "Config:", comparison.Config)
+	renderComparisonValues(ui, "Image references:", comparison.ImageRefs)
+	renderComparisonValues(ui, "Content:", comparison.Content)
+
+	if comparison.Logs != nil && (len(comparison.Logs.Added) > 0 || len(comparison.Logs.Removed) > 0) {
+		ui.NL()
+		ui.Info("Logs:")
+		for _, line := range comparison.Logs.Removed {
+			ui.Print("- " + line)
+		}
+		for _, line := range comparison.Logs.Added {
+			ui.Print("+ " + line)
+		}
+		if comparison.Logs.Truncated {
+			ui.Warn("Log differences are truncated")
+		}
+	}
+	return nil
+}
+
+func renderComparisonEntries(ui *ui.UI, title string, entries []testkube.ExecutionComparisonEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	d := [][]string{{"Name", "Base status", "Head status", "Base duration", "Head duration", "Change"}}
+	for _, entry := range entries {
+		change := entry.Change
+		if entry.Regression {
+			change += ", slower"
+		}
+		d = append(d, []string{
+			entry.Name,
+			entry.BaseStatus,
+			entry.HeadStatus,
+			formatDurationMs(entry.BaseDurationMs),
+			formatDurationMs(entry.HeadDurationMs),
+			change,
+		})
+	}
+	ui.NL()
+	ui.Info(title)
+	ui.Table(ui.NewArrayTable(d), ui.Writer)
+}
+
+func renderComparisonValues(ui *ui.UI, title string, values []testkube.ExecutionComparisonValue) {
+	if len(values) == 0 {
+		return
+	}
+	d := [][]string{{"Name", "Base", "Head", "Change"}}
+	for _, value := range values {
+		d = append(d, []string{value.Name, value.Base, value.Head, value.Change})
+	}
+	ui.NL()
+	ui.Info(title)
+	ui.Table(ui.NewArrayTable(d), ui.Writer)
+}
+
+func formatDurationMs(durationMs int64) string {
+	if durationMs <= 0 {
+		return ""
+	}
+	return (time.Duration(durationMs) * time.Millisecond).String()
+}
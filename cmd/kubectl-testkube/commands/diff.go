@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/tests"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testsuites"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflows"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/config"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "diff <resourceName>",
+		Short:       "Compare two executions",
+		Long:        "Compare two executions of the test, test suite or test workflow, to find newly failing cases and performance regressions",
+		Annotations: map[string]string{cmdGroupAnnotation: cmdGroupCommands},
+		Run: func(cmd *cobra.Command, args []string) {
+			err := cmd.Help()
+			ui.PrintOnError("Displaying help", err)
+		},
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			cfg, err := config.Load()
+			ui.ExitOnError("loading config", err)
+			common.UiContextHeader(cmd, cfg)
+
+			validator.PersistentPreRunVersionCheck(cmd, common.Version)
+		}}
+
+	cmd.AddCommand(tests.NewDiffExecutionCmd())
+	cmd.AddCommand(testsuites.NewDiffTestSuiteExecutionCmd())
+	cmd.AddCommand(testworkflows.NewDiffTestWorkflowExecutionCmd())
+
+	cmd.PersistentFlags().StringP("output", "o", "pretty", "output type can be one of json|yaml|pretty|go-template")
+	cmd.PersistentFlags().StringP("go-template", "", "{{.}}", "go template to render")
+
+	return cmd
+}
@@ -45,6 +45,7 @@ func NewGetCmd() *cobra.Command {
 	cmd.AddCommand(webhooks.NewGetWebhookCmd())
 	cmd.AddCommand(executors.NewGetExecutorCmd())
 	cmd.AddCommand(tests.NewGetExecutionCmd())
+	cmd.AddCommand(tests.NewGetQueueCmd())
 	cmd.AddCommand(artifacts.NewListArtifactsCmd())
 	cmd.AddCommand(testsuites.NewTestSuiteExecutionCmd())
 	cmd.AddCommand(testsources.NewGetTestSourceCmd())
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/migrate"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -21,5 +22,7 @@ func NewMigrateCmd() *cobra.Command {
 		},
 	}
 
+	cmd.AddCommand(migrate.NewTestsToWorkflowsCmd())
+
 	return cmd
 }
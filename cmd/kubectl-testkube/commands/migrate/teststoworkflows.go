@@ -0,0 +1,271 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	executorv1 "github.com/kubeshop/testkube-operator/api/executor/v1"
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	common2 "github.com/kubeshop/testkube/internal/common"
+	apiclient "github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	executorsmapper "github.com/kubeshop/testkube/pkg/mapper/executors"
+	testsmapper "github.com/kubeshop/testkube/pkg/mapper/tests"
+	testsuitesmapper "github.com/kubeshop/testkube/pkg/mapper/testsuites"
+	"github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowconverter"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+// NewTestsToWorkflowsCmd creates the 'testkube migrate tests-to-workflows' command
+func NewTestsToWorkflowsCmd() *cobra.Command {
+	var (
+		selector    string
+		testsOnly   bool
+		suitesOnly  bool
+		asTemplates bool
+		apply       bool
+		update      bool
+		outputDir   string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "tests-to-workflows",
+		Aliases: []string{"tests-to-testworkflows", "ttw"},
+		Short:   "Convert tests and test suites to test workflows",
+		Long: `Convert legacy tests and test suites to test workflows.
+Tests are converted to test workflows (or templates), and test suites to test workflows executing them.
+The definitions are printed as YAML, the parts which couldn't be translated are reported separately.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, _ []string) {
+			if testsOnly && suitesOnly {
+				ui.Failf("--tests and --testsuites flags are mutually exclusive")
+			}
+			if update && !apply {
+				ui.Failf("--update flag requires --apply flag")
+			}
+
+			namespace := cmd.Flag("namespace").Value.String()
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			executorDetails, err := client.ListExecutors("")
+			ui.ExitOnError("getting executors in namespace "+namespace, err)
+
+			convertTests := !suitesOnly
+			convertSuites := !testsOnly
+			converter := testworkflowconverter.New(mapExecutors(executorDetails)).
+				WithExecuteWorkflows(convertTests && !asTemplates)
+
+			var (
+				workflows []testworkflowsv1.TestWorkflow
+				templates []testworkflowsv1.TestWorkflowTemplate
+				issues    testworkflowconverter.Issues
+			)
+
+			if convertTests {
+				tests, err := client.ListTests(selector)
+				ui.ExitOnError("getting tests in namespace "+namespace, err)
+
+				for _, test := range tests {
+					cr := *testsmapper.MapUpsertToSpec(testkube.TestUpsertRequest(test))
+					if asTemplates {
+						template, testIssues := converter.ConvertTestToTemplate(cr)
+						templates = append(templates, template)
+						issues = append(issues, testIssues...)
+					} else {
+						workflow, testIssues := converter.ConvertTest(cr)
+						workflows = append(workflows, workflow)
+						issues = append(issues, testIssues...)
+					}
+				}
+			}
+
+			if convertSuites {
+				suites, err := client.ListTestSuites(selector)
+				ui.ExitOnError("getting test suites in namespace "+namespace, err)
+
+				for _, suite := range suites {
+					cr, err := testsuitesmapper.MapTestSuiteUpsertRequestToTestCRD(testkube.TestSuiteUpsertRequest{
+						Name:             suite.Name,
+						Namespace:        suite.Namespace,
+						Description:      suite.Description,
+						Before:           suite.Before,
+						Steps:            suite.Steps,
+						After:            suite.After,
+						Labels:           suite.Labels,
+						Schedule:         suite.Schedule,
+						Repeats:          suite.Repeats,
+						ExecutionRequest: suite.ExecutionRequest,
+					})
+					if err != nil {
+						issues = append(issues, testworkflowconverter.Issue{
+							Kind:    testworkflowconverter.KindTestSuite,
+							Name:    suite.Name,
+							Message: err.Error(),
+						})
+						continue
+					}
+
+					workflow, suiteIssues := converter.ConvertTestSuite(cr)
+					workflows = append(workflows, workflow)
+					issues = append(issues, suiteIssues...)
+				}
+			}
+
+			switch {
+			case apply:
+				applyResources(client, workflows, templates, update)
+			case outputDir != "":
+				writeResources(outputDir, workflows, templates)
+			default:
+				printResources(templates)
+				printResources(workflows)
+			}
+
+			if len(issues) > 0 {
+				ui.NL()
+				ui.Warn(fmt.Sprintf("%d problems found, the converted resources need to be adjusted manually:", len(issues)))
+				ui.Table(issues, os.Stderr)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&selector, "label", "l", "", "label selector of converted tests and test suites, e.g. app=tests")
+	cmd.Flags().BoolVar(&testsOnly, "tests", false, "convert only tests")
+	cmd.Flags().BoolVar(&suitesOnly, "testsuites", false, "convert only test suites")
+	cmd.Flags().BoolVar(&asTemplates, "template", false, "convert tests to test workflow templates")
+	cmd.Flags().BoolVar(&apply, "apply", false, "create the converted resources in the cluster")
+	cmd.Flags().BoolVar(&update, "update", false, "update the existing resources when applying")
+	cmd.Flags().StringVar(&outputDir, "output-dir", "", "directory to write the converted resources to, one file per resource")
+
+	return cmd
+}
+
+func mapExecutors(details []testkube.ExecutorDetails) (executors []executorv1.Executor) {
+	for _, detail := range details {
+		if detail.Executor == nil {
+			continue
+		}
+
+		executor := detail.Executor
+		executorCR := executorsmapper.MapAPIToCRD(testkube.ExecutorUpsertRequest{
+			Name:                 detail.Name,
+			ExecutorType:         executor.ExecutorType,
+			Types:                executor.Types,
+			Image:                executor.Image,
+			ImagePullSecrets:     executor.ImagePullSecrets,
+			Command:              executor.Command,
+			Args:                 executor.Args,
+			JobTemplate:          executor.JobTemplate,
+			JobTemplateReference: executor.JobTemplateReference,
+		})
+		executorCR.Spec.Slaves = executorsmapper.MapSlavesConfigsToCRD(executor.Slaves)
+		executors = append(executors, executorCR)
+	}
+
+	return executors
+}
+
+func printResources[T any](crs []T) {
+	if len(crs) == 0 {
+		return
+	}
+
+	data, err := common2.SerializeCRDs(crs, common2.SerializeOptions{
+		OmitCreationTimestamp: true,
+		CleanMeta:             true,
+	})
+	ui.ExitOnError("serializing resources", err)
+
+	fmt.Println(string(data))
+	fmt.Println("---")
+}
+
+func writeResources(dir string, workflows []testworkflowsv1.TestWorkflow, templates []testworkflowsv1.TestWorkflowTemplate) {
+	err := os.MkdirAll(dir, 0755)
+	ui.ExitOnError("creating output directory", err)
+
+	write := func(name string, cr interface{}) {
+		data, err := common2.SerializeCRD(cr, common2.SerializeOptions{
+			OmitCreationTimestamp: true,
+			CleanMeta:             true,
+		})
+		ui.ExitOnError("serializing "+name, err)
+
+		path := filepath.Join(dir, name+".yaml")
+		err = os.WriteFile(path, data, 0644)
+		ui.ExitOnError("writing "+path, err)
+	}
+
+	for _, template := range templates {
+		write("template-"+template.Name, template)
+	}
+	for _, workflow := range workflows {
+		write(workflow.Name, workflow)
+	}
+
+	ui.Success("Converted resources written to", dir)
+}
+
+func applyResources(client apiclient.Client, workflows []testworkflowsv1.TestWorkflow, templates []testworkflowsv1.TestWorkflowTemplate, update bool) {
+	existingWorkflows, err := client.ListTestWorkflows("")
+	ui.ExitOnError("getting test workflows", err)
+	workflowNames := make(map[string]struct{}, len(existingWorkflows))
+	for _, workflow := range existingWorkflows {
+		workflowNames[workflow.Name] = struct{}{}
+	}
+
+	existingTemplates, err := client.ListTestWorkflowTemplates("")
+	ui.ExitOnError("getting test workflow templates", err)
+	templateNames := make(map[string]struct{}, len(existingTemplates))
+	for _, template := range existingTemplates {
+		templateNames[template.Name] = struct{}{}
+	}
+
+	failed := 0
+	for _, cr := range templates {
+		var err error
+		template := testworkflows.MapTestWorkflowTemplateKubeToAPI(cr)
+		_, exists := templateNames[template.Name]
+		switch {
+		case exists && !update:
+			ui.Warn("Test workflow template already exists, skipping:", template.Name)
+		case exists:
+			_, err = client.UpdateTestWorkflowTemplate(template)
+		default:
+			_, err = client.CreateTestWorkflowTemplate(template)
+		}
+		if err != nil {
+			ui.Errf("applying test workflow template %s: %s", template.Name, err)
+			failed++
+		}
+	}
+
+	for _, cr := range workflows {
+		var err error
+		workflow := testworkflows.MapTestWorkflowKubeToAPI(cr)
+		_, exists := workflowNames[workflow.Name]
+		switch {
+		case exists && !update:
+			ui.Warn("Test workflow already exists, skipping:", workflow.Name)
+		case exists:
+			_, err = client.UpdateTestWorkflow(workflow)
+		default:
+			_, err = client.CreateTestWorkflow(workflow)
+		}
+		if err != nil {
+			ui.Errf("applying test workflow %s: %s", workflow.Name, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		ui.Failf("failed to apply %d resources", failed)
+	}
+	ui.Success("Converted resources applied")
+}
@@ -32,12 +32,16 @@ func init() {
 	// New commands
 	RootCmd.AddCommand(NewCreateCmd())
 	RootCmd.AddCommand(NewUpdateCmd())
+	RootCmd.AddCommand(NewApplyCmd())
+	RootCmd.AddCommand(NewValidateCmd())
 
 	RootCmd.AddCommand(NewGetCmd())
 	RootCmd.AddCommand(NewSetCmd())
 	RootCmd.AddCommand(NewRunCmd())
 	RootCmd.AddCommand(NewDeleteCmd())
 	RootCmd.AddCommand(NewAbortCmd())
+	RootCmd.AddCommand(NewApproveCmd())
+	RootCmd.AddCommand(NewDiffCmd())
 
 	RootCmd.AddCommand(NewEnableCmd())
 	RootCmd.AddCommand(NewDisableCmd())
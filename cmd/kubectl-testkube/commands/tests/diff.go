@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/pkg/compare"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewDiffExecutionCmd() *cobra.Command {
+	var threshold float64
+
+	cmd := &cobra.Command{
+		Use:     "execution <baseExecution> <headExecution>",
+		Aliases: []string{"executions", "e"},
+		Short:   "Compare two test executions",
+		Long:    "Compare the status and duration of the test cases, variables, config, content and output of two test executions, highlighting newly failing cases and performance regressions",
+		Args:    cobra.ExactArgs(2),
+
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			comparison, err := client.CompareExecutions(args[0], args[1], threshold)
+			ui.ExitOnError("comparing executions", err)
+
+			err = render.Obj(cmd, comparison, os.Stdout, render.ExecutionComparisonRenderer)
+			ui.ExitOnError("rendering obj", err)
+		},
+	}
+
+	cmd.Flags().Float64Var(&threshold, "threshold", compare.DefaultThreshold, "minimum relative duration increase to report a performance regression, i.e. 0.2 for 20%")
+
+	return cmd
+}
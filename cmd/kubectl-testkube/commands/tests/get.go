@@ -9,7 +9,9 @@ import (
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/tests/renderer"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/crd"
+	"github.com/kubeshop/testkube/pkg/flaky"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -18,6 +20,8 @@ func NewGetTestsCmd() *cobra.Command {
 		selectors   []string
 		noExecution bool
 		crdOnly     bool
+		showFlaky   bool
+		flakyRuns   int
 	)
 
 	cmd := &cobra.Command{
@@ -30,6 +34,30 @@ func NewGetTestsCmd() *cobra.Command {
 			client, _, err := common.GetClient(cmd)
 			ui.ExitOnError("getting client", err)
 
+			if showFlaky {
+				var names []string
+				if len(args) > 0 {
+					names = args[:1]
+				} else {
+					tests, err := client.ListTests(strings.Join(selectors, ","))
+					ui.ExitOnError("getting all tests in namespace "+namespace, err)
+					for _, test := range tests {
+						names = append(names, test.Name)
+					}
+				}
+
+				reports := make(testkube.FlakyTestsReports, 0, len(names))
+				for _, name := range names {
+					report, err := client.GetTestFlakyCases(name, flakyRuns)
+					ui.ExitOnError("getting flaky test cases for test "+name, err)
+					reports = append(reports, report)
+				}
+
+				err = render.List(cmd, reports, os.Stdout)
+				ui.PrintOnError("Rendering list", err)
+				return
+			}
+
 			var name string
 			firstEntry := true
 			if len(args) > 0 {
@@ -96,6 +124,8 @@ func NewGetTestsCmd() *cobra.Command {
 	cmd.Flags().StringSliceVarP(&selectors, "label", "l", nil, "label key value pair: --label key1=value1")
 	cmd.Flags().BoolVar(&noExecution, "no-execution", false, "don't show latest execution")
 	cmd.Flags().BoolVar(&crdOnly, "crd-only", false, "show only test crd")
+	cmd.Flags().BoolVar(&showFlaky, "flaky", false, "show test cases changing results between the latest executions")
+	cmd.Flags().IntVar(&flakyRuns, "flaky-runs", flaky.DefaultLimit, "number of the latest executions to analyze for flaky test cases")
 
 	return cmd
 }
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewGetQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "queue [executionID]",
+		Aliases: []string{"queued-executions"},
+		Short:   "Lists or gets queued test executions",
+		Long:    `Getting list of test executions waiting in the execution queue, in the order they will be started`,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			if len(args) == 1 {
+				execution, err := client.GetQueuedExecution(args[0])
+				ui.ExitOnError("getting queued execution: "+args[0], err)
+				err = render.List(cmd, testkube.QueuedExecutions{execution}, os.Stdout)
+				ui.ExitOnError("rendering", err)
+				return
+			}
+
+			queue, err := client.ListQueuedExecutions()
+			ui.ExitOnError("getting queued executions", err)
+			err = render.List(cmd, queue, os.Stdout)
+			ui.ExitOnError("rendering", err)
+		},
+	}
+
+	return cmd
+}
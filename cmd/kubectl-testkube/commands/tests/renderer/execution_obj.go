@@ -27,6 +27,17 @@ func ExecutionRenderer(client client.Client, ui *ui.UI, obj interface{}) error {
 	if execution.ExecutionResult != nil && execution.ExecutionResult.Status != nil {
 		ui.Warn("Status:           ", string(*execution.ExecutionResult.Status))
 	}
+	if execution.IsQueued() {
+		if queued, err := client.GetQueuedExecution(execution.Id); err == nil {
+			ui.Warn("Queue position:   ", fmt.Sprintf("%d", queued.Position))
+		}
+	}
+	if execution.Attempt != 0 && execution.RetryPolicy != nil {
+		ui.Warn("Attempt:          ", fmt.Sprintf("%d/%d", execution.Attempt, execution.RetryPolicy.MaxAttempts))
+	}
+	if execution.RetryOf != "" {
+		ui.Warn("Retry of:         ", execution.RetryOf)
+	}
 	ui.Warn("Start time:       ", execution.StartTime.String())
 	ui.Warn("End time:         ", execution.EndTime.String())
 	ui.Warn("Duration:         ", execution.Duration)
@@ -78,6 +78,7 @@ func NewRunTestCmd() *cobra.Command {
 		slavePodTemplate                   string
 		slavePodTemplateReference          string
 		executionNamespace                 string
+		priority                           int32
 	)
 
 	cmd := &cobra.Command{
@@ -127,8 +128,12 @@ func NewRunTestCmd() *cobra.Command {
 				ExecutePostRunScriptBeforeScraping: executePostRunScriptBeforeScraping,
 				SourceScripts:                      sourceScripts,
 				ExecutionNamespace:                 executionNamespace,
+				Priority:                           priority,
 			}
 
+			options.RetryPolicy, err = common.CreateRetryPolicy(cmd)
+			ui.ExitOnError("getting retry policy", err)
+
 			var fields = []struct {
 				source      string
 				title       string
@@ -406,6 +411,8 @@ func NewRunTestCmd() *cobra.Command {
 	cmd.Flags().StringVar(&slavePodTemplate, "slave-pod-template", "", "slave pod template file path for extensions to slave pod template")
 	cmd.Flags().StringVar(&slavePodTemplateReference, "slave-pod-template-reference", "", "reference to slave pod template to use for the test")
 	cmd.Flags().StringVar(&executionNamespace, "execution-namespace", "", "namespace for test execution (Pro edition only)")
+	cmd.Flags().Int32Var(&priority, "priority", 0, "execution priority in the execution queue, higher values are started first")
+	common.AddRetryPolicyFlags(cmd)
 
 	return cmd
 }
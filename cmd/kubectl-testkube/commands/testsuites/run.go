@@ -108,6 +108,9 @@ func NewRunTestSuiteCmd() *cobra.Command {
 			options.ExecutionVariables, err = common.CreateVariables(cmd, false)
 			ui.WarnOnError("getting variables", err)
 
+			options.RetryPolicy, err = common.CreateRetryPolicy(cmd)
+			ui.ExitOnError("getting retry policy", err)
+
 			if gitBranch != "" || gitCommit != "" || gitPath != "" || gitWorkingDir != "" {
 				options.ContentRequest = &testkube.TestContentRequest{
 					Repository: &testkube.RepositoryParameters{
@@ -215,6 +218,7 @@ func NewRunTestSuiteCmd() *cobra.Command {
 	cmd.Flags().StringVar(&format, "format", "folder", "data format for storing files, one of folder|archive")
 	cmd.Flags().StringArrayVarP(&masks, "mask", "", []string{}, "regexp to filter downloaded files, single or comma separated, like report/.* or .*\\.json,.*\\.js$")
 	cmd.Flags().BoolVarP(&silentMode, "silent", "", false, "don't print intermediate test suite execution")
+	common.AddRetryPolicyFlags(cmd)
 
 	return cmd
 }
@@ -7,14 +7,21 @@ import (
 
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
 func NewAbortTestWorkflowExecutionCmd() *cobra.Command {
-	return &cobra.Command{
+	var (
+		step string
+		skip bool
+	)
+
+	cmd := &cobra.Command{
 		Use:     "testworkflowexecution <executionName>",
 		Aliases: []string{"twe", "testworkflows-execution", "testworkflow-execution"},
 		Short:   "Abort test workflow execution",
+		Long:    "Abort test workflow execution, or only a single step of it with --step flag, while the rest of the execution continues.\nThe single step is stopped after the running pod receives the request, which may take up to about a minute.",
 		Args:    validator.ExecutionName,
 
 		Run: func(cmd *cobra.Command, args []string) {
@@ -26,12 +33,50 @@ func NewAbortTestWorkflowExecutionCmd() *cobra.Command {
 			execution, err := client.GetTestWorkflowExecution(executionID)
 			ui.ExitOnError("get execution failed", err)
 
-			err = client.AbortTestWorkflowExecution(execution.Workflow.Name, execution.Id)
-			ui.ExitOnError(fmt.Sprintf("aborting testworkflow execution %s", executionID), err)
+			if step == "" {
+				if skip {
+					ui.Failf("--skip flag requires the step to be passed with --step flag")
+				}
+				err = client.AbortTestWorkflowExecution(execution.Workflow.Name, execution.Id)
+				ui.ExitOnError(fmt.Sprintf("aborting testworkflow execution %s", executionID), err)
+
+				ui.SuccessAndExit("Succesfully aborted test workflow execution", executionID)
+			}
+
+			ref := findStepRef(execution.Signature, step)
+			if ref == "" {
+				ui.Failf("step %s not found in test workflow execution %s", step, executionID)
+			}
+			if skip {
+				err = client.SkipTestWorkflowExecutionStep(execution.Id, ref)
+				ui.ExitOnError(fmt.Sprintf("skipping step %s of testworkflow execution %s", step, executionID), err)
+
+				ui.SuccessAndExit("Succesfully skipped test workflow execution step", step)
+			}
+			err = client.AbortTestWorkflowExecutionStep(execution.Id, ref)
+			ui.ExitOnError(fmt.Sprintf("aborting step %s of testworkflow execution %s", step, executionID), err)
 
-			ui.SuccessAndExit("Succesfully aborted test workflow execution", executionID)
+			ui.SuccessAndExit("Succesfully aborted test workflow execution step", step)
 		},
 	}
+
+	cmd.Flags().StringVar(&step, "step", "", "reference or name of the single step to abort")
+	cmd.Flags().BoolVar(&skip, "skip", false, "skip the step instead of aborting it")
+
+	return cmd
+}
+
+// findStepRef finds the step reference in the execution signature, by its reference or name
+func findStepRef(signature []testkube.TestWorkflowSignature, step string) string {
+	for _, s := range signature {
+		if s.Ref == step || s.Name == step {
+			return s.Ref
+		}
+		if ref := findStepRef(s.Children, step); ref != "" {
+			return ref
+		}
+	}
+	return ""
 }
 
 func NewAbortTestWorkflowExecutionsCmd() *cobra.Command {
@@ -0,0 +1,59 @@
+package testworkflows
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewApproveTestWorkflowExecutionCmd() *cobra.Command {
+	var (
+		reject bool
+		inputs map[string]string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "testworkflowexecution <executionName> <step>",
+		Aliases: []string{"twe", "testworkflows-execution", "testworkflow-execution"},
+		Short:   "Approve test workflow execution paused at the approval step",
+		Long:    "Approve test workflow execution paused at the approval step, or reject it with --reject flag. The step may be passed by its reference or name.",
+		Args:    cobra.ExactArgs(2),
+
+		Run: func(cmd *cobra.Command, args []string) {
+			executionID, step := args[0], args[1]
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			execution, err := client.GetTestWorkflowExecution(executionID)
+			ui.ExitOnError("get execution failed", err)
+
+			ref := findStepRef(execution.Signature, step)
+			if ref == "" {
+				ui.Failf("step %s not found in test workflow execution %s", step, executionID)
+			}
+
+			if reject {
+				if len(inputs) > 0 {
+					ui.Failf("--input flag can't be used with --reject flag")
+				}
+				err = client.RejectTestWorkflowExecutionStep(execution.Id, ref)
+				ui.ExitOnError(fmt.Sprintf("rejecting step %s of testworkflow execution %s", step, executionID), err)
+
+				ui.SuccessAndExit("Successfully rejected test workflow execution step", step)
+			}
+			err = client.ApproveTestWorkflowExecutionStep(execution.Id, ref, inputs)
+			ui.ExitOnError(fmt.Sprintf("approving step %s of testworkflow execution %s", step, executionID), err)
+
+			ui.SuccessAndExit("Successfully approved test workflow execution step", step)
+		},
+	}
+
+	cmd.Flags().BoolVar(&reject, "reject", false, "reject the step instead of approving it")
+	cmd.Flags().StringToStringVarP(&inputs, "input", "i", map[string]string{}, "values requested by the approval step in a form of name1=val1")
+
+	return cmd
+}
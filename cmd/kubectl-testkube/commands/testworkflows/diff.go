@@ -0,0 +1,39 @@
+package testworkflows
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/pkg/compare"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewDiffTestWorkflowExecutionCmd() *cobra.Command {
+	var threshold float64
+
+	cmd := &cobra.Command{
+		Use:     "testworkflowexecution <baseExecution> <headExecution>",
+		Aliases: []string{"testworkflowexecutions", "twe", "tw-execution", "twexecution"},
+		Short:   "Compare two test workflow executions",
+		Long:    "Compare the status and duration of the steps and test cases, variables, config, image references, Git revisions and logs of two test workflow executions, highlighting newly failing cases and performance regressions",
+		Args:    cobra.ExactArgs(2),
+
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			comparison, err := client.CompareTestWorkflowExecutions(args[0], args[1], threshold)
+			ui.ExitOnError("comparing test workflow executions", err)
+
+			err = render.Obj(cmd, comparison, os.Stdout, render.ExecutionComparisonRenderer)
+			ui.ExitOnError("rendering obj", err)
+		},
+	}
+
+	cmd.Flags().Float64Var(&threshold, "threshold", compare.DefaultThreshold, "minimum relative duration increase to report a performance regression, i.e. 0.2 for 20%")
+
+	return cmd
+}
@@ -1,8 +1,11 @@
 package testworkflows
 
 import (
+	"fmt"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,6 +23,7 @@ func NewGetTestWorkflowsCmd() *cobra.Command {
 	var (
 		selectors []string
 		crdOnly   bool
+		nextRuns  int
 	)
 
 	cmd := &cobra.Command{
@@ -64,11 +68,37 @@ func NewGetTestWorkflowsCmd() *cobra.Command {
 					err = render.Obj(cmd, *workflow.LatestExecution, os.Stdout, renderer.TestWorkflowExecutionRenderer)
 					ui.ExitOnError("rendering obj", err)
 				}
+
+				if nextRuns > 0 {
+					runs, err := client.GetTestWorkflowNextRuns(name, nextRuns)
+					ui.ExitOnError("getting next runs of test workflow", err)
+					printNextRuns(runs)
+				}
 			}
 		},
 	}
 	cmd.Flags().StringSliceVarP(&selectors, "label", "l", nil, "label key value pair: --label key1=value1")
 	cmd.Flags().BoolVar(&crdOnly, "crd-only", false, "show only test workflow crd")
+	cmd.Flags().IntVar(&nextRuns, "next-runs", 0, "show given number of the upcoming scheduled runs")
 
 	return cmd
 }
+
+func printNextRuns(runs []testkube.TestWorkflowScheduledRun) {
+	ui.NL()
+	ui.Info("Next runs")
+	if len(runs) == 0 {
+		ui.Warn("There are no scheduled runs")
+		return
+	}
+	d := [][]string{{"Schedule", "Run at", "Config"}}
+	for _, run := range runs {
+		config := make([]string, 0, len(run.Config))
+		for k, v := range run.Config {
+			config = append(config, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(config)
+		d = append(d, []string{run.Schedule, run.RunAt.Local().Format(time.RFC3339), strings.Join(config, ", ")})
+	}
+	ui.Table(ui.NewArrayTable(d), ui.Writer)
+}
@@ -2,6 +2,7 @@ package renderer
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/pkg/errors"
 
@@ -45,6 +46,33 @@ func TestWorkflowExecutionRenderer(client client.Client, ui *ui.UI, obj interfac
 		ui.Err(errors.New(execution.Result.Initialization.ErrorMessage))
 	}
 
+	if execution.Result != nil {
+		renderStepOutputs(ui, execution.Signature, execution.Result.Steps)
+	}
+
 	return nil
 
 }
+
+func renderStepOutputs(ui *ui.UI, signature []testkube.TestWorkflowSignature, steps map[string]testkube.TestWorkflowStepResult) {
+	for _, sig := range signature {
+		outputs := steps[sig.Ref].Outputs
+		if len(outputs) > 0 {
+			name := sig.Name
+			if name == "" {
+				name = sig.Category
+			}
+			ui.NL()
+			ui.Info(fmt.Sprintf("Outputs of %s (%s):", name, sig.Ref))
+			keys := make([]string, 0, len(outputs))
+			for k := range outputs {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				ui.Warn(k+":", outputs[k])
+			}
+		}
+		renderStepOutputs(ui, sig.Children, steps)
+	}
+}
@@ -25,15 +25,29 @@ func NewRunTestWorkflowCmd() *cobra.Command {
 		executionName string
 		config        map[string]string
 		watchEnabled  bool
+		local         bool
+		files         []string
 	)
 
 	cmd := &cobra.Command{
 		Use:     "testworkflow [name]",
 		Aliases: []string{"testworkflows", "tw"},
-		Args:    cobra.ExactArgs(1),
+		Args:    cobra.MaximumNArgs(1),
 		Short:   "Starts test workflow execution",
+		Long: `Starts test workflow execution in the cluster.
+
+With --local flag, the test workflow is read from the file and executed with the local Docker daemon instead,
+e.g. "kubectl testkube run testworkflow --local -f workflow.yaml". The logs are always watched in local mode,
+so the argument is treated as the file path then.`,
 
 		Run: func(cmd *cobra.Command, args []string) {
+			if local {
+				os.Exit(runLocal(append(files, args...), config))
+			}
+			if len(args) != 1 {
+				ui.Failf("pass the test workflow name")
+			}
+
 			namespace := cmd.Flag("namespace").Value.String()
 			client, _, err := common.GetClient(cmd)
 			ui.ExitOnError("getting client", err)
@@ -64,6 +78,8 @@ func NewRunTestWorkflowCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&executionName, "name", "n", "", "execution name, if empty will be autogenerated")
 	cmd.Flags().StringToStringVarP(&config, "config", "", map[string]string{}, "configuration variables in a form of name1=val1 passed to executor")
 	cmd.Flags().BoolVarP(&watchEnabled, "watch", "f", false, "watch for changes after start")
+	cmd.Flags().BoolVar(&local, "local", false, "run the test workflow from the file with the local Docker daemon, without the cluster")
+	cmd.Flags().StringArrayVar(&files, "file", []string{}, "file with the test workflow and test workflow templates to run locally")
 
 	return cmd
 }
@@ -78,7 +94,11 @@ func uiWatch(execution testkube.TestWorkflowExecution, client apiclientv1.Client
 		execution.StatusAt = result.FinishedAt
 	}
 
-	// Display message depending on the result
+	return uiResult(result)
+}
+
+// uiResult displays message depending on the result, returning the exit code
+func uiResult(result *testkube.TestWorkflowResult) int {
 	switch {
 	case result.Initialization.ErrorMessage != "":
 		ui.Warn("test workflow execution failed:\n")
@@ -175,6 +195,11 @@ func watchTestWorkflowLogs(id string, signature []testkube.TestWorkflowSignature
 	notifications, err := client.GetTestWorkflowExecutionNotifications(id)
 	ui.ExitOnError("getting logs from executor", err)
 
+	return printTestWorkflowLogs(signature, notifications), err
+}
+
+// printTestWorkflowLogs prints the logs and step statuses, returning the last result
+func printTestWorkflowLogs(signature []testkube.TestWorkflowSignature, notifications chan testkube.TestWorkflowExecutionNotification) *testkube.TestWorkflowResult {
 	steps := flattenSignatures(signature)
 
 	var result *testkube.TestWorkflowResult
@@ -209,5 +234,5 @@ func watchTestWorkflowLogs(id string, signature []testkube.TestWorkflowSignature
 
 	ui.NL()
 
-	return result, err
+	return result
 }
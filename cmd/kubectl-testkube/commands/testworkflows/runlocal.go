@@ -0,0 +1,158 @@
+package testworkflows
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/apply"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+	"github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowcontroller"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowdocker"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowresolver"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+const localNamespace = "default"
+
+// runLocal executes the TestWorkflow defined in the files with the local Docker daemon,
+// the files may contain the TestWorkflowTemplates used by it too
+func runLocal(files []string, config map[string]string) int {
+	workflow, templates := loadLocalResources(files)
+
+	client := testworkflowdocker.NewClient()
+	id := primitive.NewObjectID().Hex()
+	scheduledAt := time.Now()
+	bundle, err := buildLocalBundle(context.Background(), client, workflow, templates, config, id)
+	ui.ExitOnError("processing test workflow "+workflow.Name, err)
+
+	ctrl, err := testworkflowcontroller.NewLocal(context.Background(), client, bundle, id, scheduledAt)
+	ui.ExitOnError("preparing local execution", err)
+	defer func() {
+		if err := ctrl.Cleanup(context.Background()); err != nil {
+			ui.Errf("%s", err)
+		}
+	}()
+
+	// Abort the execution on interruption
+	interrupted, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-interrupted.Done()
+		_ = ctrl.Abort(context.Background())
+	}()
+
+	ui.Info("Running test workflow locally", workflow.Name)
+	ui.ExitOnError("starting local execution", ctrl.Start())
+	notifications := make(chan testkube.TestWorkflowExecutionNotification)
+	go func() {
+		defer close(notifications)
+		for v := range ctrl.Watch(context.Background()).Stream(context.Background()).Channel() {
+			if v.Error != nil {
+				notifications <- testkube.TestWorkflowExecutionNotification{
+					Ts:  time.Now(),
+					Log: fmt.Sprintf("%s %s\n", time.Now().UTC().Format(testworkflowcontroller.KubernetesLogTimeFormat), v.Error.Error()),
+				}
+				continue
+			}
+			notifications <- v.Value.ToInternal()
+		}
+	}()
+
+	result := printTestWorkflowLogs(testworkflowprocessor.MapSignatureListToInternal(bundle.Signature), notifications)
+	if result == nil {
+		ui.Errf("test workflow execution finished without result")
+		return 1
+	}
+	return uiResult(result)
+}
+
+// loadLocalResources reads the TestWorkflow and the TestWorkflowTemplates from the files
+func loadLocalResources(files []string) (workflow testworkflowsv1.TestWorkflow, templates map[string]testworkflowsv1.TestWorkflowTemplate) {
+	if len(files) == 0 {
+		ui.Failf("pass the file with test workflow definition in --file flag")
+	}
+
+	resources, errs := apply.LoadResources(files, false, localNamespace)
+	if len(errs) > 0 {
+		for _, err := range errs {
+			ui.Errf("%s", err)
+		}
+		ui.Failf("found %d validation errors", len(errs))
+	}
+
+	var workflows []testworkflowsv1.TestWorkflow
+	templates = make(map[string]testworkflowsv1.TestWorkflowTemplate)
+	for _, resource := range resources {
+		switch {
+		case resource.Workflow != nil:
+			workflows = append(workflows, testworkflows.MapTestWorkflowAPIToKube(*resource.Workflow))
+		case resource.Template != nil:
+			templates[resource.Name] = testworkflows.MapTestWorkflowTemplateAPIToKube(*resource.Template)
+		}
+	}
+
+	if len(workflows) != 1 {
+		ui.Failf("expected exactly one test workflow in the files, found %d", len(workflows))
+	}
+	return workflows[0], templates
+}
+
+// buildLocalBundle resolves the TestWorkflow and processes it to the containers to run
+func buildLocalBundle(ctx context.Context, client testworkflowdocker.Client, workflow testworkflowsv1.TestWorkflow,
+	templates map[string]testworkflowsv1.TestWorkflowTemplate, config map[string]string, id string) (*testworkflowprocessor.Bundle, error) {
+	for name := range testworkflowresolver.ListTemplates(&workflow) {
+		if _, ok := templates[name]; !ok {
+			return nil, fmt.Errorf("template %s not found, pass it in --file flag", name)
+		}
+	}
+
+	_, err := testworkflowresolver.ApplyWorkflowConfig(&workflow, testworkflows.MapConfigValueAPIToKube(config))
+	if err != nil {
+		return nil, errors.Wrap(err, "configuration")
+	}
+
+	err = testworkflowresolver.ApplyTemplates(&workflow, templates)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving error")
+	}
+
+	if hasParallelStep(workflow.Spec.Setup) || hasParallelStep(workflow.Spec.Steps) || hasParallelStep(workflow.Spec.After) {
+		return nil, errors.New("parallel steps are not supported locally")
+	}
+
+	machine := expressionstcl.NewMachine().
+		RegisterStringMap("internal", map[string]string{
+			"namespace":     localNamespace,
+			"cloud.enabled": "false",
+		}).
+		RegisterStringMap("workflow", map[string]string{
+			"name": workflow.Name,
+		}).
+		RegisterStringMap("execution", map[string]string{
+			"id": id,
+		})
+
+	return testworkflowprocessor.NewFullFeatured(testworkflowdocker.NewInspector(client)).
+		Bundle(ctx, &workflow, machine)
+}
+
+// hasParallelStep checks if any of the steps needs to spawn the parallel executions through the API
+func hasParallelStep(steps []testworkflowsv1.Step) bool {
+	for _, step := range steps {
+		if step.Parallel != nil || hasParallelStep(step.Setup) || hasParallelStep(step.Steps) {
+			return true
+		}
+	}
+	return false
+}
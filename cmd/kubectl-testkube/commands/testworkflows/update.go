@@ -0,0 +1,82 @@
+package testworkflows
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	common2 "github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewUpdateTestWorkflowCmd() *cobra.Command {
+	var (
+		name     string
+		filePath string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "testworkflow [name]",
+		Aliases: []string{"testworkflows", "tw"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Update test workflow",
+		Long:    `Update existing test workflow with the specification from file or stdin`,
+
+		Run: func(cmd *cobra.Command, args []string) {
+			namespace := cmd.Flag("namespace").Value.String()
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			var input io.Reader
+			if filePath == "" {
+				fi, err := os.Stdin.Stat()
+				ui.ExitOnError("reading stdin", err)
+				if fi.Mode()&os.ModeDevice != 0 {
+					ui.Failf("you need to pass stdin or --file argument with file path")
+				}
+				input = cmd.InOrStdin()
+			} else {
+				file, err := os.Open(filePath)
+				ui.ExitOnError("reading "+filePath+" file", err)
+				input = file
+			}
+
+			bytes, err := io.ReadAll(input)
+			ui.ExitOnError("reading input", err)
+
+			obj := new(testworkflowsv1.TestWorkflow)
+			err = common2.DeserializeCRD(obj, bytes)
+			ui.ExitOnError("deserializing input", err)
+			if obj.Kind != "" && obj.Kind != "TestWorkflow" {
+				ui.Failf("Only TestWorkflow objects are accepted. Received: %s", obj.Kind)
+			}
+			common2.AppendTypeMeta("TestWorkflow", testworkflowsv1.GroupVersion, obj)
+			obj.Namespace = namespace
+			if name != "" {
+				obj.Name = name
+			}
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			workflow, _ := client.GetTestWorkflow(obj.Name)
+			if workflow.Name == "" {
+				ui.Failf("Test workflow with name '%s' not exists in namespace %s", obj.Name, namespace)
+			}
+
+			_, err = client.UpdateTestWorkflow(testworkflows.MapTestWorkflowKubeToAPI(*obj))
+			ui.ExitOnError("updating test workflow "+obj.Name+" in namespace "+obj.Namespace, err)
+			ui.Success("Test workflow updated", namespace, "/", obj.Name)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "test workflow name")
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "file path to get the test workflow specification")
+
+	return cmd
+}
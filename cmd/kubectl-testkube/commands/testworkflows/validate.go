@@ -0,0 +1,75 @@
+package testworkflows
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	common2 "github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewValidateTestWorkflowCmd() *cobra.Command {
+	var filePath string
+
+	cmd := &cobra.Command{
+		Use:     "testworkflow",
+		Aliases: []string{"testworkflows", "tw"},
+		Args:    cobra.NoArgs,
+		Short:   "Validate test workflow",
+		Long:    `Validate test workflow specification from file or stdin, without executing it`,
+
+		Run: func(cmd *cobra.Command, _ []string) {
+			namespace := cmd.Flag("namespace").Value.String()
+
+			var input io.Reader
+			if filePath == "" {
+				fi, err := os.Stdin.Stat()
+				ui.ExitOnError("reading stdin", err)
+				if fi.Mode()&os.ModeDevice != 0 {
+					ui.Failf("you need to pass stdin or --file argument with file path")
+				}
+				input = cmd.InOrStdin()
+			} else {
+				file, err := os.Open(filePath)
+				ui.ExitOnError("reading "+filePath+" file", err)
+				input = file
+			}
+
+			bytes, err := io.ReadAll(input)
+			ui.ExitOnError("reading input", err)
+
+			obj := new(testworkflowsv1.TestWorkflow)
+			err = common2.DeserializeCRD(obj, bytes)
+			ui.ExitOnError("deserializing input", err)
+			if obj.Kind != "" && obj.Kind != "TestWorkflow" {
+				ui.Failf("Only TestWorkflow objects are accepted. Received: %s", obj.Kind)
+			}
+			common2.AppendTypeMeta("TestWorkflow", testworkflowsv1.GroupVersion, obj)
+			obj.Namespace = namespace
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			result, err := client.ValidateTestWorkflow(testworkflows.MapTestWorkflowKubeToAPI(*obj))
+			ui.ExitOnError("validating test workflow "+obj.Name+" in namespace "+obj.Namespace, err)
+
+			if len(result.Issues) > 0 {
+				ui.Table(result, os.Stdout)
+				ui.NL()
+			}
+			if !result.Valid {
+				ui.Failf("Test workflow %s is invalid", obj.Name)
+			}
+			ui.Success("Test workflow is valid", obj.Name)
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "file path to get the test workflow specification")
+
+	return cmd
+}
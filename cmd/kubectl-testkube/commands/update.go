@@ -10,6 +10,8 @@ import (
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/tests"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testsources"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testsuites"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflows"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testworkflowtemplates"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/webhooks"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/config"
 	"github.com/kubeshop/testkube/pkg/ui"
@@ -39,6 +41,8 @@ func NewUpdateCmd() *cobra.Command {
 	cmd.AddCommand(executors.UpdateExecutorCmd())
 	cmd.AddCommand(webhooks.UpdateWebhookCmd())
 	cmd.AddCommand(templates.UpdateTemplateCmd())
+	cmd.AddCommand(testworkflows.NewUpdateTestWorkflowCmd())
+	cmd.AddCommand(testworkflowtemplates.NewUpdateTestWorkflowTemplateCmd())
 
 	return cmd
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/kubeshop/testkube/internal/common"
 	"github.com/kubeshop/testkube/pkg/agent"
 	"github.com/kubeshop/testkube/pkg/event/bus"
+	"github.com/kubeshop/testkube/pkg/k8sclient"
 	"github.com/kubeshop/testkube/pkg/log"
 	"github.com/kubeshop/testkube/pkg/logs"
 	"github.com/kubeshop/testkube/pkg/logs/adapter"
@@ -24,6 +25,9 @@ import (
 	"github.com/kubeshop/testkube/pkg/logs/pb"
 	"github.com/kubeshop/testkube/pkg/logs/repository"
 	"github.com/kubeshop/testkube/pkg/logs/state"
+	"github.com/kubeshop/testkube/pkg/redact"
+	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/storage/filesystem"
 	"github.com/kubeshop/testkube/pkg/storage/minio"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
@@ -73,13 +77,23 @@ func main() {
 	js := Must(jetstream.New(nc))
 	logStream := Must(client.NewNatsLogStream(nc))
 
-	minioClient := newStorageClient(cfg)
-	if err := minioClient.Connect(); err != nil {
-		log.Fatalw("error connecting to minio", "error", err)
-	}
+	var storageClient storage.Client
+	if cfg.StorageType == storage.TypeFilesystem {
+		filesystemClient := filesystem.NewClient(cfg.StoragePath, cfg.StorageBucket)
+		if err := filesystemClient.Connect(); err != nil {
+			log.Fatalw("error preparing filesystem storage", "error", err)
+		}
+		storageClient = filesystemClient
+	} else {
+		minioClient := newStorageClient(cfg)
+		if err := minioClient.Connect(); err != nil {
+			log.Fatalw("error connecting to minio", "error", err)
+		}
 
-	if err := minioClient.SetExpirationPolicy(cfg.StorageExpiration); err != nil {
-		log.Warnw("error setting expiration policy", "error", err)
+		if err := minioClient.SetExpirationPolicy(cfg.StorageExpiration); err != nil {
+			log.Warnw("error setting expiration policy", "error", err)
+		}
+		storageClient = minioClient
 	}
 
 	kv := Must(js.CreateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: cfg.KVBucketName}))
@@ -88,12 +102,20 @@ func main() {
 	svc := logs.NewLogsService(nc, js, state, logStream).
 		WithHttpAddress(cfg.HttpAddress).
 		WithGrpcAddress(cfg.GrpcAddress).
-		WithLogsRepositoryFactory(repository.NewJsMinioFactory(minioClient, cfg.StorageBucket, logStream))
+		WithLogsRepositoryFactory(repository.NewJsMinioFactory(storageClient, cfg.StorageBucket, logStream))
 
 	if cfg.Debug {
 		svc.AddAdapter(adapter.NewDebugAdapter())
 	}
 
+	// Mask the secrets used by the executions in the logs
+	clientset, err := k8sclient.ConnectToK8s()
+	if err != nil {
+		log.Warnw("error connecting to kubernetes, the secrets in logs will not be redacted", "error", err)
+	} else {
+		svc.WithSecretResolver(redact.NewResolver(clientset, cfg.Namespace))
+	}
+
 	creds, err := newGRPCTransportCredentials(cfg)
 	if err != nil {
 		log.Fatalw("error getting tls credentials", "error", err)
@@ -15,6 +15,9 @@ const (
 	ArgNegative       = "-n"
 	ArgNegativeLong   = "--negative"
 	ArgDebug          = "--debug"
+	ArgAlias          = "--alias"
+	ArgReport         = "--report"
 	ArgRetryUntil     = "--retryUntil" // TODO: Replace when multi-level retry will be there
 	ArgRetryCount     = "--retryCount" // TODO: Replace when multi-level retry will be there
+	ArgGracePeriod    = "--grace"
 )
@@ -0,0 +1,13 @@
+package constants
+
+const (
+	// ControlDirPath is the directory where the step control actions are projected into the containers
+	ControlDirPath = "/.tktw-control"
+	// ControlFileName is the name of file with JSON map of the step references and requested actions
+	ControlFileName = "actions"
+	// ApprovalsFileName is the name of file with JSON map of the step references and approval decisions
+	ApprovalsFileName = "approvals"
+
+	ControlActionAbort = "abort"
+	ControlActionSkip  = "skip"
+)
@@ -10,6 +10,7 @@ package data
 
 import (
 	"os"
+	"time"
 )
 
 type config struct {
@@ -17,8 +18,11 @@ type config struct {
 	Debug      bool
 	RetryCount int
 	RetryUntil string
+	// GracePeriod is the time between SIGTERM and SIGKILL, when the step is stopped
+	GracePeriod time.Duration
 
 	Resulting []Rule
+	Reports   []ReportRule
 }
 
 var Config = &config{
@@ -30,4 +34,5 @@ func LoadConfig(config map[string]string) {
 	Config.RetryCount = getInt(config, "retryCount", 0)
 	Config.RetryUntil = getStr(config, "retryUntil", "self.passed")
 	Config.Negative = getBool(config, "negative", false)
+	Config.GracePeriod = getDuration(config, "grace", 0)
 }
@@ -0,0 +1,68 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/constants"
+)
+
+const controlPollInterval = 1 * time.Second
+
+// ApprovalDecision is the decision for the step waiting for the approval
+type ApprovalDecision struct {
+	Approved bool              `json:"approved"`
+	Inputs   map[string]string `json:"inputs,omitempty"`
+}
+
+// ReadControlAction reads the action requested for the step from outside, i.e. aborting or skipping it.
+// The actions are projected into the container from the Pod annotation, so they may be updated anytime.
+// The kubelet refreshes the projected files periodically, so the action may be visible after about a minute
+// (the kubelet sync period along with the cache TTL).
+func ReadControlAction(ref string) string {
+	b, err := os.ReadFile(filepath.Join(constants.ControlDirPath, constants.ControlFileName))
+	if err != nil || len(b) == 0 {
+		return ""
+	}
+	actions := map[string]string{}
+	if err = json.Unmarshal(b, &actions); err != nil {
+		return ""
+	}
+	return actions[ref]
+}
+
+// WatchControlAction waits until there is any action requested for the step
+func WatchControlAction(ref string) string {
+	for {
+		if action := ReadControlAction(ref); action != "" {
+			return action
+		}
+		time.Sleep(controlPollInterval)
+	}
+}
+
+// ReadApprovalDecision reads the approval decision for the step, and returns nil when it has not been decided yet
+func ReadApprovalDecision(ref string) *ApprovalDecision {
+	b, err := os.ReadFile(filepath.Join(constants.ControlDirPath, constants.ApprovalsFileName))
+	if err != nil || len(b) == 0 {
+		return nil
+	}
+	decisions := map[string]ApprovalDecision{}
+	if err = json.Unmarshal(b, &decisions); err != nil {
+		return nil
+	}
+	if decision, ok := decisions[ref]; ok {
+		return &decision
+	}
+	return nil
+}
@@ -69,19 +69,37 @@ var StateMachine = expressionstcl.NewMachine().
 		}
 		return nil, false
 	}).
-	RegisterAccessorExt(func(name string) (interface{}, bool, error) {
-		if strings.HasPrefix(name, "output.") {
-			return State.GetOutput(name[7:])
+	RegisterNamespace("output", func(name string) (interface{}, bool, error) {
+		return State.GetOutput(name)
+	}).
+	RegisterNamespace("approval", func(name string) (interface{}, bool, error) {
+		// Read "approval.<ref or alias>.<name>"
+		refOrAlias, input, ok := strings.Cut(name, ".")
+		if !ok {
+			return nil, false, nil
+		}
+		v, err := State.GetApprovalInput(refOrAlias, input)
+		if err != nil {
+			return nil, true, err
+		}
+		return v, true, nil
+	}).
+	RegisterNamespace("steps", func(name string) (interface{}, bool, error) {
+		// Read "steps.<ref or alias>.outputs.<name>"
+		parts := strings.SplitN(name, ".", 3)
+		if len(parts) != 3 || parts[1] != "outputs" {
+			return nil, false, nil
+		}
+		v, err := State.GetStepOutput(parts[0], parts[2])
+		if err != nil {
+			return nil, true, err
 		}
-		return nil, false, nil
+		return v, true, nil
 	})
 
 var EnvMachine = expressionstcl.NewMachine().
-	RegisterAccessor(func(name string) (interface{}, bool) {
-		if strings.HasPrefix(name, "env.") {
-			return os.Getenv(name[4:]), true
-		}
-		return nil, false
+	RegisterNamespace("env", func(name string) (interface{}, bool, error) {
+		return os.Getenv(name), true, nil
 	})
 
 var RefSuccessMachine = expressionstcl.NewMachine().
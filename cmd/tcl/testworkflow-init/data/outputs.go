@@ -0,0 +1,95 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package data
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// OutputsEnvName is the environment variable with path to the file,
+	// where the step may write "key=value" lines to publish the outputs
+	OutputsEnvName = "TK_OUTPUT"
+
+	defaultOutputsDirName   = "outputs"
+	defaultApprovalFileName = "approval"
+)
+
+func OutputsFilePath(ref string) string {
+	return filepath.Join(defaultInternalPath, defaultOutputsDirName, ref)
+}
+
+// ApprovalFilePath is the path to the file, where the approval step writes "key=value" lines with the provided inputs
+func ApprovalFilePath() string {
+	return filepath.Join(defaultInternalPath, defaultApprovalFileName)
+}
+
+// PrepareOutputs creates the outputs file for the current step and exposes it to the process
+func PrepareOutputs() error {
+	filePath := OutputsFilePath(Step.Ref)
+	err := os.MkdirAll(filepath.Dir(filePath), 0777)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filePath, nil, 0666)
+	if err != nil {
+		return err
+	}
+	return os.Setenv(OutputsEnvName, filePath)
+}
+
+// ParseOutputs reads the "key=value" lines, ignoring empty lines and comments
+func ParseOutputs(content string) (map[string]string, error) {
+	outputs := map[string]string{}
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return outputs, fmt.Errorf("line %d: expected key=value", i+1)
+		}
+		outputs[key] = value
+	}
+	return outputs, nil
+}
+
+// LoadOutputs stores the outputs published by the current step in the shared state
+func LoadOutputs() {
+	b, err := os.ReadFile(OutputsFilePath(Step.Ref))
+	if err != nil {
+		return
+	}
+	outputs, err := ParseOutputs(string(b))
+	if err != nil {
+		fmt.Printf("Invalid outputs: %s\n", err.Error())
+	}
+	State.GetStep(Step.Ref).SetOutputs(outputs)
+}
+
+// LoadApproval stores the inputs provided for the approval in the shared state,
+// so they are available for the next steps as "approval.<ref or alias>.<name>" variables
+func LoadApproval() {
+	filePath := ApprovalFilePath()
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return
+	}
+	_ = os.Remove(filePath)
+	inputs, err := ParseOutputs(string(b))
+	if err != nil {
+		fmt.Printf("Invalid approval inputs: %s\n", err.Error())
+	}
+	State.SetApproval(Step.Ref, inputs)
+}
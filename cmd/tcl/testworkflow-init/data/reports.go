@@ -0,0 +1,77 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package data
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/kubeshop/testkube/pkg/reports"
+)
+
+// reportChunkSize is the maximum number of test cases emitted in a single output
+const reportChunkSize = 50
+
+// FindReportFiles lists the files matching the glob pattern,
+// relative patterns are resolved against the working directory
+func FindReportFiles(pattern string) ([]string, error) {
+	if !filepath.IsAbs(pattern) {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+		pattern = filepath.Join(wd, pattern)
+	}
+	base, pattern := doublestar.SplitPattern(filepath.ToSlash(pattern))
+	matches, err := doublestar.Glob(os.DirFS(base), pattern, doublestar.WithFilesOnly())
+	if err != nil {
+		return nil, err
+	}
+	for i := range matches {
+		matches[i] = filepath.Join(base, matches[i])
+	}
+	return matches, nil
+}
+
+// LoadReports parses the test reports produced by the current step and emits them as the outputs
+func LoadReports() {
+	if !Step.Executed {
+		return
+	}
+	for _, r := range Config.Reports {
+		pattern, err := Template(r.Path)
+		if err != nil {
+			fmt.Printf("Warning: resolving report path: %s: %s\n", r.Path, err.Error())
+			continue
+		}
+		files, err := FindReportFiles(pattern)
+		if err != nil {
+			fmt.Printf("Warning: searching for %s reports: %s: %s\n", r.Format, pattern, err.Error())
+			continue
+		}
+		if len(files) == 0 {
+			fmt.Printf("Warning: no %s reports found for: %s\n", r.Format, pattern)
+			continue
+		}
+		for _, filePath := range files {
+			report, err := reports.ParseFile(reports.Format(r.Format), filePath)
+			if err != nil {
+				fmt.Printf("Warning: parsing %s report: %s: %s\n", r.Format, filePath, err.Error())
+				continue
+			}
+			// Emit the report in parts, to avoid huge log lines
+			for _, chunk := range report.Chunks(reportChunkSize) {
+				PrintOutput(Step.Ref, "report", chunk)
+			}
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"syscall"
 
 	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
 )
@@ -24,14 +25,19 @@ const (
 )
 
 type state struct {
-	Status TestWorkflowStatus   `json:"status"`
-	Steps  map[string]*StepInfo `json:"steps"`
-	Output map[string]string    `json:"output"`
+	Status  TestWorkflowStatus   `json:"status"`
+	Steps   map[string]*StepInfo `json:"steps"`
+	Output  map[string]string    `json:"output"`
+	Aliases map[string]string    `json:"aliases"`
+	// Approval holds the inputs provided for the approval, by the step reference
+	Approval map[string]map[string]string `json:"approval"`
 }
 
 var State = &state{
-	Steps:  map[string]*StepInfo{},
-	Output: map[string]string{},
+	Steps:    map[string]*StepInfo{},
+	Output:   map[string]string{},
+	Aliases:  map[string]string{},
+	Approval: map[string]map[string]string{},
 }
 
 func (s *state) GetStep(ref string) *StepInfo {
@@ -42,6 +48,56 @@ func (s *state) GetStep(ref string) *StepInfo {
 	return State.Steps[ref]
 }
 
+func (s *state) SetAlias(alias, ref string) error {
+	if s.Aliases == nil {
+		s.Aliases = map[string]string{}
+	}
+	if v, ok := s.Aliases[alias]; ok && v != ref {
+		return fmt.Errorf("alias '%s' is already used by step '%s'", alias, v)
+	}
+	s.Aliases[alias] = ref
+	return nil
+}
+
+func (s *state) GetStepOutput(refOrAlias, name string) (string, error) {
+	ref := refOrAlias
+	if v, ok := s.Aliases[refOrAlias]; ok {
+		ref = v
+	}
+	step, ok := s.Steps[ref]
+	if !ok {
+		return "", fmt.Errorf("unknown step '%s'", refOrAlias)
+	}
+	v, ok := step.Outputs[name]
+	if !ok {
+		return "", fmt.Errorf("step '%s' has no output '%s'", refOrAlias, name)
+	}
+	return v, nil
+}
+
+func (s *state) SetApproval(ref string, inputs map[string]string) {
+	if s.Approval == nil {
+		s.Approval = map[string]map[string]string{}
+	}
+	s.Approval[ref] = inputs
+}
+
+func (s *state) GetApprovalInput(refOrAlias, name string) (string, error) {
+	ref := refOrAlias
+	if v, ok := s.Aliases[refOrAlias]; ok {
+		ref = v
+	}
+	inputs, ok := s.Approval[ref]
+	if !ok {
+		return "", fmt.Errorf("step '%s' has not been approved", refOrAlias)
+	}
+	v, ok := inputs[name]
+	if !ok {
+		return "", fmt.Errorf("step '%s' has no approval input '%s'", refOrAlias, name)
+	}
+	return v, nil
+}
+
 func (s *state) GetOutput(name string) (expressionstcl.Expression, bool, error) {
 	v, ok := s.Output[name]
 	if !ok {
@@ -152,18 +208,25 @@ func LoadState() {
 
 func Finish() {
 	// Persist step information and shared data
+	LoadOutputs()
+	LoadApproval()
+	LoadReports()
 	recomputeStatuses()
 	persistStatus(defaultTerminationLogPath)
 	persistState(filepath.Join(defaultInternalPath, "state"))
 
-	// Kill the sub-process
+	// Kill the sub-process along with its children
 	if Step.Cmd != nil && Step.Cmd.Process != nil {
+		_ = syscall.Kill(-Step.Cmd.Process.Pid, syscall.SIGKILL)
 		_ = Step.Cmd.Process.Kill()
 	}
 
 	// Emit end hint to allow exporting the timestamp
 	PrintHint(Step.Ref, "end")
 
+	// Export the step span
+	finishTracing()
+
 	// The init process needs to finish with zero exit code,
 	// to continue with the next container.
 	os.Exit(0)
@@ -0,0 +1,47 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package data
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_ApprovalInputsInNextStep(t *testing.T) {
+	prevState, prevStep := State, Step
+	t.Cleanup(func() {
+		State, Step = prevState, prevStep
+	})
+	statePath := filepath.Join(t.TempDir(), "state")
+
+	// The approval step stores the provided inputs
+	State = &state{}
+	Step = &step{Ref: "r1"}
+	require.NoError(t, State.SetAlias("approve_deploy", "r1"))
+	State.SetApproval(Step.Ref, map[string]string{"reason": "hotfix"})
+	persistState(statePath)
+
+	// The next step reads them by the step alias or reference
+	State = &state{}
+	Step = &step{Ref: "r2"}
+	readState(statePath)
+
+	result, err := Template("{{approval.approve_deploy.reason}} {{approval.r1.reason}}")
+	assert.NoError(t, err)
+	assert.Equal(t, "hotfix hotfix", result)
+
+	_, err = Template("{{approval.approve_deploy.missing}}")
+	assert.ErrorContains(t, err, "step 'approve_deploy' has no approval input 'missing'")
+
+	_, err = Template("{{approval.r3.reason}}")
+	assert.ErrorContains(t, err, "step 'r3' has not been approved")
+}
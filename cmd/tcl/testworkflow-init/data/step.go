@@ -8,7 +8,13 @@
 
 package data
 
-import "os/exec"
+import (
+	"os/exec"
+	"sync"
+)
+
+// RefEnvName is the environment variable with reference of the step, exposed to the process
+const RefEnvName = "TK_REF"
 
 type step struct {
 	Ref        string
@@ -17,6 +23,29 @@ type step struct {
 	ExitCode   uint8
 	Executed   bool
 	InitStatus string
+
+	interrupted bool
+	mu          sync.Mutex
 }
 
 var Step = &step{}
+
+// Interrupt marks the step as stopped from outside (abort, skip or timeout),
+// so the result of the process is ignored. It returns false when the step has been already interrupted.
+func (s *step) Interrupt(status StepStatus, exitCode uint8) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.interrupted {
+		return false
+	}
+	s.interrupted = true
+	s.Status = status
+	s.ExitCode = exitCode
+	return true
+}
+
+func (s *step) Interrupted() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.interrupted
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kubeshop/testkube/pkg/tracing"
+	"github.com/kubeshop/testkube/pkg/version"
+)
+
+const tracingShutdownTimeout = 5 * time.Second
+
+var (
+	stepSpan        trace.Span
+	shutdownTracing func(context.Context) error
+)
+
+// StartTracing starts the span for the current step, as a child of the execution trace passed by the API.
+// The trace context is exposed to the process, so the tools may attach their own spans.
+func StartTracing(name string) {
+	shutdown, err := tracing.Init(context.Background(), "testworkflow-init", version.Version)
+	if err != nil {
+		fmt.Printf("Warning: tracing will not be available: %s\n", err.Error())
+		return
+	}
+	shutdownTracing = shutdown
+
+	ctx := tracing.ContextFromEnv(context.Background())
+	ctx, stepSpan = tracing.Start(ctx, name, tracing.StepRefKey.String(Step.Ref))
+	tracing.SetEnv(ctx)
+}
+
+// finishTracing ends the step span with its final status, and flushes the pending spans
+func finishTracing() {
+	if stepSpan == nil {
+		return
+	}
+	status := State.GetStep(Step.Ref).Status
+	stepSpan.SetAttributes(tracing.StepStatusKey.String(string(status)))
+	if status != StepStatusPassed && status != StepStatusSkipped {
+		stepSpan.SetStatus(codes.Error, string(status))
+	}
+	stepSpan.End()
+
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	_ = shutdownTracing(ctx)
+}
@@ -36,18 +36,24 @@ type Rule struct {
 	Refs []string
 }
 
+type ReportRule struct {
+	Format string
+	Path   string
+}
+
 type Timeout struct {
 	Ref      string
 	Duration string
 }
 
 type StepInfo struct {
-	Ref       string     `json:"ref"`
-	Status    StepStatus `json:"status"`
-	HasStatus bool       `json:"hasStatus"`
-	StartTime time.Time  `json:"startTime"`
-	TimeoutAt time.Time  `json:"timeoutAt"`
-	Iteration uint64     `json:"iteration"`
+	Ref       string            `json:"ref"`
+	Status    StepStatus        `json:"status"`
+	HasStatus bool              `json:"hasStatus"`
+	StartTime time.Time         `json:"startTime"`
+	TimeoutAt time.Time         `json:"timeoutAt"`
+	Iteration uint64            `json:"iteration"`
+	Outputs   map[string]string `json:"outputs"`
 }
 
 func (s *StepInfo) Start(t time.Time) {
@@ -92,6 +98,19 @@ func (s *StepInfo) SetTimeoutDuration(t time.Time, duration string) error {
 	return nil
 }
 
+func (s *StepInfo) SetOutputs(outputs map[string]string) {
+	if len(outputs) == 0 {
+		return
+	}
+	if s.Outputs == nil {
+		s.Outputs = map[string]string{}
+	}
+	for k, v := range outputs {
+		s.Outputs[k] = v
+	}
+	PrintHintDetails(s.Ref, "outputs", s.Outputs)
+}
+
 func (s *StepInfo) SetStatus(status StepStatus) {
 	if !s.HasStatus || s.Status == StepStatusPassed {
 		s.Status = status
@@ -13,6 +13,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func getStr(config map[string]string, key string, defaultValue string) string {
@@ -44,6 +45,19 @@ func getBool(config map[string]string, key string, defaultValue bool) bool {
 	return strings.ToLower(str) == "true" || str == "1"
 }
 
+func getDuration(config map[string]string, key string, defaultValue time.Duration) time.Duration {
+	str := getStr(config, key, "")
+	if str == "" {
+		return defaultValue
+	}
+	val, err := time.ParseDuration(strings.ReplaceAll(str, " ", ""))
+	if err != nil {
+		fmt.Printf("invalid '%s' provided: '%s': %v\n", key, str, err)
+		os.Exit(155)
+	}
+	return val
+}
+
 // Iterate over all items, all the time, until no more is done
 func Iterate[T any](v []T, fn func(T) bool) {
 	result := v
@@ -42,7 +42,9 @@ func main() {
 	conditions := []data.Rule(nil)
 	resulting := []data.Rule(nil)
 	timeouts := []data.Timeout(nil)
+	reports := []data.ReportRule(nil)
 	args := []string(nil)
+	alias := ""
 
 	// Read arguments into the base data
 	for i := 2; i < len(os.Args); i += 2 {
@@ -88,6 +90,19 @@ func main() {
 			config["retryUntil"] = os.Args[i+1]
 		case constants.ArgDebug:
 			config["debug"] = os.Args[i+1]
+		case constants.ArgGracePeriod:
+			config["grace"] = os.Args[i+1]
+		case constants.ArgAlias:
+			alias = os.Args[i+1]
+			if err := data.State.SetAlias(alias, data.Step.Ref); err != nil {
+				output.Failf(output.CodeInputError, "invalid step alias: %s", err.Error())
+			}
+		case constants.ArgReport:
+			v := strings.SplitN(os.Args[i+1], "=", 2)
+			if len(v) != 2 {
+				output.Failf(output.CodeInputError, "invalid report definition: %s", os.Args[i+1])
+			}
+			reports = append(reports, data.ReportRule{Format: v[0], Path: v[1]})
 		default:
 			output.Failf(output.CodeInputError, "unknown parameter: %s", os.Args[i])
 		}
@@ -141,6 +156,13 @@ func main() {
 	}
 	data.State.GetStep(data.Step.Ref).Start(now)
 
+	// Trace the step, using the alias of the step when it is named
+	if alias != "" {
+		data.StartTracing(alias)
+	} else {
+		data.StartTracing(data.Step.Ref)
+	}
+
 	// Register timeouts
 	for _, t := range timeouts {
 		err := data.State.GetStep(t.Ref).SetTimeoutDuration(now, t.Duration)
@@ -149,8 +171,20 @@ func main() {
 		}
 	}
 
-	// Save the resulting conditions
+	// Save the resulting conditions and expected reports
 	data.Config.Resulting = resulting
+	data.Config.Reports = reports
+
+	// Apply the action requested for the step before it has started
+	switch data.ReadControlAction(data.Step.Ref) {
+	case constants.ControlActionSkip:
+		data.State.GetStep(data.Step.Ref).Skip(now)
+	case constants.ControlActionAbort:
+		fmt.Println("The step was aborted.")
+		data.Step.Status = data.StepStatusAborted
+		data.Step.ExitCode = output.CodeAborted
+		data.Finish()
+	}
 
 	// Don't call further if the step is already skipped
 	if data.State.GetStep(data.Step.Ref).Status == data.StepStatusSkipped {
@@ -189,24 +223,49 @@ func main() {
 	signal.Notify(stopSignal, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
 		<-stopSignal
-		fmt.Println("The task was aborted.")
-		data.Step.Status = data.StepStatusAborted
-		data.Step.ExitCode = output.CodeAborted
-		data.Finish()
+		if data.Step.Interrupt(data.StepStatusAborted, output.CodeAborted) {
+			fmt.Println("The task was aborted.")
+			run.Stop()
+		}
+	}()
+
+	// Handle the actions requested for the step while it's running
+	go func() {
+		switch data.WatchControlAction(data.Step.Ref) {
+		case constants.ControlActionSkip:
+			if data.Step.Interrupt(data.StepStatusSkipped, 0) {
+				fmt.Println("The step was skipped.")
+				run.Stop()
+			}
+		case constants.ControlActionAbort:
+			if data.Step.Interrupt(data.StepStatusAborted, output.CodeAborted) {
+				fmt.Println("The step was aborted.")
+				run.Stop()
+			}
+		}
 	}()
 
 	// Handle timeouts
 	for _, t := range timeouts {
 		go func(ref string) {
 			time.Sleep(data.State.GetStep(ref).TimeoutAt.Sub(time.Now()))
-			fmt.Printf("Timed out.\n")
-			data.State.GetStep(ref).SetStatus(data.StepStatusTimeout)
-			data.Step.Status = data.StepStatusTimeout
-			data.Step.ExitCode = output.CodeTimeout
-			data.Finish()
+			if data.Step.Interrupt(data.StepStatusTimeout, output.CodeTimeout) {
+				fmt.Printf("Timed out.\n")
+				data.State.GetStep(ref).SetStatus(data.StepStatusTimeout)
+				run.Stop()
+			}
 		}(t.Ref)
 	}
 
+	// Expose the step reference, so the process may interact with its own step
+	_ = os.Setenv(data.RefEnvName, data.Step.Ref)
+
+	// Prepare the file for publishing outputs
+	err = data.PrepareOutputs()
+	if err != nil {
+		fmt.Printf("Warning: outputs will not be available: %s\n", err.Error())
+	}
+
 	// Start the task
 	data.Step.Executed = true
 	run.Run(args[0], args[1:])
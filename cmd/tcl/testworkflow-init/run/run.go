@@ -12,10 +12,14 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"syscall"
+	"time"
 
 	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
 )
 
+const terminationPollInterval = 100 * time.Millisecond
+
 func getProcessStatus(err error) (bool, uint8) {
 	if err == nil {
 		return true, 0
@@ -36,12 +40,18 @@ func createCommand(cmd string, args ...string) (c *exec.Cmd) {
 	c.Stdout = os.Stdout
 	c.Stderr = os.Stderr
 	c.Stdin = os.Stdin
+	// Run the process in its own group, so it may be stopped along with its children
+	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
 	return
 }
 
 func execute(cmd string, args ...string) {
 	data.Step.Cmd = createCommand(cmd, args...)
 	success, exitCode := getProcessStatus(data.Step.Cmd.Run())
+	if data.Step.Interrupted() {
+		// The step has been stopped from outside, it will be finished there
+		select {}
+	}
 	data.Step.ExitCode = exitCode
 
 	actualSuccess := success
@@ -62,6 +72,21 @@ func execute(cmd string, args ...string) {
 	}
 }
 
+// Stop terminates the running process group gracefully and finishes the step.
+// The processes receive SIGTERM first, and they are killed after the grace period.
+func Stop() {
+	if data.Step.Cmd != nil && data.Step.Cmd.Process != nil {
+		pgid := -data.Step.Cmd.Process.Pid
+		if syscall.Kill(pgid, syscall.SIGTERM) == nil {
+			deadline := time.Now().Add(data.Config.GracePeriod)
+			for time.Now().Before(deadline) && syscall.Kill(pgid, syscall.Signal(0)) == nil {
+				time.Sleep(terminationPollInterval)
+			}
+		}
+	}
+	data.Finish()
+}
+
 func Run(cmd string, args []string) {
 	// Instantiate the command and run
 	execute(cmd, args...)
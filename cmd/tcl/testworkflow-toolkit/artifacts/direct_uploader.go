@@ -18,7 +18,6 @@ import (
 	minio2 "github.com/minio/minio-go/v7"
 
 	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-toolkit/env"
-	"github.com/kubeshop/testkube/pkg/storage/minio"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -36,7 +35,8 @@ func NewDirectUploader(opts ...DirectUploaderOpt) Uploader {
 }
 
 type directUploader struct {
-	client      *minio.Client
+	client      env.ObjectStorageUploader
+	folder      string
 	wg          sync.WaitGroup
 	sema        chan struct{}
 	parallelism int
@@ -45,7 +45,7 @@ type directUploader struct {
 }
 
 func (d *directUploader) Start() (err error) {
-	d.client, err = env.ObjectStorageClient()
+	d.client, err = env.ObjectStorageUploaderClient()
 	d.sema = make(chan struct{}, d.parallelism)
 	return err
 }
@@ -61,7 +61,10 @@ func (d *directUploader) buildOptions(path string, size int64) (options minio2.P
 }
 
 func (d *directUploader) upload(path string, file io.ReadCloser, size int64) {
-	ns := env.ExecutionId()
+	ns := d.folder
+	if ns == "" {
+		ns = env.ExecutionId()
+	}
 	opts := d.buildOptions(path, size)
 	err := d.client.SaveFileDirect(context.Background(), ns, path, file, size, opts)
 
@@ -103,6 +106,12 @@ func WithParallelism(parallelism int) DirectUploaderOpt {
 	}
 }
 
+func WithFolder(folder string) DirectUploaderOpt {
+	return func(uploader *directUploader) {
+		uploader.folder = folder
+	}
+}
+
 func WithMinioOptionsEnhancer(fn PutObjectOptionsEnhancer) DirectUploaderOpt {
 	return func(uploader *directUploader) {
 		uploader.options = append(uploader.options, fn)
@@ -31,6 +31,7 @@ type Handler interface {
 	Start() error
 	Add(path string, file fs.File, stat fs.FileInfo) error
 	End() error
+	Summary() (files uint32, totalSize uint64)
 }
 
 func NewHandler(uploader Uploader, processor Processor) Handler {
@@ -90,3 +91,8 @@ func (h *handler) End() (err error) {
 	}
 	return nil
 }
+
+// Summary returns the number and total size of the successfully uploaded files
+func (h *handler) Summary() (files uint32, totalSize uint64) {
+	return h.success.Load(), h.totalSize.Load()
+}
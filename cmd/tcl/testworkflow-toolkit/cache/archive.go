@@ -0,0 +1,72 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package cache
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Unpack extracts the compressed archive into the root directory
+func Unpack(reader io.Reader, root string) (count int, err error) {
+	gz, err := gzip.NewReader(reader)
+	if err != nil {
+		return 0, err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return count, nil
+		} else if err != nil {
+			return count, err
+		}
+
+		// Protect against writing outside the root directory
+		target := filepath.Join(root, header.Name)
+		if target != filepath.Clean(root) && !strings.HasPrefix(target, strings.TrimRight(filepath.Clean(root), "/")+"/") {
+			return count, fmt.Errorf("invalid path in the archive: %s", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, 0755)
+		case tar.TypeReg:
+			err = unpackFile(tr, target, header.FileInfo().Mode())
+			count++
+		default:
+			continue
+		}
+		if err != nil {
+			return count, err
+		}
+	}
+}
+
+func unpackFile(reader io.Reader, target string, mode fs.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, reader)
+	return errors.Join(err, file.Close())
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"time"
+)
+
+const (
+	archiveExtension = ".tar.gz"
+	maxKeyLength     = 128
+)
+
+var unsafeKeyCharsRe = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// Folder returns the storage folder for the caches of the TestWorkflow
+func Folder(workflowName string) string {
+	return "caches/" + workflowName
+}
+
+// FileName returns the storage file name for the cache key
+func FileName(key string) string {
+	name := unsafeKeyCharsRe.ReplaceAllString(key, "-")
+	if len(name) > maxKeyLength || name != key {
+		sum := sha256.Sum256([]byte(key))
+		name = name[:min(len(name), maxKeyLength-17)] + "-" + hex.EncodeToString(sum[:8])
+	}
+	return name + archiveExtension
+}
+
+// IsExpired checks if the cache modified at specific time should be evicted
+func IsExpired(modifiedAt time.Time, ttl time.Duration) bool {
+	return ttl > 0 && time.Since(modifiedAt) > ttl
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package cache
+
+import (
+	"errors"
+	"io"
+	"sync/atomic"
+
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-toolkit/artifacts"
+)
+
+var ErrSizeExceeded = errors.New("the cache exceeds the size limit")
+
+// LimitedUploader stops uploading the files that exceed the size limit
+type LimitedUploader struct {
+	artifacts.Uploader
+	limit    int64
+	exceeded atomic.Bool
+}
+
+func NewLimitedUploader(uploader artifacts.Uploader, limit int64) *LimitedUploader {
+	return &LimitedUploader{Uploader: uploader, limit: limit}
+}
+
+func (l *LimitedUploader) Add(path string, file io.ReadCloser, size int64) error {
+	if size > l.limit {
+		l.exceeded.Store(true)
+		_ = file.Close()
+		return ErrSizeExceeded
+	}
+	return l.Uploader.Add(path, &limitedReader{ReadCloser: file, remaining: l.limit, exceeded: &l.exceeded}, size)
+}
+
+// Exceeded checks if any file has been rejected because of the size limit
+func (l *LimitedUploader) Exceeded() bool {
+	return l.exceeded.Load()
+}
+
+type limitedReader struct {
+	io.ReadCloser
+	remaining int64
+	exceeded  *atomic.Bool
+}
+
+func (r *limitedReader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		r.exceeded.Store(true)
+		return n, ErrSizeExceeded
+	}
+	return n, err
+}
+
+// Close drains the rest of the stream, so the archive writer is not blocked after the upload is aborted
+func (r *limitedReader) Close() error {
+	_, _ = io.Copy(io.Discard, r.ReadCloser)
+	return r.ReadCloser.Close()
+}
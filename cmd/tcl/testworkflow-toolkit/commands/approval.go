@@ -0,0 +1,146 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+const approvalPollInterval = 1 * time.Second
+
+// publishApprovalInputs exposes the values provided by the approver as the step outputs,
+// and as the "approval.<step>.<name>" variables for the next steps
+func publishApprovalInputs(specs []testworkflowprocessor.ApprovalInput, inputs map[string]string) error {
+	if len(specs) == 0 {
+		return nil
+	}
+	if err := writeApprovalInputs(data.ApprovalFilePath(), specs, inputs); err != nil {
+		return err
+	}
+	filePath := os.Getenv(data.OutputsEnvName)
+	if filePath == "" {
+		return nil
+	}
+	return writeApprovalInputs(filePath, specs, inputs)
+}
+
+func writeApprovalInputs(filePath string, specs []testworkflowprocessor.ApprovalInput, inputs map[string]string) error {
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	for _, spec := range specs {
+		_, err = fmt.Fprintf(file, "%s=%s\n", spec.Name, inputs[spec.Name])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveApprovalInputs fills the missing inputs with the default values, and ensures the required ones are provided
+func resolveApprovalInputs(specs []testworkflowprocessor.ApprovalInput, provided map[string]string) (map[string]string, error) {
+	inputs := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		value, ok := provided[spec.Name]
+		if !ok && spec.Default == nil {
+			return nil, fmt.Errorf("missing required input: %s", spec.Name)
+		}
+		if !ok {
+			value = *spec.Default
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, fmt.Errorf("input %s: multi-line values are not supported", spec.Name)
+		}
+		inputs[spec.Name] = value
+	}
+	return inputs, nil
+}
+
+func NewApprovalCmd() *cobra.Command {
+	var (
+		message       string
+		timeout       time.Duration
+		defaultAction string
+		rawInputs     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "approval",
+		Short: "Wait for the approval of the workflow",
+		Args:  cobra.NoArgs,
+
+		Run: func(cmd *cobra.Command, _ []string) {
+			ref := os.Getenv(data.RefEnvName)
+			if ref == "" {
+				ui.Failf("the step reference is not available")
+			}
+			var specs []testworkflowprocessor.ApprovalInput
+			if rawInputs != "" {
+				ui.ExitOnError("reading inputs", json.Unmarshal([]byte(rawInputs), &specs))
+			}
+
+			// Announce that the workflow is waiting for the decision
+			request := testworkflowprocessor.ApprovalRequest{Message: message, Default: defaultAction, Inputs: specs}
+			var deadline <-chan time.Time
+			if timeout > 0 {
+				request.Deadline = common.Ptr(time.Now().Add(timeout))
+				deadline = time.After(timeout)
+			}
+			data.PrintOutput(ref, testworkflowprocessor.ApprovalOutputName, request)
+			if message != "" {
+				fmt.Println(message)
+			}
+			if request.Deadline != nil {
+				fmt.Printf("Waiting for the approval until %s (then: %s).\n", request.Deadline.Format(time.RFC3339), defaultAction)
+			} else {
+				fmt.Println("Waiting for the approval.")
+			}
+
+			// Wait for the decision
+			var decision *data.ApprovalDecision
+			for decision == nil {
+				select {
+				case <-deadline:
+					fmt.Printf("Timed out, applying the default action: %s.\n", defaultAction)
+					decision = &data.ApprovalDecision{Approved: defaultAction == testworkflowprocessor.ApprovalActionApprove}
+				case <-time.After(approvalPollInterval):
+					decision = data.ReadApprovalDecision(ref)
+				}
+			}
+
+			if !decision.Approved {
+				ui.Failf("Rejected.")
+			}
+			inputs, err := resolveApprovalInputs(specs, decision.Inputs)
+			ui.ExitOnError("reading inputs", err)
+			ui.ExitOnError("publishing inputs", publishApprovalInputs(specs, inputs))
+			fmt.Println("Approved.")
+		},
+	}
+
+	cmd.Flags().StringVar(&message, "message", "", "message for the approvers")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "maximum time to wait for the decision")
+	cmd.Flags().StringVar(&defaultAction, "default", testworkflowprocessor.ApprovalActionReject, "action taken after the timeout: approve or reject")
+	cmd.Flags().StringVar(&rawInputs, "inputs", "", "JSON list of the inputs to collect from the approvers")
+
+	return cmd
+}
@@ -20,8 +20,10 @@ import (
 	"github.com/minio/minio-go/v7"
 	"github.com/spf13/cobra"
 
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
 	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-toolkit/artifacts"
 	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-toolkit/env"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -160,9 +162,14 @@ func NewArtifactsCmd() *cobra.Command {
 			})
 			ui.ExitOnError("reading the file system", err)
 			err = handler.End()
-
-			// TODO: Emit information about artifacts
 			ui.ExitOnError("finishing upload", err)
+
+			// Emit information about artifacts
+			files, totalSize := handler.Summary()
+			data.PrintOutput(env.Ref(), testworkflowprocessor.ArtifactsOutputName, testworkflowprocessor.ArtifactsSummary{
+				Files: files,
+				Size:  totalSize,
+			})
 			fmt.Printf("Took %s.\n", time.Now().Sub(started).Truncate(time.Millisecond))
 		},
 	}
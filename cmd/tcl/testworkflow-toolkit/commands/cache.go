@@ -0,0 +1,214 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-toolkit/artifacts"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-toolkit/cache"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-toolkit/env"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+// publishCacheHit exposes the information about cache hit as the step output
+func publishCacheHit(hit bool) {
+	filePath := os.Getenv(data.OutputsEnvName)
+	if filePath == "" {
+		return
+	}
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0666)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	_, _ = fmt.Fprintf(file, "hit=%v\n", hit)
+}
+
+func NewCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Restore and save the workflow cache",
+	}
+	cmd.AddCommand(newCacheRestoreCmd())
+	cmd.AddCommand(newCacheSaveCmd())
+	return cmd
+}
+
+func newCacheRestoreCmd() *cobra.Command {
+	var (
+		key string
+		ttl time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore <paths...>",
+		Short: "Restore the cached paths",
+		Args:  cobra.MinimumNArgs(1),
+
+		Run: func(cmd *cobra.Command, paths []string) {
+			publishCacheHit(false)
+			if env.CloudEnabled() {
+				fmt.Printf("Warning: the cache is not supported in the Cloud mode yet\n")
+				return
+			}
+			if env.FilesystemStorageEnabled() {
+				fmt.Printf("Warning: the cache is not supported with the filesystem storage, use MinIO or another S3-compatible storage\n")
+				return
+			}
+
+			storage, err := env.ObjectStorageClient()
+			ui.ExitOnError("connecting to the storage", err)
+
+			ctx := context.Background()
+			folder, fileName := cache.Folder(env.WorkflowName()), cache.FileName(key)
+			fmt.Printf("Key: %s\n", ui.LightCyan(key))
+			object, err := storage.DownloadFile(ctx, folder, fileName)
+			if err != nil {
+				fmt.Printf("Cache miss.\n")
+				return
+			}
+			defer object.Close()
+			stat, err := object.Stat()
+			if err != nil {
+				fmt.Printf("Cache miss.\n")
+				return
+			}
+			if cache.IsExpired(stat.LastModified, ttl) {
+				fmt.Printf("Cache miss, the cache has expired at %s.\n", stat.LastModified.Add(ttl).Format(time.RFC3339))
+				return
+			}
+
+			started := time.Now()
+			count, err := cache.Unpack(object, "/")
+			ui.ExitOnError("restoring the cache", err)
+			fmt.Printf("Cache hit, restored %d files (%s) in %s.\n", count, resource.NewQuantity(stat.Size, resource.BinarySI).String(), time.Since(started).Truncate(time.Millisecond))
+			publishCacheHit(true)
+		},
+	}
+
+	cmd.Flags().StringVar(&key, "key", "", "cache key")
+	cmd.Flags().DurationVar(&ttl, "ttl", 0, "time after the cache expires")
+	_ = cmd.MarkFlagRequired("key")
+
+	return cmd
+}
+
+func newCacheSaveCmd() *cobra.Command {
+	var (
+		key     string
+		ttl     time.Duration
+		maxSize string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "save <paths...>",
+		Short: "Save the paths in the cache",
+		Args:  cobra.MinimumNArgs(1),
+
+		Run: func(cmd *cobra.Command, paths []string) {
+			if env.CloudEnabled() {
+				fmt.Printf("Warning: the cache is not supported in the Cloud mode yet\n")
+				return
+			}
+			if env.FilesystemStorageEnabled() {
+				fmt.Printf("Warning: the cache is not supported with the filesystem storage, use MinIO or another S3-compatible storage\n")
+				return
+			}
+			limit, err := resource.ParseQuantity(maxSize)
+			ui.ExitOnError("parsing the max size", err)
+
+			storage, err := env.ObjectStorageClient()
+			ui.ExitOnError("connecting to the storage", err)
+
+			ctx := context.Background()
+			folder, fileName := cache.Folder(env.WorkflowName()), cache.FileName(key)
+			fmt.Printf("Key: %s\n", ui.LightCyan(key))
+
+			// Evict the expired caches, and check if the current one is still valid
+			objects, err := storage.ListObjects(ctx, folder)
+			if err != nil {
+				fmt.Printf("Warning: listing existing caches: %s\n", err.Error())
+			}
+			upToDate := false
+			for _, obj := range objects {
+				if !cache.IsExpired(obj.LastModified, ttl) {
+					upToDate = upToDate || obj.Key == fileName
+					continue
+				}
+				err = storage.DeleteFile(ctx, folder, obj.Key)
+				if err != nil {
+					fmt.Printf("Warning: evicting expired cache: %s: %s\n", obj.Key, err.Error())
+				} else {
+					fmt.Printf("Evicted expired cache: %s\n", obj.Key)
+				}
+			}
+			if upToDate {
+				fmt.Printf("The cache is up to date.\n")
+				return
+			}
+
+			// Archive and upload the files
+			patterns := make([]string, 0, len(paths))
+			for _, p := range paths {
+				patterns = append(patterns, strings.TrimRight(p, "/")+"/**")
+			}
+			walker, err := artifacts.CreateWalker(patterns, paths, "/")
+			ui.ExitOnError("building a walker", err)
+
+			started := time.Now()
+			uploader := cache.NewLimitedUploader(artifacts.NewDirectUploader(directAddGzipEncoding, artifacts.WithFolder(folder)), limit.Value())
+			handler := artifacts.NewHandler(uploader, artifacts.NewTarProcessor(fileName))
+			err = handler.Start()
+			ui.ExitOnError("initializing uploader", err)
+			err = walker.Walk(os.DirFS("/"), func(path string, file fs.File, err error) error {
+				if err != nil {
+					fmt.Printf("Warning: '%s' has been ignored, as there was a problem reading it: %s\n", path, err.Error())
+					return nil
+				}
+				stat, err := file.Stat()
+				if err != nil {
+					fmt.Printf("Warning: '%s' has been ignored, as there was a problem reading it: %s\n", path, err.Error())
+					return nil
+				}
+				return handler.Add(path, file, stat)
+			})
+			ui.ExitOnError("reading the file system", err)
+			err = handler.End()
+			if uploader.Exceeded() {
+				fmt.Printf("Warning: the cache is not saved, as its compressed size exceeds the limit (%s)\n", limit.String())
+				return
+			}
+			ui.ExitOnError("uploading the cache", err)
+
+			files, _ := handler.Summary()
+			if files == 0 {
+				fmt.Printf("There are no files to cache.\n")
+				return
+			}
+			fmt.Printf("Saved %d files in %s.\n", files, time.Since(started).Truncate(time.Millisecond))
+		},
+	}
+
+	cmd.Flags().StringVar(&key, "key", "", "cache key")
+	cmd.Flags().DurationVar(&ttl, "ttl", 0, "time after the cache expires")
+	cmd.Flags().StringVar(&maxSize, "max-size", "1Gi", "maximum size of the compressed cache")
+	_ = cmd.MarkFlagRequired("key")
+
+	return cmd
+}
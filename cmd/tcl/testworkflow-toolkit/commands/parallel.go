@@ -0,0 +1,157 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-toolkit/env"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowresolver"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+const (
+	parallelPollInterval = time.Second
+	parallelMaxRetries   = 10
+)
+
+func buildParallelExecution(combination testworkflowresolver.ParallelCombination, steps []testkube.TestWorkflowStep) func() error {
+	label := combination.Name()
+	return func() (err error) {
+		c := env.Testkube()
+
+		exec, err := c.ExecuteTestWorkflowParallelStep(env.ExecutionId(), env.Ref(), testkube.TestWorkflowParallelExecutionRequest{
+			Index:      int32(combination.Index),
+			Matrix:     combination.Matrix,
+			ShardIndex: int32(combination.ShardIndex),
+			ShardCount: int32(combination.ShardCount),
+			Steps:      steps,
+		})
+		if err != nil {
+			ui.Errf("failed to execute parallel step: %s: %s", label, err.Error())
+			return err
+		}
+
+		details := testworkflowprocessor.ParallelExecution{
+			Id:     exec.Id,
+			Name:   exec.Name,
+			Index:  combination.Index,
+			Label:  label,
+			Matrix: combination.Matrix,
+		}
+		data.PrintOutput(env.Ref(), testworkflowprocessor.ParallelStartOutputName, &details)
+		fmt.Printf("%s • scheduled %s\n", ui.LightCyan(label), ui.DarkGray("("+exec.Id+")"))
+
+		// Fail after a few consecutive errors, instead of guessing the result
+		retries := 0
+	loop:
+		for {
+			time.Sleep(parallelPollInterval)
+			next, err := c.GetTestWorkflowExecution(exec.Id)
+			if err != nil {
+				retries++
+				if retries >= parallelMaxRetries {
+					ui.Errf("error while getting execution result: %s: %s", ui.LightCyan(label), err.Error())
+					return err
+				}
+				continue
+			}
+			retries = 0
+			exec = next
+			if exec.Result != nil && exec.Result.Status != nil {
+				switch *exec.Result.Status {
+				case testkube.QUEUED_TestWorkflowStatus, testkube.RUNNING_TestWorkflowStatus:
+					continue
+				default:
+					break loop
+				}
+			}
+		}
+
+		status := *exec.Result.Status
+		color := ui.Green
+
+		if status != testkube.PASSED_TestWorkflowStatus {
+			err = errors.New("parallel execution failed")
+			color = ui.Red
+		}
+
+		// Report the result, so it is aggregated in the parallel step result
+		details.Status = string(status)
+		data.PrintOutput(env.Ref(), testworkflowprocessor.ParallelEndOutputName, &details)
+		fmt.Printf("%s • %s\n", color(label), string(status))
+		return
+	}
+}
+
+func NewParallelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "parallel <spec>",
+		Short: "Run steps in parallel pods",
+		Args:  cobra.ExactArgs(1),
+
+		Run: func(cmd *cobra.Command, args []string) {
+			var spec testkube.TestWorkflowStepParallel
+			err := json.Unmarshal([]byte(args[0]), &spec)
+			if err != nil {
+				ui.Fail(errors.Wrap(err, "unmarshal parallel step"))
+			}
+
+			combinations, err := testworkflowresolver.ParallelCombinations(spec.Matrix, int(spec.Shards))
+			if err != nil {
+				ui.Fail(errors.Wrap(err, "parallel"))
+			}
+
+			// Calculate parallelism
+			parallelism := int(spec.Parallelism)
+			if parallelism <= 0 || parallelism > len(combinations) {
+				parallelism = len(combinations)
+			}
+			fmt.Printf("Running %d combinations, up to %d at once\n", len(combinations), parallelism)
+
+			// Create channel for execution
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			wg.Add(len(combinations))
+			ch := make(chan struct{}, parallelism)
+			success := true
+
+			// Execute all combinations
+			for _, combination := range combinations {
+				ch <- struct{}{}
+				go func(op func() error) {
+					if op() != nil {
+						mu.Lock()
+						success = false
+						mu.Unlock()
+					}
+					<-ch
+					wg.Done()
+				}(buildParallelExecution(combination, spec.Steps))
+			}
+			wg.Wait()
+
+			if !success {
+				os.Exit(1)
+			}
+		},
+	}
+
+	return cmd
+}
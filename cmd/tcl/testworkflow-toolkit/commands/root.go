@@ -27,6 +27,9 @@ func init() {
 	RootCmd.AddCommand(NewCloneCmd())
 	RootCmd.AddCommand(NewExecuteCmd())
 	RootCmd.AddCommand(NewArtifactsCmd())
+	RootCmd.AddCommand(NewCacheCmd())
+	RootCmd.AddCommand(NewApprovalCmd())
+	RootCmd.AddCommand(NewParallelCmd())
 }
 
 var RootCmd = &cobra.Command{
@@ -11,7 +11,9 @@ package env
 import (
 	"context"
 	"fmt"
+	"io"
 
+	minio2 "github.com/minio/minio-go/v7"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -23,6 +25,7 @@ import (
 	phttp "github.com/kubeshop/testkube/pkg/http"
 	"github.com/kubeshop/testkube/pkg/k8sclient"
 	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/storage/filesystem"
 	"github.com/kubeshop/testkube/pkg/storage/minio"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
@@ -63,6 +66,22 @@ func ObjectStorageClient() (*minio.Client, error) {
 	return c, c.Connect()
 }
 
+// ObjectStorageUploader saves the files directly in the storage
+type ObjectStorageUploader interface {
+	SaveFileDirect(ctx context.Context, folder, file string, data io.Reader, size int64, opts minio2.PutObjectOptions) error
+}
+
+// ObjectStorageUploaderClient returns the client to save the files in the storage,
+// using the signed API URLs when the storage is in the API server filesystem.
+func ObjectStorageUploaderClient() (ObjectStorageUploader, error) {
+	if FilesystemStorageEnabled() {
+		cfg := Config().ObjectStorage
+		signer := filesystem.NewSigner(fmt.Sprintf("http://%s:%d/v1/storage", config.APIServerName, config.APIServerPort), []byte(cfg.SecretAccessKey))
+		return filesystem.NewRemoteClient(signer, cfg.Bucket), nil
+	}
+	return ObjectStorageClient()
+}
+
 func Cloud(ctx context.Context) cloudexecutor.Executor {
 	cfg := Config().Cloud
 	grpcConn, err := agent.NewGRPCConnection(ctx, cfg.TlsInsecure, cfg.SkipVerify, cfg.Url, "", "", "", log.DefaultLogger)
@@ -11,6 +11,7 @@ package env
 import (
 	"github.com/kelseyhightower/envconfig"
 
+	"github.com/kubeshop/testkube/pkg/storage"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -19,6 +20,7 @@ var (
 )
 
 type envObjectStorageConfig struct {
+	Type            string `envconfig:"TK_OS_TYPE"`
 	Endpoint        string `envconfig:"TK_OS_ENDPOINT"`
 	AccessKeyID     string `envconfig:"TK_OS_ACCESSKEY"`
 	SecretAccessKey string `envconfig:"TK_OS_SECRETKEY"`
@@ -83,6 +85,10 @@ func CloudEnabled() bool {
 	return Config().Cloud.ApiKey != ""
 }
 
+func FilesystemStorageEnabled() bool {
+	return Config().ObjectStorage.Type == storage.TypeFilesystem
+}
+
 func UseProxy() bool {
 	return UseProxyValue
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -67,6 +68,7 @@ func (r *ArtilleryRunner) Run(ctx context.Context, execution testkube.Execution)
 	testDir, _ := filepath.Split(path)
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 
 	var envFile string
 	if len(envManager.Variables) != 0 {
@@ -118,10 +120,10 @@ func (r *ArtilleryRunner) Run(ctx context.Context, execution testkube.Execution)
 
 	// run executor
 	command, args := executor.MergeCommandAndArgs(execution.Command, args)
-	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(envManager.ObfuscateStringSlice(args), " "))
-	out, runerr := executor.Run(runPath, command, envManager, args...)
+	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(redactor.Strings(args), " "))
+	out, runerr := executor.Run(runPath, command, redactor, args...)
 
-	out = envManager.ObfuscateSecrets(out)
+	out = redactor.Bytes(out)
 
 	if hasJunit && hasReport {
 		var artilleryResult ArtilleryExecutionResult
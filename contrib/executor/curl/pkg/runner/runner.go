@@ -25,6 +25,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
 	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -106,6 +107,7 @@ func (r *CurlRunner) Run(ctx context.Context, execution testkube.Execution) (res
 
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 	variables := testkube.VariablesToMap(envManager.Variables)
 
 	outputPkg.PrintLogf("%s Filling in the input templates", ui.IconKey)
@@ -140,9 +142,9 @@ func (r *CurlRunner) Run(ctx context.Context, execution testkube.Execution) (res
 	}
 
 	runPath := workingDir
-	outputPkg.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(envManager.ObfuscateStringSlice(args), " "))
-	output, err := executor.Run(runPath, command, envManager, args...)
-	output = envManager.ObfuscateSecrets(output)
+	outputPkg.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(redactor.Strings(args), " "))
+	output, err := executor.Run(runPath, command, redactor, args...)
+	output = redactor.Bytes(output)
 
 	if err != nil {
 		r.Log.Errorf("Error occured when running a command %s", err)
@@ -7,7 +7,6 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/joshdk/go-junit"
 	"github.com/pkg/errors"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -20,6 +19,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
+	"github.com/kubeshop/testkube/pkg/reports"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -102,6 +103,7 @@ func (r *CypressRunner) Run(ctx context.Context, execution testkube.Execution) (
 
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 	envVars := make([]string, 0, len(envManager.Variables))
 	for _, value := range envManager.Variables {
 		if !value.IsSecret() {
@@ -153,15 +155,15 @@ func (r *CypressRunner) Run(ctx context.Context, execution testkube.Execution) (
 
 	// run cypress inside repo directory ignore execution error in case of failed test
 	command, args = executor.MergeCommandAndArgs(execution.Command, args)
-	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(envManager.ObfuscateStringSlice(args), " "))
-	out, err = executor.Run(runPath, command, envManager, args...)
-	out = envManager.ObfuscateSecrets(out)
+	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(redactor.Strings(args), " "))
+	out, err = executor.Run(runPath, command, redactor, args...)
+	out = redactor.Bytes(out)
 
-	var suites []junit.Suite
+	var junitReports []reports.Report
 	var serr error
 	if hasJunit && hasReporter {
-		suites, serr = junit.IngestDir(junitReportDir)
-		result = MapJunitToExecutionResults(out, suites)
+		junitReports, serr = reports.ParseDir(reports.FormatJUnit, junitReportDir, ".xml")
+		result = MapJunitToExecutionResults(out, junitReports)
 	} else {
 		result = makeSuccessExecution(out)
 	}
@@ -288,41 +290,12 @@ func makeSuccessExecution(out []byte) (result testkube.ExecutionResult) {
 	return result
 }
 
-func MapJunitToExecutionResults(out []byte, suites []junit.Suite) (result testkube.ExecutionResult) {
+func MapJunitToExecutionResults(out []byte, junitReports []reports.Report) (result testkube.ExecutionResult) {
 	result = makeSuccessExecution(out)
-
-	for _, suite := range suites {
-		for _, test := range suite.Tests {
-
-			result.Steps = append(
-				result.Steps,
-				testkube.ExecutionStepResult{
-					Name:     fmt.Sprintf("%s - %s", suite.Name, test.Name),
-					Duration: test.Duration.String(),
-					Status:   MapStatus(test.Status),
-				})
-		}
-
-		// TODO parse sub suites recursively
-
-	}
-
+	result.Steps = append(result.Steps, reports.ExecutionSteps(junitReports...)...)
 	return result
 }
 
-func MapStatus(in junit.Status) (out string) {
-	switch string(in) {
-	case "passed":
-		return string(testkube.PASSED_ExecutionStatus)
-	case "pending":
-		return string(testkube.QUEUED_ExecutionStatus)
-	case "skipped":
-		return string(testkube.SKIPPED_ExecutionStatus)
-	default:
-		return string(testkube.FAILED_ExecutionStatus)
-	}
-}
-
 // GetType returns runner type
 func (r *CypressRunner) GetType() runner.Type {
 	return runner.TypeMain
@@ -7,7 +7,6 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/joshdk/go-junit"
 	"github.com/pkg/errors"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -20,6 +19,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
+	"github.com/kubeshop/testkube/pkg/reports"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -62,6 +63,7 @@ func (r *GinkgoRunner) Run(ctx context.Context, execution testkube.Execution) (r
 	// variables of type "secret" will be automatically decoded
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 
 	path, workingDir, err := content.GetPathAndWorkingDir(execution.Content, r.Params.DataDir)
 	if err != nil {
@@ -114,7 +116,7 @@ func (r *GinkgoRunner) Run(ctx context.Context, execution testkube.Execution) (r
 	// check Ginkgo version
 	output.PrintLogf("%s Checking Ginkgo CLI version", ui.IconTruck)
 	command, args := executor.MergeCommandAndArgs(execution.Command, []string{"version"})
-	_, err = executor.Run(runPath, command, envManager, args...)
+	_, err = executor.Run(runPath, command, redactor, args...)
 	if err != nil {
 		output.PrintLogf("%s error checking Ginkgo CLI version: %s", ui.IconCross, err.Error())
 		return result, err
@@ -122,9 +124,9 @@ func (r *GinkgoRunner) Run(ctx context.Context, execution testkube.Execution) (r
 
 	// run executor here
 	command, args = executor.MergeCommandAndArgs(execution.Command, ginkgoArgs)
-	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(envManager.ObfuscateStringSlice(args), " "))
-	out, err := executor.Run(runPath, command, envManager, args...)
-	out = envManager.ObfuscateSecrets(out)
+	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(redactor.Strings(args), " "))
+	out, err := executor.Run(runPath, command, redactor, args...)
+	out = redactor.Bytes(out)
 
 	// generate report/result
 	if ginkgoParams["GinkgoJsonReport"] != "" {
@@ -150,10 +152,10 @@ func (r *GinkgoRunner) Run(ctx context.Context, execution testkube.Execution) (r
 			output.PrintLogf("%s could not move Junit report: %s", ui.IconCross, moveErr.Error())
 		}
 
-		var suites []junit.Suite
-		suites, serr = junit.IngestFile(filepath.Join(reportsPath, reportFile))
+		var report *reports.Report
+		report, serr = reports.ParseFile(reports.FormatJUnit, filepath.Join(reportsPath, reportFile))
 		if serr == nil {
-			result = MapJunitToExecutionResults(out, suites)
+			result = MapJunitToExecutionResults(out, *report)
 			output.PrintLogf("%s Mapped Junit to Execution Results...", ui.IconCheckMark)
 		}
 	} else {
@@ -316,7 +318,7 @@ func BuildGinkgoArgs(envManager *env.Manager, params map[string]string, path, ru
 		args[i] = os.ExpandEnv(args[i])
 	}
 
-	output.PrintLogf("%s Ginkgo arguments from params built: %s", ui.IconCheckMark, envManager.ObfuscateStringSlice(args))
+	output.PrintLogf("%s Ginkgo arguments from params built: %s", ui.IconCheckMark, redact.FromVariables(envManager.Variables).Strings(args))
 	return args, hasJunit && hasReport, nil
 }
 
@@ -341,28 +343,10 @@ func (r *GinkgoRunner) Validate(execution testkube.Execution) error {
 	return nil
 }
 
-func MapJunitToExecutionResults(out []byte, suites []junit.Suite) (result testkube.ExecutionResult) {
+func MapJunitToExecutionResults(out []byte, report reports.Report) (result testkube.ExecutionResult) {
 	result = makeSuccessExecution(out)
-
-	overallStatusFailed := false
-	for _, suite := range suites {
-		for _, test := range suite.Tests {
-			result.Steps = append(
-				result.Steps,
-				testkube.ExecutionStepResult{
-					Name:     fmt.Sprintf("%s - %s", suite.Name, test.Name),
-					Duration: test.Duration.String(),
-					Status:   MapStatus(test.Status),
-				})
-			if test.Status == junit.Status(testkube.FAILED_ExecutionStatus) {
-				overallStatusFailed = true
-			}
-		}
-
-		// TODO parse sub suites recursively
-
-	}
-	if overallStatusFailed {
+	result.Steps = append(result.Steps, reports.ExecutionSteps(report)...)
+	if len(report.FailedCases()) > 0 {
 		result.Status = testkube.ExecutionStatusFailed
 	} else {
 		result.Status = testkube.ExecutionStatusPassed
@@ -370,15 +354,6 @@ func MapJunitToExecutionResults(out []byte, suites []junit.Suite) (result testku
 	return result
 }
 
-func MapStatus(in junit.Status) (out string) {
-	switch string(in) {
-	case "passed":
-		return string(testkube.PASSED_ExecutionStatus)
-	default:
-		return string(testkube.FAILED_ExecutionStatus)
-	}
-}
-
 // GetType returns runner type
 func (r *GinkgoRunner) GetType() runner.Type {
 	return runner.TypeMain
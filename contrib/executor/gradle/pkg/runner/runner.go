@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/joshdk/go-junit"
 	"github.com/pkg/errors"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -20,6 +19,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
+	"github.com/kubeshop/testkube/pkg/reports"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -65,6 +66,7 @@ func (r *GradleRunner) Run(ctx context.Context, execution testkube.Execution) (r
 	// TODO design it better for now just append variables as envs
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 
 	// check settings.gradle or settings.gradle.kts files exist
 	directory := filepath.Join(r.params.DataDir, "repo", execution.Content.Repository.Path)
@@ -135,9 +137,9 @@ func (r *GradleRunner) Run(ctx context.Context, execution testkube.Execution) (r
 		args[i] = os.ExpandEnv(args[i])
 	}
 
-	output.PrintEvent("Running task: "+task, project, gradleCommand, envManager.ObfuscateStringSlice(args))
-	out, err := executor.Run(runPath, gradleCommand, envManager, args...)
-	out = envManager.ObfuscateSecrets(out)
+	output.PrintEvent("Running task: "+task, project, gradleCommand, redactor.Strings(args))
+	out, err := executor.Run(runPath, gradleCommand, redactor, args...)
+	out = redactor.Bytes(out)
 
 	var ls []string
 	_ = filepath.Walk("/data", func(path string, info fs.FileInfo, err error) error {
@@ -188,29 +190,11 @@ func (r *GradleRunner) Run(ctx context.Context, execution testkube.Execution) (r
 	result.OutputType = "text/plain"
 
 	junitReportPath := filepath.Join(directory, "build", "test-results")
-	err = filepath.Walk(junitReportPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			output.PrintLogf("%s Could not process reports: %s", ui.IconCross, err.Error())
-			return err
-		}
-
-		if !info.IsDir() && filepath.Ext(path) == ".xml" {
-			suites, _ := junit.IngestFile(path)
-			for _, suite := range suites {
-				for _, test := range suite.Tests {
-					result.Steps = append(
-						result.Steps,
-						testkube.ExecutionStepResult{
-							Name:     fmt.Sprintf("%s - %s", suite.Name, test.Name),
-							Duration: test.Duration.String(),
-							Status:   mapStatus(test.Status),
-						})
-				}
-			}
-		}
-
-		return nil
-	})
+	junitReports, err := reports.ParseDir(reports.FormatJUnit, junitReportPath, ".xml")
+	if err != nil {
+		output.PrintLogf("%s Could not process reports: %s", ui.IconCross, err.Error())
+	}
+	result.Steps = append(result.Steps, reports.ExecutionSteps(junitReports...)...)
 
 	if err != nil {
 		return *result.Err(err), nil
@@ -223,15 +207,6 @@ func (r *GradleRunner) Run(ctx context.Context, execution testkube.Execution) (r
 	return result, nil
 }
 
-func mapStatus(in junit.Status) (out string) {
-	switch string(in) {
-	case "passed":
-		return string(testkube.PASSED_ExecutionStatus)
-	default:
-		return string(testkube.FAILED_ExecutionStatus)
-	}
-}
-
 // GetType returns runner type
 func (r *GradleRunner) GetType() runner.Type {
 	return runner.TypeMain
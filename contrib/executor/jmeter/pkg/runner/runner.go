@@ -20,6 +20,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -61,6 +62,7 @@ func (r *JMeterRunner) Run(ctx context.Context, execution testkube.Execution) (r
 
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 
 	path, workingDir, err := content.GetPathAndWorkingDir(execution.Content, r.Params.DataDir)
 	if err != nil {
@@ -162,7 +164,7 @@ func (r *JMeterRunner) Run(ctx context.Context, execution testkube.Execution) (r
 		args[i] = os.ExpandEnv(args[i])
 	}
 
-	output.PrintLogf("%s Using arguments: %v", ui.IconWorld, envManager.ObfuscateStringSlice(args))
+	output.PrintLogf("%s Using arguments: %v", ui.IconWorld, redactor.Strings(args))
 
 	entryPoint := getEntryPoint()
 	for i := range execution.Command {
@@ -173,12 +175,12 @@ func (r *JMeterRunner) Run(ctx context.Context, execution testkube.Execution) (r
 
 	command, args := executor.MergeCommandAndArgs(execution.Command, args)
 	// run JMeter inside repo directory ignore execution error in case of failed test
-	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(envManager.ObfuscateStringSlice(args), " "))
-	out, err := executor.Run(runPath, command, envManager, args...)
+	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(redactor.Strings(args), " "))
+	out, err := executor.Run(runPath, command, redactor, args...)
 	if err != nil {
 		return *result.WithErrors(errors.Errorf("jmeter run error: %v", err)), nil
 	}
-	out = envManager.ObfuscateSecrets(out)
+	out = redactor.Bytes(out)
 
 	var executionResult testkube.ExecutionResult
 	if hasJunit && hasReport {
@@ -26,6 +26,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -81,6 +82,7 @@ func (r *JMeterDRunner) Run(ctx context.Context, execution testkube.Execution) (
 
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 
 	slavesEnvVariables := slaves.ExtractSlaveEnvVariables(envManager.Variables)
 	// creating slaves provided in SLAVES_COUNT env variable
@@ -158,7 +160,7 @@ func (r *JMeterDRunner) Run(ctx context.Context, execution testkube.Execution) (
 	}
 
 	args = injectAndExpandEnvVars(args, nil)
-	output.PrintLogf("%s Using arguments: %v", ui.IconWorld, envManager.ObfuscateStringSlice(args))
+	output.PrintLogf("%s Using arguments: %v", ui.IconWorld, redactor.Strings(args))
 
 	// TODO: this is a workaround, the check should be ideally performed in the getTestPathAndWorkingDir function
 	if err := checkIfTestFileExists(r.fs, args, workingDir); err != nil {
@@ -176,12 +178,12 @@ func (r *JMeterDRunner) Run(ctx context.Context, execution testkube.Execution) (
 
 	command, args := executor.MergeCommandAndArgs(execution.Command, args)
 	// run JMeter inside repo directory ignore execution error in case of failed test
-	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(envManager.ObfuscateStringSlice(args), " "))
-	out, err := executor.Run(runPath, command, envManager, args...)
+	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(redactor.Strings(args), " "))
+	out, err := executor.Run(runPath, command, redactor, args...)
 	if err != nil {
 		return *result.Err(errors.Errorf("jmeter run error: %v", err)), nil
 	}
-	out = envManager.ObfuscateSecrets(out)
+	out = redactor.Bytes(out)
 
 	var executionResult testkube.ExecutionResult
 	if hasJunit && hasReport {
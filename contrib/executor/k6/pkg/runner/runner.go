@@ -19,6 +19,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -79,6 +80,7 @@ func (r *K6Runner) Run(ctx context.Context, execution testkube.Execution) (resul
 	var envVars []string
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 	for _, variable := range envManager.Variables {
 		if variable.Name != "K6_CLOUD_TOKEN" {
 			// pass to k6 using -e option
@@ -177,14 +179,14 @@ func (r *K6Runner) Run(ctx context.Context, execution testkube.Execution) (resul
 	}
 
 	command, args := executor.MergeCommandAndArgs(execution.Command, args)
-	outputPkg.PrintEvent("Running", directory, command, envManager.ObfuscateStringSlice(args))
+	outputPkg.PrintEvent("Running", directory, command, redactor.Strings(args))
 	runPath := directory
 	if execution.Content.Repository != nil && execution.Content.Repository.WorkingDir != "" {
 		runPath = filepath.Join(directory, execution.Content.Repository.WorkingDir)
 	}
 
-	output, err := executor.Run(runPath, command, envManager, args...)
-	output = envManager.ObfuscateSecrets(output)
+	output, err := executor.Run(runPath, command, redactor, args...)
+	output = redactor.Bytes(output)
 
 	var rerr error
 	if execution.PostRunScript != "" && execution.ExecutePostRunScriptBeforeScraping {
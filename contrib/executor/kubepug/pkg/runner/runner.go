@@ -19,6 +19,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -79,13 +80,14 @@ func (r *KubepugRunner) Run(ctx context.Context, execution testkube.Execution) (
 
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
-	output.PrintLogf("%s Running kubepug with arguments: %v", ui.IconWorld, envManager.ObfuscateStringSlice(args))
+	redactor := redact.FromVariables(envManager.Variables)
+	output.PrintLogf("%s Running kubepug with arguments: %v", ui.IconWorld, redactor.Strings(args))
 
 	runPath := workingDir
 	command, args := executor.MergeCommandAndArgs(execution.Command, args)
-	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(envManager.ObfuscateStringSlice(args), " "))
-	out, err := executor.Run(runPath, command, envManager, args...)
-	out = envManager.ObfuscateSecrets(out)
+	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(redactor.Strings(args), " "))
+	out, err := executor.Run(runPath, command, redactor, args...)
+	out = redactor.Bytes(out)
 	if err != nil {
 		output.PrintLogf("%s Could not execute kubepug: %s", ui.IconCross, err.Error())
 		return testkube.ExecutionResult{}, fmt.Errorf("could not execute kubepug: %w", err)
@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/joshdk/go-junit"
 	"github.com/pkg/errors"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -21,6 +20,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
+	"github.com/kubeshop/testkube/pkg/reports"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -98,6 +99,7 @@ func (r *MavenRunner) Run(ctx context.Context, execution testkube.Execution) (re
 
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 
 	var settingsXML string
 	if execution.VariablesFile != "" {
@@ -163,9 +165,9 @@ func (r *MavenRunner) Run(ctx context.Context, execution testkube.Execution) (re
 		args[i] = os.ExpandEnv(args[i])
 	}
 
-	outputPkg.PrintEvent("Running goal: "+goal, mavenHome, mavenCommand, envManager.ObfuscateStringSlice(args))
-	output, err := executor.Run(runPath, mavenCommand, envManager, args...)
-	output = envManager.ObfuscateSecrets(output)
+	outputPkg.PrintEvent("Running goal: "+goal, mavenHome, mavenCommand, redactor.Strings(args))
+	output, err := executor.Run(runPath, mavenCommand, redactor, args...)
+	output = redactor.Bytes(output)
 
 	if err == nil {
 		result.Status = testkube.ExecutionStatusPassed
@@ -209,27 +211,8 @@ func (r *MavenRunner) Run(ctx context.Context, execution testkube.Execution) (re
 	result.OutputType = "text/plain"
 
 	junitReportPath := filepath.Join(directory, "target", "surefire-reports")
-	err = filepath.Walk(junitReportPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if !info.IsDir() && filepath.Ext(path) == ".xml" {
-			suites, _ := junit.IngestFile(path)
-			for _, suite := range suites {
-				for _, test := range suite.Tests {
-					result.Steps = append(
-						result.Steps,
-						testkube.ExecutionStepResult{
-							Name:     fmt.Sprintf("%s - %s", suite.Name, test.Name),
-							Duration: test.Duration.String(),
-							Status:   mapStatus(test.Status),
-						})
-				}
-			}
-		}
-
-		return nil
-	})
+	junitReports, err := reports.ParseDir(reports.FormatJUnit, junitReportPath, ".xml")
+	result.Steps = append(result.Steps, reports.ExecutionSteps(junitReports...)...)
 
 	if err != nil {
 		return *result.Err(err), nil
@@ -242,15 +225,6 @@ func (r *MavenRunner) Run(ctx context.Context, execution testkube.Execution) (re
 	return result, nil
 }
 
-func mapStatus(in junit.Status) (out string) {
-	switch string(in) {
-	case "passed":
-		return string(testkube.PASSED_ExecutionStatus)
-	default:
-		return string(testkube.FAILED_ExecutionStatus)
-	}
-}
-
 // createSettingsXML saves the settings.xml to maven config folder and adds it to the list of arguments.
 // In case it is taken from storage, it will return the path to the file
 func createSettingsXML(directory string, variablesFile string, isUploaded bool) (string, error) {
@@ -17,6 +17,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -103,11 +104,12 @@ func (r *PlaywrightRunner) Run(ctx context.Context, execution testkube.Execution
 
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 
 	command, args := executor.MergeCommandAndArgs(execution.Command, args)
-	output.PrintEvent("Running", runPath, command, envManager.ObfuscateStringSlice(args))
-	out, runErr := executor.Run(runPath, command, envManager, args...)
-	out = envManager.ObfuscateSecrets(out)
+	output.PrintEvent("Running", runPath, command, redactor.Strings(args))
+	out, runErr := executor.Run(runPath, command, redactor, args...)
+	out = redactor.Bytes(out)
 
 	if runErr != nil {
 		output.PrintLogf("%s Test run failed", ui.IconCross)
@@ -20,6 +20,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/tmp"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
@@ -90,6 +91,7 @@ func (r *NewmanRunner) Run(ctx context.Context, execution testkube.Execution) (r
 
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 
 	variablesFileContent := execution.VariablesFile
 	if execution.IsVariablesFileUploaded {
@@ -142,10 +144,10 @@ func (r *NewmanRunner) Run(ctx context.Context, execution testkube.Execution) (r
 	// we'll get error here in case of failed test too so we treat this as
 	// starter test execution with failed status
 	command, args := executor.MergeCommandAndArgs(execution.Command, args)
-	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(envManager.ObfuscateStringSlice(args), " "))
-	out, err := executor.Run(runPath, command, envManager, args...)
+	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(redactor.Strings(args), " "))
+	out, err := executor.Run(runPath, command, redactor, args...)
 
-	out = envManager.ObfuscateSecrets(out)
+	out = redactor.Bytes(out)
 
 	var nerr error
 	if hasJunit && hasReport {
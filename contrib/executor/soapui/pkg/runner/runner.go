@@ -20,6 +20,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/env"
 	"github.com/kubeshop/testkube/pkg/executor/output"
 	"github.com/kubeshop/testkube/pkg/executor/runner"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -145,13 +146,14 @@ func (r *SoapUIRunner) runSoapUI(execution *testkube.Execution, workingDir strin
 
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 
 	runPath := workingDir
 	command, args := executor.MergeCommandAndArgs(execution.Command, nil)
 	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, strings.Join(execution.Command, " "),
-		strings.Join(envManager.ObfuscateStringSlice(execution.Args), " "))
-	output, err := executor.Run(runPath, command, envManager, args...)
-	output = envManager.ObfuscateSecrets(output)
+		strings.Join(redactor.Strings(execution.Args), " "))
+	output, err := executor.Run(runPath, command, redactor, args...)
+	output = redactor.Bytes(output)
 	if err != nil {
 		return testkube.ExecutionResult{
 			Status:       testkube.ExecutionStatusFailed,
@@ -8,6 +8,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor"
 	"github.com/kubeshop/testkube/pkg/executor/env"
 	"github.com/kubeshop/testkube/pkg/executor/output"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -30,6 +31,8 @@ func (e *tracetestCoreExecutor) HasEnvVarsDefined(envManager *env.Manager) bool
 }
 
 func (e *tracetestCoreExecutor) Execute(envManager *env.Manager, execution testkube.Execution, testFilePath string) (model.Result, error) {
+	redactor := redact.FromVariables(envManager.Variables)
+
 	// Get TRACETEST_ENDPOINT from execution variables
 	tracetestEndpoint, err := getVariable(envManager, TRACETEST_ENDPOINT_VAR)
 	if err != nil {
@@ -45,13 +48,13 @@ func (e *tracetestCoreExecutor) Execute(envManager *env.Manager, execution testk
 		"run", "test", "--server-url", tracetestEndpoint, "--file", testFilePath, "--output", "pretty",
 	}
 
-	output.PrintLogf("%s Using arguments: %v", ui.IconWorld, envManager.ObfuscateStringSlice(args))
+	output.PrintLogf("%s Using arguments: %v", ui.IconWorld, redactor.Strings(args))
 
 	command, args := executor.MergeCommandAndArgs(execution.Command, args)
 
 	// Run tracetest test from test file
-	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(envManager.ObfuscateStringSlice(args), " "))
-	output, err := executor.Run("", command, envManager, args...)
+	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, command, strings.Join(redactor.Strings(args), " "))
+	output, err := executor.Run("", command, redactor, args...)
 
 	result := model.Result{
 		Output:         string(output),
@@ -10,6 +10,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/env"
 	"github.com/kubeshop/testkube/pkg/executor/output"
 	outputPkg "github.com/kubeshop/testkube/pkg/executor/output"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -37,6 +38,8 @@ func (e *tracetestCloudExecutor) HasEnvVarsDefined(envManager *env.Manager) bool
 }
 
 func (e *tracetestCloudExecutor) Execute(envManager *env.Manager, execution testkube.Execution, testFilePath string) (model.Result, error) {
+	redactor := redact.FromVariables(envManager.Variables)
+
 	tracetestToken, err := getVariable(envManager, TRACETEST_TOKEN_VAR)
 	if err != nil {
 		return model.Result{}, err
@@ -59,11 +62,11 @@ func (e *tracetestCloudExecutor) Execute(envManager *env.Manager, execution test
 		"configure", "--token", tracetestToken, "--organization", tracetestOrganization, "--environment", tracetestEnvironment,
 	}
 
-	output.PrintLogf("%s Using arguments to configure CLI: %v", ui.IconWorld, envManager.ObfuscateStringSlice(configArgs))
+	output.PrintLogf("%s Using arguments to configure CLI: %v", ui.IconWorld, redactor.Strings(configArgs))
 	configCommand, configArgs := executor.MergeCommandAndArgs(execution.Command, configArgs)
 
-	output.PrintLogf("%s Configure command %s %s", ui.IconRocket, configCommand, strings.Join(envManager.ObfuscateStringSlice(configArgs), " "))
-	_, err = executor.Run("", configCommand, envManager, configArgs...)
+	output.PrintLogf("%s Configure command %s %s", ui.IconRocket, configCommand, strings.Join(redactor.Strings(configArgs), " "))
+	_, err = executor.Run("", configCommand, redactor, configArgs...)
 
 	if err != nil {
 		outputPkg.PrintLogf("%s Failed to configure Tracetest CLI %v", ui.IconCross, err)
@@ -76,12 +79,12 @@ func (e *tracetestCloudExecutor) Execute(envManager *env.Manager, execution test
 		"run", "test", "--file", testFilePath, "--output", "pretty",
 	}
 
-	output.PrintLogf("%s Using arguments to run test: %v", ui.IconWorld, envManager.ObfuscateStringSlice(runTestArgs))
+	output.PrintLogf("%s Using arguments to run test: %v", ui.IconWorld, redactor.Strings(runTestArgs))
 	runTestCommand, runTestArgs := executor.MergeCommandAndArgs(execution.Command, runTestArgs)
 
 	// Run tracetest test from definition file
-	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, runTestCommand, strings.Join(envManager.ObfuscateStringSlice(runTestArgs), " "))
-	output, err := executor.Run("", runTestCommand, envManager, runTestArgs...)
+	output.PrintLogf("%s Test run command %s %s", ui.IconRocket, runTestCommand, strings.Join(redactor.Strings(runTestArgs), " "))
+	output, err := executor.Run("", runTestCommand, redactor, runTestArgs...)
 
 	result := model.Result{
 		Output:         string(output),
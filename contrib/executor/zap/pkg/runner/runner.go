@@ -19,6 +19,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -106,12 +107,13 @@ func (r *ZapRunner) Run(ctx context.Context, execution testkube.Execution) (resu
 	output.PrintLogf("%s Preparing variables", ui.IconWorld)
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
+	redactor := redact.FromVariables(envManager.Variables)
 	output.PrintLogf("%s Variables are prepared", ui.IconCheckMark)
 
 	args := zapArgs(scanType, options, reportFile)
 	output.PrintLogf("%s Reading execution arguments", ui.IconWorld)
 	args = MergeArgs(args, reportFile, execution)
-	output.PrintLogf("%s Arguments are ready: %s", ui.IconCheckMark, envManager.ObfuscateStringSlice(args))
+	output.PrintLogf("%s Arguments are ready: %s", ui.IconCheckMark, redact.FromVariables(envManager.Variables).Strings(args))
 
 	// when using file based ZAP parameters it expects a /zap/wrk directory
 	// we simply symlink the directory
@@ -119,8 +121,8 @@ func (r *ZapRunner) Run(ctx context.Context, execution testkube.Execution) (resu
 
 	output.PrintLogf("%s Running ZAP test", ui.IconMicroscope)
 	command, args := executor.MergeCommandAndArgs(execution.Command, args)
-	logs, err := executor.Run(r.ZapHome, command, envManager, args...)
-	logs = envManager.ObfuscateSecrets(logs)
+	logs, err := executor.Run(r.ZapHome, command, redactor, args...)
+	logs = redactor.Bytes(logs)
 
 	output.PrintLogf("%s Calculating results", ui.IconMicroscope)
 	if err == nil {
@@ -217,7 +217,9 @@ const sidebars = {
           ],
         },
         "openapi",
+        "articles/graphql",
         "articles/metrics",
+        "articles/tracing",
         "articles/artifacts",
         "articles/testkube-dependencies",
         "articles/architecture",
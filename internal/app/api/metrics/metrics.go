@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -101,6 +102,56 @@ var testWorkflowTemplateDeletesCount = promauto.NewCounterVec(prometheus.Counter
 	Help: "The total number of test workflow template deleted events",
 }, []string{"result"})
 
+const (
+	// LabelName is the optional histogram label with the name of the test, test suite or test workflow
+	LabelName = "name"
+	// LabelLabels is the optional histogram label with the labels of the execution
+	LabelLabels = "labels"
+	// LabelStep is the optional histogram label with the name of the test workflow step
+	LabelStep = "step"
+)
+
+// DefaultHistogramLabels are the optional labels included in the histograms by default
+var DefaultHistogramLabels = []string{LabelName, LabelStep}
+
+var durationBuckets = []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600, 7200, 14400}
+
+var testExecutionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "testkube_test_execution_duration_seconds",
+	Help:    "The duration of test executions",
+	Buckets: durationBuckets,
+}, []string{"type", "name", "result", "labels"})
+
+var testSuiteExecutionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "testkube_testsuite_execution_duration_seconds",
+	Help:    "The duration of test suite executions",
+	Buckets: durationBuckets,
+}, []string{"name", "result", "labels"})
+
+var testWorkflowExecutionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "testkube_testworkflow_execution_duration_seconds",
+	Help:    "The duration of test workflow executions",
+	Buckets: durationBuckets,
+}, []string{"name", "result", "labels"})
+
+var testWorkflowStepDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "testkube_testworkflow_step_duration_seconds",
+	Help:    "The duration of test workflow steps",
+	Buckets: durationBuckets,
+}, []string{"name", "step", "result"})
+
+var testWorkflowSchedulingDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "testkube_testworkflow_scheduling_duration_seconds",
+	Help:    "The time from scheduling the test workflow execution until its first pod is scheduled",
+	Buckets: []float64{0.5, 1, 2, 5, 10, 20, 30, 60, 120, 300, 600},
+}, []string{"name"})
+
+var testWorkflowArtifactsSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "testkube_testworkflow_artifacts_size_bytes",
+	Help:    "The total size of artifacts uploaded by test workflow steps",
+	Buckets: prometheus.ExponentialBuckets(1024, 4, 12),
+}, []string{"name"})
+
 func NewMetrics() Metrics {
 	return Metrics{
 		TestExecutions:                testExecutionCount,
@@ -121,7 +172,13 @@ func NewMetrics() Metrics {
 		TestWorkflowTemplateCreations: testWorkflowTemplateCreationCount,
 		TestWorkflowTemplateUpdates:   testWorkflowTemplateUpdatesCount,
 		TestWorkflowTemplateDeletes:   testWorkflowTemplateDeletesCount,
-	}
+		TestExecutionDuration:         testExecutionDuration,
+		TestSuiteExecutionDuration:    testSuiteExecutionDuration,
+		TestWorkflowExecutionDuration: testWorkflowExecutionDuration,
+		TestWorkflowStepDuration:      testWorkflowStepDuration,
+		TestWorkflowSchedulingTime:    testWorkflowSchedulingDuration,
+		TestWorkflowArtifactsSize:     testWorkflowArtifactsSize,
+	}.WithHistogramLabels(DefaultHistogramLabels...)
 }
 
 type Metrics struct {
@@ -143,6 +200,51 @@ type Metrics struct {
 	TestWorkflowTemplateCreations *prometheus.CounterVec
 	TestWorkflowTemplateUpdates   *prometheus.CounterVec
 	TestWorkflowTemplateDeletes   *prometheus.CounterVec
+	TestExecutionDuration         *prometheus.HistogramVec
+	TestSuiteExecutionDuration    *prometheus.HistogramVec
+	TestWorkflowExecutionDuration *prometheus.HistogramVec
+	TestWorkflowStepDuration      *prometheus.HistogramVec
+	TestWorkflowSchedulingTime    *prometheus.HistogramVec
+	TestWorkflowArtifactsSize     *prometheus.HistogramVec
+
+	histogramLabels map[string]struct{}
+}
+
+// WithHistogramLabels limits the optional labels of the histograms, to control their cardinality.
+// The optional labels that are not listed are kept empty.
+func (m Metrics) WithHistogramLabels(labels ...string) Metrics {
+	m.histogramLabels = make(map[string]struct{})
+	for _, label := range labels {
+		if label = strings.TrimSpace(label); label != "" {
+			m.histogramLabels[label] = struct{}{}
+		}
+	}
+	return m
+}
+
+// histogramLabel returns the value for the optional histogram label, or empty string when it's disabled
+func (m Metrics) histogramLabel(label, value string) string {
+	if _, ok := m.histogramLabels[label]; !ok {
+		return ""
+	}
+	return value
+}
+
+func joinLabels(labels map[string]string) string {
+	var result []string
+	for key, value := range labels {
+		result = append(result, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	slices.Sort(result)
+	return strings.Join(result, ",")
+}
+
+func duration(start, end time.Time, durationMs int32) float64 {
+	if !start.IsZero() && !end.IsZero() {
+		return end.Sub(start).Seconds()
+	}
+	return float64(durationMs) / 1000
 }
 
 func (m Metrics) IncExecuteTest(execution testkube.Execution, dashboardURI string) {
@@ -151,19 +253,22 @@ func (m Metrics) IncExecuteTest(execution testkube.Execution, dashboardURI strin
 		status = string(*execution.ExecutionResult.Status)
 	}
 
-	var labels []string
-	for key, value := range execution.Labels {
-		labels = append(labels, fmt.Sprintf("%s=%s", key, value))
-	}
-
-	slices.Sort(labels)
 	m.TestExecutions.With(map[string]string{
 		"type":     execution.TestType,
 		"name":     execution.TestName,
 		"result":   status,
-		"labels":   strings.Join(labels, ","),
+		"labels":   joinLabels(execution.Labels),
 		"test_uri": fmt.Sprintf("%s/tests/%s", dashboardURI, execution.TestName),
 	}).Inc()
+
+	if m.TestExecutionDuration != nil {
+		m.TestExecutionDuration.With(map[string]string{
+			"type":   execution.TestType,
+			"name":   m.histogramLabel(LabelName, execution.TestName),
+			"result": status,
+			"labels": m.histogramLabel(LabelLabels, joinLabels(execution.Labels)),
+		}).Observe(duration(execution.StartTime, execution.EndTime, execution.DurationMs))
+	}
 }
 
 func (m Metrics) IncExecuteTestSuite(execution testkube.TestSuiteExecution, dashboardURI string) {
@@ -177,12 +282,6 @@ func (m Metrics) IncExecuteTestSuite(execution testkube.TestSuiteExecution, dash
 		status = string(*execution.Status)
 	}
 
-	var labels []string
-	for key, value := range execution.Labels {
-		labels = append(labels, fmt.Sprintf("%s=%s", key, value))
-	}
-
-	slices.Sort(labels)
 	testSuiteName := ""
 	if execution.TestSuite != nil {
 		testSuiteName = execution.TestSuite.Name
@@ -191,9 +290,78 @@ func (m Metrics) IncExecuteTestSuite(execution testkube.TestSuiteExecution, dash
 	m.TestSuiteExecutions.With(map[string]string{
 		"name":          name,
 		"result":        status,
-		"labels":        strings.Join(labels, ","),
+		"labels":        joinLabels(execution.Labels),
 		"testsuite_uri": fmt.Sprintf("%s/test-suites/%s", dashboardURI, testSuiteName),
 	}).Inc()
+
+	if m.TestSuiteExecutionDuration != nil {
+		m.TestSuiteExecutionDuration.With(map[string]string{
+			"name":   m.histogramLabel(LabelName, name),
+			"result": status,
+			"labels": m.histogramLabel(LabelLabels, joinLabels(execution.Labels)),
+		}).Observe(duration(execution.StartTime, execution.EndTime, execution.DurationMs))
+	}
+}
+
+func (m Metrics) ObserveTestWorkflowExecution(execution testkube.TestWorkflowExecution) {
+	if m.TestWorkflowExecutionDuration == nil || execution.Result == nil {
+		return
+	}
+
+	name := ""
+	var labels map[string]string
+	if execution.Workflow != nil {
+		name = execution.Workflow.Name
+		labels = execution.Workflow.Labels
+	}
+
+	status := ""
+	if execution.Result.Status != nil {
+		status = string(*execution.Result.Status)
+	}
+
+	m.TestWorkflowExecutionDuration.With(map[string]string{
+		"name":   m.histogramLabel(LabelName, name),
+		"result": status,
+		"labels": m.histogramLabel(LabelLabels, joinLabels(labels)),
+	}).Observe(duration(execution.Result.QueuedAt, execution.Result.FinishedAt, execution.Result.DurationMs))
+}
+
+func (m Metrics) ObserveTestWorkflowStep(workflowName, stepName string, result testkube.TestWorkflowStepResult) {
+	if m.TestWorkflowStepDuration == nil || result.StartedAt.IsZero() || result.FinishedAt.IsZero() {
+		return
+	}
+
+	status := ""
+	if result.Status != nil {
+		status = string(*result.Status)
+	}
+
+	m.TestWorkflowStepDuration.With(map[string]string{
+		"name":   m.histogramLabel(LabelName, workflowName),
+		"step":   m.histogramLabel(LabelStep, stepName),
+		"result": status,
+	}).Observe(result.FinishedAt.Sub(result.StartedAt).Seconds())
+}
+
+func (m Metrics) ObserveTestWorkflowScheduling(workflowName string, scheduledAt, podScheduledAt time.Time) {
+	if m.TestWorkflowSchedulingTime == nil || scheduledAt.IsZero() || podScheduledAt.IsZero() {
+		return
+	}
+
+	m.TestWorkflowSchedulingTime.With(map[string]string{
+		"name": m.histogramLabel(LabelName, workflowName),
+	}).Observe(podScheduledAt.Sub(scheduledAt).Seconds())
+}
+
+func (m Metrics) ObserveTestWorkflowArtifacts(workflowName string, size uint64) {
+	if m.TestWorkflowArtifactsSize == nil {
+		return
+	}
+
+	m.TestWorkflowArtifactsSize.With(map[string]string{
+		"name": m.histogramLabel(LabelName, workflowName),
+	}).Observe(float64(size))
 }
 
 func (m Metrics) IncUpdateTest(testType string, err error) {
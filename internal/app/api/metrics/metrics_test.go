@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func collect(t *testing.T, collector prometheus.Collector) []*dto.Metric {
+	ch := make(chan prometheus.Metric, 100)
+	collector.Collect(ch)
+	close(ch)
+	var result []*dto.Metric
+	for metric := range ch {
+		m := &dto.Metric{}
+		require.NoError(t, metric.Write(m))
+		result = append(result, m)
+	}
+	return result
+}
+
+func labelValues(metric *dto.Metric) map[string]string {
+	result := make(map[string]string)
+	for _, pair := range metric.GetLabel() {
+		result[pair.GetName()] = pair.GetValue()
+	}
+	return result
+}
+
+func newTestMetrics() Metrics {
+	return Metrics{
+		TestExecutions: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_executions"},
+			[]string{"type", "name", "result", "labels", "test_uri"}),
+		TestExecutionDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "test_duration"},
+			[]string{"type", "name", "result", "labels"}),
+		TestWorkflowStepDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "step_duration"},
+			[]string{"name", "step", "result"}),
+	}
+}
+
+func TestMetrics_IncExecuteTest(t *testing.T) {
+	m := newTestMetrics().WithHistogramLabels(LabelName, LabelLabels)
+	started := time.Now()
+	m.IncExecuteTest(testkube.Execution{
+		TestType:        "k6/script",
+		TestName:        "smoke",
+		Labels:          map[string]string{"b": "2", "a": "1"},
+		StartTime:       started,
+		EndTime:         started.Add(90 * time.Second),
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusPassed},
+	}, "http://dashboard")
+
+	metrics := collect(t, m.TestExecutionDuration)
+	require.Len(t, metrics, 1)
+	assert.Equal(t, map[string]string{"type": "k6/script", "name": "smoke", "result": "passed", "labels": "a=1,b=2"}, labelValues(metrics[0]))
+	assert.Equal(t, uint64(1), metrics[0].GetHistogram().GetSampleCount())
+	assert.Equal(t, 90.0, metrics[0].GetHistogram().GetSampleSum())
+}
+
+func TestMetrics_HistogramLabelsDisabled(t *testing.T) {
+	m := newTestMetrics().WithHistogramLabels("")
+	m.IncExecuteTest(testkube.Execution{TestType: "k6/script", TestName: "smoke", Labels: map[string]string{"a": "1"}, DurationMs: 1500}, "")
+	m.IncExecuteTest(testkube.Execution{TestType: "k6/script", TestName: "other", DurationMs: 500}, "")
+
+	metrics := collect(t, m.TestExecutionDuration)
+	require.Len(t, metrics, 1)
+	assert.Equal(t, map[string]string{"type": "k6/script", "name": "", "result": "", "labels": ""}, labelValues(metrics[0]))
+	assert.Equal(t, uint64(2), metrics[0].GetHistogram().GetSampleCount())
+	assert.Equal(t, 2.0, metrics[0].GetHistogram().GetSampleSum())
+
+	// The counters keep all the labels
+	assert.Len(t, collect(t, m.TestExecutions), 2)
+}
+
+func TestMetrics_ObserveTestWorkflowStep(t *testing.T) {
+	m := newTestMetrics().WithHistogramLabels(DefaultHistogramLabels...)
+	started := time.Now()
+	m.ObserveTestWorkflowStep("e2e", "Run tests", testkube.TestWorkflowStepResult{
+		Status:     common.Ptr(testkube.FAILED_TestWorkflowStepStatus),
+		StartedAt:  started,
+		FinishedAt: started.Add(3 * time.Second),
+	})
+	// Ignore the steps that have not been started
+	m.ObserveTestWorkflowStep("e2e", "Skipped", testkube.TestWorkflowStepResult{
+		Status: common.Ptr(testkube.SKIPPED_TestWorkflowStepStatus),
+	})
+
+	metrics := collect(t, m.TestWorkflowStepDuration)
+	require.Len(t, metrics, 1)
+	assert.Equal(t, map[string]string{"name": "e2e", "step": "Run tests", "result": "failed"}, labelValues(metrics[0]))
+	assert.Equal(t, 3.0, metrics[0].GetHistogram().GetSampleSum())
+}
@@ -0,0 +1,156 @@
+package metrics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
+)
+
+const (
+	ExecutionTypeTest         = "test"
+	ExecutionTypeTestSuite    = "testsuite"
+	ExecutionTypeTestWorkflow = "testworkflow"
+
+	// runningExecutionsLimit is the maximum number of running executions read for each type
+	runningExecutionsLimit = 10000
+	// runningExecutionsTimeout is the maximum time for reading running executions during the scrape
+	runningExecutionsTimeout = 10 * time.Second
+)
+
+var runningDurationBuckets = []float64{60, 300, 600, 1800, 3600, 7200, 14400, 43200, 86400}
+
+var (
+	runningExecutionsDesc = prometheus.NewDesc(
+		"testkube_executions_running",
+		"The number of executions that are currently running",
+		[]string{"type"}, nil,
+	)
+	runningExecutionsDurationDesc = prometheus.NewDesc(
+		"testkube_executions_running_duration_seconds",
+		"For how long the currently running executions are running",
+		[]string{"type"}, nil,
+	)
+)
+
+// RunningExecutionsFn returns the start time of each execution that is still running
+type RunningExecutionsFn func(ctx context.Context) ([]time.Time, error)
+
+type runningExecutionsCollector struct {
+	listers map[string]RunningExecutionsFn
+	now     func() time.Time
+}
+
+// NewRunningExecutionsCollector creates the collector for the number and age of running executions by type.
+// The executions are read from the storage during the scrape, so all the API replicas report the same values.
+func NewRunningExecutionsCollector(listers map[string]RunningExecutionsFn) prometheus.Collector {
+	return &runningExecutionsCollector{
+		listers: listers,
+		now:     time.Now,
+	}
+}
+
+func (c *runningExecutionsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- runningExecutionsDesc
+	ch <- runningExecutionsDurationDesc
+}
+
+func (c *runningExecutionsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), runningExecutionsTimeout)
+	defer cancel()
+
+	types := make([]string, 0, len(c.listers))
+	for executionType := range c.listers {
+		types = append(types, executionType)
+	}
+	sort.Strings(types)
+
+	now := c.now()
+	for _, executionType := range types {
+		startTimes, err := c.listers[executionType](ctx)
+		if err != nil {
+			// Skip the type, so the rest of metrics is still exposed
+			log.DefaultLogger.Errorw("failed to read running executions for metrics", "type", executionType, "error", err)
+			continue
+		}
+
+		buckets := make(map[float64]uint64, len(runningDurationBuckets))
+		sum := 0.0
+		for _, startTime := range startTimes {
+			age := 0.0
+			if !startTime.IsZero() && now.After(startTime) {
+				age = now.Sub(startTime).Seconds()
+			}
+			sum += age
+			for _, bucket := range runningDurationBuckets {
+				if age <= bucket {
+					buckets[bucket]++
+				}
+			}
+		}
+
+		ch <- prometheus.MustNewConstMetric(runningExecutionsDesc, prometheus.GaugeValue, float64(len(startTimes)), executionType)
+		ch <- prometheus.MustNewConstHistogram(runningExecutionsDurationDesc, uint64(len(startTimes)), sum, buckets, executionType)
+	}
+}
+
+// RunningTests reads the start time of running test executions
+func RunningTests(repository result.Repository) RunningExecutionsFn {
+	return func(ctx context.Context) ([]time.Time, error) {
+		filter := result.NewExecutionsFilter().
+			WithStatus(string(testkube.RUNNING_ExecutionStatus)).
+			WithPageSize(runningExecutionsLimit)
+		executions, err := repository.GetExecutions(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		startTimes := make([]time.Time, len(executions))
+		for i := range executions {
+			startTimes[i] = executions[i].StartTime
+		}
+		return startTimes, nil
+	}
+}
+
+// RunningTestSuites reads the start time of running test suite executions
+func RunningTestSuites(repository testresult.Repository) RunningExecutionsFn {
+	return func(ctx context.Context) ([]time.Time, error) {
+		filter := testresult.NewExecutionsFilter().
+			WithStatus(string(testkube.RUNNING_TestSuiteExecutionStatus)).
+			WithPageSize(runningExecutionsLimit)
+		executions, err := repository.GetExecutions(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		startTimes := make([]time.Time, len(executions))
+		for i := range executions {
+			startTimes[i] = executions[i].StartTime
+		}
+		return startTimes, nil
+	}
+}
+
+// RunningTestWorkflows reads the start time of test workflow executions that are not finished yet
+func RunningTestWorkflows(repository testworkflow.Repository) RunningExecutionsFn {
+	return func(ctx context.Context) ([]time.Time, error) {
+		executions, err := repository.GetRunning(ctx)
+		if err != nil {
+			return nil, err
+		}
+		startTimes := make([]time.Time, len(executions))
+		for i := range executions {
+			startTimes[i] = executions[i].ScheduledAt
+			if executions[i].Result != nil && !executions[i].Result.QueuedAt.IsZero() {
+				startTimes[i] = executions[i].Result.QueuedAt
+			}
+		}
+		return startTimes, nil
+	}
+}
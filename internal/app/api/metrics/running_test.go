@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunningExecutionsCollector(t *testing.T) {
+	now := time.Now()
+	collector := NewRunningExecutionsCollector(map[string]RunningExecutionsFn{
+		ExecutionTypeTestWorkflow: func(ctx context.Context) ([]time.Time, error) {
+			return []time.Time{now.Add(-30 * time.Second), now.Add(-2 * time.Hour), {}}, nil
+		},
+		ExecutionTypeTest: func(ctx context.Context) ([]time.Time, error) {
+			return nil, errors.New("connection refused")
+		},
+	}).(*runningExecutionsCollector)
+	collector.now = func() time.Time { return now }
+
+	metrics := collect(t, collector)
+	require.Len(t, metrics, 2)
+
+	assert.Equal(t, map[string]string{"type": "testworkflow"}, labelValues(metrics[0]))
+	assert.Equal(t, 3.0, metrics[0].GetGauge().GetValue())
+
+	histogram := metrics[1].GetHistogram()
+	assert.Equal(t, uint64(3), histogram.GetSampleCount())
+	assert.Equal(t, 7230.0, histogram.GetSampleSum())
+	buckets := make(map[float64]uint64)
+	for _, bucket := range histogram.GetBucket() {
+		buckets[bucket.GetUpperBound()] = bucket.GetCumulativeCount()
+	}
+	assert.Equal(t, uint64(2), buckets[3600])
+	assert.Equal(t, uint64(3), buckets[7200])
+}
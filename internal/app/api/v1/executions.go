@@ -24,10 +24,12 @@ import (
 	testsv3 "github.com/kubeshop/testkube-operator/api/tests/v3"
 	"github.com/kubeshop/testkube/internal/common"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/compare"
 	"github.com/kubeshop/testkube/pkg/executor/client"
 	"github.com/kubeshop/testkube/pkg/executor/output"
 	"github.com/kubeshop/testkube/pkg/scheduler"
 	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/storage/filesystem"
 	"github.com/kubeshop/testkube/pkg/storage/minio"
 	"github.com/kubeshop/testkube/pkg/types"
 	"github.com/kubeshop/testkube/pkg/workerpool"
@@ -43,7 +45,7 @@ const (
 // ExecuteTestsHandler calls particular executor based on execution request content and type
 func (s *TestkubeAPI) ExecuteTestsHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		ctx := c.Context()
+		ctx := c.UserContext()
 		errPrefix := "failed to execute test"
 
 		var request testkube.ExecutionRequest
@@ -52,6 +54,10 @@ func (s *TestkubeAPI) ExecuteTestsHandler() fiber.Handler {
 			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: test request body invalid: %w", errPrefix, err))
 		}
 
+		if err = request.RetryPolicy.Validate(); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid retry policy: %w", errPrefix, err))
+		}
+
 		if request.Args != nil {
 			request.Args, err = testkube.PrepareExecutorArgs(request.Args)
 			if err != nil {
@@ -401,6 +407,20 @@ func (s *TestkubeAPI) AbortExecutionHandler() fiber.Handler {
 			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: could not get test %v", errPrefix, err))
 		}
 
+		// the aborted execution should not be retried
+		if err = s.scheduler.CancelExecutionRetry(ctx, execution.Id); err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: could not cancel execution retry: %v", errPrefix, err))
+		}
+
+		aborted, err := s.scheduler.AbortQueuedExecution(ctx, &execution)
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: could not abort queued execution: %v", errPrefix, err))
+		}
+		if aborted {
+			s.Metrics.IncAbortTest(execution.TestType, false)
+			return c.JSON(execution.ExecutionResult)
+		}
+
 		res, err := s.Executor.Abort(ctx, &execution)
 		if err != nil {
 			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: could not abort execution: %v", errPrefix, err))
@@ -727,6 +747,10 @@ func (s *TestkubeAPI) getArtifactStorage(bucket string) (storage.ArtifactsStorag
 		return s.ArtifactsStorage, nil
 	}
 
+	if s.storageParams.Type == storage.TypeFilesystem {
+		return minio.NewMinIOArtifactClient(filesystem.NewClient(s.storageParams.Path, bucket)), nil
+	}
+
 	opts := minio.GetTLSOptions(s.storageParams.SSL, s.storageParams.SkipVerify, s.storageParams.CertFile, s.storageParams.KeyFile, s.storageParams.CAFile)
 	minioClient := minio.NewClient(
 		s.storageParams.Endpoint,
@@ -769,3 +793,33 @@ func (s *TestkubeAPI) streamLogsFromLogServer(logs chan events.LogResponse, w *b
 
 	s.Log.Debugw("logs streaming stopped")
 }
+
+// CompareExecutionsHandler is a method for finding the differences between two test executions
+func (s *TestkubeAPI) CompareExecutionsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		baseID, headID := c.Query("base"), c.Query("head")
+		if baseID == "" || headID == "" {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("both base and head execution ids are required"))
+		}
+
+		threshold, err := strconv.ParseFloat(c.Query("threshold", ""), 64)
+		if err != nil || threshold <= 0 {
+			threshold = compare.DefaultThreshold
+		}
+
+		snapshots := make([]compare.Snapshot, 2)
+		for i, id := range []string{baseID, headID} {
+			execution, err := s.ExecutionResults.Get(ctx, id)
+			if err == mongo.ErrNoDocuments {
+				return s.Error(c, http.StatusNotFound, fmt.Errorf("execution %s not found", id))
+			}
+			if err != nil {
+				return s.Error(c, http.StatusInternalServerError, fmt.Errorf("db client was unable to get execution %s: %w", id, err))
+			}
+			snapshots[i] = compare.FromExecution(execution)
+		}
+
+		return c.JSON(compare.Compare(snapshots[0], snapshots[1], threshold))
+	}
+}
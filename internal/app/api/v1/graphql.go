@@ -5,13 +5,33 @@ import (
 	"net"
 	"net/http"
 
+	testworkflowsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
 	"github.com/kubeshop/testkube/internal/graphql"
 	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
 )
 
 // RunGraphQLServer runs GraphQL server on go net/http server
-func (s *TestkubeAPI) RunGraphQLServer(ctx context.Context, port string) error {
-	srv := graphql.GetServer(s.Events.Bus, s.ExecutorsClient)
+func (s *TestkubeAPI) RunGraphQLServer(
+	ctx context.Context,
+	port string,
+	testWorkflowsClient testworkflowsclientv1.Interface,
+	testWorkflowTemplatesClient testworkflowsclientv1.TestWorkflowTemplatesInterface,
+	testWorkflowResults testworkflow.Repository,
+) error {
+	srv := graphql.GetServer(s.Events.Bus, graphql.Clients{
+		Executors:              s.ExecutorsClient,
+		Tests:                  s.TestsClient,
+		TestSuites:             s.TestsSuitesClient,
+		TestWorkflows:          testWorkflowsClient,
+		TestWorkflowTemplates:  testWorkflowTemplatesClient,
+		Webhooks:               s.WebhooksClient,
+		Testkube:               s.TestKubeClientset,
+		Executions:             s.ExecutionResults,
+		TestSuiteExecutions:    s.TestExecutionResults,
+		TestWorkflowExecutions: testWorkflowResults,
+		Namespace:              s.Namespace,
+	})
 
 	mux := http.NewServeMux()
 	mux.Handle("/graphql", srv)
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListQueuedExecutionsHandler returns the queued test executions, in the order they will be started
+func (s *TestkubeAPI) ListQueuedExecutionsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		queue, err := s.scheduler.GetExecutionQueue(c.Context())
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("failed to list queued executions: %w", err))
+		}
+
+		return c.JSON(queue)
+	}
+}
+
+// GetQueuedExecutionHandler returns the queued test execution with its position in the queue
+func (s *TestkubeAPI) GetQueuedExecutionHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		executionID := c.Params("executionID")
+		errPrefix := fmt.Sprintf("failed to get queued execution %s", executionID)
+
+		queue, err := s.scheduler.GetExecutionQueue(c.Context())
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		for _, execution := range queue {
+			if execution.Id == executionID || execution.Name == executionID {
+				return c.JSON(execution)
+			}
+		}
+
+		return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: execution is not queued", errPrefix))
+	}
+}
@@ -22,8 +22,10 @@ import (
 	"github.com/kubeshop/testkube/pkg/version"
 
 	"github.com/kubeshop/testkube/pkg/datefilter"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/repository/result"
 	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/repository/webhookdelivery"
 
 	"k8s.io/client-go/kubernetes"
 
@@ -53,6 +55,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/secret"
 	"github.com/kubeshop/testkube/pkg/server"
 	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/storage/filesystem"
 	"github.com/kubeshop/testkube/pkg/telemetry"
 	"github.com/kubeshop/testkube/pkg/utils/text"
 )
@@ -71,6 +74,7 @@ func NewTestkubeAPI(
 	testsuitesClient *testsuitesclientv3.TestSuitesClient,
 	secretClient *secret.Client,
 	webhookClient *executorsclientv1.WebhooksClient,
+	webhookDeliveryResults webhookdelivery.Repository,
 	clientset kubernetes.Interface,
 	testkubeClientset testkubeclientset.Interface,
 	testsourcesClient *testsourcesclientv1.TestSourcesClient,
@@ -111,48 +115,53 @@ func NewTestkubeAPI(
 	if httpConfig.HttpBodyLimit == 0 {
 		httpConfig.Http.BodyLimit = DefaultHttpBodyLimit
 	}
+	// Stream the large bodies, i.e. the files uploaded to the filesystem storage
+	httpConfig.Http.StreamRequestBody = true
 
 	s := TestkubeAPI{
-		HTTPServer:            server.NewServer(httpConfig),
-		TestExecutionResults:  testsuiteExecutionsResults,
-		ExecutionResults:      testExecutionResults,
-		TestsClient:           testsClient,
-		ExecutorsClient:       executorsClient,
-		SecretClient:          secretClient,
-		Clientset:             clientset,
-		TestsSuitesClient:     testsuitesClient,
-		TestKubeClientset:     testkubeClientset,
-		Metrics:               metrics,
-		Events:                eventsEmitter,
-		WebhooksClient:        webhookClient,
-		TestSourcesClient:     testsourcesClient,
-		Namespace:             namespace,
-		ConfigMap:             configMap,
-		Executor:              executor,
-		ContainerExecutor:     containerExecutor,
-		scheduler:             scheduler,
-		slackLoader:           slackLoader,
-		Storage:               storage,
-		graphqlPort:           graphqlPort,
-		ArtifactsStorage:      artifactsStorage,
-		TemplatesClient:       templatesClient,
-		dashboardURI:          dashboardURI,
-		helmchartVersion:      helmchartVersion,
-		mode:                  mode,
-		eventsBus:             eventsBus,
-		enableSecretsEndpoint: enableSecretsEndpoint,
-		featureFlags:          ff,
-		logsStream:            logsStream,
-		logGrpcClient:         logGrpcClient,
-		SubscriptionChecker:   subscriptionChecker,
-		disableSecretCreation: disableSecretCreation,
-		LabelSources:          common.Ptr(make([]LabelSource, 0)),
+		HTTPServer:             server.NewServer(httpConfig),
+		TestExecutionResults:   testsuiteExecutionsResults,
+		ExecutionResults:       testExecutionResults,
+		TestsClient:            testsClient,
+		ExecutorsClient:        executorsClient,
+		SecretClient:           secretClient,
+		Clientset:              clientset,
+		TestsSuitesClient:      testsuitesClient,
+		TestKubeClientset:      testkubeClientset,
+		Metrics:                metrics,
+		Events:                 eventsEmitter,
+		WebhooksClient:         webhookClient,
+		WebhookDeliveryResults: webhookDeliveryResults,
+		TestSourcesClient:      testsourcesClient,
+		Namespace:              namespace,
+		ConfigMap:              configMap,
+		Executor:               executor,
+		ContainerExecutor:      containerExecutor,
+		scheduler:              scheduler,
+		slackLoader:            slackLoader,
+		Storage:                storage,
+		graphqlPort:            graphqlPort,
+		ArtifactsStorage:       artifactsStorage,
+		TemplatesClient:        templatesClient,
+		dashboardURI:           dashboardURI,
+		helmchartVersion:       helmchartVersion,
+		mode:                   mode,
+		eventsBus:              eventsBus,
+		enableSecretsEndpoint:  enableSecretsEndpoint,
+		featureFlags:           ff,
+		logsStream:             logsStream,
+		logGrpcClient:          logGrpcClient,
+		SubscriptionChecker:    subscriptionChecker,
+		disableSecretCreation:  disableSecretCreation,
+		LabelSources:           common.Ptr(make([]LabelSource, 0)),
 	}
 
 	// will be reused in websockets handler
 	s.WebsocketLoader = ws.NewWebsocketLoader()
 
-	s.Events.Loader.Register(webhook.NewWebhookLoader(s.Log, webhookClient, templatesClient))
+	s.WebhookRetrier = webhook.NewRetrier(s.Log, webhookDeliveryResults, eventsBus)
+	s.Events.Loader.Register(webhook.NewWebhookLoader(s.Log, webhookClient, templatesClient, webhookDeliveryResults, secretClient,
+		redact.NewResolver(clientset, namespace), s.WebhookRetrier))
 	s.Events.Loader.Register(s.WebsocketLoader)
 	s.Events.Loader.Register(s.slackLoader)
 
@@ -173,46 +182,50 @@ func NewTestkubeAPI(
 
 type TestkubeAPI struct {
 	server.HTTPServer
-	ExecutionResults      result.Repository
-	TestExecutionResults  testresult.Repository
-	Executor              client.Executor
-	ContainerExecutor     client.Executor
-	TestsSuitesClient     *testsuitesclientv3.TestSuitesClient
-	TestsClient           *testsclientv3.TestsClient
-	ExecutorsClient       *executorsclientv1.ExecutorsClient
-	SecretClient          *secret.Client
-	WebhooksClient        *executorsclientv1.WebhooksClient
-	TestKubeClientset     testkubeclientset.Interface
-	TestSourcesClient     *testsourcesclientv1.TestSourcesClient
-	Metrics               metrics.Metrics
-	Storage               storage.Client
-	storageParams         storageParams
-	Namespace             string
-	oauthParams           oauthParams
-	WebsocketLoader       *ws.WebsocketLoader
-	Events                *event.Emitter
-	ConfigMap             repoConfig.Repository
-	scheduler             *scheduler.Scheduler
-	Clientset             kubernetes.Interface
-	slackLoader           *slack.SlackLoader
-	graphqlPort           string
-	ArtifactsStorage      storage.ArtifactsStorage
-	TemplatesClient       *templatesclientv1.TemplatesClient
-	dashboardURI          string
-	helmchartVersion      string
-	mode                  string
-	eventsBus             bus.Bus
-	enableSecretsEndpoint bool
-	featureFlags          featureflags.FeatureFlags
-	logsStream            logsclient.Stream
-	logGrpcClient         logsclient.StreamGetter
-	proContext            *config.ProContext
-	SubscriptionChecker   checktcl.SubscriptionChecker
-	disableSecretCreation bool
-	LabelSources          *[]LabelSource
+	ExecutionResults       result.Repository
+	TestExecutionResults   testresult.Repository
+	Executor               client.Executor
+	ContainerExecutor      client.Executor
+	TestsSuitesClient      *testsuitesclientv3.TestSuitesClient
+	TestsClient            *testsclientv3.TestsClient
+	ExecutorsClient        *executorsclientv1.ExecutorsClient
+	SecretClient           *secret.Client
+	WebhooksClient         *executorsclientv1.WebhooksClient
+	WebhookDeliveryResults webhookdelivery.Repository
+	WebhookRetrier         *webhook.Retrier
+	TestKubeClientset      testkubeclientset.Interface
+	TestSourcesClient      *testsourcesclientv1.TestSourcesClient
+	Metrics                metrics.Metrics
+	Storage                storage.Client
+	storageParams          storageParams
+	Namespace              string
+	oauthParams            oauthParams
+	WebsocketLoader        *ws.WebsocketLoader
+	Events                 *event.Emitter
+	ConfigMap              repoConfig.Repository
+	scheduler              *scheduler.Scheduler
+	Clientset              kubernetes.Interface
+	slackLoader            *slack.SlackLoader
+	graphqlPort            string
+	ArtifactsStorage       storage.ArtifactsStorage
+	TemplatesClient        *templatesclientv1.TemplatesClient
+	dashboardURI           string
+	helmchartVersion       string
+	mode                   string
+	eventsBus              bus.Bus
+	enableSecretsEndpoint  bool
+	featureFlags           featureflags.FeatureFlags
+	logsStream             logsclient.Stream
+	logGrpcClient          logsclient.StreamGetter
+	proContext             *config.ProContext
+	SubscriptionChecker    checktcl.SubscriptionChecker
+	disableSecretCreation  bool
+	LabelSources           *[]LabelSource
 }
 
 type storageParams struct {
+	Type            string `envconfig:"STORAGE_TYPE" default:"minio"`
+	Path            string `envconfig:"STORAGE_PATH" default:"/data/storage"`
 	SSL             bool   `envconfig:"STORAGE_SSL" default:"false"`
 	SkipVerify      bool   `envconfig:"STORAGE_SKIP_VERIFY" default:"false"`
 	CertFile        string `envconfig:"STORAGE_CERT_FILE"`
@@ -311,6 +324,7 @@ func (s *TestkubeAPI) InitRoutes() {
 	webhooks.Patch("/:name", s.UpdateWebhookHandler())
 	webhooks.Get("/", s.ListWebhooksHandler())
 	webhooks.Get("/:name", s.GetWebhookHandler())
+	webhooks.Get("/:name/deliveries", s.ListWebhookDeliveriesHandler())
 	webhooks.Delete("/:name", s.DeleteWebhookHandler())
 	webhooks.Delete("/", s.DeleteWebhooksHandler())
 
@@ -318,6 +332,7 @@ func (s *TestkubeAPI) InitRoutes() {
 
 	executions.Get("/", s.ListExecutionsHandler())
 	executions.Post("/", s.ExecuteTestsHandler())
+	executions.Get("/compare", s.CompareExecutionsHandler())
 	executions.Get("/:executionID", s.GetExecutionHandler())
 	executions.Get("/:executionID/artifacts", s.ListArtifactsHandler())
 	executions.Get("/:executionID/logs", s.ExecutionLogsHandler())
@@ -327,6 +342,11 @@ func (s *TestkubeAPI) InitRoutes() {
 	executions.Get("/:executionID/artifacts/:filename", s.GetArtifactHandler())
 	executions.Get("/:executionID/artifact-archive", s.GetArtifactArchiveHandler())
 
+	queue := root.Group("/queue")
+
+	queue.Get("/", s.ListQueuedExecutionsHandler())
+	queue.Get("/:executionID", s.GetQueuedExecutionHandler())
+
 	tests := root.Group("/tests")
 
 	tests.Get("/", s.ListTestsHandler())
@@ -339,6 +359,7 @@ func (s *TestkubeAPI) InitRoutes() {
 	tests.Post("/:id/abort", s.AbortTestHandler())
 
 	tests.Get("/:id/metrics", s.TestMetricsHandler())
+	tests.Get("/:id/flaky", s.TestFlakyCasesHandler())
 
 	tests.Post("/:id/executions", s.ExecuteTestsHandler())
 
@@ -373,6 +394,7 @@ func (s *TestkubeAPI) InitRoutes() {
 	testSuiteExecutions := root.Group("/test-suite-executions")
 	testSuiteExecutions.Get("/", s.ListTestSuiteExecutionsHandler())
 	testSuiteExecutions.Post("/", s.ExecuteTestSuitesHandler())
+	testSuiteExecutions.Get("/compare", s.CompareTestSuiteExecutionsHandler())
 	testSuiteExecutions.Get("/:executionID", s.GetTestSuiteExecutionHandler())
 	testSuiteExecutions.Get("/:executionID/artifacts", s.ListTestSuiteArtifactsHandler())
 	testSuiteExecutions.Patch("/:executionID", s.AbortTestSuiteExecutionHandler())
@@ -431,6 +453,13 @@ func (s *TestkubeAPI) InitRoutes() {
 	files := root.Group("/uploads")
 	files.Post("/", s.UploadFiles())
 
+	// signed URLs emulating the presigned object storage URLs for the filesystem storage
+	if filesystemStorage, ok := s.Storage.(*filesystem.Client); ok {
+		storageFiles := root.Group("/storage")
+		storageFiles.Get("/:bucket/*", s.GetStorageFileHandler(filesystemStorage))
+		storageFiles.Put("/:bucket/*", s.PutStorageFileHandler(filesystemStorage))
+	}
+
 	if s.enableSecretsEndpoint {
 		files := root.Group("/secrets")
 		files.Get("/", s.ListSecretsHandler())
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubeshop/testkube/pkg/storage/filesystem"
+)
+
+// verifyStorageRequest reads the object from the signed storage URL and checks the signature
+func (s *TestkubeAPI) verifyStorageRequest(c *fiber.Ctx, client *filesystem.Client, method string) (bucket, key string, status int, err error) {
+	bucket, err = url.PathUnescape(c.Params("bucket"))
+	if err != nil {
+		return "", "", http.StatusBadRequest, fmt.Errorf("invalid bucket: %w", err)
+	}
+	key, err = url.PathUnescape(c.Params("*"))
+	if err != nil {
+		return "", "", http.StatusBadRequest, fmt.Errorf("invalid object key: %w", err)
+	}
+	if client.Signer() == nil {
+		return "", "", http.StatusForbidden, filesystem.ErrPresignNotConfigured
+	}
+	err = client.Signer().Verify(method, bucket, key, c.Query(filesystem.ExpiresParam), c.Query(filesystem.SignatureParam))
+	if err != nil {
+		return "", "", http.StatusForbidden, err
+	}
+	return bucket, key, 0, nil
+}
+
+// GetStorageFileHandler downloads the file from the filesystem storage, using the signed URL
+func (s *TestkubeAPI) GetStorageFileHandler(client *filesystem.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		errPrefix := "failed to download file from storage"
+		bucket, key, status, err := s.verifyStorageRequest(c, client, http.MethodGet)
+		if err != nil {
+			return s.Error(c, status, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		file, info, err := client.DownloadFileFromBucket(c.Context(), bucket, "", key)
+		if errors.Is(err, os.ErrNotExist) {
+			return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: file %s not found", errPrefix, key))
+		}
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		// SendStream promises to close file using io.Close() method
+		return c.SendStream(file, int(info.Size))
+	}
+}
+
+// PutStorageFileHandler uploads the file to the filesystem storage, using the signed URL
+func (s *TestkubeAPI) PutStorageFileHandler(client *filesystem.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		errPrefix := "failed to upload file to storage"
+		bucket, key, status, err := s.verifyStorageRequest(c, client, http.MethodPut)
+		if err != nil {
+			return s.Error(c, status, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		// stream the body directly to the file, instead of buffering it in the memory
+		var body io.Reader = c.Context().RequestBodyStream()
+		size := int64(c.Request().Header.ContentLength())
+		if body == nil {
+			data := c.Body()
+			body, size = bytes.NewReader(data), int64(len(data))
+		}
+		opts := filesystem.PutObjectOptions(func(key string) string {
+			return c.Get(key)
+		})
+		err = client.SaveFileDirectToBucket(c.Context(), bucket, "", key, body, size, opts)
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		return c.SendStatus(http.StatusOK)
+	}
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/server"
+	"github.com/kubeshop/testkube/pkg/storage/filesystem"
+)
+
+func TestTestkubeAPI_PutStorageFileHandler_Stream(t *testing.T) {
+	signer := filesystem.NewSigner("/v1/storage", []byte("key"))
+	client := filesystem.NewClient(t.TempDir(), "testkube", filesystem.WithSigner(signer))
+	require.NoError(t, client.Connect())
+
+	app := fiber.New(fiber.Config{StreamRequestBody: true})
+	s := &TestkubeAPI{
+		HTTPServer: server.HTTPServer{
+			Mux: app,
+			Log: log.DefaultLogger,
+		},
+	}
+	app.Put("/v1/storage/:bucket/*", s.PutStorageFileHandler(client))
+
+	content := strings.Repeat("line of the report\n", 100_000)
+	link, err := signer.Sign(http.MethodPut, "testkube", "execution-1/report.txt", time.Minute)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPut, link, strings.NewReader(content))
+	req.ContentLength = int64(len(content))
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	object, err := client.DownloadFile(context.Background(), "execution-1", "report.txt")
+	require.NoError(t, err)
+	data, err := io.ReadAll(object)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal([]byte(content), data))
+}
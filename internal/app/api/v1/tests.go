@@ -21,6 +21,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/crd"
 	"github.com/kubeshop/testkube/pkg/executor/client"
+	"github.com/kubeshop/testkube/pkg/flaky"
 	executionsmapper "github.com/kubeshop/testkube/pkg/mapper/executions"
 	testsmapper "github.com/kubeshop/testkube/pkg/mapper/tests"
 	"github.com/kubeshop/testkube/pkg/repository/result"
@@ -179,6 +180,31 @@ func (s TestkubeAPI) TestMetricsHandler() fiber.Handler {
 	}
 }
 
+// TestFlakyCasesHandler is a method for detecting flaky test cases in the latest test executions
+func (s TestkubeAPI) TestFlakyCasesHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		testName := c.Params("id")
+
+		last, err := strconv.Atoi(c.Query("last", strconv.Itoa(flaky.DefaultLimit)))
+		if err != nil || last <= 0 {
+			last = flaky.DefaultLimit
+		}
+
+		threshold, err := strconv.ParseFloat(c.Query("threshold", ""), 64)
+		if err != nil || threshold <= 0 {
+			threshold = flaky.DefaultThreshold
+		}
+
+		filter := result.NewExecutionsFilter().WithTestName(testName).WithPageSize(last)
+		executions, err := s.ExecutionResults.GetExecutions(c.Context(), filter)
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("failed to get executions for test %s: %w", testName, err))
+		}
+
+		return c.JSON(flaky.Report(testName, flaky.RunsFromExecutions(executions), threshold))
+	}
+}
+
 // getLatestExecutions return latest executions either by starttime or endtime for tests
 func (s TestkubeAPI) getLatestExecutions(ctx context.Context, testNames []string) (map[string]testkube.Execution, error) {
 	executions, err := s.ExecutionResults.GetLatestByTests(ctx, testNames)
@@ -523,7 +549,8 @@ func (s TestkubeAPI) AbortTestHandler() fiber.Handler {
 			return s.Error(c, http.StatusBadRequest, fmt.Errorf("failed to abort test: id cannot be empty"))
 		}
 		errPrefix := fmt.Sprintf("failed to abort test %s", name)
-		filter := result.NewExecutionsFilter().WithTestName(name).WithStatus(string(testkube.RUNNING_ExecutionStatus))
+		statuses := string(testkube.RUNNING_ExecutionStatus) + "," + string(testkube.QUEUED_ExecutionStatus)
+		filter := result.NewExecutionsFilter().WithTestName(name).WithStatus(statuses)
 		executions, err := s.ExecutionResults.GetExecutions(ctx, filter)
 		if err != nil {
 			if err == mongo.ErrNoDocuments {
@@ -534,6 +561,26 @@ func (s TestkubeAPI) AbortTestHandler() fiber.Handler {
 
 		var results []testkube.ExecutionResult
 		for _, execution := range executions {
+			// the aborted execution should not be retried
+			if errCancel := s.scheduler.CancelExecutionRetry(ctx, execution.Id); errCancel != nil {
+				s.Log.Errorw("cancelling execution retry failed", "execution", execution, "error", errCancel)
+				err = errCancel
+			}
+
+			aborted, errAbort := s.scheduler.AbortQueuedExecution(ctx, &execution)
+			if errAbort != nil {
+				s.Log.Errorw("aborting queued execution failed", "execution", execution, "error", errAbort)
+				err = errAbort
+			}
+			if aborted {
+				s.Metrics.IncAbortTest(execution.TestType, false)
+				results = append(results, *execution.ExecutionResult)
+				continue
+			}
+			if execution.IsQueued() {
+				continue
+			}
+
 			res, errAbort := s.Executor.Abort(ctx, &execution)
 			if errAbort != nil {
 				s.Log.Errorw("aborting execution failed", "execution", execution, "error", errAbort)
@@ -19,6 +19,7 @@ import (
 
 	testsuitesv3 "github.com/kubeshop/testkube-operator/api/testsuite/v3"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/compare"
 	"github.com/kubeshop/testkube/pkg/crd"
 	"github.com/kubeshop/testkube/pkg/datefilter"
 	"github.com/kubeshop/testkube/pkg/event/bus"
@@ -547,6 +548,10 @@ func (s TestkubeAPI) ExecuteTestSuitesHandler() fiber.Handler {
 			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: test execution request body invalid: %w", errPrefix, err))
 		}
 
+		if err = request.RetryPolicy.Validate(); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid retry policy: %w", errPrefix, err))
+		}
+
 		name := c.Params("id")
 		selector := c.Query("selector")
 		s.Log.Debugw("getting test suite", "name", name, "selector", selector)
@@ -583,7 +588,7 @@ func (s TestkubeAPI) ExecuteTestSuitesHandler() fiber.Handler {
 			workerpoolService := workerpool.New[testkube.TestSuite, testkube.TestSuiteExecutionRequest, testkube.TestSuiteExecution](concurrencyLevel)
 
 			go workerpoolService.SendRequests(s.scheduler.PrepareTestSuiteRequests(testSuites, request))
-			go workerpoolService.Run(c.Context())
+			go workerpoolService.Run(c.UserContext())
 
 			for r := range workerpoolService.GetResponses() {
 				results = append(results, r.Result)
@@ -898,3 +903,33 @@ func getExecutionsFilterFromRequest(c *fiber.Ctx) testresult.Filter {
 
 	return filter
 }
+
+// CompareTestSuiteExecutionsHandler is a method for finding the differences between two test suite executions
+func (s TestkubeAPI) CompareTestSuiteExecutionsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		baseID, headID := c.Query("base"), c.Query("head")
+		if baseID == "" || headID == "" {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("both base and head execution ids are required"))
+		}
+
+		threshold, err := strconv.ParseFloat(c.Query("threshold", ""), 64)
+		if err != nil || threshold <= 0 {
+			threshold = compare.DefaultThreshold
+		}
+
+		snapshots := make([]compare.Snapshot, 2)
+		for i, id := range []string{baseID, headID} {
+			execution, err := s.TestExecutionResults.Get(ctx, id)
+			if err == mongo.ErrNoDocuments {
+				return s.Error(c, http.StatusNotFound, fmt.Errorf("test suite execution %s not found", id))
+			}
+			if err != nil {
+				return s.Error(c, http.StatusInternalServerError, fmt.Errorf("could not get test suite execution %s from db: %w", id, err))
+			}
+			snapshots[i] = compare.FromTestSuiteExecution(execution)
+		}
+
+		return c.JSON(compare.Compare(snapshots[0], snapshots[1], threshold))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -13,6 +14,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/crd"
 	webhooksmapper "github.com/kubeshop/testkube/pkg/mapper/webhooks"
+	"github.com/kubeshop/testkube/pkg/repository/webhookdelivery"
 )
 
 func (s TestkubeAPI) CreateWebhookHandler() fiber.Handler {
@@ -159,6 +161,47 @@ func (s TestkubeAPI) GetWebhookHandler() fiber.Handler {
 	}
 }
 
+func (s TestkubeAPI) ListWebhookDeliveriesHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		name := c.Params("name")
+		errPrefix := fmt.Sprintf("failed to list webhook %s deliveries", name)
+
+		if s.WebhookDeliveryResults == nil {
+			return s.Error(c, http.StatusNotImplemented, fmt.Errorf("%s: webhook delivery history is not supported", errPrefix))
+		}
+
+		page, err := strconv.Atoi(c.Query("page", "0"))
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: page filter invalid: %w", errPrefix, err))
+		}
+
+		pageSize, err := strconv.Atoi(c.Query("pageSize", strconv.Itoa(webhookdelivery.PageDefaultLimit)))
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: page size filter invalid: %w", errPrefix, err))
+		}
+
+		var status *testkube.WebhookDeliveryStatus
+		if c.Query("status") != "" {
+			status = testkube.WebhookDeliveryStatusPtr(testkube.WebhookDeliveryStatus(c.Query("status")))
+		}
+
+		if _, err = s.WebhooksClient.Get(name); err != nil {
+			if errors.IsNotFound(err) {
+				return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: webhook not found: %w", errPrefix, err))
+			}
+			return s.Error(c, http.StatusBadGateway, fmt.Errorf("%s: client could not get webhook: %w", errPrefix, err))
+		}
+
+		// listeners are named after the webhook namespace and name
+		deliveries, err := s.WebhookDeliveryResults.GetByWebhook(c.Context(), fmt.Sprintf("%s.%s", s.Namespace, name), status, page, pageSize)
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: db client could not get deliveries: %w", errPrefix, err))
+		}
+
+		return c.JSON(deliveries)
+	}
+}
+
 func (s TestkubeAPI) DeleteWebhookHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		name := c.Params("name")
@@ -22,6 +22,8 @@ type Config struct {
 	SlackToken                                  string        `envconfig:"SLACK_TOKEN" default:""`
 	SlackConfig                                 string        `envconfig:"SLACK_CONFIG" default:""`
 	SlackTemplate                               string        `envconfig:"SLACK_TEMPLATE" default:""`
+	StorageType                                 string        `envconfig:"STORAGE_TYPE" default:"minio"`
+	StoragePath                                 string        `envconfig:"STORAGE_PATH" default:"/data/storage"`
 	StorageEndpoint                             string        `envconfig:"STORAGE_ENDPOINT" default:"localhost:9000"`
 	StorageBucket                               string        `envconfig:"STORAGE_BUCKET" default:"testkube-logs"`
 	StorageExpiration                           int           `envconfig:"STORAGE_EXPIRATION"`
@@ -47,6 +49,7 @@ type Config struct {
 	JobServiceAccountName                       string        `envconfig:"JOB_SERVICE_ACCOUNT_NAME" default:""`
 	JobTemplateFile                             string        `envconfig:"JOB_TEMPLATE_FILE" default:""`
 	DisableTestTriggers                         bool          `envconfig:"DISABLE_TEST_TRIGGERS" default:"false"`
+	DisableTestWorkflowScheduler                bool          `envconfig:"DISABLE_TEST_WORKFLOW_SCHEDULER" default:"false"`
 	TestkubeDefaultExecutors                    string        `envconfig:"TESTKUBE_DEFAULT_EXECUTORS" default:""`
 	TestkubeEnabledExecutors                    string        `envconfig:"TESTKUBE_ENABLED_EXECUTORS" default:""`
 	TestkubeTemplateJob                         string        `envconfig:"TESTKUBE_TEMPLATE_JOB" default:""`
@@ -84,6 +87,7 @@ type Config struct {
 	TestkubePodStartTimeout                     time.Duration `envconfig:"TESTKUBE_POD_START_TIMEOUT" default:"30m"`
 	CDEventsTarget                              string        `envconfig:"CDEVENTS_TARGET" default:""`
 	TestkubeDashboardURI                        string        `envconfig:"TESTKUBE_DASHBOARD_URI" default:""`
+	TestkubeMetricsHistogramLabels              string        `envconfig:"TESTKUBE_METRICS_HISTOGRAM_LABELS" default:"name,step"`
 	DisableReconciler                           bool          `envconfig:"DISABLE_RECONCILER" default:"false"`
 	TestkubeClusterName                         string        `envconfig:"TESTKUBE_CLUSTER_NAME" default:""`
 	CompressArtifacts                           bool          `envconfig:"COMPRESSARTIFACTS" default:"false"`
@@ -103,6 +107,11 @@ type Config struct {
 	LogServerCAFile                             string        `envconfig:"LOG_SERVER_CA_FILE" default:""`
 	DisableSecretCreation                       bool          `envconfig:"DISABLE_SECRET_CREATION" default:"false"`
 	TestkubeExecutionNamespaces                 string        `envconfig:"TESTKUBE_EXECUTION_NAMESPACES" default:""`
+	ExecutionQueueGlobalLimit                   int           `envconfig:"EXECUTION_QUEUE_GLOBAL_LIMIT" default:"0"`
+	ExecutionQueueNamespaceLimit                int           `envconfig:"EXECUTION_QUEUE_NAMESPACE_LIMIT" default:"0"`
+	ExecutionQueueTestLimit                     int           `envconfig:"EXECUTION_QUEUE_TEST_LIMIT" default:"0"`
+	ExecutionQueueExecutorLimit                 int           `envconfig:"EXECUTION_QUEUE_EXECUTOR_LIMIT" default:"0"`
+	ExecutionQueuePollInterval                  time.Duration `envconfig:"EXECUTION_QUEUE_POLL_INTERVAL" default:"5s"`
 
 	// DEPRECATED: Use TestkubeProAPIKey instead
 	TestkubeCloudAPIKey string `envconfig:"TESTKUBE_CLOUD_API_KEY" default:""`
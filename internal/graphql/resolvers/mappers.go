@@ -0,0 +1,140 @@
+package resolvers
+
+import (
+	"github.com/kubeshop/testkube/internal/graphql/gen"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
+)
+
+// This file will not be regenerated automatically.
+//
+// It maps the GraphQL input to the repository filters, equivalent to the query parameters of REST API.
+
+func mapExecutionsFilter(input *gen.ExecutionsFilter) *result.FilterImpl {
+	filter := result.NewExecutionsFilter()
+	if input == nil {
+		return filter
+	}
+	if input.TestName != nil {
+		filter = filter.WithTestName(*input.TestName)
+	}
+	if input.Type != nil {
+		filter = filter.WithType(*input.Type)
+	}
+	if input.TextSearch != nil {
+		filter = filter.WithTextSearch(*input.TextSearch)
+	}
+	if input.Selector != nil {
+		filter = filter.WithSelector(*input.Selector)
+	}
+	if input.Status != nil {
+		filter = filter.WithStatus(*input.Status)
+	}
+	if input.StartDate != nil {
+		filter = filter.WithStartDate(*input.StartDate)
+	}
+	if input.EndDate != nil {
+		filter = filter.WithEndDate(*input.EndDate)
+	}
+	if input.LastNDays != nil {
+		filter = filter.WithLastNDays(*input.LastNDays)
+	}
+	if input.Page != nil {
+		filter = filter.WithPage(*input.Page)
+	}
+	if input.PageSize != nil {
+		filter = filter.WithPageSize(*input.PageSize)
+	}
+	return filter
+}
+
+func mapTestSuiteExecutionsFilter(input *gen.TestSuiteExecutionsFilter) *testresult.FilterImpl {
+	filter := testresult.NewExecutionsFilter()
+	if input == nil {
+		return filter
+	}
+	if input.Name != nil {
+		filter = filter.WithName(*input.Name)
+	}
+	if input.TextSearch != nil {
+		filter = filter.WithTextSearch(*input.TextSearch)
+	}
+	if input.Selector != nil {
+		filter = filter.WithSelector(*input.Selector)
+	}
+	if input.Status != nil {
+		filter = filter.WithStatus(*input.Status)
+	}
+	if input.StartDate != nil {
+		filter = filter.WithStartDate(*input.StartDate)
+	}
+	if input.EndDate != nil {
+		filter = filter.WithEndDate(*input.EndDate)
+	}
+	if input.LastNDays != nil {
+		filter = filter.WithLastNDays(*input.LastNDays)
+	}
+	if input.Page != nil {
+		filter = filter.WithPage(*input.Page)
+	}
+	if input.PageSize != nil {
+		filter = filter.WithPageSize(*input.PageSize)
+	}
+	return filter
+}
+
+func mapTestWorkflowExecutionsFilter(input *gen.TestWorkflowExecutionsFilter) *testworkflow.FilterImpl {
+	filter := testworkflow.NewExecutionsFilter()
+	if input == nil {
+		return filter
+	}
+	if input.Name != nil {
+		filter = filter.WithName(*input.Name)
+	}
+	if input.TextSearch != nil {
+		filter = filter.WithTextSearch(*input.TextSearch)
+	}
+	if input.Selector != nil {
+		filter = filter.WithSelector(*input.Selector)
+	}
+	if input.Status != nil {
+		filter = filter.WithStatus(*input.Status)
+	}
+	if input.StartDate != nil {
+		filter = filter.WithStartDate(*input.StartDate)
+	}
+	if input.EndDate != nil {
+		filter = filter.WithEndDate(*input.EndDate)
+	}
+	if input.LastNDays != nil {
+		filter = filter.WithLastNDays(*input.LastNDays)
+	}
+	if input.Page != nil {
+		filter = filter.WithPage(*input.Page)
+	}
+	if input.PageSize != nil {
+		filter = filter.WithPageSize(*input.PageSize)
+	}
+	return filter
+}
+
+// mapTestWorkflowExecutionSteps combines the step results with the structure of the executed TestWorkflow
+func mapTestWorkflowExecutionSteps(signature []testkube.TestWorkflowSignature, results map[string]testkube.TestWorkflowStepResult) []gen.TestWorkflowExecutionStep {
+	steps := make([]gen.TestWorkflowExecutionStep, len(signature))
+	for i, sig := range signature {
+		steps[i] = gen.TestWorkflowExecutionStep{
+			Ref:      sig.Ref,
+			Name:     sig.Name,
+			Category: sig.Category,
+			Optional: sig.Optional,
+			Negative: sig.Negative,
+			Children: mapTestWorkflowExecutionSteps(sig.Children, results),
+		}
+		if stepResult, ok := results[sig.Ref]; ok {
+			steps[i].Result = &stepResult
+		}
+	}
+	return steps
+}
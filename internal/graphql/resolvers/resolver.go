@@ -11,5 +11,13 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	ExecutorsService services.ExecutorsService
+	ExecutorsService              services.ExecutorsService
+	TestsService                  services.TestsService
+	TestSuitesService             services.TestSuitesService
+	TestWorkflowsService          services.TestWorkflowsService
+	TestTriggersService           services.TestTriggersService
+	WebhooksService               services.WebhooksService
+	ExecutionsService             services.ExecutionsService
+	TestSuiteExecutionsService    services.TestSuiteExecutionsService
+	TestWorkflowExecutionsService services.TestWorkflowExecutionsService
 }
@@ -12,22 +12,46 @@ import (
 )
 
 type TestClient struct {
-	ctrl             *gomock.Controller
-	Client           *client.Client
-	ExecutorsService *services.MockExecutorsService
+	ctrl                          *gomock.Controller
+	Client                        *client.Client
+	ExecutorsService              *services.MockExecutorsService
+	TestsService                  *services.MockTestsService
+	TestSuitesService             *services.MockTestSuitesService
+	TestWorkflowsService          *services.MockTestWorkflowsService
+	TestTriggersService           *services.MockTestTriggersService
+	WebhooksService               *services.MockWebhooksService
+	ExecutionsService             *services.MockExecutionsService
+	TestSuiteExecutionsService    *services.MockTestSuiteExecutionsService
+	TestWorkflowExecutionsService *services.MockTestWorkflowExecutionsService
 }
 
 func NewTestClient(t *testing.T) *TestClient {
 	ctrl := gomock.NewController(t)
-	executorsSrv := services.NewMockExecutorsService(ctrl)
-	res := &Resolver{
-		ExecutorsService: executorsSrv,
+	c := &TestClient{
+		ctrl:                          ctrl,
+		ExecutorsService:              services.NewMockExecutorsService(ctrl),
+		TestsService:                  services.NewMockTestsService(ctrl),
+		TestSuitesService:             services.NewMockTestSuitesService(ctrl),
+		TestWorkflowsService:          services.NewMockTestWorkflowsService(ctrl),
+		TestTriggersService:           services.NewMockTestTriggersService(ctrl),
+		WebhooksService:               services.NewMockWebhooksService(ctrl),
+		ExecutionsService:             services.NewMockExecutionsService(ctrl),
+		TestSuiteExecutionsService:    services.NewMockTestSuiteExecutionsService(ctrl),
+		TestWorkflowExecutionsService: services.NewMockTestWorkflowExecutionsService(ctrl),
 	}
-	return &TestClient{
-		ctrl:             ctrl,
-		Client:           client.New(handler.NewDefaultServer(gen.NewExecutableSchema(gen.Config{Resolvers: res}))),
-		ExecutorsService: executorsSrv,
+	res := &Resolver{
+		ExecutorsService:              c.ExecutorsService,
+		TestsService:                  c.TestsService,
+		TestSuitesService:             c.TestSuitesService,
+		TestWorkflowsService:          c.TestWorkflowsService,
+		TestTriggersService:           c.TestTriggersService,
+		WebhooksService:               c.WebhooksService,
+		ExecutionsService:             c.ExecutionsService,
+		TestSuiteExecutionsService:    c.TestSuiteExecutionsService,
+		TestWorkflowExecutionsService: c.TestWorkflowExecutionsService,
 	}
+	c.Client = client.New(handler.NewDefaultServer(gen.NewExecutableSchema(gen.Config{Resolvers: res})))
+	return c
 }
 
 func (t *TestClient) Finish() {
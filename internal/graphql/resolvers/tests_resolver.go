@@ -0,0 +1,47 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/internal/graphql/gen"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// Tests is the resolver for the tests field.
+func (r *queryResolver) Tests(ctx context.Context, selector string) ([]testkube.Test, error) {
+	return r.TestsService.List(selector)
+}
+
+// Test is the resolver for the test field.
+func (r *queryResolver) Test(ctx context.Context, name string) (testkube.Test, error) {
+	return r.TestsService.Get(name)
+}
+
+// Executions is the resolver for the executions field.
+func (r *queryResolver) Executions(ctx context.Context, filter *gen.ExecutionsFilter) ([]testkube.Execution, error) {
+	return r.ExecutionsService.List(ctx, mapExecutionsFilter(filter))
+}
+
+// Execution is the resolver for the execution field.
+func (r *queryResolver) Execution(ctx context.Context, id string) (testkube.Execution, error) {
+	return r.ExecutionsService.Get(ctx, id)
+}
+
+// Execution is the resolver for the execution field.
+func (r *subscriptionResolver) Execution(ctx context.Context, id string) (<-chan testkube.Execution, error) {
+	return r.ExecutionsService.Subscribe(ctx, id)
+}
+
+// Executions is the resolver for the executions field.
+func (r *testResolver) Executions(ctx context.Context, obj *testkube.Test, filter *gen.ExecutionsFilter) ([]testkube.Execution, error) {
+	return r.ExecutionsService.List(ctx, mapExecutionsFilter(filter).WithTestName(obj.Name))
+}
+
+// Test returns gen.TestResolver implementation.
+func (r *Resolver) Test() gen.TestResolver { return &testResolver{r} }
+
+type testResolver struct{ *Resolver }
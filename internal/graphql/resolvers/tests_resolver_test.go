@@ -0,0 +1,77 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+)
+
+var sampleTest = testkube.Test{
+	Name:  "sample",
+	Type_: "curl/test",
+}
+
+func TestTestsResolver_Query_Test(t *testing.T) {
+	t.Run("should return the test with its executions", func(t *testing.T) {
+		c := NewTestClient(t)
+		defer c.Finish()
+
+		q := `query { test(name: "sample") { name type executions(filter: {testName: "other", status: "failed"}) { id executionResult { status } } } }`
+		c.TestsService.EXPECT().Get("sample").Return(sampleTest, nil)
+		c.ExecutionsService.EXPECT().List(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, filter result.Filter) ([]testkube.Execution, error) {
+				assert.Equal(t, "sample", filter.TestName())
+				assert.Equal(t, testkube.ExecutionStatuses{testkube.FAILED_ExecutionStatus}, filter.Statuses())
+				return []testkube.Execution{{
+					Id:              "id-1",
+					ExecutionResult: &testkube.ExecutionResult{Status: common.Ptr(testkube.FAILED_ExecutionStatus)},
+				}}, nil
+			})
+
+		var resp struct {
+			Test struct {
+				Name       string
+				Type       string
+				Executions []struct {
+					Id              string
+					ExecutionResult struct{ Status string }
+				}
+			}
+		}
+		c.Client.MustPost(q, &resp)
+		assert.Equal(t, "sample", resp.Test.Name)
+		assert.Equal(t, "curl/test", resp.Test.Type)
+		assert.Len(t, resp.Test.Executions, 1)
+		assert.Equal(t, "failed", resp.Test.Executions[0].ExecutionResult.Status)
+	})
+
+	t.Run("should throw client error", func(t *testing.T) {
+		c := NewTestClient(t)
+		defer c.Finish()
+
+		q := `query { test(name: "sample") { name } }`
+		c.TestsService.EXPECT().Get("sample").Return(testkube.Test{}, errors.New("not found"))
+
+		var resp interface{}
+		assert.Error(t, c.Client.Post(q, &resp))
+	})
+}
+
+func TestTestsResolver_Query_Executions(t *testing.T) {
+	t.Run("should use default filter when not provided", func(t *testing.T) {
+		c := NewTestClient(t)
+		defer c.Finish()
+
+		q := `query { executions { id } }`
+		c.ExecutionsService.EXPECT().List(gomock.Any(), result.NewExecutionsFilter()).Return([]testkube.Execution{}, nil)
+
+		var resp interface{}
+		c.Client.MustPost(q, &resp)
+	})
+}
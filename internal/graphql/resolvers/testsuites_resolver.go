@@ -0,0 +1,47 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/internal/graphql/gen"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// TestSuites is the resolver for the testSuites field.
+func (r *queryResolver) TestSuites(ctx context.Context, selector string) ([]testkube.TestSuite, error) {
+	return r.TestSuitesService.List(selector)
+}
+
+// TestSuite is the resolver for the testSuite field.
+func (r *queryResolver) TestSuite(ctx context.Context, name string) (testkube.TestSuite, error) {
+	return r.TestSuitesService.Get(name)
+}
+
+// TestSuiteExecutions is the resolver for the testSuiteExecutions field.
+func (r *queryResolver) TestSuiteExecutions(ctx context.Context, filter *gen.TestSuiteExecutionsFilter) ([]testkube.TestSuiteExecution, error) {
+	return r.TestSuiteExecutionsService.List(ctx, mapTestSuiteExecutionsFilter(filter))
+}
+
+// TestSuiteExecution is the resolver for the testSuiteExecution field.
+func (r *queryResolver) TestSuiteExecution(ctx context.Context, id string) (testkube.TestSuiteExecution, error) {
+	return r.TestSuiteExecutionsService.Get(ctx, id)
+}
+
+// TestSuiteExecution is the resolver for the testSuiteExecution field.
+func (r *subscriptionResolver) TestSuiteExecution(ctx context.Context, id string) (<-chan testkube.TestSuiteExecution, error) {
+	return r.TestSuiteExecutionsService.Subscribe(ctx, id)
+}
+
+// Executions is the resolver for the executions field.
+func (r *testSuiteResolver) Executions(ctx context.Context, obj *testkube.TestSuite, filter *gen.TestSuiteExecutionsFilter) ([]testkube.TestSuiteExecution, error) {
+	return r.TestSuiteExecutionsService.List(ctx, mapTestSuiteExecutionsFilter(filter).WithName(obj.Name))
+}
+
+// TestSuite returns gen.TestSuiteResolver implementation.
+func (r *Resolver) TestSuite() gen.TestSuiteResolver { return &testSuiteResolver{r} }
+
+type testSuiteResolver struct{ *Resolver }
@@ -0,0 +1,16 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// TestTriggers is the resolver for the testTriggers field.
+func (r *queryResolver) TestTriggers(ctx context.Context, selector string) ([]testkube.TestTrigger, error) {
+	return r.TestTriggersService.List(ctx, selector)
+}
@@ -0,0 +1,72 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/internal/graphql/gen"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// TestWorkflows is the resolver for the testWorkflows field.
+func (r *queryResolver) TestWorkflows(ctx context.Context, selector string) ([]testkube.TestWorkflow, error) {
+	return r.TestWorkflowsService.List(selector)
+}
+
+// TestWorkflow is the resolver for the testWorkflow field.
+func (r *queryResolver) TestWorkflow(ctx context.Context, name string) (testkube.TestWorkflow, error) {
+	return r.TestWorkflowsService.Get(name)
+}
+
+// TestWorkflowTemplates is the resolver for the testWorkflowTemplates field.
+func (r *queryResolver) TestWorkflowTemplates(ctx context.Context, selector string) ([]testkube.TestWorkflowTemplate, error) {
+	return r.TestWorkflowsService.ListTemplates(selector)
+}
+
+// TestWorkflowTemplate is the resolver for the testWorkflowTemplate field.
+func (r *queryResolver) TestWorkflowTemplate(ctx context.Context, name string) (testkube.TestWorkflowTemplate, error) {
+	return r.TestWorkflowsService.GetTemplate(name)
+}
+
+// TestWorkflowExecutions is the resolver for the testWorkflowExecutions field.
+func (r *queryResolver) TestWorkflowExecutions(ctx context.Context, filter *gen.TestWorkflowExecutionsFilter) ([]testkube.TestWorkflowExecution, error) {
+	return r.TestWorkflowExecutionsService.List(ctx, mapTestWorkflowExecutionsFilter(filter))
+}
+
+// TestWorkflowExecution is the resolver for the testWorkflowExecution field.
+func (r *queryResolver) TestWorkflowExecution(ctx context.Context, id string) (testkube.TestWorkflowExecution, error) {
+	return r.TestWorkflowExecutionsService.Get(ctx, id)
+}
+
+// TestWorkflowExecution is the resolver for the testWorkflowExecution field.
+func (r *subscriptionResolver) TestWorkflowExecution(ctx context.Context, id string) (<-chan testkube.TestWorkflowExecution, error) {
+	return r.TestWorkflowExecutionsService.Subscribe(ctx, id)
+}
+
+// Executions is the resolver for the executions field.
+func (r *testWorkflowResolver) Executions(ctx context.Context, obj *testkube.TestWorkflow, filter *gen.TestWorkflowExecutionsFilter) ([]testkube.TestWorkflowExecution, error) {
+	return r.TestWorkflowExecutionsService.List(ctx, mapTestWorkflowExecutionsFilter(filter).WithName(obj.Name))
+}
+
+// Steps is the resolver for the steps field.
+func (r *testWorkflowExecutionResolver) Steps(ctx context.Context, obj *testkube.TestWorkflowExecution) ([]gen.TestWorkflowExecutionStep, error) {
+	var results map[string]testkube.TestWorkflowStepResult
+	if obj.Result != nil {
+		results = obj.Result.Steps
+	}
+	return mapTestWorkflowExecutionSteps(obj.Signature, results), nil
+}
+
+// TestWorkflow returns gen.TestWorkflowResolver implementation.
+func (r *Resolver) TestWorkflow() gen.TestWorkflowResolver { return &testWorkflowResolver{r} }
+
+// TestWorkflowExecution returns gen.TestWorkflowExecutionResolver implementation.
+func (r *Resolver) TestWorkflowExecution() gen.TestWorkflowExecutionResolver {
+	return &testWorkflowExecutionResolver{r}
+}
+
+type testWorkflowResolver struct{ *Resolver }
+type testWorkflowExecutionResolver struct{ *Resolver }
@@ -0,0 +1,156 @@
+package resolvers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
+)
+
+var (
+	sampleWorkflow = testkube.TestWorkflow{
+		Name:   "sample",
+		Labels: map[string]string{"label-name": "label-value"},
+	}
+	sampleWorkflowExecution = testkube.TestWorkflowExecution{
+		Id:   "id-1",
+		Name: "sample-1",
+		Signature: []testkube.TestWorkflowSignature{
+			{Ref: "r1", Name: "setup"},
+			{Ref: "r2", Category: "Run tests", Children: []testkube.TestWorkflowSignature{
+				{Ref: "r3", Name: "api"},
+			}},
+		},
+		Result: &testkube.TestWorkflowResult{
+			Status: common.Ptr(testkube.FAILED_TestWorkflowStatus),
+			Steps: map[string]testkube.TestWorkflowStepResult{
+				"r1": {Status: common.Ptr(testkube.PASSED_TestWorkflowStepStatus)},
+				"r2": {Status: common.Ptr(testkube.FAILED_TestWorkflowStepStatus)},
+				"r3": {Status: common.Ptr(testkube.FAILED_TestWorkflowStepStatus), ErrorMessage: "process exited with code 1"},
+			},
+		},
+		Workflow: &sampleWorkflow,
+	}
+)
+
+type workflowStepResponse struct {
+	Ref    string
+	Name   string
+	Result *struct {
+		Status       string
+		ErrorMessage string
+	}
+	Children []workflowStepResponse
+}
+
+func TestTestWorkflowsResolver_Query_TestWorkflow(t *testing.T) {
+	t.Run("should return the workflow with its latest executions and step results", func(t *testing.T) {
+		c := NewTestClient(t)
+		defer c.Finish()
+
+		q := `query {
+			testWorkflow(name: "sample") {
+				name
+				labels
+				executions(filter: {pageSize: 5}) {
+					name
+					result { status }
+					steps { ref name result { status errorMessage } children { ref name result { status errorMessage } } }
+				}
+			}
+		}`
+		c.TestWorkflowsService.EXPECT().Get("sample").Return(sampleWorkflow, nil)
+		c.TestWorkflowExecutionsService.EXPECT().List(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, filter testworkflow.Filter) ([]testkube.TestWorkflowExecution, error) {
+				assert.Equal(t, "sample", filter.Name())
+				assert.Equal(t, 5, filter.PageSize())
+				return []testkube.TestWorkflowExecution{sampleWorkflowExecution}, nil
+			})
+
+		var resp struct {
+			TestWorkflow struct {
+				Name       string
+				Labels     map[string]string
+				Executions []struct {
+					Name   string
+					Result struct{ Status string }
+					Steps  []workflowStepResponse
+				}
+			}
+		}
+		c.Client.MustPost(q, &resp)
+		assert.Equal(t, "sample", resp.TestWorkflow.Name)
+		assert.Equal(t, map[string]string{"label-name": "label-value"}, resp.TestWorkflow.Labels)
+		assert.Len(t, resp.TestWorkflow.Executions, 1)
+
+		execution := resp.TestWorkflow.Executions[0]
+		assert.Equal(t, "sample-1", execution.Name)
+		assert.Equal(t, "failed", execution.Result.Status)
+		assert.Len(t, execution.Steps, 2)
+		assert.Equal(t, "setup", execution.Steps[0].Name)
+		assert.Equal(t, "passed", execution.Steps[0].Result.Status)
+		assert.Len(t, execution.Steps[1].Children, 1)
+		assert.Equal(t, "r3", execution.Steps[1].Children[0].Ref)
+		assert.Equal(t, "process exited with code 1", execution.Steps[1].Children[0].Result.ErrorMessage)
+	})
+}
+
+func TestTestWorkflowsResolver_Query_TestWorkflowExecutions(t *testing.T) {
+	t.Run("should pass the filter to the repository", func(t *testing.T) {
+		c := NewTestClient(t)
+		defer c.Finish()
+
+		q := `query { testWorkflowExecutions(filter: {name: "sample", status: "passed,failed", page: 2, pageSize: 10}) { id } }`
+		c.TestWorkflowExecutionsService.EXPECT().List(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, filter testworkflow.Filter) ([]testkube.TestWorkflowExecution, error) {
+				assert.Equal(t, "sample", filter.Name())
+				assert.Equal(t, []testkube.TestWorkflowStatus{testkube.PASSED_TestWorkflowStatus, testkube.FAILED_TestWorkflowStatus}, filter.Statuses())
+				assert.Equal(t, 2, filter.Page())
+				assert.Equal(t, 10, filter.PageSize())
+				return []testkube.TestWorkflowExecution{sampleWorkflowExecution}, nil
+			})
+
+		var resp struct{ TestWorkflowExecutions []struct{ Id string } }
+		c.Client.MustPost(q, &resp)
+		assert.Len(t, resp.TestWorkflowExecutions, 1)
+		assert.Equal(t, "id-1", resp.TestWorkflowExecutions[0].Id)
+	})
+}
+
+func TestTestWorkflowsResolver_Subscription_TestWorkflowExecution(t *testing.T) {
+	t.Run("should return back the execution updates", func(t *testing.T) {
+		c := NewTestClient(t)
+		defer c.Finish()
+
+		input := make(chan testkube.TestWorkflowExecution, 1)
+		queued := sampleWorkflowExecution
+		queued.Result = &testkube.TestWorkflowResult{Status: common.Ptr(testkube.QUEUED_TestWorkflowStatus)}
+		input <- queued
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			input <- sampleWorkflowExecution
+			close(input)
+		}()
+
+		q := `subscription { testWorkflowExecution(id: "id-1") { id result { status } } }`
+		c.TestWorkflowExecutionsService.EXPECT().Subscribe(gomock.Any(), "id-1").Return(input, nil)
+		var resp struct {
+			TestWorkflowExecution struct {
+				Id     string
+				Result struct{ Status string }
+			}
+		}
+		s := c.Client.Websocket(q)
+
+		assert.NoError(t, s.Next(&resp))
+		assert.Equal(t, "queued", resp.TestWorkflowExecution.Result.Status)
+
+		assert.NoError(t, s.Next(&resp))
+		assert.Equal(t, "failed", resp.TestWorkflowExecution.Result.Status)
+	})
+}
@@ -0,0 +1,16 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// Webhooks is the resolver for the webhooks field.
+func (r *queryResolver) Webhooks(ctx context.Context, selector string) ([]testkube.Webhook, error) {
+	return r.WebhooksService.List(selector)
+}
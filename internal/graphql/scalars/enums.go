@@ -0,0 +1,92 @@
+package scalars
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// Generic implementation
+
+func MarshalEnumScalar[T ~string](val T) graphql.Marshaler {
+	return graphql.MarshalString(string(val))
+}
+
+func UnmarshalEnumScalar[T ~string](v interface{}) (T, error) {
+	s, err := graphql.UnmarshalString(v)
+	return T(s), err
+}
+
+// Specific types
+
+func MarshalExecutionStatusScalar(val testkube.ExecutionStatus) graphql.Marshaler {
+	return MarshalEnumScalar(val)
+}
+
+func UnmarshalExecutionStatusScalar(v interface{}) (testkube.ExecutionStatus, error) {
+	return UnmarshalEnumScalar[testkube.ExecutionStatus](v)
+}
+
+func MarshalTestSuiteExecutionStatusScalar(val testkube.TestSuiteExecutionStatus) graphql.Marshaler {
+	return MarshalEnumScalar(val)
+}
+
+func UnmarshalTestSuiteExecutionStatusScalar(v interface{}) (testkube.TestSuiteExecutionStatus, error) {
+	return UnmarshalEnumScalar[testkube.TestSuiteExecutionStatus](v)
+}
+
+func MarshalTestWorkflowStatusScalar(val testkube.TestWorkflowStatus) graphql.Marshaler {
+	return MarshalEnumScalar(val)
+}
+
+func UnmarshalTestWorkflowStatusScalar(v interface{}) (testkube.TestWorkflowStatus, error) {
+	return UnmarshalEnumScalar[testkube.TestWorkflowStatus](v)
+}
+
+func MarshalTestWorkflowStepStatusScalar(val testkube.TestWorkflowStepStatus) graphql.Marshaler {
+	return MarshalEnumScalar(val)
+}
+
+func UnmarshalTestWorkflowStepStatusScalar(v interface{}) (testkube.TestWorkflowStepStatus, error) {
+	return UnmarshalEnumScalar[testkube.TestWorkflowStepStatus](v)
+}
+
+func MarshalTestTriggerResourcesScalar(val testkube.TestTriggerResources) graphql.Marshaler {
+	return MarshalEnumScalar(val)
+}
+
+func UnmarshalTestTriggerResourcesScalar(v interface{}) (testkube.TestTriggerResources, error) {
+	return UnmarshalEnumScalar[testkube.TestTriggerResources](v)
+}
+
+func MarshalTestTriggerActionsScalar(val testkube.TestTriggerActions) graphql.Marshaler {
+	return MarshalEnumScalar(val)
+}
+
+func UnmarshalTestTriggerActionsScalar(v interface{}) (testkube.TestTriggerActions, error) {
+	return UnmarshalEnumScalar[testkube.TestTriggerActions](v)
+}
+
+func MarshalTestTriggerExecutionsScalar(val testkube.TestTriggerExecutions) graphql.Marshaler {
+	return MarshalEnumScalar(val)
+}
+
+func UnmarshalTestTriggerExecutionsScalar(v interface{}) (testkube.TestTriggerExecutions, error) {
+	return UnmarshalEnumScalar[testkube.TestTriggerExecutions](v)
+}
+
+func MarshalTestTriggerConcurrencyPoliciesScalar(val testkube.TestTriggerConcurrencyPolicies) graphql.Marshaler {
+	return MarshalEnumScalar(val)
+}
+
+func UnmarshalTestTriggerConcurrencyPoliciesScalar(v interface{}) (testkube.TestTriggerConcurrencyPolicies, error) {
+	return UnmarshalEnumScalar[testkube.TestTriggerConcurrencyPolicies](v)
+}
+
+func MarshalEventTypeScalar(val testkube.EventType) graphql.Marshaler {
+	return MarshalEnumScalar(val)
+}
+
+func UnmarshalEventTypeScalar(v interface{}) (testkube.EventType, error) {
+	return UnmarshalEnumScalar[testkube.EventType](v)
+}
@@ -10,17 +10,47 @@ import (
 	"github.com/gorilla/websocket"
 
 	executorsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/executors/v1"
+	testsclientv3 "github.com/kubeshop/testkube-operator/pkg/client/tests/v3"
+	testsuitesclientv3 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v3"
+	testworkflowsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
+	testkubeclientset "github.com/kubeshop/testkube-operator/pkg/clientset/versioned"
 	"github.com/kubeshop/testkube/internal/graphql/gen"
 	"github.com/kubeshop/testkube/internal/graphql/resolvers"
 	"github.com/kubeshop/testkube/internal/graphql/services"
 	"github.com/kubeshop/testkube/pkg/event/bus"
 	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
 )
 
-func GetServer(eventBus bus.Bus, executorsClient *executorsclientv1.ExecutorsClient) *handler.Server {
+// Clients are the sources of the Testkube resources exposed in GraphQL API
+type Clients struct {
+	Executors              *executorsclientv1.ExecutorsClient
+	Tests                  testsclientv3.Interface
+	TestSuites             testsuitesclientv3.Interface
+	TestWorkflows          testworkflowsclientv1.Interface
+	TestWorkflowTemplates  testworkflowsclientv1.TestWorkflowTemplatesInterface
+	Webhooks               *executorsclientv1.WebhooksClient
+	Testkube               testkubeclientset.Interface
+	Executions             result.Repository
+	TestSuiteExecutions    testresult.Repository
+	TestWorkflowExecutions testworkflow.Repository
+	Namespace              string
+}
+
+func GetServer(eventBus bus.Bus, clients Clients) *handler.Server {
 	service := services.NewService(eventBus, log.DefaultLogger)
 	resolver := &resolvers.Resolver{
-		ExecutorsService: services.NewExecutorsService(service, executorsClient),
+		ExecutorsService:              services.NewExecutorsService(service, clients.Executors),
+		TestsService:                  services.NewTestsService(service, clients.Tests),
+		TestSuitesService:             services.NewTestSuitesService(service, clients.TestSuites),
+		TestWorkflowsService:          services.NewTestWorkflowsService(service, clients.TestWorkflows, clients.TestWorkflowTemplates),
+		TestTriggersService:           services.NewTestTriggersService(service, clients.Testkube, clients.Namespace),
+		WebhooksService:               services.NewWebhooksService(service, clients.Webhooks),
+		ExecutionsService:             services.NewExecutionsService(service, clients.Executions),
+		TestSuiteExecutionsService:    services.NewTestSuiteExecutionsService(service, clients.TestSuiteExecutions),
+		TestWorkflowExecutionsService: services.NewTestWorkflowExecutionsService(service, clients.TestWorkflowExecutions),
 	}
 	srv := handler.New(gen.NewExecutableSchema(gen.Config{Resolvers: resolver}))
 	srv.AddTransport(transport.Websocket{
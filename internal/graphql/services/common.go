@@ -20,6 +20,18 @@ func HandleSubscription[T Service, U interface{}](
 	topic string,
 	s T,
 	get func() (U, error),
+) (<-chan U, error) {
+	return HandleEventSubscription(ctx, topic, s, nil, get)
+}
+
+// HandleEventSubscription streams the data each time the matching event is published on the topic.
+// When the match function is not provided, all the events on the topic are considered.
+func HandleEventSubscription[T Service, U interface{}](
+	ctx context.Context,
+	topic string,
+	s T,
+	match func(e testkube.Event) bool,
+	get func() (U, error),
 ) (<-chan U, error) {
 	ch := make(chan U, 1)
 
@@ -35,7 +47,10 @@ func HandleSubscription[T Service, U interface{}](
 	// Setup queue
 	queue := rand.String(30)
 	err = s.Bus().SubscribeTopic(topic, queue, func(e testkube.Event) error {
-		s.Logger().Debugf("graphql subscription event: %s %s %s", e.Type_, *e.Resource, e.ResourceId)
+		if match != nil && !match(e) {
+			return nil
+		}
+		s.Logger().Debugf("graphql subscription event: %s %s", e.Type(), e.Topic())
 		result, err := get()
 		if err != nil {
 			s.Logger().Errorw("failed to get data after change for "+topic, err)
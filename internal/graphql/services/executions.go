@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
+)
+
+// executionEventsTopic contains the events of all the executions
+const executionEventsTopic = "events.>"
+
+var (
+	testExecutionEventTypes = []testkube.EventType{
+		testkube.START_TEST_EventType,
+		testkube.END_TEST_SUCCESS_EventType,
+		testkube.END_TEST_FAILED_EventType,
+		testkube.END_TEST_ABORTED_EventType,
+		testkube.END_TEST_TIMEOUT_EventType,
+	}
+	testSuiteExecutionEventTypes = []testkube.EventType{
+		testkube.START_TESTSUITE_EventType,
+		testkube.END_TESTSUITE_SUCCESS_EventType,
+		testkube.END_TESTSUITE_FAILED_EventType,
+		testkube.END_TESTSUITE_ABORTED_EventType,
+		testkube.END_TESTSUITE_TIMEOUT_EventType,
+	}
+	testWorkflowExecutionEventTypes = []testkube.EventType{
+		testkube.QUEUE_TESTWORKFLOW_EventType,
+		testkube.START_TESTWORKFLOW_EventType,
+		testkube.END_TESTWORKFLOW_SUCCESS_EventType,
+		testkube.END_TESTWORKFLOW_FAILED_EventType,
+		testkube.END_TESTWORKFLOW_ABORTED_EventType,
+		testkube.PAUSE_TESTWORKFLOW_EventType,
+	}
+)
+
+// matchEvent accepts only the events of the specified types that are matching the resource,
+// so the execution is not fetched from the database for the unrelated events
+func matchEvent(types []testkube.EventType, match func(e testkube.Event) bool) func(e testkube.Event) bool {
+	return func(e testkube.Event) bool {
+		return slices.Contains(types, e.Type()) && match(e)
+	}
+}
+
+//go:generate mockgen -destination=./mock_executions.go -package=services "github.com/kubeshop/testkube/internal/graphql/services" ExecutionsService,TestSuiteExecutionsService,TestWorkflowExecutionsService
+type ExecutionsService interface {
+	List(ctx context.Context, filter result.Filter) ([]testkube.Execution, error)
+	Get(ctx context.Context, id string) (testkube.Execution, error)
+	Subscribe(ctx context.Context, id string) (<-chan testkube.Execution, error)
+}
+
+type executionsService struct {
+	ServiceBase
+	repository result.Repository
+}
+
+func NewExecutionsService(service Service, repository result.Repository) ExecutionsService {
+	return &executionsService{ServiceBase: ServiceBase{Service: service}, repository: repository}
+}
+
+func (s *executionsService) List(ctx context.Context, filter result.Filter) ([]testkube.Execution, error) {
+	return s.repository.GetExecutions(ctx, filter)
+}
+
+func (s *executionsService) Get(ctx context.Context, id string) (testkube.Execution, error) {
+	return s.repository.Get(ctx, id)
+}
+
+func (s *executionsService) Subscribe(ctx context.Context, id string) (<-chan testkube.Execution, error) {
+	match := matchEvent(testExecutionEventTypes, func(e testkube.Event) bool {
+		return e.TestExecution != nil && (e.TestExecution.Id == id || e.TestExecution.Name == id)
+	})
+	return HandleEventSubscription(ctx, executionEventsTopic, s, match, func() (testkube.Execution, error) {
+		return s.Get(ctx, id)
+	})
+}
+
+type TestSuiteExecutionsService interface {
+	List(ctx context.Context, filter testresult.Filter) ([]testkube.TestSuiteExecution, error)
+	Get(ctx context.Context, id string) (testkube.TestSuiteExecution, error)
+	Subscribe(ctx context.Context, id string) (<-chan testkube.TestSuiteExecution, error)
+}
+
+type testSuiteExecutionsService struct {
+	ServiceBase
+	repository testresult.Repository
+}
+
+func NewTestSuiteExecutionsService(service Service, repository testresult.Repository) TestSuiteExecutionsService {
+	return &testSuiteExecutionsService{ServiceBase: ServiceBase{Service: service}, repository: repository}
+}
+
+func (s *testSuiteExecutionsService) List(ctx context.Context, filter testresult.Filter) ([]testkube.TestSuiteExecution, error) {
+	return s.repository.GetExecutions(ctx, filter)
+}
+
+func (s *testSuiteExecutionsService) Get(ctx context.Context, id string) (testkube.TestSuiteExecution, error) {
+	return s.repository.Get(ctx, id)
+}
+
+func (s *testSuiteExecutionsService) Subscribe(ctx context.Context, id string) (<-chan testkube.TestSuiteExecution, error) {
+	match := matchEvent(testSuiteExecutionEventTypes, func(e testkube.Event) bool {
+		return e.TestSuiteExecution != nil && (e.TestSuiteExecution.Id == id || e.TestSuiteExecution.Name == id)
+	})
+	return HandleEventSubscription(ctx, executionEventsTopic, s, match, func() (testkube.TestSuiteExecution, error) {
+		return s.Get(ctx, id)
+	})
+}
+
+type TestWorkflowExecutionsService interface {
+	List(ctx context.Context, filter testworkflow.Filter) ([]testkube.TestWorkflowExecution, error)
+	Get(ctx context.Context, id string) (testkube.TestWorkflowExecution, error)
+	Subscribe(ctx context.Context, id string) (<-chan testkube.TestWorkflowExecution, error)
+}
+
+type testWorkflowExecutionsService struct {
+	ServiceBase
+	repository testworkflow.Repository
+}
+
+func NewTestWorkflowExecutionsService(service Service, repository testworkflow.Repository) TestWorkflowExecutionsService {
+	return &testWorkflowExecutionsService{ServiceBase: ServiceBase{Service: service}, repository: repository}
+}
+
+func (s *testWorkflowExecutionsService) List(ctx context.Context, filter testworkflow.Filter) ([]testkube.TestWorkflowExecution, error) {
+	return s.repository.GetExecutions(ctx, filter)
+}
+
+func (s *testWorkflowExecutionsService) Get(ctx context.Context, id string) (testkube.TestWorkflowExecution, error) {
+	return s.repository.Get(ctx, id)
+}
+
+func (s *testWorkflowExecutionsService) Subscribe(ctx context.Context, id string) (<-chan testkube.TestWorkflowExecution, error) {
+	match := matchEvent(testWorkflowExecutionEventTypes, func(e testkube.Event) bool {
+		return e.TestWorkflowExecution != nil && (e.TestWorkflowExecution.Id == id || e.TestWorkflowExecution.Name == id)
+	})
+	return HandleEventSubscription(ctx, executionEventsTopic, s, match, func() (testkube.TestWorkflowExecution, error) {
+		return s.Get(ctx, id)
+	})
+}
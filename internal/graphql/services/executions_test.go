@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
+)
+
+func TestTestWorkflowExecutionsService_Subscribe(t *testing.T) {
+	t.Run("should return new execution state only after its events are triggered", func(t *testing.T) {
+		srvMock.Reset()
+		ctrl := gomock.NewController(t)
+		repository := testworkflow.NewMockRepository(ctrl)
+		service := NewTestWorkflowExecutionsService(srvMock, repository)
+
+		queued := testkube.TestWorkflowExecution{Id: "id-1", Name: "sample-1", Result: &testkube.TestWorkflowResult{
+			Status: common.Ptr(testkube.QUEUED_TestWorkflowStatus),
+		}}
+		passed := testkube.TestWorkflowExecution{Id: "id-1", Name: "sample-1", Result: &testkube.TestWorkflowResult{
+			Status: common.Ptr(testkube.PASSED_TestWorkflowStatus),
+		}}
+		other := testkube.TestWorkflowExecution{Id: "id-2", Name: "sample-2"}
+		gomock.InOrder(
+			repository.EXPECT().Get(gomock.Any(), "id-1").Return(queued, nil),
+			repository.EXPECT().Get(gomock.Any(), "id-1").Return(passed, nil),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ch, err := service.Subscribe(ctx, "id-1")
+		assert.NoError(t, err)
+		assert.Equal(t, queued, <-ch)
+
+		assert.NoError(t, srvMock.BusMock().PublishTopic("events.all", testkube.NewEventEndTestWorkflowSuccess(&other)))
+		assert.NoError(t, srvMock.BusMock().PublishTopic("events.all", testkube.NewEventFlakyTestWorkflow(&queued, nil)))
+		assert.NoError(t, srvMock.BusMock().PublishTopic("events.all", testkube.NewEventEndTestWorkflowSuccess(&passed)))
+		select {
+		case result := <-ch:
+			assert.Equal(t, passed, result)
+		case <-time.After(time.Second):
+			t.Fatal("execution update not received")
+		}
+	})
+}
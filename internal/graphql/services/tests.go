@@ -0,0 +1,38 @@
+package services
+
+import (
+	testsclientv3 "github.com/kubeshop/testkube-operator/pkg/client/tests/v3"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	testsmapper "github.com/kubeshop/testkube/pkg/mapper/tests"
+)
+
+//go:generate mockgen -destination=./mock_tests.go -package=services "github.com/kubeshop/testkube/internal/graphql/services" TestsService
+type TestsService interface {
+	List(selector string) ([]testkube.Test, error)
+	Get(name string) (testkube.Test, error)
+}
+
+type testsService struct {
+	ServiceBase
+	client testsclientv3.Interface
+}
+
+func NewTestsService(service Service, client testsclientv3.Interface) TestsService {
+	return &testsService{ServiceBase: ServiceBase{Service: service}, client: client}
+}
+
+func (s *testsService) List(selector string) ([]testkube.Test, error) {
+	tests, err := s.client.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return testsmapper.MapTestListKubeToAPI(*tests), nil
+}
+
+func (s *testsService) Get(name string) (testkube.Test, error) {
+	test, err := s.client.Get(name)
+	if err != nil {
+		return testkube.Test{}, err
+	}
+	return testsmapper.MapTestCRToAPI(*test), nil
+}
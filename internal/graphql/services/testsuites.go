@@ -0,0 +1,38 @@
+package services
+
+import (
+	testsuitesclientv3 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v3"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	testsuitesmapper "github.com/kubeshop/testkube/pkg/mapper/testsuites"
+)
+
+//go:generate mockgen -destination=./mock_testsuites.go -package=services "github.com/kubeshop/testkube/internal/graphql/services" TestSuitesService
+type TestSuitesService interface {
+	List(selector string) ([]testkube.TestSuite, error)
+	Get(name string) (testkube.TestSuite, error)
+}
+
+type testSuitesService struct {
+	ServiceBase
+	client testsuitesclientv3.Interface
+}
+
+func NewTestSuitesService(service Service, client testsuitesclientv3.Interface) TestSuitesService {
+	return &testSuitesService{ServiceBase: ServiceBase{Service: service}, client: client}
+}
+
+func (s *testSuitesService) List(selector string) ([]testkube.TestSuite, error) {
+	testSuites, err := s.client.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return testsuitesmapper.MapTestSuiteListKubeToAPI(*testSuites), nil
+}
+
+func (s *testSuitesService) Get(name string) (testkube.TestSuite, error) {
+	testSuite, err := s.client.Get(name)
+	if err != nil {
+		return testkube.TestSuite{}, err
+	}
+	return testsuitesmapper.MapCRToAPI(*testSuite), nil
+}
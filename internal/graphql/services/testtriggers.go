@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	testkubeclientset "github.com/kubeshop/testkube-operator/pkg/clientset/versioned"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
+)
+
+//go:generate mockgen -destination=./mock_testtriggers.go -package=services "github.com/kubeshop/testkube/internal/graphql/services" TestTriggersService
+type TestTriggersService interface {
+	List(ctx context.Context, selector string) ([]testkube.TestTrigger, error)
+}
+
+type testTriggersService struct {
+	ServiceBase
+	clientset testkubeclientset.Interface
+	namespace string
+}
+
+func NewTestTriggersService(service Service, clientset testkubeclientset.Interface, namespace string) TestTriggersService {
+	return &testTriggersService{ServiceBase: ServiceBase{Service: service}, clientset: clientset, namespace: namespace}
+}
+
+func (s *testTriggersService) List(ctx context.Context, selector string) ([]testkube.TestTrigger, error) {
+	triggers, err := s.clientset.TestsV1().TestTriggers(s.namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return testtriggersmapper.MapTestTriggerListKubeToAPI(triggers), nil
+}
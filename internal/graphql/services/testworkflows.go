@@ -0,0 +1,57 @@
+package services
+
+import (
+	testworkflowsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	testworkflowmappers "github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
+)
+
+//go:generate mockgen -destination=./mock_testworkflows.go -package=services "github.com/kubeshop/testkube/internal/graphql/services" TestWorkflowsService
+type TestWorkflowsService interface {
+	List(selector string) ([]testkube.TestWorkflow, error)
+	Get(name string) (testkube.TestWorkflow, error)
+	ListTemplates(selector string) ([]testkube.TestWorkflowTemplate, error)
+	GetTemplate(name string) (testkube.TestWorkflowTemplate, error)
+}
+
+type testWorkflowsService struct {
+	ServiceBase
+	client          testworkflowsclientv1.Interface
+	templatesClient testworkflowsclientv1.TestWorkflowTemplatesInterface
+}
+
+func NewTestWorkflowsService(service Service, client testworkflowsclientv1.Interface, templatesClient testworkflowsclientv1.TestWorkflowTemplatesInterface) TestWorkflowsService {
+	return &testWorkflowsService{ServiceBase: ServiceBase{Service: service}, client: client, templatesClient: templatesClient}
+}
+
+func (s *testWorkflowsService) List(selector string) ([]testkube.TestWorkflow, error) {
+	workflows, err := s.client.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return testworkflowmappers.MapListKubeToAPI(workflows), nil
+}
+
+func (s *testWorkflowsService) Get(name string) (testkube.TestWorkflow, error) {
+	workflow, err := s.client.Get(name)
+	if err != nil {
+		return testkube.TestWorkflow{}, err
+	}
+	return testworkflowmappers.MapTestWorkflowKubeToAPI(*workflow), nil
+}
+
+func (s *testWorkflowsService) ListTemplates(selector string) ([]testkube.TestWorkflowTemplate, error) {
+	templates, err := s.templatesClient.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return testworkflowmappers.MapTemplateListKubeToAPI(templates), nil
+}
+
+func (s *testWorkflowsService) GetTemplate(name string) (testkube.TestWorkflowTemplate, error) {
+	template, err := s.templatesClient.Get(name)
+	if err != nil {
+		return testkube.TestWorkflowTemplate{}, err
+	}
+	return testworkflowmappers.MapTestWorkflowTemplateKubeToAPI(*template), nil
+}
@@ -0,0 +1,29 @@
+package services
+
+import (
+	executorsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/executors/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	webhooksmapper "github.com/kubeshop/testkube/pkg/mapper/webhooks"
+)
+
+//go:generate mockgen -destination=./mock_webhooks.go -package=services "github.com/kubeshop/testkube/internal/graphql/services" WebhooksService
+type WebhooksService interface {
+	List(selector string) ([]testkube.Webhook, error)
+}
+
+type webhooksService struct {
+	ServiceBase
+	client *executorsclientv1.WebhooksClient
+}
+
+func NewWebhooksService(service Service, client *executorsclientv1.WebhooksClient) WebhooksService {
+	return &webhooksService{ServiceBase: ServiceBase{Service: service}, client: client}
+}
+
+func (s *webhooksService) List(selector string) ([]testkube.Webhook, error) {
+	webhooks, err := s.client.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return Map(webhooks.Items, webhooksmapper.MapCRDToAPI), nil
+}
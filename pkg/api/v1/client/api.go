@@ -23,6 +23,9 @@ func NewProxyAPIClient(client kubernetes.Interface, config APIConfig) APIClient
 			NewProxyClient[testkube.Artifact](client, config),
 			NewProxyClient[testkube.ServerInfo](client, config),
 			NewProxyClient[testkube.DebugInfo](client, config),
+			NewProxyClient[testkube.FlakyTestsReport](client, config),
+			NewProxyClient[testkube.QueuedExecution](client, config),
+			NewProxyClient[testkube.ExecutionComparison](client, config),
 		),
 		TestSuiteClient: NewTestSuiteClient(
 			NewProxyClient[testkube.TestSuite](client, config),
@@ -31,6 +34,7 @@ func NewProxyAPIClient(client kubernetes.Interface, config APIConfig) APIClient
 			NewProxyClient[testkube.TestSuiteWithExecutionSummary](client, config),
 			NewProxyClient[testkube.TestSuiteExecutionsResult](client, config),
 			NewProxyClient[testkube.Artifact](client, config),
+			NewProxyClient[testkube.ExecutionComparison](client, config),
 		),
 		ExecutorClient:   NewExecutorClient(NewProxyClient[testkube.ExecutorDetails](client, config)),
 		WebhookClient:    NewWebhookClient(NewProxyClient[testkube.Webhook](client, config)),
@@ -44,6 +48,9 @@ func NewProxyAPIClient(client kubernetes.Interface, config APIConfig) APIClient
 			NewProxyClient[testkube.TestWorkflowExecution](client, config),
 			NewProxyClient[testkube.TestWorkflowExecutionsResult](client, config),
 			NewProxyClient[testkube.Artifact](client, config),
+			NewProxyClient[testkube.TestWorkflowValidationResult](client, config),
+			NewProxyClient[testkube.TestWorkflowScheduledRun](client, config),
+			NewProxyClient[testkube.ExecutionComparison](client, config),
 		),
 		TestWorkflowTemplateClient: NewTestWorkflowTemplateClient(NewProxyClient[testkube.TestWorkflowTemplate](client, config)),
 	}
@@ -61,6 +68,9 @@ func NewDirectAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewDirectClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.ServerInfo](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.DebugInfo](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.FlakyTestsReport](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.QueuedExecution](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.ExecutionComparison](httpClient, apiURI, apiPathPrefix),
 		),
 		TestSuiteClient: NewTestSuiteClient(
 			NewDirectClient[testkube.TestSuite](httpClient, apiURI, apiPathPrefix),
@@ -69,6 +79,7 @@ func NewDirectAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewDirectClient[testkube.TestSuiteWithExecutionSummary](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.TestSuiteExecutionsResult](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.ExecutionComparison](httpClient, apiURI, apiPathPrefix),
 		),
 		ExecutorClient:   NewExecutorClient(NewDirectClient[testkube.ExecutorDetails](httpClient, apiURI, apiPathPrefix)),
 		WebhookClient:    NewWebhookClient(NewDirectClient[testkube.Webhook](httpClient, apiURI, apiPathPrefix)),
@@ -82,6 +93,9 @@ func NewDirectAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewDirectClient[testkube.TestWorkflowExecution](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.TestWorkflowExecutionsResult](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.TestWorkflowValidationResult](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.TestWorkflowScheduledRun](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.ExecutionComparison](httpClient, apiURI, apiPathPrefix),
 		),
 		TestWorkflowTemplateClient: NewTestWorkflowTemplateClient(NewDirectClient[testkube.TestWorkflowTemplate](httpClient, apiURI, apiPathPrefix)),
 	}
@@ -99,6 +113,9 @@ func NewCloudAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewCloudClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix),
 			NewCloudClient[testkube.ServerInfo](httpClient, apiURI, apiPathPrefix),
 			NewCloudClient[testkube.DebugInfo](httpClient, apiURI, apiPathPrefix),
+			NewCloudClient[testkube.FlakyTestsReport](httpClient, apiURI, apiPathPrefix),
+			NewCloudClient[testkube.QueuedExecution](httpClient, apiURI, apiPathPrefix),
+			NewCloudClient[testkube.ExecutionComparison](httpClient, apiURI, apiPathPrefix),
 		),
 		TestSuiteClient: NewTestSuiteClient(
 			NewCloudClient[testkube.TestSuite](httpClient, apiURI, apiPathPrefix),
@@ -107,6 +124,7 @@ func NewCloudAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewCloudClient[testkube.TestSuiteWithExecutionSummary](httpClient, apiURI, apiPathPrefix),
 			NewCloudClient[testkube.TestSuiteExecutionsResult](httpClient, apiURI, apiPathPrefix),
 			NewCloudClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix),
+			NewCloudClient[testkube.ExecutionComparison](httpClient, apiURI, apiPathPrefix),
 		),
 		ExecutorClient:   NewExecutorClient(NewCloudClient[testkube.ExecutorDetails](httpClient, apiURI, apiPathPrefix)),
 		WebhookClient:    NewWebhookClient(NewCloudClient[testkube.Webhook](httpClient, apiURI, apiPathPrefix)),
@@ -120,6 +138,9 @@ func NewCloudAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewCloudClient[testkube.TestWorkflowExecution](httpClient, apiURI, apiPathPrefix),
 			NewCloudClient[testkube.TestWorkflowExecutionsResult](httpClient, apiURI, apiPathPrefix),
 			NewCloudClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix),
+			NewCloudClient[testkube.TestWorkflowValidationResult](httpClient, apiURI, apiPathPrefix),
+			NewCloudClient[testkube.TestWorkflowScheduledRun](httpClient, apiURI, apiPathPrefix),
+			NewCloudClient[testkube.ExecutionComparison](httpClient, apiURI, apiPathPrefix),
 		),
 		TestWorkflowTemplateClient: NewTestWorkflowTemplateClient(NewCloudClient[testkube.TestWorkflowTemplate](httpClient, apiURI, apiPathPrefix)),
 	}
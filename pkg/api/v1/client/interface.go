@@ -40,17 +40,21 @@ type TestAPI interface {
 	ExecuteTests(selector string, concurrencyLevel int, options ExecuteTestOptions) (executions []testkube.Execution, err error)
 	Logs(id string) (logs chan output.Output, err error)
 	LogsV2(id string) (logs chan events.Log, err error)
+	GetTestFlakyCases(id string, last int) (report testkube.FlakyTestsReport, err error)
 }
 
 // ExecutionAPI describes execution api methods
 type ExecutionAPI interface {
 	GetExecution(executionID string) (execution testkube.Execution, err error)
+	CompareExecutions(baseID, headID string, threshold float64) (comparison testkube.ExecutionComparison, err error)
 	ListExecutions(id string, limit int, selector string) (executions testkube.ExecutionsResult, err error)
 	AbortExecution(test string, id string) error
 	AbortExecutions(test string) error
 	GetExecutionArtifacts(executionID string) (artifacts testkube.Artifacts, err error)
 	DownloadFile(executionID, fileName, destination string) (artifact string, err error)
 	DownloadArchive(executionID, destination string, masks []string) (archive string, err error)
+	ListQueuedExecutions() (executions testkube.QueuedExecutions, err error)
+	GetQueuedExecution(executionID string) (execution testkube.QueuedExecution, err error)
 }
 
 // TestSuiteAPI describes test suite api methods
@@ -70,6 +74,7 @@ type TestSuiteAPI interface {
 // TestSuiteExecutionAPI describes test suite execution api methods
 type TestSuiteExecutionAPI interface {
 	GetTestSuiteExecution(executionID string) (execution testkube.TestSuiteExecution, err error)
+	CompareTestSuiteExecutions(baseID, headID string, threshold float64) (comparison testkube.ExecutionComparison, err error)
 	ListTestSuiteExecutions(testsuite string, limit int, selector string) (executions testkube.TestSuiteExecutionsResult, err error)
 	WatchTestSuiteExecution(executionID string) (resp chan testkube.WatchTestSuiteExecutionResponse)
 	AbortTestSuiteExecution(executionID string) error
@@ -141,14 +146,22 @@ type TestWorkflowAPI interface {
 	DeleteTestWorkflow(name string) error
 	ExecuteTestWorkflow(name string, request testkube.TestWorkflowExecutionRequest) (testkube.TestWorkflowExecution, error)
 	GetTestWorkflowExecutionNotifications(id string) (chan testkube.TestWorkflowExecutionNotification, error)
+	ValidateTestWorkflow(workflow testkube.TestWorkflow) (testkube.TestWorkflowValidationResult, error)
+	GetTestWorkflowNextRuns(name string, count int) ([]testkube.TestWorkflowScheduledRun, error)
 }
 
 // TestWorkflowExecutionAPI describes test workflow api methods
 type TestWorkflowExecutionAPI interface {
 	GetTestWorkflowExecution(executionID string) (execution testkube.TestWorkflowExecution, err error)
+	CompareTestWorkflowExecutions(baseID, headID string, threshold float64) (comparison testkube.ExecutionComparison, err error)
 	ListTestWorkflowExecutions(id string, limit int, selector string) (executions testkube.TestWorkflowExecutionsResult, err error)
 	AbortTestWorkflowExecution(workflow string, id string) error
 	AbortTestWorkflowExecutions(workflow string) error
+	AbortTestWorkflowExecutionStep(executionID, ref string) error
+	SkipTestWorkflowExecutionStep(executionID, ref string) error
+	ApproveTestWorkflowExecutionStep(executionID, ref string, inputs map[string]string) error
+	RejectTestWorkflowExecutionStep(executionID, ref string) error
+	ExecuteTestWorkflowParallelStep(executionID, ref string, request testkube.TestWorkflowParallelExecutionRequest) (execution testkube.TestWorkflowExecution, err error)
 	GetTestWorkflowExecutionArtifacts(executionID string) (artifacts testkube.Artifacts, err error)
 	DownloadTestWorkflowArtifact(executionID, fileName, destination string) (artifact string, err error)
 	DownloadTestWorkflowArtifactArchive(executionID, destination string, masks []string) (archive string, err error)
@@ -247,6 +260,8 @@ type ExecuteTestOptions struct {
 	RunningContext                     *testkube.RunningContext
 	SlavePodRequest                    *testkube.PodRequest
 	ExecutionNamespace                 string
+	Priority                           int32
+	RetryPolicy                        *testkube.ExecutionRetryPolicy
 }
 
 // ExecuteTestSuiteOptions contains test suite run options
@@ -264,6 +279,7 @@ type ExecuteTestSuiteOptions struct {
 	ScraperTemplateReference string
 	PvcTemplate              string
 	PvcTemplateReference     string
+	RetryPolicy              *testkube.ExecutionRetryPolicy
 }
 
 // Gettable is an interface of gettable objects
@@ -272,7 +288,9 @@ type Gettable interface {
 		testkube.Webhook | testkube.TestWithExecution | testkube.TestSuiteWithExecution | testkube.TestWithExecutionSummary |
 		testkube.TestSuiteWithExecutionSummary | testkube.Artifact | testkube.ServerInfo | testkube.Config | testkube.DebugInfo |
 		testkube.TestSource | testkube.Template |
-		testkube.TestWorkflow | testkube.TestWorkflowWithExecution | testkube.TestWorkflowTemplate | testkube.TestWorkflowExecution
+		testkube.TestWorkflow | testkube.TestWorkflowWithExecution | testkube.TestWorkflowTemplate | testkube.TestWorkflowExecution |
+		testkube.FlakyTestsReport | testkube.QueuedExecution | testkube.TestWorkflowValidationResult |
+		testkube.TestWorkflowScheduledRun | testkube.ExecutionComparison
 }
 
 // Executable is an interface of executable objects
@@ -22,6 +22,9 @@ func NewTestClient(
 	artifactTransport Transport[testkube.Artifact],
 	serverInfoTransport Transport[testkube.ServerInfo],
 	debugInfoTransport Transport[testkube.DebugInfo],
+	flakyTestsReportTransport Transport[testkube.FlakyTestsReport],
+	queuedExecutionTransport Transport[testkube.QueuedExecution],
+	executionComparisonTransport Transport[testkube.ExecutionComparison],
 ) TestClient {
 	return TestClient{
 		testTransport:                     testTransport,
@@ -32,6 +35,9 @@ func NewTestClient(
 		artifactTransport:                 artifactTransport,
 		serverInfoTransport:               serverInfoTransport,
 		debugInfoTransport:                debugInfoTransport,
+		flakyTestsReportTransport:         flakyTestsReportTransport,
+		queuedExecutionTransport:          queuedExecutionTransport,
+		executionComparisonTransport:      executionComparisonTransport,
 	}
 }
 
@@ -45,6 +51,9 @@ type TestClient struct {
 	artifactTransport                 Transport[testkube.Artifact]
 	serverInfoTransport               Transport[testkube.ServerInfo]
 	debugInfoTransport                Transport[testkube.DebugInfo]
+	flakyTestsReportTransport         Transport[testkube.FlakyTestsReport]
+	queuedExecutionTransport          Transport[testkube.QueuedExecution]
+	executionComparisonTransport      Transport[testkube.ExecutionComparison]
 }
 
 // GetTest returns single test by id
@@ -59,6 +68,16 @@ func (c TestClient) GetTestWithExecution(id string) (test testkube.TestWithExecu
 	return c.testWithExecutionTransport.Execute(http.MethodGet, uri, nil, nil)
 }
 
+// GetTestFlakyCases returns flaky test cases detected in the latest test executions
+func (c TestClient) GetTestFlakyCases(id string, last int) (report testkube.FlakyTestsReport, err error) {
+	uri := c.flakyTestsReportTransport.GetURI("/tests/%s/flaky", id)
+	params := map[string]string{
+		"last": fmt.Sprintf("%d", last),
+	}
+
+	return c.flakyTestsReportTransport.Execute(http.MethodGet, uri, nil, params)
+}
+
 // ListTests list all tests
 func (c TestClient) ListTests(selector string) (tests testkube.Tests, err error) {
 	uri := c.testTransport.GetURI("/tests")
@@ -132,6 +151,17 @@ func (c TestClient) GetExecution(executionID string) (execution testkube.Executi
 	return c.executionTransport.Execute(http.MethodGet, uri, nil, nil)
 }
 
+// CompareExecutions compares two test executions
+func (c TestClient) CompareExecutions(baseID, headID string, threshold float64) (comparison testkube.ExecutionComparison, err error) {
+	uri := c.executionComparisonTransport.GetURI("/executions/compare")
+	params := map[string]string{
+		"base":      baseID,
+		"head":      headID,
+		"threshold": strconv.FormatFloat(threshold, 'f', -1, 64),
+	}
+	return c.executionComparisonTransport.Execute(http.MethodGet, uri, nil, params)
+}
+
 // ExecuteTest starts test execution, reads data and returns ID
 // execution is started asynchronously client can check later for results
 func (c TestClient) ExecuteTest(id, executionName string, options ExecuteTestOptions) (execution testkube.Execution, err error) {
@@ -171,6 +201,8 @@ func (c TestClient) ExecuteTest(id, executionName string, options ExecuteTestOpt
 		RunningContext:                     options.RunningContext,
 		SlavePodRequest:                    options.SlavePodRequest,
 		ExecutionNamespace:                 options.ExecutionNamespace,
+		Priority:                           options.Priority,
+		RetryPolicy:                        options.RetryPolicy,
 	}
 
 	body, err := json.Marshal(request)
@@ -215,6 +247,8 @@ func (c TestClient) ExecuteTests(selector string, concurrencyLevel int, options
 		RunningContext:                     options.RunningContext,
 		SlavePodRequest:                    options.SlavePodRequest,
 		ExecutionNamespace:                 options.ExecutionNamespace,
+		Priority:                           options.Priority,
+		RetryPolicy:                        options.RetryPolicy,
 	}
 
 	body, err := json.Marshal(request)
@@ -257,6 +291,18 @@ func (c TestClient) ListExecutions(id string, limit int, selector string) (execu
 	return c.executionsResultTransport.Execute(http.MethodGet, uri, nil, params)
 }
 
+// ListQueuedExecutions list test executions waiting in the execution queue
+func (c TestClient) ListQueuedExecutions() (executions testkube.QueuedExecutions, err error) {
+	uri := c.queuedExecutionTransport.GetURI("/queue")
+	return c.queuedExecutionTransport.ExecuteMultiple(http.MethodGet, uri, nil, nil)
+}
+
+// GetQueuedExecution returns test execution waiting in the execution queue with its position
+func (c TestClient) GetQueuedExecution(executionID string) (execution testkube.QueuedExecution, err error) {
+	uri := c.queuedExecutionTransport.GetURI("/queue/%s", executionID)
+	return c.queuedExecutionTransport.Execute(http.MethodGet, uri, nil, nil)
+}
+
 // Logs returns logs stream from job pods, based on job pods logs
 func (c TestClient) Logs(id string) (logs chan output.Output, err error) {
 	logs = make(chan output.Output)
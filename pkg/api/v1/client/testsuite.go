@@ -18,6 +18,7 @@ func NewTestSuiteClient(
 	testSuiteWithExecutionSummaryTransport Transport[testkube.TestSuiteWithExecutionSummary],
 	testSuiteExecutionsResultTransport Transport[testkube.TestSuiteExecutionsResult],
 	testSuiteArtifactTransport Transport[testkube.Artifact],
+	executionComparisonTransport Transport[testkube.ExecutionComparison],
 ) TestSuiteClient {
 	return TestSuiteClient{
 		testSuiteTransport:                     testSuiteTransport,
@@ -26,6 +27,7 @@ func NewTestSuiteClient(
 		testSuiteWithExecutionSummaryTransport: testSuiteWithExecutionSummaryTransport,
 		testSuiteExecutionsResultTransport:     testSuiteExecutionsResultTransport,
 		testSuiteArtifactTransport:             testSuiteArtifactTransport,
+		executionComparisonTransport:           executionComparisonTransport,
 	}
 }
 
@@ -37,6 +39,7 @@ type TestSuiteClient struct {
 	testSuiteWithExecutionSummaryTransport Transport[testkube.TestSuiteWithExecutionSummary]
 	testSuiteExecutionsResultTransport     Transport[testkube.TestSuiteExecutionsResult]
 	testSuiteArtifactTransport             Transport[testkube.Artifact]
+	executionComparisonTransport           Transport[testkube.ExecutionComparison]
 }
 
 // GetTestSuite returns single test suite by id
@@ -125,6 +128,17 @@ func (c TestSuiteClient) GetTestSuiteExecution(executionID string) (execution te
 	return c.testSuiteExecutionTransport.Execute(http.MethodGet, uri, nil, nil)
 }
 
+// CompareTestSuiteExecutions compares two test suite executions
+func (c TestSuiteClient) CompareTestSuiteExecutions(baseID, headID string, threshold float64) (comparison testkube.ExecutionComparison, err error) {
+	uri := c.executionComparisonTransport.GetURI("/test-suite-executions/compare")
+	params := map[string]string{
+		"base":      baseID,
+		"head":      headID,
+		"threshold": strconv.FormatFloat(threshold, 'f', -1, 64),
+	}
+	return c.executionComparisonTransport.Execute(http.MethodGet, uri, nil, params)
+}
+
 // AbortTestSuiteExecution aborts a test suite execution
 func (c TestSuiteClient) AbortTestSuiteExecution(executionID string) error {
 	uri := c.testSuiteExecutionTransport.GetURI("/test-suite-executions/%s", executionID)
@@ -156,6 +170,7 @@ func (c TestSuiteClient) ExecuteTestSuite(id, executionName string, options Exec
 		ContentRequest:           options.ContentRequest,
 		RunningContext:           options.RunningContext,
 		ConcurrencyLevel:         options.ConcurrencyLevel,
+		RetryPolicy:              options.RetryPolicy,
 		JobTemplate:              options.JobTemplate,
 		JobTemplateReference:     options.JobTemplateReference,
 		ScraperTemplate:          options.ScraperTemplate,
@@ -184,6 +199,7 @@ func (c TestSuiteClient) ExecuteTestSuites(selector string, concurrencyLevel int
 		ContentRequest:           options.ContentRequest,
 		RunningContext:           options.RunningContext,
 		ConcurrencyLevel:         options.ConcurrencyLevel,
+		RetryPolicy:              options.RetryPolicy,
 		JobTemplate:              options.JobTemplate,
 		JobTemplateReference:     options.JobTemplateReference,
 		ScraperTemplate:          options.ScraperTemplate,
@@ -2,9 +2,12 @@ package client
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 )
@@ -16,6 +19,9 @@ func NewTestWorkflowClient(
 	testWorkflowExecutionTransport Transport[testkube.TestWorkflowExecution],
 	testWorkflowExecutionsResultTransport Transport[testkube.TestWorkflowExecutionsResult],
 	artifactTransport Transport[testkube.Artifact],
+	testWorkflowValidationResultTransport Transport[testkube.TestWorkflowValidationResult],
+	testWorkflowScheduledRunTransport Transport[testkube.TestWorkflowScheduledRun],
+	executionComparisonTransport Transport[testkube.ExecutionComparison],
 ) TestWorkflowClient {
 	return TestWorkflowClient{
 		testWorkflowTransport:                 testWorkflowTransport,
@@ -23,6 +29,9 @@ func NewTestWorkflowClient(
 		testWorkflowExecutionTransport:        testWorkflowExecutionTransport,
 		testWorkflowExecutionsResultTransport: testWorkflowExecutionsResultTransport,
 		artifactTransport:                     artifactTransport,
+		testWorkflowValidationResultTransport: testWorkflowValidationResultTransport,
+		testWorkflowScheduledRunTransport:     testWorkflowScheduledRunTransport,
+		executionComparisonTransport:          executionComparisonTransport,
 	}
 }
 
@@ -33,6 +42,9 @@ type TestWorkflowClient struct {
 	testWorkflowExecutionTransport        Transport[testkube.TestWorkflowExecution]
 	testWorkflowExecutionsResultTransport Transport[testkube.TestWorkflowExecutionsResult]
 	artifactTransport                     Transport[testkube.Artifact]
+	testWorkflowValidationResultTransport Transport[testkube.TestWorkflowValidationResult]
+	testWorkflowScheduledRunTransport     Transport[testkube.TestWorkflowScheduledRun]
+	executionComparisonTransport          Transport[testkube.ExecutionComparison]
 }
 
 // GetTestWorkflow returns single test workflow by id
@@ -121,6 +133,25 @@ func (c TestWorkflowClient) ExecuteTestWorkflow(name string, request testkube.Te
 	return c.testWorkflowExecutionTransport.Execute(http.MethodPost, uri, body, nil)
 }
 
+// ValidateTestWorkflow checks the TestWorkflow statically, without executing it
+func (c TestWorkflowClient) ValidateTestWorkflow(workflow testkube.TestWorkflow) (result testkube.TestWorkflowValidationResult, err error) {
+	uri := c.testWorkflowValidationResultTransport.GetURI("/test-workflows/validate")
+
+	body, err := json.Marshal(workflow)
+	if err != nil {
+		return result, err
+	}
+
+	return c.testWorkflowValidationResultTransport.Execute(http.MethodPost, uri, body, nil)
+}
+
+// GetTestWorkflowNextRuns returns upcoming runs of the scheduled test workflow
+func (c TestWorkflowClient) GetTestWorkflowNextRuns(name string, count int) ([]testkube.TestWorkflowScheduledRun, error) {
+	uri := c.testWorkflowScheduledRunTransport.GetURI("/test-workflows/%s/next-runs", name)
+	params := map[string]string{"count": fmt.Sprintf("%d", count)}
+	return c.testWorkflowScheduledRunTransport.ExecuteMultiple(http.MethodGet, uri, nil, params)
+}
+
 // GetTestWorkflowExecutionNotifications returns events stream from job pods, based on job pods logs
 func (c TestWorkflowClient) GetTestWorkflowExecutionNotifications(id string) (notifications chan testkube.TestWorkflowExecutionNotification, err error) {
 	notifications = make(chan testkube.TestWorkflowExecutionNotification)
@@ -135,6 +166,17 @@ func (c TestWorkflowClient) GetTestWorkflowExecution(id string) (testkube.TestWo
 	return c.testWorkflowExecutionTransport.Execute(http.MethodGet, uri, nil, nil)
 }
 
+// CompareTestWorkflowExecutions compares two test workflow executions
+func (c TestWorkflowClient) CompareTestWorkflowExecutions(baseID, headID string, threshold float64) (comparison testkube.ExecutionComparison, err error) {
+	uri := c.executionComparisonTransport.GetURI("/test-workflow-executions/compare")
+	params := map[string]string{
+		"base":      baseID,
+		"head":      headID,
+		"threshold": strconv.FormatFloat(threshold, 'f', -1, 64),
+	}
+	return c.executionComparisonTransport.Execute(http.MethodGet, uri, nil, params)
+}
+
 // ListTestWorkflowExecutions list test workflow executions for selected workflow
 func (c TestWorkflowClient) ListTestWorkflowExecutions(id string, limit int, selector string) (testkube.TestWorkflowExecutionsResult, error) {
 	uri := c.testWorkflowExecutionsResultTransport.GetURI("/test-workflow-executions/")
@@ -160,6 +202,51 @@ func (c TestWorkflowClient) AbortTestWorkflowExecutions(workflow string) error {
 	return c.testWorkflowTransport.ExecuteMethod(http.MethodPost, uri, "", false)
 }
 
+// AbortTestWorkflowExecutionStep aborts selected step of the execution
+func (c TestWorkflowClient) AbortTestWorkflowExecutionStep(executionID, ref string) error {
+	uri := c.testWorkflowTransport.GetURI("/test-workflow-executions/%s/steps/%s/abort", executionID, ref)
+	return c.testWorkflowTransport.ExecuteMethod(http.MethodPost, uri, "", false)
+}
+
+// SkipTestWorkflowExecutionStep skips selected step of the execution
+func (c TestWorkflowClient) SkipTestWorkflowExecutionStep(executionID, ref string) error {
+	uri := c.testWorkflowTransport.GetURI("/test-workflow-executions/%s/steps/%s/skip", executionID, ref)
+	return c.testWorkflowTransport.ExecuteMethod(http.MethodPost, uri, "", false)
+}
+
+// ApproveTestWorkflowExecutionStep approves selected step of the execution, that waits for the approval
+func (c TestWorkflowClient) ApproveTestWorkflowExecutionStep(executionID, ref string, inputs map[string]string) error {
+	uri := c.testWorkflowTransport.GetURI("/test-workflow-executions/%s/steps/%s/approve", executionID, ref)
+	body, err := json.Marshal(testkube.TestWorkflowApprovalRequest{Inputs: inputs})
+	if err != nil {
+		return err
+	}
+	_, err = c.testWorkflowTransport.Execute(http.MethodPost, uri, body, nil)
+	if errors.Is(err, io.EOF) {
+		// The approval doesn't return any content
+		return nil
+	}
+	return err
+}
+
+// RejectTestWorkflowExecutionStep rejects selected step of the execution, that waits for the approval
+func (c TestWorkflowClient) RejectTestWorkflowExecutionStep(executionID, ref string) error {
+	uri := c.testWorkflowTransport.GetURI("/test-workflow-executions/%s/steps/%s/reject", executionID, ref)
+	return c.testWorkflowTransport.ExecuteMethod(http.MethodPost, uri, "", false)
+}
+
+// ExecuteTestWorkflowParallelStep schedules the child execution for a single combination of the parallel step
+func (c TestWorkflowClient) ExecuteTestWorkflowParallelStep(executionID, ref string, request testkube.TestWorkflowParallelExecutionRequest) (result testkube.TestWorkflowExecution, err error) {
+	uri := c.testWorkflowExecutionTransport.GetURI("/test-workflow-executions/%s/steps/%s/parallel", executionID, ref)
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return result, err
+	}
+
+	return c.testWorkflowExecutionTransport.Execute(http.MethodPost, uri, body, nil)
+}
+
 // GetTestWorkflowExecutionArtifacts returns execution artifacts
 func (c TestWorkflowClient) GetTestWorkflowExecutionArtifacts(executionID string) (artifacts testkube.Artifacts, err error) {
 	uri := c.artifactTransport.GetURI("/test-workflow-executions/%s/artifacts", executionID)
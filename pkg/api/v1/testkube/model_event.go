@@ -22,6 +22,9 @@ type Event struct {
 	TestExecution         *Execution             `json:"testExecution,omitempty"`
 	TestSuiteExecution    *TestSuiteExecution    `json:"testSuiteExecution,omitempty"`
 	TestWorkflowExecution *TestWorkflowExecution `json:"testWorkflowExecution,omitempty"`
+	// test cases that have recently become flaky
+	FlakyTestCases []FlakyTestCase     `json:"flakyTestCases,omitempty"`
+	Approval       *TestWorkflowOutput `json:"approval,omitempty"`
 	// cluster name of event
 	ClusterName string `json:"clusterName,omitempty"`
 	// environment variables
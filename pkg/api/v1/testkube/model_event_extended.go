@@ -159,6 +159,34 @@ func NewEventEndTestWorkflowAborted(execution *TestWorkflowExecution) Event {
 	}
 }
 
+func NewEventPauseTestWorkflow(execution *TestWorkflowExecution, approval *TestWorkflowOutput) Event {
+	return Event{
+		Id:                    uuid.NewString(),
+		Type_:                 EventPauseTestWorkflow,
+		TestWorkflowExecution: execution,
+		Approval:              approval,
+	}
+}
+
+func NewEventFlakyTest(execution *Execution, cases []FlakyTestCase) Event {
+	return Event{
+		Id:             uuid.NewString(),
+		Type_:          EventFlakyTest,
+		TestExecution:  execution,
+		ResourceId:     execution.Id,
+		FlakyTestCases: cases,
+	}
+}
+
+func NewEventFlakyTestWorkflow(execution *TestWorkflowExecution, cases []FlakyTestCase) Event {
+	return Event{
+		Id:                    uuid.NewString(),
+		Type_:                 EventFlakyTestWorkflow,
+		TestWorkflowExecution: execution,
+		FlakyTestCases:        cases,
+	}
+}
+
 func (e Event) Type() EventType {
 	if e.Type_ != nil {
 		return *e.Type_
@@ -28,6 +28,9 @@ const (
 	END_TESTWORKFLOW_SUCCESS_EventType EventType = "end-testworkflow-success"
 	END_TESTWORKFLOW_FAILED_EventType  EventType = "end-testworkflow-failed"
 	END_TESTWORKFLOW_ABORTED_EventType EventType = "end-testworkflow-aborted"
+	PAUSE_TESTWORKFLOW_EventType       EventType = "pause-testworkflow"
+	FLAKY_TEST_EventType               EventType = "flaky-test"
+	FLAKY_TESTWORKFLOW_EventType       EventType = "flaky-testworkflow"
 	CREATED_EventType                  EventType = "created"
 	UPDATED_EventType                  EventType = "updated"
 	DELETED_EventType                  EventType = "deleted"
@@ -16,6 +16,9 @@ var AllEventTypes = []EventType{
 	END_TESTWORKFLOW_SUCCESS_EventType,
 	END_TESTWORKFLOW_FAILED_EventType,
 	END_TESTWORKFLOW_ABORTED_EventType,
+	PAUSE_TESTWORKFLOW_EventType,
+	FLAKY_TEST_EventType,
+	FLAKY_TESTWORKFLOW_EventType,
 	CREATED_EventType,
 	DELETED_EventType,
 	UPDATED_EventType,
@@ -45,6 +48,9 @@ var (
 	EventEndTestWorkflowSuccess = EventTypePtr(END_TESTWORKFLOW_SUCCESS_EventType)
 	EventEndTestWorkflowFailed  = EventTypePtr(END_TESTWORKFLOW_FAILED_EventType)
 	EventEndTestWorkflowAborted = EventTypePtr(END_TESTWORKFLOW_ABORTED_EventType)
+	EventPauseTestWorkflow      = EventTypePtr(PAUSE_TESTWORKFLOW_EventType)
+	EventFlakyTest              = EventTypePtr(FLAKY_TEST_EventType)
+	EventFlakyTestWorkflow      = EventTypePtr(FLAKY_TESTWORKFLOW_EventType)
 	EventCreated                = EventTypePtr(CREATED_EventType)
 	EventDeleted                = EventTypePtr(DELETED_EventType)
 	EventUpdated                = EventTypePtr(UPDATED_EventType)
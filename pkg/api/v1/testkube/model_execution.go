@@ -83,5 +83,10 @@ type Execution struct {
 	DownloadArtifactTestNames []string    `json:"downloadArtifactTestNames,omitempty"`
 	SlavePodRequest           *PodRequest `json:"slavePodRequest,omitempty"`
 	// namespace for test execution (Pro edition only)
-	ExecutionNamespace string `json:"executionNamespace,omitempty"`
+	ExecutionNamespace string                `json:"executionNamespace,omitempty"`
+	RetryPolicy        *ExecutionRetryPolicy `json:"retryPolicy,omitempty"`
+	// id of the first execution attempt, if this execution is a retry
+	RetryOf string `json:"retryOf,omitempty"`
+	// execution attempt number, starting from 1
+	Attempt int32 `json:"attempt,omitempty"`
 }
@@ -0,0 +1,47 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// differences between two executions
+type ExecutionComparison struct {
+	// base execution id
+	BaseId string `json:"baseId"`
+	// head execution id
+	HeadId string `json:"headId"`
+	// status of the base execution
+	BaseStatus string `json:"baseStatus,omitempty"`
+	// status of the head execution
+	HeadStatus string `json:"headStatus,omitempty"`
+	// duration of the base execution in milliseconds
+	BaseDurationMs int64 `json:"baseDurationMs,omitempty"`
+	// duration of the head execution in milliseconds
+	HeadDurationMs int64 `json:"headDurationMs,omitempty"`
+	// minimum relative duration increase to consider it a performance regression
+	Threshold float64 `json:"threshold"`
+	// is the head execution slower than the threshold
+	Regression bool `json:"regression,omitempty"`
+	// status and duration of all the steps
+	Steps []ExecutionComparisonEntry `json:"steps,omitempty"`
+	// test cases with changed status or performance regression
+	Cases []ExecutionComparisonEntry `json:"cases,omitempty"`
+	// changed variables
+	Variables []ExecutionComparisonValue `json:"variables,omitempty"`
+	// changed configuration
+	Config []ExecutionComparisonValue `json:"config,omitempty"`
+	// changed image references from the specification, with the tag or digest
+	ImageRefs []ExecutionComparisonValue `json:"imageRefs,omitempty"`
+	// changed content sources from the specification, like Git repository, branch or revision
+	Content []ExecutionComparisonValue `json:"content,omitempty"`
+	Logs    *ExecutionComparisonLogs   `json:"logs,omitempty"`
+	// names of the steps and test cases that are failing only in the head execution
+	NewlyFailing []string `json:"newlyFailing,omitempty"`
+	// names of the steps and test cases slower than the threshold
+	PerformanceRegressions []string `json:"performanceRegressions,omitempty"`
+}
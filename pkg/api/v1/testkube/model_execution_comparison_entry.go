@@ -0,0 +1,28 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// comparison of the step or test case
+type ExecutionComparisonEntry struct {
+	// step or test case name
+	Name string `json:"name"`
+	// status in the base execution
+	BaseStatus string `json:"baseStatus,omitempty"`
+	// status in the head execution
+	HeadStatus string `json:"headStatus,omitempty"`
+	// duration in the base execution in milliseconds
+	BaseDurationMs int64 `json:"baseDurationMs,omitempty"`
+	// duration in the head execution in milliseconds
+	HeadDurationMs int64 `json:"headDurationMs,omitempty"`
+	// kind of the change: added, removed, broken, fixed, changed or unchanged
+	Change string `json:"change"`
+	// is it slower than the threshold
+	Regression bool `json:"regression,omitempty"`
+}
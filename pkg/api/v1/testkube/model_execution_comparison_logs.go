@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// log lines that differ between two executions, ignoring the timestamps
+type ExecutionComparisonLogs struct {
+	// lines present only in the head execution
+	Added []string `json:"added,omitempty"`
+	// lines present only in the base execution
+	Removed []string `json:"removed,omitempty"`
+	// are there more lines than returned, or the logs were too large to compare them fully
+	Truncated bool `json:"truncated,omitempty"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// changed value between two executions
+type ExecutionComparisonValue struct {
+	// value name
+	Name string `json:"name"`
+	// value in the base execution
+	Base string `json:"base,omitempty"`
+	// value in the head execution
+	Head string `json:"head,omitempty"`
+	// kind of the change: added, removed or changed
+	Change string `json:"change"`
+}
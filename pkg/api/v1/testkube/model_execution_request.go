@@ -104,4 +104,11 @@ type ExecutionRequest struct {
 	SlavePodRequest           *PodRequest `json:"slavePodRequest,omitempty"`
 	// namespace for test execution (Pro edition only)
 	ExecutionNamespace string `json:"executionNamespace,omitempty"`
+	// execution priority in the execution queue, higher values are started first
+	Priority    int32                 `json:"priority,omitempty"`
+	RetryPolicy *ExecutionRetryPolicy `json:"retryPolicy,omitempty"`
+	// id of the first execution attempt, if this execution is a retry
+	RetryOf string `json:"retryOf,omitempty"`
+	// execution attempt number, starting from 1
+	Attempt int32 `json:"attempt,omitempty"`
 }
@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// automatic retry policy for failed executions
+type ExecutionRetryPolicy struct {
+	// maximum number of attempts, including the first one
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+	// delay before the next attempt, doubled after each retry
+	Backoff string `json:"backoff,omitempty"`
+	// execution statuses which should be retried, failed and timeout by default
+	RetryOn []ExecutionStatus `json:"retryOn,omitempty"`
+}
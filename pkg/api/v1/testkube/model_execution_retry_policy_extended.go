@@ -0,0 +1,75 @@
+package testkube
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultRetryOnStatuses are execution statuses retried when retry policy doesn't specify any
+var DefaultRetryOnStatuses = []ExecutionStatus{FAILED_ExecutionStatus, TIMEOUT_ExecutionStatus}
+
+// IsEnabled checks if retry policy allows more than a single attempt
+func (p *ExecutionRetryPolicy) IsEnabled() bool {
+	return p != nil && p.MaxAttempts > 1
+}
+
+// IsRetriable checks if execution finished with status should be retried
+func (p *ExecutionRetryPolicy) IsRetriable(status *ExecutionStatus) bool {
+	if !p.IsEnabled() || status == nil {
+		return false
+	}
+
+	retryOn := p.RetryOn
+	if len(retryOn) == 0 {
+		retryOn = DefaultRetryOnStatuses
+	}
+
+	for _, s := range retryOn {
+		if s == *status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetBackoff returns delay before the attempt, doubling the base backoff after each retry
+func (p *ExecutionRetryPolicy) GetBackoff(attempt int32) (time.Duration, error) {
+	if p == nil || p.Backoff == "" || attempt < 2 {
+		return 0, nil
+	}
+
+	backoff, err := time.ParseDuration(p.Backoff)
+	if err != nil {
+		return 0, fmt.Errorf("invalid retry backoff %s: %w", p.Backoff, err)
+	}
+
+	for i := int32(2); i < attempt; i++ {
+		backoff *= 2
+	}
+
+	return backoff, nil
+}
+
+// Validate checks if retry policy is correct
+func (p *ExecutionRetryPolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if p.MaxAttempts < 0 {
+		return fmt.Errorf("retry max attempts should not be negative: %d", p.MaxAttempts)
+	}
+
+	if _, err := p.GetBackoff(2); err != nil {
+		return err
+	}
+
+	for _, status := range p.RetryOn {
+		if status != FAILED_ExecutionStatus && status != TIMEOUT_ExecutionStatus && status != ABORTED_ExecutionStatus {
+			return fmt.Errorf("unsupported retry status: %s", status)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package testkube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionRetryPolicy_IsRetriable(t *testing.T) {
+	var disabled *ExecutionRetryPolicy
+	assert.False(t, disabled.IsRetriable(ExecutionStatusFailed))
+	assert.False(t, (&ExecutionRetryPolicy{MaxAttempts: 1}).IsRetriable(ExecutionStatusFailed))
+
+	policy := &ExecutionRetryPolicy{MaxAttempts: 3}
+	assert.True(t, policy.IsRetriable(ExecutionStatusFailed))
+	assert.True(t, policy.IsRetriable(ExecutionStatusTimeout))
+	assert.False(t, policy.IsRetriable(ExecutionStatusPassed))
+	assert.False(t, policy.IsRetriable(ExecutionStatusAborted))
+
+	policy.RetryOn = []ExecutionStatus{TIMEOUT_ExecutionStatus}
+	assert.False(t, policy.IsRetriable(ExecutionStatusFailed))
+	assert.True(t, policy.IsRetriable(ExecutionStatusTimeout))
+}
+
+func TestExecutionRetryPolicy_GetBackoff(t *testing.T) {
+	policy := &ExecutionRetryPolicy{MaxAttempts: 4, Backoff: "5s"}
+
+	backoffs := make([]time.Duration, 0)
+	for attempt := int32(1); attempt <= 4; attempt++ {
+		backoff, err := policy.GetBackoff(attempt)
+		assert.NoError(t, err)
+		backoffs = append(backoffs, backoff)
+	}
+
+	assert.Equal(t, []time.Duration{0, 5 * time.Second, 10 * time.Second, 20 * time.Second}, backoffs)
+
+	_, err := (&ExecutionRetryPolicy{Backoff: "soon"}).GetBackoff(2)
+	assert.Error(t, err)
+}
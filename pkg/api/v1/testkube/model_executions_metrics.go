@@ -34,4 +34,6 @@ type ExecutionsMetrics struct {
 	FailedExecutions int32 `json:"failedExecutions,omitempty"`
 	// List of test/testsuite executions
 	Executions []ExecutionsMetricsExecutions `json:"executions,omitempty"`
+	// number of executions being retry attempts
+	RetriedExecutions int32 `json:"retriedExecutions,omitempty"`
 }
@@ -20,4 +20,5 @@ type ExecutionsMetricsExecutions struct {
 	Status      string    `json:"status,omitempty"`
 	Name        string    `json:"name,omitempty"`
 	StartTime   time.Time `json:"startTime,omitempty"`
+	Attempt     int32     `json:"attempt,omitempty"`
 }
@@ -0,0 +1,30 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// flakiness of the single test case
+type FlakyTestCase struct {
+	// test case name
+	Name string `json:"name"`
+	// number of runs containing the test case
+	Runs int32 `json:"runs"`
+	// number of passed runs
+	Passed int32 `json:"passed"`
+	// number of failed runs
+	Failed int32 `json:"failed"`
+	// number of status changes between the consecutive runs
+	Flips int32 `json:"flips"`
+	// ratio of status changes to possible changes
+	FlipRate float64 `json:"flipRate"`
+	// is the test case considered flaky
+	Flaky bool `json:"flaky"`
+	// status in the latest run
+	LastStatus string `json:"lastStatus,omitempty"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// flakiness of the test cases across the latest executions
+type FlakyTestsReport struct {
+	// test or test workflow name
+	Name string `json:"name"`
+	// number of analyzed executions
+	Runs int32 `json:"runs"`
+	// minimum flip rate to consider the test case flaky
+	Threshold float64 `json:"threshold"`
+	// test cases that changed the status at least once, the most flaky first
+	Cases []FlakyTestCase `json:"cases"`
+}
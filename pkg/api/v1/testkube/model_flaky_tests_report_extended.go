@@ -0,0 +1,26 @@
+package testkube
+
+import "fmt"
+
+type FlakyTestsReports []FlakyTestsReport
+
+func (r FlakyTestsReports) Table() (header []string, output [][]string) {
+	header = []string{"Name", "Test case", "Runs", "Passed", "Failed", "Flips", "Flip rate", "Flaky", "Last status"}
+	for _, report := range r {
+		for _, c := range report.Cases {
+			output = append(output, []string{
+				report.Name,
+				c.Name,
+				fmt.Sprintf("%d", c.Runs),
+				fmt.Sprintf("%d", c.Passed),
+				fmt.Sprintf("%d", c.Failed),
+				fmt.Sprintf("%d", c.Flips),
+				fmt.Sprintf("%.1f%%", c.FlipRate*100),
+				fmt.Sprintf("%v", c.Flaky),
+				c.LastStatus,
+			})
+		}
+	}
+
+	return
+}
@@ -0,0 +1,34 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// test execution waiting in the execution queue
+type QueuedExecution struct {
+	// execution id
+	Id string `json:"id"`
+	// execution name
+	Name string `json:"name"`
+	// test name
+	TestName string `json:"testName"`
+	// test namespace
+	TestNamespace string `json:"testNamespace,omitempty"`
+	// test type, used for the executor limits
+	TestType string `json:"testType,omitempty"`
+	// execution priority, higher values are started first
+	Priority int32 `json:"priority,omitempty"`
+	// position in the execution queue, starting from 1
+	Position int32 `json:"position"`
+	// time when the execution has been queued
+	EnqueuedAt time.Time `json:"enqueuedAt,omitempty"`
+}
@@ -0,0 +1,23 @@
+package testkube
+
+import "fmt"
+
+type QueuedExecutions []QueuedExecution
+
+func (q QueuedExecutions) Table() (header []string, output [][]string) {
+	header = []string{"Position", "Id", "Name", "Test name", "Namespace", "Type", "Priority", "Queued"}
+	for _, e := range q {
+		output = append(output, []string{
+			fmt.Sprintf("%d", e.Position),
+			e.Id,
+			e.Name,
+			e.TestName,
+			e.TestNamespace,
+			e.TestType,
+			fmt.Sprintf("%d", e.Priority),
+			e.EnqueuedAt.String(),
+		})
+	}
+
+	return
+}
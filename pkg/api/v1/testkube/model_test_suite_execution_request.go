@@ -53,5 +53,6 @@ type TestSuiteExecutionRequest struct {
 	// number of tests run in parallel
 	ConcurrencyLevel int32 `json:"concurrencyLevel,omitempty"`
 	// test suite execution name started the test suite execution
-	TestSuiteExecutionName string `json:"testSuiteExecutionName,omitempty"`
+	TestSuiteExecutionName string                `json:"testSuiteExecutionName,omitempty"`
+	RetryPolicy            *ExecutionRetryPolicy `json:"retryPolicy,omitempty"`
 }
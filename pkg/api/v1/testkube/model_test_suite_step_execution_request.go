@@ -43,6 +43,7 @@ type TestSuiteStepExecutionRequest struct {
 	// pvc template extensions
 	PvcTemplate string `json:"pvcTemplate,omitempty"`
 	// name of the template resource
-	PvcTemplateReference string          `json:"pvcTemplateReference,omitempty"`
-	RunningContext       *RunningContext `json:"runningContext,omitempty"`
+	PvcTemplateReference string                `json:"pvcTemplateReference,omitempty"`
+	RunningContext       *RunningContext       `json:"runningContext,omitempty"`
+	RetryPolicy          *ExecutionRetryPolicy `json:"retryPolicy,omitempty"`
 }
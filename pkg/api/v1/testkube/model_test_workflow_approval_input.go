@@ -0,0 +1,18 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowApprovalInput struct {
+	// name of the input
+	Name string `json:"name"`
+	// description for the approvers
+	Description string       `json:"description,omitempty"`
+	Default_    *BoxedString `json:"default,omitempty"`
+}
@@ -0,0 +1,16 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// decision for the test workflow step waiting for the approval
+type TestWorkflowApprovalRequest struct {
+	// values provided by the approver
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowCacheSpec struct {
+	// expression for the cache key
+	Key string `json:"key"`
+	// paths to cache, relative to the data directory
+	Paths []string `json:"paths"`
+	// maximum size of the compressed cache, defaults to 1Gi
+	MaxSize string `json:"maxSize,omitempty"`
+	// time after the cache is evicted, defaults to 168h
+	Ttl string `json:"ttl,omitempty"`
+}
@@ -28,7 +28,13 @@ type TestWorkflowExecution struct {
 	Signature []TestWorkflowSignature `json:"signature,omitempty"`
 	Result    *TestWorkflowResult     `json:"result,omitempty"`
 	// additional information from the steps, like referenced executed tests or artifacts
-	Output           []TestWorkflowOutput `json:"output,omitempty"`
-	Workflow         *TestWorkflow        `json:"workflow"`
-	ResolvedWorkflow *TestWorkflow        `json:"resolvedWorkflow,omitempty"`
+	Output []TestWorkflowOutput `json:"output,omitempty"`
+	// test reports parsed from the steps
+	Reports []TestWorkflowReport `json:"reports,omitempty"`
+	// configuration values used for the execution
+	Config           map[string]string `json:"config,omitempty"`
+	Workflow         *TestWorkflow     `json:"workflow"`
+	ResolvedWorkflow *TestWorkflow     `json:"resolvedWorkflow,omitempty"`
+	// identifier of the execution that has spawned this one with the parallel step
+	ParentExecutionId string `json:"parentExecutionId,omitempty"`
 }
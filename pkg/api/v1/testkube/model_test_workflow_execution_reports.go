@@ -0,0 +1,16 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowExecutionReports struct {
+	Summary *TestWorkflowReportSummary `json:"summary"`
+	// reports with the failed test cases only
+	Reports []TestWorkflowReport `json:"reports"`
+}
@@ -0,0 +1,23 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowParallelExecutionRequest struct {
+	// index of the combination
+	Index int32 `json:"index,omitempty"`
+	// matrix values for the combination
+	Matrix map[string]string `json:"matrix,omitempty"`
+	// index of the shard
+	ShardIndex int32 `json:"shardIndex,omitempty"`
+	// number of shards for the matrix combination
+	ShardCount int32 `json:"shardCount,omitempty"`
+	// steps to run for the combination
+	Steps []TestWorkflowStep `json:"steps"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// result of the execution spawned by the parallel step
+type TestWorkflowParallelExecutionResult struct {
+	// execution id
+	Id string `json:"id,omitempty"`
+	// execution name
+	Name string `json:"name,omitempty"`
+	// index of the combination
+	Index  int32               `json:"index,omitempty"`
+	Status *TestWorkflowStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// aggregated result of the executions spawned by the parallel step
+type TestWorkflowParallelStepResult struct {
+	Status *TestWorkflowStatus `json:"status,omitempty"`
+	// results of the spawned executions
+	Executions []TestWorkflowParallelExecutionResult `json:"executions,omitempty"`
+}
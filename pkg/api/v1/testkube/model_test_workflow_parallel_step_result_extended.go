@@ -0,0 +1,74 @@
+package testkube
+
+import (
+	"slices"
+
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+func (r *TestWorkflowParallelStepResult) Clone() *TestWorkflowParallelStepResult {
+	if r == nil {
+		return nil
+	}
+	return &TestWorkflowParallelStepResult{
+		Status:     r.Status,
+		Executions: slices.Clone(r.Executions),
+	}
+}
+
+// Merge updates the results of the provided executions, and recomputes the aggregated status
+func (r *TestWorkflowParallelStepResult) Merge(next TestWorkflowParallelStepResult) {
+	for _, execution := range next.Executions {
+		i := slices.IndexFunc(r.Executions, func(e TestWorkflowParallelExecutionResult) bool {
+			return e.Id == execution.Id
+		})
+		if i == -1 {
+			r.Executions = append(r.Executions, execution)
+			continue
+		}
+		if execution.Name != "" {
+			r.Executions[i].Name = execution.Name
+		}
+		if execution.Index != 0 {
+			r.Executions[i].Index = execution.Index
+		}
+		if execution.Status != nil {
+			r.Executions[i].Status = execution.Status
+		}
+	}
+	slices.SortStableFunc(r.Executions, func(a, b TestWorkflowParallelExecutionResult) int {
+		return int(a.Index - b.Index)
+	})
+	r.Status = r.aggregateStatus()
+}
+
+// Failed checks if any of the finished executions has not passed
+func (r *TestWorkflowParallelStepResult) Failed() bool {
+	if r == nil {
+		return false
+	}
+	for _, execution := range r.Executions {
+		if isTestWorkflowStatusFinished(execution.Status) && *execution.Status != PASSED_TestWorkflowStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregateStatus computes the status of all the executions:
+// failed when any has failed, running until all are finished, and passed when all have passed
+func (r *TestWorkflowParallelStepResult) aggregateStatus() *TestWorkflowStatus {
+	if r.Failed() {
+		return common.Ptr(FAILED_TestWorkflowStatus)
+	}
+	for _, execution := range r.Executions {
+		if !isTestWorkflowStatusFinished(execution.Status) {
+			return common.Ptr(RUNNING_TestWorkflowStatus)
+		}
+	}
+	return common.Ptr(PASSED_TestWorkflowStatus)
+}
+
+func isTestWorkflowStatusFinished(status *TestWorkflowStatus) bool {
+	return status != nil && *status != QUEUED_TestWorkflowStatus && *status != RUNNING_TestWorkflowStatus
+}
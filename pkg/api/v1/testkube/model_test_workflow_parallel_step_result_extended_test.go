@@ -0,0 +1,54 @@
+package testkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+func TestTestWorkflowParallelStepResult_Merge(t *testing.T) {
+	result := &TestWorkflowParallelStepResult{}
+
+	result.Merge(TestWorkflowParallelStepResult{Executions: []TestWorkflowParallelExecutionResult{
+		{Id: "id-2", Name: "example-2", Index: 1},
+		{Id: "id-1", Name: "example-1", Index: 0},
+	}})
+	assert.Equal(t, RUNNING_TestWorkflowStatus, *result.Status)
+	assert.Equal(t, "id-1", result.Executions[0].Id)
+
+	result.Merge(TestWorkflowParallelStepResult{Executions: []TestWorkflowParallelExecutionResult{
+		{Id: "id-1", Status: common.Ptr(PASSED_TestWorkflowStatus)},
+	}})
+	assert.Equal(t, RUNNING_TestWorkflowStatus, *result.Status)
+	assert.Equal(t, "example-1", result.Executions[0].Name)
+	assert.False(t, result.Failed())
+
+	result.Merge(TestWorkflowParallelStepResult{Executions: []TestWorkflowParallelExecutionResult{
+		{Id: "id-2", Index: 1, Status: common.Ptr(PASSED_TestWorkflowStatus)},
+	}})
+	assert.Equal(t, PASSED_TestWorkflowStatus, *result.Status)
+	assert.Len(t, result.Executions, 2)
+
+	result.Merge(TestWorkflowParallelStepResult{Executions: []TestWorkflowParallelExecutionResult{
+		{Id: "id-3", Index: 2, Status: common.Ptr(ABORTED_TestWorkflowStatus)},
+	}})
+	assert.Equal(t, FAILED_TestWorkflowStatus, *result.Status)
+	assert.True(t, result.Failed())
+}
+
+func TestTestWorkflowStepResult_MergeParallel(t *testing.T) {
+	step := TestWorkflowStepResult{Status: common.Ptr(RUNNING_TestWorkflowStepStatus)}
+	step.Merge(TestWorkflowStepResult{Parallel: &TestWorkflowParallelStepResult{Executions: []TestWorkflowParallelExecutionResult{
+		{Id: "id-1", Status: common.Ptr(FAILED_TestWorkflowStatus)},
+	}}})
+	assert.Equal(t, RUNNING_TestWorkflowStepStatus, *step.Status)
+
+	clone := step.Clone()
+	step.Merge(TestWorkflowStepResult{Status: common.Ptr(PASSED_TestWorkflowStepStatus)})
+
+	assert.Equal(t, FAILED_TestWorkflowStepStatus, *step.Status)
+	assert.Equal(t, "parallel execution failed", step.ErrorMessage)
+	assert.Equal(t, FAILED_TestWorkflowStatus, *clone.Parallel.Status)
+}
@@ -0,0 +1,21 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// probe to determine when the service is ready, defaults to TCP check of the first port
+type TestWorkflowProbe struct {
+	Exec                *TestWorkflowProbeExec      `json:"exec,omitempty"`
+	TcpSocket           *TestWorkflowProbeTcpSocket `json:"tcpSocket,omitempty"`
+	HttpGet             *TestWorkflowProbeHttpGet   `json:"httpGet,omitempty"`
+	InitialDelaySeconds int32                       `json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       int32                       `json:"periodSeconds,omitempty"`
+	TimeoutSeconds      int32                       `json:"timeoutSeconds,omitempty"`
+	FailureThreshold    int32                       `json:"failureThreshold,omitempty"`
+}
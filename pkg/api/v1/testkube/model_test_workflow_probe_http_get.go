@@ -0,0 +1,17 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowProbeHttpGet struct {
+	// path to request
+	Path string `json:"path,omitempty"`
+	// port to request
+	Port int32 `json:"port,omitempty"`
+}
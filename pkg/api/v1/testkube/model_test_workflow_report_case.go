@@ -0,0 +1,23 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowReportCase struct {
+	// test case name
+	Name string `json:"name"`
+	// test case class or package
+	Classname string `json:"classname,omitempty"`
+	// test case status: passed, failed, skipped or error
+	Status string `json:"status"`
+	// test case duration in milliseconds
+	DurationMs int64 `json:"durationMs,omitempty"`
+	// failure or skip message
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,49 @@
+package testkube
+
+const (
+	TestWorkflowReportCasePassed  = "passed"
+	TestWorkflowReportCaseFailed  = "failed"
+	TestWorkflowReportCaseSkipped = "skipped"
+	TestWorkflowReportCaseError   = "error"
+)
+
+func (c *TestWorkflowReportCase) IsFailed() bool {
+	return c.Status == TestWorkflowReportCaseFailed || c.Status == TestWorkflowReportCaseError
+}
+
+func (s *TestWorkflowReportSummary) Add(other *TestWorkflowReportSummary) {
+	if other == nil {
+		return
+	}
+	s.Tests += other.Tests
+	s.Passed += other.Passed
+	s.Failed += other.Failed
+	s.Skipped += other.Skipped
+	s.Errored += other.Errored
+	s.DurationMs += other.DurationMs
+}
+
+// FailedOnly returns copy of the report, that has only failed and errored test cases
+func (r TestWorkflowReport) FailedOnly() TestWorkflowReport {
+	cases := make([]TestWorkflowReportCase, 0)
+	for _, c := range r.Cases {
+		if c.IsFailed() {
+			cases = append(cases, c)
+		}
+	}
+	r.Cases = cases
+	return r
+}
+
+// GetReports builds the totals for all the test reports of the execution, along with the failed test cases
+func (e *TestWorkflowExecution) GetReports() TestWorkflowExecutionReports {
+	result := TestWorkflowExecutionReports{
+		Summary: &TestWorkflowReportSummary{},
+		Reports: make([]TestWorkflowReport, 0, len(e.Reports)),
+	}
+	for _, r := range e.Reports {
+		result.Summary.Add(r.Summary)
+		result.Reports = append(result.Reports, r.FailedOnly())
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowReportSummary struct {
+	// number of all test cases
+	Tests int32 `json:"tests"`
+	// number of passed test cases
+	Passed int32 `json:"passed"`
+	// number of failed test cases
+	Failed int32 `json:"failed"`
+	// number of skipped test cases
+	Skipped int32 `json:"skipped"`
+	// number of test cases that finished with error
+	Errored int32 `json:"errored"`
+	// total duration of the test cases in milliseconds
+	DurationMs int64 `json:"durationMs"`
+}
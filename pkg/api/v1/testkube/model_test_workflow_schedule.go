@@ -0,0 +1,28 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// cron schedule to execute the test workflow automatically
+type TestWorkflowSchedule struct {
+	// unique name of the schedule, defaults to \"default\"
+	Name string `json:"name,omitempty"`
+	// cron expression
+	Cron string `json:"cron"`
+	// IANA time zone for the cron expression, defaults to UTC
+	Timezone string `json:"timezone,omitempty"`
+	// maximum random delay added to each run, to spread the load
+	Jitter string `json:"jitter,omitempty"`
+	// config values passed to the execution
+	Config map[string]string `json:"config,omitempty"`
+	// policy for the runs missed while the scheduler was not running
+	CatchUp string `json:"catchUp,omitempty"`
+	// pause scheduling, the missed runs are not caught up after enabling it back
+	Disabled bool `json:"disabled,omitempty"`
+}
@@ -0,0 +1,26 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// upcoming run of the scheduled test workflow
+type TestWorkflowScheduledRun struct {
+	// name of the schedule
+	Schedule string `json:"schedule"`
+	// time resulting from the cron expression
+	ScheduledAt time.Time `json:"scheduledAt"`
+	// time when the execution will be started, including the jitter
+	RunAt time.Time `json:"runAt"`
+	// config values passed to the execution
+	Config map[string]string `json:"config,omitempty"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowServiceSpec struct {
+	// image to run
+	Image string `json:"image"`
+	// override the image entrypoint
+	Command []string `json:"command,omitempty"`
+	// override the image arguments
+	Args []string `json:"args,omitempty"`
+	// environment variables for the service
+	Env []EnvVar `json:"env,omitempty"`
+	// ports exposed by the service
+	Ports          []int32                `json:"ports,omitempty"`
+	ReadinessProbe *TestWorkflowProbe     `json:"readinessProbe,omitempty"`
+	Resources      *TestWorkflowResources `json:"resources,omitempty"`
+}
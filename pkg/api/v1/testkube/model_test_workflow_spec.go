@@ -16,7 +16,12 @@ type TestWorkflowSpec struct {
 	Container *TestWorkflowContainerConfig           `json:"container,omitempty"`
 	Job       *TestWorkflowJobConfig                 `json:"job,omitempty"`
 	Pod       *TestWorkflowPodConfig                 `json:"pod,omitempty"`
-	Setup     []TestWorkflowStep                     `json:"setup,omitempty"`
-	Steps     []TestWorkflowStep                     `json:"steps,omitempty"`
-	After     []TestWorkflowStep                     `json:"after,omitempty"`
+	Services  map[string]TestWorkflowServiceSpec     `json:"services,omitempty"`
+	// paths to restore before the steps, and save after they succeed
+	Cache []TestWorkflowCacheSpec `json:"cache,omitempty"`
+	Setup []TestWorkflowStep      `json:"setup,omitempty"`
+	Steps []TestWorkflowStep      `json:"steps,omitempty"`
+	After []TestWorkflowStep      `json:"after,omitempty"`
+	// cron schedules to execute the workflow automatically
+	Schedules []TestWorkflowSchedule `json:"schedules,omitempty"`
 }
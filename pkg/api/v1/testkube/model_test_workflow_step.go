@@ -25,17 +25,25 @@ type TestWorkflowStep struct {
 	// maximum time this step may take
 	Timeout string `json:"timeout,omitempty"`
 	// delay before the step
-	Delay   string               `json:"delay,omitempty"`
-	Content *TestWorkflowContent `json:"content,omitempty"`
+	Delay string `json:"delay,omitempty"`
+	// time between SIGTERM and SIGKILL when the step is aborted or skipped, inherited by the nested steps
+	GracePeriod string               `json:"gracePeriod,omitempty"`
+	Content     *TestWorkflowContent `json:"content,omitempty"`
 	// script to run in a default shell for the container
 	Shell      string                       `json:"shell,omitempty"`
 	Run        *TestWorkflowContainerConfig `json:"run,omitempty"`
 	WorkingDir *BoxedString                 `json:"workingDir,omitempty"`
 	Container  *TestWorkflowContainerConfig `json:"container,omitempty"`
 	Execute    *TestWorkflowStepExecute     `json:"execute,omitempty"`
+	Approval   *TestWorkflowStepApproval    `json:"approval,omitempty"`
 	Artifacts  *TestWorkflowStepArtifacts   `json:"artifacts,omitempty"`
+	// test reports to parse after running the step
+	Reports []TestWorkflowStepReport `json:"reports,omitempty"`
+	// paths to restore before the step, and save after it succeeds
+	Cache []TestWorkflowCacheSpec `json:"cache,omitempty"`
 	// nested setup steps to run
 	Setup []TestWorkflowStep `json:"setup,omitempty"`
 	// nested steps to run
-	Steps []TestWorkflowStep `json:"steps,omitempty"`
+	Steps    []TestWorkflowStep        `json:"steps,omitempty"`
+	Parallel *TestWorkflowStepParallel `json:"parallel,omitempty"`
 }
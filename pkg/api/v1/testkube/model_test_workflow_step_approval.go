@@ -0,0 +1,22 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// pause the test workflow until it is approved or rejected, before running the step
+type TestWorkflowStepApproval struct {
+	// message for the approvers
+	Message string `json:"message,omitempty"`
+	// maximum time to wait for the decision, there is no limit by default
+	Timeout string `json:"timeout,omitempty"`
+	// action taken after the timeout, defaults to \"reject\"
+	Default_ string `json:"default,omitempty"`
+	// values to collect from the approvers, available for the next steps as \"approval.<name>\"
+	Inputs []TestWorkflowApprovalInput `json:"inputs,omitempty"`
+}
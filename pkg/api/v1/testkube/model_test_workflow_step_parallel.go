@@ -0,0 +1,21 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowStepParallel struct {
+	// how many pods could be running at once, all by default
+	Parallelism int32 `json:"parallelism,omitempty"`
+	// number of shards to split each matrix combination into
+	Shards int32 `json:"shards,omitempty"`
+	// values to run the steps for, each combination is executed in a separate pod, and available as \"matrix.<name>\" in the expressions
+	Matrix map[string][]string `json:"matrix,omitempty"`
+	// steps to run in each pod
+	Steps []TestWorkflowStep `json:"steps,omitempty"`
+}
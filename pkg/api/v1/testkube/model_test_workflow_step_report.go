@@ -0,0 +1,17 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+type TestWorkflowStepReport struct {
+	// format of the test report
+	Format string `json:"format"`
+	// glob pattern for the report files, relative to the working directory
+	Path string `json:"path"`
+}
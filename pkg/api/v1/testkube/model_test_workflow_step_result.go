@@ -23,4 +23,7 @@ type TestWorkflowStepResult struct {
 	StartedAt time.Time `json:"startedAt,omitempty"`
 	// when the container was finished
 	FinishedAt time.Time `json:"finishedAt,omitempty"`
+	// values published by the step for the next steps
+	Outputs  map[string]string               `json:"outputs,omitempty"`
+	Parallel *TestWorkflowParallelStepResult `json:"parallel,omitempty"`
 }
@@ -1,5 +1,11 @@
 package testkube
 
+import (
+	"maps"
+
+	"github.com/kubeshop/testkube/internal/common"
+)
+
 func (r *TestWorkflowStepResult) Clone() *TestWorkflowStepResult {
 	if r == nil {
 		return nil
@@ -11,6 +17,8 @@ func (r *TestWorkflowStepResult) Clone() *TestWorkflowStepResult {
 		QueuedAt:     r.QueuedAt,
 		StartedAt:    r.StartedAt,
 		FinishedAt:   r.FinishedAt,
+		Outputs:      maps.Clone(r.Outputs),
+		Parallel:     r.Parallel.Clone(),
 	}
 }
 
@@ -33,4 +41,26 @@ func (r *TestWorkflowStepResult) Merge(next TestWorkflowStepResult) {
 	if !next.FinishedAt.IsZero() {
 		r.FinishedAt = next.FinishedAt
 	}
+	if len(next.Outputs) > 0 {
+		if r.Outputs == nil {
+			r.Outputs = make(map[string]string, len(next.Outputs))
+		}
+		maps.Copy(r.Outputs, next.Outputs)
+	}
+	if next.Parallel != nil {
+		parallel := r.Parallel.Clone()
+		if parallel == nil {
+			parallel = &TestWorkflowParallelStepResult{}
+		}
+		parallel.Merge(*next.Parallel)
+		r.Parallel = parallel
+	}
+
+	// Fail the parallel step when any of the spawned executions has failed
+	if r.Parallel.Failed() && r.Status != nil && *r.Status == PASSED_TestWorkflowStepStatus {
+		r.Status = common.Ptr(FAILED_TestWorkflowStepStatus)
+		if r.ErrorMessage == "" {
+			r.ErrorMessage = "parallel execution failed"
+		}
+	}
 }
@@ -0,0 +1,5 @@
+package testkube
+
+func (s TestWorkflowStepStatus) Finished() bool {
+	return s != "" && s != QUEUED_TestWorkflowStepStatus && s != RUNNING_TestWorkflowStepStatus
+}
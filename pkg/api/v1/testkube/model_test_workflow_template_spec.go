@@ -15,7 +15,10 @@ type TestWorkflowTemplateSpec struct {
 	Container *TestWorkflowContainerConfig           `json:"container,omitempty"`
 	Job       *TestWorkflowJobConfig                 `json:"job,omitempty"`
 	Pod       *TestWorkflowPodConfig                 `json:"pod,omitempty"`
-	Setup     []TestWorkflowIndependentStep          `json:"setup,omitempty"`
-	Steps     []TestWorkflowIndependentStep          `json:"steps,omitempty"`
-	After     []TestWorkflowIndependentStep          `json:"after,omitempty"`
+	Services  map[string]TestWorkflowServiceSpec     `json:"services,omitempty"`
+	// paths to restore before the steps, and save after they succeed
+	Cache []TestWorkflowCacheSpec       `json:"cache,omitempty"`
+	Setup []TestWorkflowIndependentStep `json:"setup,omitempty"`
+	Steps []TestWorkflowIndependentStep `json:"steps,omitempty"`
+	After []TestWorkflowIndependentStep `json:"after,omitempty"`
 }
@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// problem found in the test workflow definition
+type TestWorkflowValidationIssue struct {
+	// issue severity, errors will fail the test workflow execution
+	Severity string `json:"severity"`
+	// YAML path to the faulty property
+	Path string `json:"path"`
+	// issue description
+	Message string `json:"message"`
+}
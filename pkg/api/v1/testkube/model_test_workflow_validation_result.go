@@ -0,0 +1,18 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// result of the test workflow static validation
+type TestWorkflowValidationResult struct {
+	// are there no errors in the test workflow
+	Valid bool `json:"valid"`
+	// errors and warnings found in the test workflow
+	Issues []TestWorkflowValidationIssue `json:"issues"`
+}
@@ -0,0 +1,15 @@
+package testkube
+
+const (
+	TestWorkflowValidationSeverityError   = "error"
+	TestWorkflowValidationSeverityWarning = "warning"
+)
+
+func (r TestWorkflowValidationResult) Table() (header []string, output [][]string) {
+	header = []string{"Severity", "Path", "Message"}
+	for _, issue := range r.Issues {
+		output = append(output, []string{issue.Severity, issue.Path, issue.Message})
+	}
+
+	return
+}
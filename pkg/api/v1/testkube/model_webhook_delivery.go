@@ -0,0 +1,36 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// webhook delivery details
+type WebhookDelivery struct {
+	// unique delivery id
+	Id string `json:"id"`
+	// webhook name
+	WebhookName string `json:"webhookName"`
+	// id of the delivered event
+	EventId   string     `json:"eventId,omitempty"`
+	EventType *EventType `json:"eventType,omitempty"`
+	// target uri
+	Uri string `json:"uri,omitempty"`
+	// request body
+	Request string                 `json:"request,omitempty"`
+	Status  *WebhookDeliveryStatus `json:"status"`
+	// delivery attempts
+	Attempts []WebhookDeliveryAttempt `json:"attempts,omitempty"`
+	// delivery start time
+	StartTime time.Time `json:"startTime,omitempty"`
+	// delivery end time
+	EndTime time.Time `json:"endTime,omitempty"`
+}
@@ -0,0 +1,30 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// single webhook delivery attempt
+type WebhookDeliveryAttempt struct {
+	// attempt number
+	Number int32 `json:"number"`
+	// response status code
+	ResponseCode int32 `json:"responseCode,omitempty"`
+	// response body
+	Response string `json:"response,omitempty"`
+	// error message if any
+	Error_ string `json:"error,omitempty"`
+	// request latency in milliseconds
+	Latency int64 `json:"latency,omitempty"`
+	// attempt start time
+	StartTime time.Time `json:"startTime,omitempty"`
+}
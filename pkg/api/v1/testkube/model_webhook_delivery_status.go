@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// WebhookDeliveryStatus : webhook delivery status, \"dead\" when the event could not be delivered after all the attempts
+type WebhookDeliveryStatus string
+
+// List of WebhookDeliveryStatus
+const (
+	SUCCESS_WebhookDeliveryStatus  WebhookDeliveryStatus = "success"
+	RETRYING_WebhookDeliveryStatus WebhookDeliveryStatus = "retrying"
+	DEAD_WebhookDeliveryStatus     WebhookDeliveryStatus = "dead"
+)
@@ -0,0 +1,5 @@
+package testkube
+
+func WebhookDeliveryStatusPtr(status WebhookDeliveryStatus) *WebhookDeliveryStatus {
+	return &status
+}
@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// webhook delivery retry policy
+type WebhookRetryPolicy struct {
+	// maximum number of delivery attempts
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+	// initial backoff duration between attempts, doubled after each attempt
+	Backoff string `json:"backoff,omitempty"`
+	// maximum backoff duration between attempts
+	MaxBackoff string `json:"maxBackoff,omitempty"`
+}
@@ -0,0 +1,16 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// webhook client TLS configuration
+type WebhookTls struct {
+	// secret with client certificate for mTLS (tls.crt and tls.key keys) and/or custom CA certificate (ca.crt key)
+	SecretName string `json:"secretName"`
+}
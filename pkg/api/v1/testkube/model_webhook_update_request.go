@@ -26,5 +26,8 @@ type WebhookUpdateRequest struct {
 	// webhook headers (golang template supported)
 	Headers *map[string]string `json:"headers,omitempty"`
 	// webhook labels
-	Labels *map[string]string `json:"labels,omitempty"`
+	Labels           *map[string]string   `json:"labels,omitempty"`
+	RetryPolicy      **WebhookRetryPolicy `json:"retryPolicy,omitempty"`
+	SigningSecretRef **SecretRef          `json:"signingSecretRef,omitempty"`
+	Tls              **WebhookTls         `json:"tls,omitempty"`
 }
@@ -0,0 +1,282 @@
+// Package compare finds the differences between two executions, to detect regressions
+package compare
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	// DefaultThreshold is the default minimum relative duration increase to consider it a performance regression
+	DefaultThreshold = 0.2
+	// MinRegressionDelta is the minimum absolute duration increase to consider it a performance regression,
+	// so the noise in the very short steps is ignored
+	MinRegressionDelta = 500 * time.Millisecond
+	// MaxLogLines is the maximum number of the added and removed log lines in the comparison
+	MaxLogLines = 100
+	// MaxLogSize is the maximum size of the execution logs read for the comparison
+	MaxLogSize = 4 * 1024 * 1024
+
+	ChangeAdded     = "added"
+	ChangeRemoved   = "removed"
+	ChangeBroken    = "broken"
+	ChangeFixed     = "fixed"
+	ChangeChanged   = "changed"
+	ChangeUnchanged = "unchanged"
+
+	StatusPassed = "passed"
+	StatusFailed = "failed"
+)
+
+// Item is the status and duration of a single step or test case
+type Item struct {
+	Name       string
+	Status     string
+	DurationMs int64
+}
+
+// Snapshot is the execution data normalized for comparison
+type Snapshot struct {
+	Id         string
+	Status     string
+	DurationMs int64
+	// Steps in the order of execution
+	Steps []Item
+	Cases []Item
+	// Variables, Config, ImageRefs and Content map the name to the value
+	Variables map[string]string
+	Config    map[string]string
+	ImageRefs map[string]string
+	Content   map[string]string
+	Output    string
+	// OutputTruncated is set when the Output is only the beginning of the logs
+	OutputTruncated bool
+}
+
+// ReadLogs reads the execution logs for the comparison, up to the MaxLogSize,
+// and returns false when they are truncated
+func ReadLogs(reader io.Reader) (string, bool) {
+	logs, err := io.ReadAll(io.LimitReader(reader, MaxLogSize+1))
+	if err != nil || len(logs) <= MaxLogSize {
+		return string(logs), true
+	}
+	// Skip the partial line
+	logs = logs[:MaxLogSize]
+	if i := bytes.LastIndexByte(logs, '\n'); i >= 0 {
+		logs = logs[:i+1]
+	}
+	return string(logs), false
+}
+
+// Compare builds the comparison between the base and head executions
+func Compare(base, head Snapshot, threshold float64) testkube.ExecutionComparison {
+	result := testkube.ExecutionComparison{
+		BaseId:         base.Id,
+		HeadId:         head.Id,
+		BaseStatus:     base.Status,
+		HeadStatus:     head.Status,
+		BaseDurationMs: base.DurationMs,
+		HeadDurationMs: head.DurationMs,
+		Threshold:      threshold,
+		Regression:     isRegression(base.DurationMs, head.DurationMs, threshold),
+		Steps:          compareItems(base.Steps, head.Steps, threshold, true),
+		Cases:          compareItems(base.Cases, head.Cases, threshold, false),
+		Variables:      compareValues(base.Variables, head.Variables),
+		Config:         compareValues(base.Config, head.Config),
+		ImageRefs:      compareValues(base.ImageRefs, head.ImageRefs),
+		Content:        compareValues(base.Content, head.Content),
+		Logs:           compareLogs(base.Output, head.Output),
+	}
+	if result.Logs != nil && (base.OutputTruncated || head.OutputTruncated) {
+		result.Logs.Truncated = true
+	}
+	for _, entries := range [][]testkube.ExecutionComparisonEntry{result.Steps, result.Cases} {
+		for _, entry := range entries {
+			if isNewlyFailing(entry) {
+				result.NewlyFailing = append(result.NewlyFailing, entry.Name)
+			}
+			if entry.Regression {
+				result.PerformanceRegressions = append(result.PerformanceRegressions, entry.Name)
+			}
+		}
+	}
+	return result
+}
+
+// NormalizeStatus maps the status of any execution, step or test case to "passed" or "failed",
+// leaving other statuses intact
+func NormalizeStatus(status string) string {
+	switch status {
+	case "passed", "success":
+		return StatusPassed
+	case "failed", "error", "failure", "aborted", "timeout":
+		return StatusFailed
+	}
+	return status
+}
+
+func isRegression(baseMs, headMs int64, threshold float64) bool {
+	if baseMs <= 0 || headMs <= 0 {
+		return false
+	}
+	delta := headMs - baseMs
+	return delta >= MinRegressionDelta.Milliseconds() && float64(delta) > float64(baseMs)*threshold
+}
+
+func isNewlyFailing(entry testkube.ExecutionComparisonEntry) bool {
+	return NormalizeStatus(entry.HeadStatus) == StatusFailed && NormalizeStatus(entry.BaseStatus) != StatusFailed
+}
+
+func change(base, head *Item) string {
+	switch {
+	case base == nil:
+		return ChangeAdded
+	case head == nil:
+		return ChangeRemoved
+	}
+	baseStatus, headStatus := NormalizeStatus(base.Status), NormalizeStatus(head.Status)
+	switch {
+	case baseStatus == headStatus:
+		return ChangeUnchanged
+	case baseStatus == StatusPassed && headStatus == StatusFailed:
+		return ChangeBroken
+	case baseStatus == StatusFailed && headStatus == StatusPassed:
+		return ChangeFixed
+	}
+	return ChangeChanged
+}
+
+// compareItems matches the items by name. It keeps the unchanged items only when all is true.
+func compareItems(base, head []Item, threshold float64, all bool) []testkube.ExecutionComparisonEntry {
+	baseMap := make(map[string]*Item, len(base))
+	for i := range base {
+		baseMap[base[i].Name] = &base[i]
+	}
+	headMap := make(map[string]*Item, len(head))
+	for i := range head {
+		headMap[head[i].Name] = &head[i]
+	}
+
+	// Keep the head order, and put the removed items at the end
+	names := make([]string, 0, len(head)+len(base))
+	for i := range head {
+		names = append(names, head[i].Name)
+	}
+	for i := range base {
+		if _, ok := headMap[base[i].Name]; !ok {
+			names = append(names, base[i].Name)
+		}
+	}
+
+	result := make([]testkube.ExecutionComparisonEntry, 0)
+	for _, name := range names {
+		b, h := baseMap[name], headMap[name]
+		entry := testkube.ExecutionComparisonEntry{Name: name, Change: change(b, h)}
+		if b != nil {
+			entry.BaseStatus = b.Status
+			entry.BaseDurationMs = b.DurationMs
+		}
+		if h != nil {
+			entry.HeadStatus = h.Status
+			entry.HeadDurationMs = h.DurationMs
+		}
+		entry.Regression = isRegression(entry.BaseDurationMs, entry.HeadDurationMs, threshold)
+		if all || entry.Change != ChangeUnchanged || entry.Regression {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// compareValues returns only the values that are different
+func compareValues(base, head map[string]string) []testkube.ExecutionComparisonValue {
+	names := make([]string, 0, len(base)+len(head))
+	for name := range base {
+		names = append(names, name)
+	}
+	for name := range head {
+		if _, ok := base[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	result := make([]testkube.ExecutionComparisonValue, 0)
+	for _, name := range names {
+		b, inBase := base[name]
+		h, inHead := head[name]
+		switch {
+		case !inBase:
+			result = append(result, testkube.ExecutionComparisonValue{Name: name, Head: h, Change: ChangeAdded})
+		case !inHead:
+			result = append(result, testkube.ExecutionComparisonValue{Name: name, Base: b, Change: ChangeRemoved})
+		case b != h:
+			result = append(result, testkube.ExecutionComparisonValue{Name: name, Base: b, Head: h, Change: ChangeChanged})
+		}
+	}
+	return result
+}
+
+// normalizeLogLine strips the timestamp and whitespaces, so only the meaningful changes are detected
+func normalizeLogLine(line string) string {
+	line = strings.TrimSpace(line)
+	if first, rest, ok := strings.Cut(line, " "); ok {
+		if _, err := time.Parse(time.RFC3339Nano, first); err == nil {
+			line = strings.TrimSpace(rest)
+		}
+	}
+	return line
+}
+
+func logLines(output string) []string {
+	lines := strings.Split(output, "\n")
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line = normalizeLogLine(line); line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// subtractLines returns the lines from a, that are not in b, respecting the number of occurrences
+func subtractLines(a, b []string) []string {
+	counts := make(map[string]int, len(b))
+	for _, line := range b {
+		counts[line]++
+	}
+	result := make([]string, 0)
+	for _, line := range a {
+		if counts[line] > 0 {
+			counts[line]--
+			continue
+		}
+		result = append(result, line)
+	}
+	return result
+}
+
+func compareLogs(base, head string) *testkube.ExecutionComparisonLogs {
+	if base == "" && head == "" {
+		return nil
+	}
+	baseLines, headLines := logLines(base), logLines(head)
+	logs := &testkube.ExecutionComparisonLogs{
+		Added:   subtractLines(headLines, baseLines),
+		Removed: subtractLines(baseLines, headLines),
+	}
+	if len(logs.Added) > MaxLogLines {
+		logs.Added = logs.Added[:MaxLogLines]
+		logs.Truncated = true
+	}
+	if len(logs.Removed) > MaxLogLines {
+		logs.Removed = logs.Removed[:MaxLogLines]
+		logs.Truncated = true
+	}
+	return logs
+}
@@ -0,0 +1,172 @@
+package compare
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestCompare_Cases(t *testing.T) {
+	base := Snapshot{Cases: []Item{
+		{Name: "login", Status: "passed", DurationMs: 1000},
+		{Name: "logout", Status: "failed", DurationMs: 1000},
+		{Name: "search", Status: "passed", DurationMs: 1000},
+		{Name: "removed", Status: "passed"},
+	}}
+	head := Snapshot{Cases: []Item{
+		{Name: "login", Status: "failure", DurationMs: 1000},
+		{Name: "logout", Status: "success", DurationMs: 1000},
+		{Name: "search", Status: "passed", DurationMs: 1100},
+		{Name: "added", Status: "error"},
+	}}
+
+	result := Compare(base, head, DefaultThreshold)
+
+	assert.Equal(t, []testkube.ExecutionComparisonEntry{
+		{Name: "login", BaseStatus: "passed", HeadStatus: "failure", BaseDurationMs: 1000, HeadDurationMs: 1000, Change: ChangeBroken},
+		{Name: "logout", BaseStatus: "failed", HeadStatus: "success", BaseDurationMs: 1000, HeadDurationMs: 1000, Change: ChangeFixed},
+		{Name: "added", HeadStatus: "error", Change: ChangeAdded},
+		{Name: "removed", BaseStatus: "passed", Change: ChangeRemoved},
+	}, result.Cases)
+	assert.Equal(t, []string{"login", "added"}, result.NewlyFailing)
+	assert.Empty(t, result.PerformanceRegressions)
+}
+
+func TestCompare_StepsKeepUnchanged(t *testing.T) {
+	base := Snapshot{Steps: []Item{{Name: "build", Status: "passed"}}}
+	head := Snapshot{Steps: []Item{{Name: "build", Status: "passed"}}}
+
+	result := Compare(base, head, DefaultThreshold)
+
+	assert.Equal(t, []testkube.ExecutionComparisonEntry{
+		{Name: "build", BaseStatus: "passed", HeadStatus: "passed", Change: ChangeUnchanged},
+	}, result.Steps)
+	assert.Empty(t, result.Cases)
+}
+
+func TestCompare_PerformanceRegressions(t *testing.T) {
+	base := Snapshot{DurationMs: 10000, Steps: []Item{
+		{Name: "slower", Status: "passed", DurationMs: 2000},
+		{Name: "within threshold", Status: "passed", DurationMs: 10000},
+		{Name: "too short", Status: "passed", DurationMs: 100},
+	}}
+	head := Snapshot{DurationMs: 13000, Steps: []Item{
+		{Name: "slower", Status: "passed", DurationMs: 3000},
+		{Name: "within threshold", Status: "passed", DurationMs: 11000},
+		{Name: "too short", Status: "passed", DurationMs: 400},
+	}}
+
+	result := Compare(base, head, DefaultThreshold)
+
+	assert.True(t, result.Regression)
+	assert.Equal(t, []string{"slower"}, result.PerformanceRegressions)
+	assert.True(t, result.Steps[0].Regression)
+	assert.False(t, result.Steps[1].Regression)
+	assert.False(t, result.Steps[2].Regression)
+
+	result = Compare(base, head, 0.5)
+	assert.False(t, result.Regression)
+	assert.Empty(t, result.PerformanceRegressions)
+}
+
+func TestCompare_Values(t *testing.T) {
+	base := Snapshot{
+		Variables: map[string]string{"same": "1", "changed": "a", "removed": "x"},
+		ImageRefs: map[string]string{"node": "node:20"},
+	}
+	head := Snapshot{
+		Variables: map[string]string{"same": "1", "changed": "b", "added": "y"},
+		ImageRefs: map[string]string{"node": "node:21"},
+	}
+
+	result := Compare(base, head, DefaultThreshold)
+
+	assert.Equal(t, []testkube.ExecutionComparisonValue{
+		{Name: "added", Head: "y", Change: ChangeAdded},
+		{Name: "changed", Base: "a", Head: "b", Change: ChangeChanged},
+		{Name: "removed", Base: "x", Change: ChangeRemoved},
+	}, result.Variables)
+	assert.Equal(t, []testkube.ExecutionComparisonValue{
+		{Name: "node", Base: "node:20", Head: "node:21", Change: ChangeChanged},
+	}, result.ImageRefs)
+	assert.Empty(t, result.Config)
+}
+
+func TestCompare_Logs(t *testing.T) {
+	base := Snapshot{Output: "2024-01-10T10:00:00.123Z starting\n2024-01-10T10:00:01Z ok\nok\n"}
+	head := Snapshot{Output: "2024-01-11T08:00:00Z starting\n2024-01-11T08:00:01Z ok\n2024-01-11T08:00:02Z error: timeout\n"}
+
+	result := Compare(base, head, DefaultThreshold)
+
+	assert.Equal(t, &testkube.ExecutionComparisonLogs{
+		Added:   []string{"error: timeout"},
+		Removed: []string{"ok"},
+	}, result.Logs)
+	assert.Nil(t, Compare(Snapshot{}, Snapshot{}, DefaultThreshold).Logs)
+}
+
+func TestReadLogs(t *testing.T) {
+	logs, complete := ReadLogs(strings.NewReader("line 1\nline 2\n"))
+	assert.True(t, complete)
+	assert.Equal(t, "line 1\nline 2\n", logs)
+
+	line := strings.Repeat("x", 1023) + "\n"
+	logs, complete = ReadLogs(strings.NewReader(strings.Repeat(line, MaxLogSize/len(line)+2)))
+	assert.False(t, complete)
+	assert.Len(t, logs, MaxLogSize)
+
+	result := Compare(Snapshot{Output: "a"}, Snapshot{Output: "a", OutputTruncated: true}, DefaultThreshold)
+	assert.True(t, result.Logs.Truncated)
+}
+
+func TestFromTestWorkflowExecution(t *testing.T) {
+	passed, failed := testkube.PASSED_TestWorkflowStepStatus, testkube.FAILED_TestWorkflowStatus
+	started := time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)
+	execution := testkube.TestWorkflowExecution{
+		Id: "exec-1",
+		Signature: []testkube.TestWorkflowSignature{
+			{Ref: "r1", Name: "setup", Children: []testkube.TestWorkflowSignature{{Ref: "r2", Name: "run"}}},
+			{Ref: "r3", Name: "setup"},
+		},
+		Result: &testkube.TestWorkflowResult{
+			Status:     &failed,
+			DurationMs: 5000,
+			Steps: map[string]testkube.TestWorkflowStepResult{
+				"r2": {Status: &passed, StartedAt: started, FinishedAt: started.Add(2 * time.Second)},
+			},
+		},
+		ResolvedWorkflow: &testkube.TestWorkflow{Spec: &testkube.TestWorkflowSpec{
+			Container: &testkube.TestWorkflowContainerConfig{
+				Image: "node:20",
+				Env:   []testkube.EnvVar{{Name: "DEBUG", Value: "1"}},
+			},
+			Content: &testkube.TestWorkflowContent{
+				Git: &testkube.TestWorkflowContentGit{Uri: "https://github.com/kubeshop/testkube", Revision: "main"},
+			},
+		}},
+	}
+
+	snapshot := FromTestWorkflowExecution(execution, "logs", true)
+
+	assert.Equal(t, "failed", snapshot.Status)
+	assert.Equal(t, int64(5000), snapshot.DurationMs)
+	assert.Equal(t, []Item{
+		{Name: "setup"},
+		{Name: "setup / run", Status: "passed", DurationMs: 2000},
+		{Name: "setup #2"},
+	}, snapshot.Steps)
+	assert.Equal(t, map[string]string{"DEBUG": "1"}, snapshot.Variables)
+	assert.Equal(t, map[string]string{"node": "node:20"}, snapshot.ImageRefs)
+	assert.Equal(t, map[string]string{"git https://github.com/kubeshop/testkube revision": "main"}, snapshot.Content)
+	assert.Equal(t, "logs", snapshot.Output)
+}
+
+func TestImageName(t *testing.T) {
+	assert.Equal(t, "node", imageName("node:20"))
+	assert.Equal(t, "localhost:5000/app", imageName("localhost:5000/app"))
+	assert.Equal(t, "localhost:5000/app", imageName("localhost:5000/app:1.0@sha256:abc"))
+}
@@ -0,0 +1,277 @@
+package compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const secretValue = "<secret>"
+
+// FromExecution builds the snapshot of the test execution
+func FromExecution(execution testkube.Execution) Snapshot {
+	snapshot := Snapshot{
+		Id:         execution.Id,
+		DurationMs: int64(execution.DurationMs),
+		Variables:  variablesMap(execution.Variables),
+		Config: map[string]string{
+			"type":      execution.TestType,
+			"command":   strings.Join(execution.Command, " "),
+			"args":      strings.Join(execution.Args, " "),
+			"argsMode":  execution.ArgsMode,
+			"namespace": execution.ExecutionNamespace,
+		},
+		Content: contentMap(execution.Content, ""),
+	}
+	for name, value := range execution.Envs {
+		snapshot.Config["env."+name] = value
+	}
+	if execution.ExecutionResult != nil {
+		if execution.ExecutionResult.Status != nil {
+			snapshot.Status = string(*execution.ExecutionResult.Status)
+		}
+		snapshot.Output = execution.ExecutionResult.Output
+		// The steps reported by the executors are the test cases
+		for _, step := range execution.ExecutionResult.Steps {
+			snapshot.Cases = append(snapshot.Cases, Item{
+				Name:       step.Name,
+				Status:     step.Status,
+				DurationMs: parseDurationMs(step.Duration),
+			})
+		}
+	}
+	return snapshot
+}
+
+// FromTestSuiteExecution builds the snapshot of the test suite execution, treating the tests as the steps
+func FromTestSuiteExecution(execution testkube.TestSuiteExecution) Snapshot {
+	snapshot := Snapshot{
+		Id:         execution.Id,
+		DurationMs: int64(execution.DurationMs),
+		Variables:  variablesMap(execution.Variables),
+		Config:     map[string]string{},
+		Content:    map[string]string{},
+	}
+	if execution.Status != nil {
+		snapshot.Status = string(*execution.Status)
+	}
+	for name, value := range execution.Envs {
+		snapshot.Config["env."+name] = value
+	}
+
+	var executions []testkube.Execution
+	for _, batch := range execution.ExecuteStepResults {
+		for _, result := range batch.Execute {
+			if result.Execution != nil && result.Execution.Id != "" {
+				executions = append(executions, *result.Execution)
+			}
+		}
+	}
+	for _, result := range execution.StepResults {
+		if result.Execution != nil && result.Execution.Id != "" {
+			executions = append(executions, *result.Execution)
+		}
+	}
+
+	seen := make(map[string]int)
+	var output []string
+	for _, e := range executions {
+		test := FromExecution(e)
+		name := uniqueName(seen, common.GetOr(e.TestName, e.Name))
+		snapshot.Steps = append(snapshot.Steps, Item{Name: name, Status: test.Status, DurationMs: test.DurationMs})
+		for _, c := range test.Cases {
+			c.Name = name + " / " + c.Name
+			snapshot.Cases = append(snapshot.Cases, c)
+		}
+		for k, v := range contentMap(e.Content, name+".") {
+			snapshot.Content[k] = v
+		}
+		if test.Output != "" {
+			output = append(output, test.Output)
+		}
+	}
+	snapshot.Output = strings.Join(output, "\n")
+	return snapshot
+}
+
+// FromTestWorkflowExecution builds the snapshot of the TestWorkflow execution along with its logs.
+// The image references and Git revisions are taken from the specification, not resolved in the runtime.
+func FromTestWorkflowExecution(execution testkube.TestWorkflowExecution, logs string, logsComplete bool) Snapshot {
+	snapshot := Snapshot{
+		Id:              execution.Id,
+		Variables:       map[string]string{},
+		Config:          map[string]string{},
+		ImageRefs:       map[string]string{},
+		Content:         map[string]string{},
+		Output:          logs,
+		OutputTruncated: !logsComplete,
+	}
+	for name, value := range execution.Config {
+		snapshot.Config[name] = value
+	}
+	if execution.Result != nil {
+		if execution.Result.Status != nil {
+			snapshot.Status = string(*execution.Result.Status)
+		}
+		snapshot.DurationMs = int64(execution.Result.DurationMs)
+		collectWorkflowSteps(execution.Signature, execution.Result.Steps, "", make(map[string]int), &snapshot.Steps)
+	}
+	for _, report := range execution.Reports {
+		for _, c := range report.Cases {
+			name := c.Name
+			if c.Classname != "" {
+				name = c.Classname + "." + c.Name
+			}
+			snapshot.Cases = append(snapshot.Cases, Item{Name: name, Status: c.Status, DurationMs: c.DurationMs})
+		}
+	}
+
+	workflow := execution.ResolvedWorkflow
+	if workflow == nil {
+		workflow = execution.Workflow
+	}
+	if workflow != nil && workflow.Spec != nil {
+		if workflow.Spec.Container != nil {
+			for _, env := range workflow.Spec.Container.Env {
+				snapshot.Variables[env.Name] = envValue(env)
+			}
+		}
+		var spec interface{}
+		if v, err := json.Marshal(workflow.Spec); err == nil && json.Unmarshal(v, &spec) == nil {
+			images := make(map[string]map[string]struct{})
+			collectWorkflowResources(spec, images, snapshot.Content)
+			for name, refs := range images {
+				list := make([]string, 0, len(refs))
+				for ref := range refs {
+					list = append(list, ref)
+				}
+				sort.Strings(list)
+				snapshot.ImageRefs[name] = strings.Join(list, ", ")
+			}
+		}
+	}
+	return snapshot
+}
+
+func collectWorkflowSteps(signature []testkube.TestWorkflowSignature, results map[string]testkube.TestWorkflowStepResult,
+	prefix string, seen map[string]int, items *[]Item) {
+	for _, sig := range signature {
+		name := common.GetOr(sig.Name, sig.Category, sig.Ref)
+		if prefix != "" {
+			name = prefix + " / " + name
+		}
+		name = uniqueName(seen, name)
+		item := Item{Name: name}
+		if result, ok := results[sig.Ref]; ok {
+			if result.Status != nil {
+				item.Status = string(*result.Status)
+			}
+			if !result.StartedAt.IsZero() && !result.FinishedAt.IsZero() {
+				item.DurationMs = result.FinishedAt.Sub(result.StartedAt).Milliseconds()
+			}
+		}
+		*items = append(*items, item)
+		collectWorkflowSteps(sig.Children, results, name, seen, items)
+	}
+}
+
+// collectWorkflowResources finds all the image references and Git revisions used in the TestWorkflow specification
+func collectWorkflowResources(v interface{}, images map[string]map[string]struct{}, content map[string]string) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if image, ok := value["image"].(string); ok && image != "" {
+			name := imageName(image)
+			if images[name] == nil {
+				images[name] = make(map[string]struct{})
+			}
+			images[name][image] = struct{}{}
+		}
+		if git, ok := value["git"].(map[string]interface{}); ok {
+			if uri, ok := git["uri"].(string); ok && uri != "" {
+				revision, _ := git["revision"].(string)
+				content["git "+uri+" revision"] = revision
+			}
+		}
+		for _, item := range value {
+			collectWorkflowResources(item, images, content)
+		}
+	case []interface{}:
+		for _, item := range value {
+			collectWorkflowResources(item, images, content)
+		}
+	}
+}
+
+// imageName strips the tag and digest from the image reference
+func imageName(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
+
+func variablesMap(variables map[string]testkube.Variable) map[string]string {
+	result := make(map[string]string, len(variables))
+	for name, variable := range variables {
+		switch {
+		case variable.SecretRef != nil:
+			result[name] = fmt.Sprintf("secret %s/%s", variable.SecretRef.Name, variable.SecretRef.Key)
+		case variable.ConfigMapRef != nil:
+			result[name] = fmt.Sprintf("config map %s/%s", variable.ConfigMapRef.Name, variable.ConfigMapRef.Key)
+		case variable.IsSecret():
+			result[name] = secretValue
+		default:
+			result[name] = variable.Value
+		}
+	}
+	return result
+}
+
+func contentMap(content *testkube.TestContent, prefix string) map[string]string {
+	result := make(map[string]string)
+	if content == nil {
+		return result
+	}
+	result[prefix+"type"] = content.Type_
+	if content.Uri != "" {
+		result[prefix+"uri"] = content.Uri
+	}
+	if content.Repository != nil {
+		result[prefix+"repository"] = content.Repository.Uri
+		result[prefix+"branch"] = content.Repository.Branch
+		result[prefix+"commit"] = content.Repository.Commit
+		result[prefix+"path"] = content.Repository.Path
+	}
+	return result
+}
+
+func envValue(env testkube.EnvVar) string {
+	if env.ValueFrom != nil {
+		if v, err := json.Marshal(env.ValueFrom); err == nil {
+			return string(v)
+		}
+	}
+	return env.Value
+}
+
+func uniqueName(seen map[string]int, name string) string {
+	seen[name]++
+	if seen[name] > 1 {
+		return fmt.Sprintf("%s #%d", name, seen[name])
+	}
+	return name
+}
+
+func parseDurationMs(duration string) int64 {
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return 0
+	}
+	return d.Milliseconds()
+}
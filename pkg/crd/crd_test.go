@@ -73,6 +73,28 @@ func TestGenerateYAML(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, expected, result)
 	})
+	t.Run("generate webhook CRD yaml with signing secret and TLS", func(t *testing.T) {
+		// given
+		expected := "apiVersion: executor.testkube.io/v1\nkind: Webhook\nmetadata:\n  name: name1\n  namespace: namespace1\nspec:\n  events:\n  - start-test\n  uri: http://localhost\n  signingSecretRef:\n    name: webhook-secret\n    key: hmac\n  tls:\n    secretName: webhook-tls\n"
+		webhooks := []testkube.Webhook{
+			{
+				Name:             "name1",
+				Namespace:        "namespace1",
+				Uri:              "http://localhost",
+				Events:           []testkube.EventType{*testkube.EventStartTest},
+				SigningSecretRef: &testkube.SecretRef{Name: "webhook-secret", Key: "hmac"},
+				Tls:              &testkube.WebhookTls{SecretName: "webhook-tls"},
+			},
+		}
+
+		// when
+		result, err := GenerateYAML[testkube.Webhook](TemplateWebhook, webhooks)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
 	t.Run("generate executor CRD yaml", func(t *testing.T) {
 		// given
 		expected := "apiVersion: executor.testkube.io/v1\nkind: Executor\nmetadata:\n  name: name1\n  namespace: namespace1\n  labels:\n    key1: value1\nspec:\n  types:\n  - custom-curl-container/test\n  executor_type: container\n  image: docker.io/curlimages/curl:latest\n  args:\n  - -v\n  - test\n  command:\n  - curl\n  imagePullSecrets:\n  - name: secret-name\n  features:\n  - artifacts\n  content_types:\n  - git-file\n  - git-dir\n  meta:\n    iconURI: http://mydomain.com/icon.jpg\n    docsURI: http://mydomain.com/docs\n    tooltips:\n      name: please enter executor name\n  useDataDirAsWorkingDir: true\n"
@@ -22,6 +22,7 @@ const (
 	SubscriptionName       = "events"
 	InternalPublishTopic   = "internal.all"
 	InternalSubscribeTopic = "internal.>"
+	WebhookDeadLetterTopic = "deadletter.webhooks"
 )
 
 type ConnectionConfig struct {
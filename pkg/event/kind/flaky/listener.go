@@ -0,0 +1,136 @@
+package flaky
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/flaky"
+	"github.com/kubeshop/testkube/pkg/log"
+)
+
+const (
+	ListenerKind = "flaky"
+
+	historyTimeout = 30 * time.Second
+)
+
+var _ common.Listener = (*FlakyListener)(nil)
+
+// HistoryFunc returns the latest runs of the test or TestWorkflow, ordered from the latest one
+type HistoryFunc func(ctx context.Context, name string, limit int) ([]flaky.Run, error)
+
+// Notifier emits the events
+type Notifier interface {
+	Notify(event testkube.Event)
+}
+
+func NewTestListener(notifier Notifier, history HistoryFunc) *FlakyListener {
+	return &FlakyListener{
+		name:      "flaky-tests",
+		Log:       log.DefaultLogger,
+		events:    []testkube.EventType{testkube.END_TEST_SUCCESS_EventType, testkube.END_TEST_FAILED_EventType},
+		notifier:  notifier,
+		history:   history,
+		limit:     flaky.DefaultLimit,
+		threshold: flaky.DefaultThreshold,
+	}
+}
+
+func NewTestWorkflowListener(notifier Notifier, history HistoryFunc) *FlakyListener {
+	return &FlakyListener{
+		name:      "flaky-testworkflows",
+		Log:       log.DefaultLogger,
+		events:    []testkube.EventType{testkube.END_TESTWORKFLOW_SUCCESS_EventType, testkube.END_TESTWORKFLOW_FAILED_EventType},
+		notifier:  notifier,
+		history:   history,
+		limit:     flaky.DefaultLimit,
+		threshold: flaky.DefaultThreshold,
+	}
+}
+
+// FlakyListener analyzes the finished executions and emits an event when some test cases became flaky
+type FlakyListener struct {
+	name      string
+	Log       *zap.SugaredLogger
+	events    []testkube.EventType
+	notifier  Notifier
+	history   HistoryFunc
+	limit     int
+	threshold float64
+}
+
+func (l *FlakyListener) Name() string {
+	return l.name
+}
+
+func (l *FlakyListener) Selector() string {
+	return ""
+}
+
+func (l *FlakyListener) Events() []testkube.EventType {
+	return l.events
+}
+
+func (l *FlakyListener) Metadata() map[string]string {
+	return map[string]string{
+		"name":      l.Name(),
+		"events":    fmt.Sprintf("%v", l.Events()),
+		"limit":     fmt.Sprintf("%d", l.limit),
+		"threshold": fmt.Sprintf("%g", l.threshold),
+	}
+}
+
+func (l *FlakyListener) Kind() string {
+	return ListenerKind
+}
+
+func (l *FlakyListener) Notify(event testkube.Event) (result testkube.EventResult) {
+	var name string
+	var current flaky.Run
+	var ok bool
+	if event.TestExecution != nil {
+		name = event.TestExecution.TestName
+		current, ok = flaky.RunFromExecution(*event.TestExecution)
+	} else if event.TestWorkflowExecution != nil && event.TestWorkflowExecution.Workflow != nil {
+		name = event.TestWorkflowExecution.Workflow.Name
+		current, ok = flaky.RunFromTestWorkflowExecution(*event.TestWorkflowExecution)
+	}
+	if !ok {
+		return testkube.NewSuccessEventResult(event.Id, "no test cases to analyze")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), historyTimeout)
+	defer cancel()
+	history, err := l.history(ctx, name, l.limit)
+	if err != nil {
+		l.Log.Errorw("flaky: failed to get the execution history", "name", name, "error", err)
+		return testkube.NewFailedEventResult(event.Id, err)
+	}
+
+	runs := []flaky.Run{current}
+	for _, run := range history {
+		if len(runs) >= l.limit {
+			break
+		}
+		if run.ExecutionId != current.ExecutionId {
+			runs = append(runs, run)
+		}
+	}
+
+	cases := flaky.NewlyFlaky(runs, l.threshold)
+	if len(cases) == 0 {
+		return testkube.NewSuccessEventResult(event.Id, "no newly flaky test cases")
+	}
+
+	if event.TestExecution != nil {
+		l.notifier.Notify(testkube.NewEventFlakyTest(event.TestExecution, cases))
+	} else {
+		l.notifier.Notify(testkube.NewEventFlakyTestWorkflow(event.TestWorkflowExecution, cases))
+	}
+	return testkube.NewSuccessEventResult(event.Id, fmt.Sprintf("found %d newly flaky test cases", len(cases)))
+}
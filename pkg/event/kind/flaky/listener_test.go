@@ -0,0 +1,67 @@
+package flaky
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/flaky"
+)
+
+type notifierMock struct {
+	events []testkube.Event
+}
+
+func (n *notifierMock) Notify(event testkube.Event) {
+	n.events = append(n.events, event)
+}
+
+func execution(id string, statuses map[string]string) testkube.Execution {
+	result := testkube.ExecutionResult{Status: testkube.StatusPtr(testkube.PASSED_ExecutionStatus)}
+	for name, status := range statuses {
+		result.Steps = append(result.Steps, testkube.ExecutionStepResult{Name: name, Status: status})
+	}
+	return testkube.Execution{Id: id, TestName: "test-1", ExecutionResult: &result}
+}
+
+func TestFlakyListener_Notify(t *testing.T) {
+	t.Run("emits event for newly flaky test cases", func(t *testing.T) {
+		notifier := &notifierMock{}
+		history := func(ctx context.Context, name string, limit int) ([]flaky.Run, error) {
+			assert.Equal(t, "test-1", name)
+			return flaky.RunsFromExecutions([]testkube.Execution{
+				execution("3", map[string]string{"a": "failed", "b": "passed"}),
+				execution("2", map[string]string{"a": "passed", "b": "passed"}),
+				execution("1", map[string]string{"a": "passed", "b": "passed"}),
+			}), nil
+		}
+		listener := NewTestListener(notifier, history)
+		current := execution("3", map[string]string{"a": "failed", "b": "passed"})
+
+		result := listener.Notify(testkube.NewEventEndTestFailed(&current))
+
+		assert.Empty(t, result.Error_)
+		assert.Len(t, notifier.events, 1)
+		assert.Equal(t, testkube.EventFlakyTest, notifier.events[0].Type_)
+		assert.Len(t, notifier.events[0].FlakyTestCases, 1)
+		assert.Equal(t, "a", notifier.events[0].FlakyTestCases[0].Name)
+	})
+
+	t.Run("skips stable test cases", func(t *testing.T) {
+		notifier := &notifierMock{}
+		history := func(ctx context.Context, name string, limit int) ([]flaky.Run, error) {
+			return flaky.RunsFromExecutions([]testkube.Execution{
+				execution("1", map[string]string{"a": "passed"}),
+			}), nil
+		}
+		listener := NewTestListener(notifier, history)
+		current := execution("2", map[string]string{"a": "passed"})
+
+		result := listener.Notify(testkube.NewEventEndTestSuccess(&current))
+
+		assert.Empty(t, result.Error_)
+		assert.Empty(t, notifier.events)
+	})
+}
@@ -0,0 +1,37 @@
+package flaky
+
+import (
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+)
+
+var _ common.ListenerLoader = (*FlakyLoader)(nil)
+
+func NewFlakyLoader(notifier Notifier, testHistory, testWorkflowHistory HistoryFunc) *FlakyLoader {
+	return &FlakyLoader{
+		notifier:            notifier,
+		testHistory:         testHistory,
+		testWorkflowHistory: testWorkflowHistory,
+	}
+}
+
+// FlakyLoader returns listeners detecting newly flaky test cases for tests and TestWorkflows
+type FlakyLoader struct {
+	notifier            Notifier
+	testHistory         HistoryFunc
+	testWorkflowHistory HistoryFunc
+}
+
+func (r *FlakyLoader) Kind() string {
+	return ListenerKind
+}
+
+// Load returns listeners for the tests and TestWorkflows with available history
+func (r *FlakyLoader) Load() (listeners common.Listeners, err error) {
+	if r.testHistory != nil {
+		listeners = append(listeners, NewTestListener(r.notifier, r.testHistory))
+	}
+	if r.testWorkflowHistory != nil {
+		listeners = append(listeners, NewTestWorkflowListener(r.notifier, r.testWorkflowHistory))
+	}
+	return listeners, nil
+}
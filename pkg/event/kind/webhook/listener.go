@@ -2,28 +2,115 @@ package webhook
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"text/template"
+	"time"
 
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/zap"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/event/kind/common"
 	thttp "github.com/kubeshop/testkube/pkg/http"
 	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/redact"
+	"github.com/kubeshop/testkube/pkg/repository/webhookdelivery"
 	"github.com/kubeshop/testkube/pkg/utils"
 	"github.com/kubeshop/testkube/pkg/utils/text"
 )
 
 var _ common.Listener = (*WebhookListener)(nil)
 
+const (
+	DefaultMaxAttempts = 1
+	DefaultBackoff     = time.Second
+	DefaultMaxBackoff  = time.Minute
+)
+
+// RetryPolicy defines how failed webhook deliveries are retried
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of delivery attempts
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled after each next attempt
+	Backoff time.Duration
+	// MaxBackoff is the maximum delay between attempts
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns the policy of single delivery attempt
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultMaxAttempts,
+		Backoff:     DefaultBackoff,
+		MaxBackoff:  DefaultMaxBackoff,
+	}
+}
+
+// Delay returns the backoff before the next attempt after the provided attempt number
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+type Option func(*WebhookListener)
+
+// WithRetryPolicy configures retries for failed deliveries
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(l *WebhookListener) {
+		l.retryPolicy = policy
+	}
+}
+
+// WithDeliveryRepository configures persisting the delivery history
+func WithDeliveryRepository(repository webhookdelivery.Repository) Option {
+	return func(l *WebhookListener) {
+		l.deliveryRepository = repository
+	}
+}
+
+// WithSigningSecret configures signing the payloads with HMAC-SHA256
+func WithSigningSecret(secret []byte) Option {
+	return func(l *WebhookListener) {
+		l.signingSecret = secret
+	}
+}
+
+// WithSecretResolver configures masking the secrets used by the execution in the payloads
+func WithSecretResolver(resolver *redact.Resolver) Option {
+	return func(l *WebhookListener) {
+		l.secretResolver = resolver
+	}
+}
+
+// WithRetrier configures the retrier used for the next delivery attempts, shared between the listeners
+func WithRetrier(retrier *Retrier) Option {
+	return func(l *WebhookListener) {
+		l.retrier = retrier
+	}
+}
+
+// WithTLSConfig configures the client certificate and CA used for the HTTP client
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(l *WebhookListener) {
+		l.HttpClient = thttp.NewTLSClient(tlsConfig)
+	}
+}
+
 func NewWebhookListener(name, uri, selector string, events []testkube.EventType,
-	payloadObjectField, payloadTemplate string, headers map[string]string) *WebhookListener {
-	return &WebhookListener{
+	payloadObjectField, payloadTemplate string, headers map[string]string, opts ...Option) *WebhookListener {
+	l := &WebhookListener{
 		name:               name,
 		Uri:                uri,
 		Log:                log.DefaultLogger,
@@ -33,7 +120,18 @@ func NewWebhookListener(name, uri, selector string, events []testkube.EventType,
 		payloadObjectField: payloadObjectField,
 		payloadTemplate:    payloadTemplate,
 		headers:            headers,
+		retryPolicy:        DefaultRetryPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	if l.retrier == nil {
+		l.retrier = NewRetrier(l.Log, nil, nil)
 	}
+
+	return l
 }
 
 type WebhookListener struct {
@@ -46,6 +144,11 @@ type WebhookListener struct {
 	payloadObjectField string
 	payloadTemplate    string
 	headers            map[string]string
+	retryPolicy        RetryPolicy
+	deliveryRepository webhookdelivery.Repository
+	signingSecret      []byte
+	secretResolver     *redact.Resolver
+	retrier            *Retrier
 }
 
 func (l *WebhookListener) Name() string {
@@ -68,6 +171,10 @@ func (l *WebhookListener) Metadata() map[string]string {
 		"payloadObjectField": l.payloadObjectField,
 		"payloadTemplate":    l.payloadTemplate,
 		"headers":            fmt.Sprintf("%v", l.headers),
+		"maxAttempts":        fmt.Sprintf("%d", l.retryPolicy.MaxAttempts),
+		"backoff":            l.retryPolicy.Backoff.String(),
+		"maxBackoff":         l.retryPolicy.MaxBackoff.String(),
+		"signed":             fmt.Sprintf("%t", len(l.signingSecret) != 0),
 	}
 }
 
@@ -111,55 +218,211 @@ func (l *WebhookListener) Notify(event testkube.Event) (result testkube.EventRes
 		return testkube.NewFailedEventResult(event.Id, err)
 	}
 
-	data, err := l.processTemplate("uri", l.Uri, event)
+	uri, headers, err := l.request(event)
 	if err != nil {
 		return testkube.NewFailedEventResult(event.Id, err)
 	}
 
-	request, err := http.NewRequest(http.MethodPost, string(data), body)
+	payload := l.redact(event, body.Bytes())
+	delivery := testkube.WebhookDelivery{
+		Id:          primitive.NewObjectID().Hex(),
+		WebhookName: l.name,
+		EventId:     event.Id,
+		EventType:   event.Type_,
+		Uri:         uri,
+		Request:     string(payload),
+		StartTime:   time.Now(),
+	}
+
+	// Make the first attempt right away, so the result is reported to the emitter
+	result, attempt, retriable := l.send(event, uri, headers, payload)
+	attempt.Number = 1
+	delivery.Attempts = append(delivery.Attempts, attempt)
+
+	switch {
+	case result.Error() == "":
+		delivery.Status = testkube.WebhookDeliveryStatusPtr(testkube.SUCCESS_WebhookDeliveryStatus)
+	case retriable && l.retryPolicy.MaxAttempts > 1:
+		// Retry in the background, so the backoff is not blocking delivery of the next events
+		delivery.Status = testkube.WebhookDeliveryStatusPtr(testkube.RETRYING_WebhookDeliveryStatus)
+		l.insertDelivery(delivery)
+		attemptTime := time.Now().Add(l.retryPolicy.Delay(1))
+		l.retrier.Go(func(ctx context.Context) {
+			l.retry(ctx, event, delivery, uri, headers, payload, 2, attemptTime)
+		})
+		return result
+	default:
+		delivery.Status = testkube.WebhookDeliveryStatusPtr(testkube.DEAD_WebhookDeliveryStatus)
+		log.Warnw("webhook delivery failed, moved to dead letters", "webhook", l.name)
+		l.retrier.DeadLetter(l.name, event)
+	}
+
+	delivery.EndTime = time.Now()
+	l.insertDelivery(delivery)
+	return result
+}
+
+// request builds the uri and the headers of the request from the templates
+func (l *WebhookListener) request(event testkube.Event) (uri string, headers http.Header, err error) {
+	data, err := l.processTemplate("uri", l.Uri, event)
 	if err != nil {
-		log.Errorw("webhook request creating error", "error", err)
-		return testkube.NewFailedEventResult(event.Id, err)
+		return "", nil, err
 	}
 
-	request.Header.Set("Content-Type", "application/json")
+	uri = string(data)
+	headers = make(http.Header)
+	headers.Set("Content-Type", "application/json")
 	for key, value := range l.headers {
 		values := []*string{&key, &value}
 		for i := range values {
 			data, err = l.processTemplate("header", *values[i], event)
 			if err != nil {
-				return testkube.NewFailedEventResult(event.Id, err)
+				return "", nil, err
 			}
 
 			*values[i] = string(data)
 		}
 
-		request.Header.Set(key, value)
+		headers.Set(key, value)
+	}
+
+	return uri, headers, nil
+}
+
+// retry makes the next delivery attempts with backoff, starting from the provided attempt,
+// until succeeded or the retry policy is exhausted. The pending attempt is persisted,
+// so it is resumed when the retry is interrupted by the shutdown.
+func (l *WebhookListener) retry(ctx context.Context, event testkube.Event, delivery testkube.WebhookDelivery,
+	uri string, headers http.Header, payload []byte, number int, attemptTime time.Time) {
+	log := l.Log.With(event.Log()...)
+	maxAttempts := l.retryPolicy.MaxAttempts
+
+	for ; number <= maxAttempts; number++ {
+		l.retrier.SaveRetry(webhookdelivery.Retry{
+			DeliveryId:  delivery.Id,
+			WebhookName: l.name,
+			Event:       event,
+			Attempt:     number,
+			AttemptTime: attemptTime,
+		})
+
+		delay := time.Until(attemptTime)
+		log.Warnw("webhook send failed, retrying", "attempt", number, "maxAttempts", maxAttempts, "delay", delay.String())
+		if !l.retrier.sleep(ctx, delay) {
+			log.Infow("webhook retry interrupted, will be resumed", "webhook", l.name, "attempt", number)
+			return
+		}
+
+		result, attempt, retriable := l.send(event, uri, headers, payload)
+		attempt.Number = int32(number)
+		delivery.Attempts = append(delivery.Attempts, attempt)
+		if result.Error() == "" {
+			delivery.Status = testkube.WebhookDeliveryStatusPtr(testkube.SUCCESS_WebhookDeliveryStatus)
+			break
+		}
+		if !retriable || number == maxAttempts {
+			delivery.Status = testkube.WebhookDeliveryStatusPtr(testkube.DEAD_WebhookDeliveryStatus)
+			log.Warnw("webhook delivery failed, moved to dead letters", "webhook", l.name, "attempts", number)
+			break
+		}
+		l.updateDelivery(delivery)
+		attemptTime = time.Now().Add(l.retryPolicy.Delay(number))
+	}
+
+	// The resumed retry may be already exhausted, when the retry policy has changed
+	if delivery.Status == nil || *delivery.Status == testkube.RETRYING_WebhookDeliveryStatus {
+		delivery.Status = testkube.WebhookDeliveryStatusPtr(testkube.DEAD_WebhookDeliveryStatus)
+	}
+
+	delivery.EndTime = time.Now()
+	l.updateDelivery(delivery)
+	l.retrier.DeleteRetry(delivery.Id)
+	if *delivery.Status == testkube.DEAD_WebhookDeliveryStatus {
+		l.retrier.DeadLetter(l.name, event)
+	}
+}
+
+// send makes a single delivery attempt, returning whether a failure should be retried
+func (l *WebhookListener) send(event testkube.Event, uri string, headers http.Header, payload []byte) (
+	result testkube.EventResult, attempt testkube.WebhookDeliveryAttempt, retriable bool) {
+	log := l.Log.With(event.Log()...)
+	attempt.StartTime = time.Now()
+	defer func() {
+		attempt.Latency = time.Since(attempt.StartTime).Milliseconds()
+		attempt.Error_ = result.Error()
+	}()
+
+	request, err := http.NewRequest(http.MethodPost, uri, bytes.NewBuffer(payload))
+	if err != nil {
+		log.Errorw("webhook request creating error", "error", err)
+		return testkube.NewFailedEventResult(event.Id, err), attempt, false
+	}
+
+	request.Header = headers.Clone()
+	if len(l.signingSecret) != 0 {
+		thttp.SignRequest(request, l.signingSecret, time.Now(), payload)
 	}
 
 	resp, err := l.HttpClient.Do(request)
 	if err != nil {
 		log.Errorw("webhook send error", "error", err)
-		return testkube.NewFailedEventResult(event.Id, err)
+		return testkube.NewFailedEventResult(event.Id, err), attempt, true
 	}
 	defer resp.Body.Close()
 
-	data, err = io.ReadAll(resp.Body)
+	attempt.ResponseCode = int32(resp.StatusCode)
+	data, err := io.ReadAll(resp.Body)
 	if err != nil {
 		log.Errorw("webhook read response error", "error", err)
-		return testkube.NewFailedEventResult(event.Id, err)
+		return testkube.NewFailedEventResult(event.Id, err), attempt, true
 	}
 
 	responseStr := string(data)
+	attempt.Response = responseStr
 
 	if resp.StatusCode >= 400 {
 		err := fmt.Errorf("webhook response with bad status code: %d", resp.StatusCode)
 		log.Errorw("webhook send error", "error", err, "status", resp.StatusCode)
-		return testkube.NewFailedEventResult(event.Id, err).WithResult(responseStr)
+		retriable = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return testkube.NewFailedEventResult(event.Id, err).WithResult(responseStr), attempt, retriable
 	}
 
 	log.Debugw("got webhook send result", "response", responseStr)
-	return testkube.NewSuccessEventResult(event.Id, responseStr)
+	return testkube.NewSuccessEventResult(event.Id, responseStr), attempt, false
+}
+
+// redact masks the secrets used by the execution, so they are not sent nor stored in the delivery history
+func (l *WebhookListener) redact(event testkube.Event, payload []byte) []byte {
+	if l.secretResolver == nil {
+		return payload
+	}
+
+	redactor, err := l.secretResolver.Event(context.Background(), event)
+	if err != nil {
+		// Redact at least the secrets that have been found
+		l.Log.Warnw("webhook failed to resolve all the secrets to redact", "webhook", l.name, "error", err)
+	}
+	return redactor.Bytes(payload)
+}
+
+func (l *WebhookListener) insertDelivery(delivery testkube.WebhookDelivery) {
+	if l.deliveryRepository == nil {
+		return
+	}
+
+	if err := l.deliveryRepository.Insert(context.Background(), delivery); err != nil {
+		l.Log.Errorw("webhook delivery saving error", "webhook", l.name, "error", err)
+	}
+}
+
+func (l *WebhookListener) updateDelivery(delivery testkube.WebhookDelivery) {
+	if l.deliveryRepository == nil {
+		return
+	}
+
+	if err := l.deliveryRepository.Update(context.Background(), delivery); err != nil {
+		l.Log.Errorw("webhook delivery updating error", "webhook", l.name, "error", err)
+	}
 }
 
 func (l *WebhookListener) Kind() string {
@@ -2,15 +2,26 @@ package webhook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/bus"
+	thttp "github.com/kubeshop/testkube/pkg/http"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/redact"
+	"github.com/kubeshop/testkube/pkg/repository/webhookdelivery"
 )
 
 const executionID = "id-1"
@@ -145,8 +156,373 @@ func TestWebhookListener_Notify(t *testing.T) {
 	})
 }
 
+func TestWebhookListener_NotifyRetry(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Minute}
+	noSleep := func(l *WebhookListener) {
+		if l.retrier == nil {
+			l.retrier = NewRetrier(l.Log, nil, nil)
+		}
+		l.retrier.sleep = func(context.Context, time.Duration) bool { return true }
+	}
+
+	t.Run("retry server errors until success", func(t *testing.T) {
+		t.Parallel()
+		// given
+		var calls int32
+		testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		})
+
+		svr := httptest.NewServer(testHandler)
+		defer svr.Close()
+
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		mockRepository := webhookdelivery.NewMockRepository(mockCtrl)
+		mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, delivery testkube.WebhookDelivery) error {
+			assert.Equal(t, "l1", delivery.WebhookName)
+			assert.Equal(t, testkube.RETRYING_WebhookDeliveryStatus, *delivery.Status)
+			assert.Len(t, delivery.Attempts, 1)
+			return nil
+		})
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, delivery testkube.WebhookDelivery) error {
+			assert.Equal(t, testkube.SUCCESS_WebhookDeliveryStatus, *delivery.Status)
+			assert.Len(t, delivery.Attempts, 3)
+			assert.Equal(t, int32(http.StatusServiceUnavailable), delivery.Attempts[0].ResponseCode)
+			assert.Equal(t, int32(http.StatusOK), delivery.Attempts[2].ResponseCode)
+			return nil
+		})
+
+		l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil,
+			WithRetryPolicy(policy), WithDeliveryRepository(mockRepository), noSleep)
+
+		// when
+		r := l.Notify(testkube.Event{
+			Type_:         testkube.EventStartTest,
+			TestExecution: exampleExecution(),
+		})
+		l.retrier.Wait()
+
+		// then
+		assert.NotEqual(t, "", r.Error())
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("do not block on retries", func(t *testing.T) {
+		t.Parallel()
+		// given
+		testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		svr := httptest.NewServer(testHandler)
+		defer svr.Close()
+
+		release := make(chan struct{})
+		l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil, WithRetryPolicy(policy), func(l *WebhookListener) {
+			l.retrier = NewRetrier(l.Log, nil, nil)
+			l.retrier.sleep = func(context.Context, time.Duration) bool {
+				<-release
+				return true
+			}
+		})
+
+		// when
+		done := make(chan struct{})
+		go func() {
+			l.Notify(testkube.Event{Type_: testkube.EventStartTest, TestExecution: exampleExecution()})
+			close(done)
+		}()
+
+		// then
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("notify blocked by the retry backoff")
+		}
+		close(release)
+		l.retrier.Wait()
+	})
+
+	t.Run("do not retry client errors", func(t *testing.T) {
+		t.Parallel()
+		// given
+		var calls int32
+		testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		})
+
+		svr := httptest.NewServer(testHandler)
+		defer svr.Close()
+
+		l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil, WithRetryPolicy(policy), noSleep)
+
+		// when
+		r := l.Notify(testkube.Event{
+			Type_:         testkube.EventStartTest,
+			TestExecution: exampleExecution(),
+		})
+
+		// then
+		assert.NotEqual(t, "", r.Error())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("persist dead letter when all attempts failed", func(t *testing.T) {
+		t.Parallel()
+		// given
+		var calls int32
+		testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		})
+
+		svr := httptest.NewServer(testHandler)
+		defer svr.Close()
+
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		mockRepository := webhookdelivery.NewMockRepository(mockCtrl)
+		mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, delivery testkube.WebhookDelivery) error {
+			assert.Equal(t, "12345", delivery.EventId)
+			assert.Equal(t, testkube.DEAD_WebhookDeliveryStatus, *delivery.Status)
+			assert.Len(t, delivery.Attempts, 3)
+			assert.False(t, delivery.EndTime.IsZero())
+			return nil
+		})
+
+		l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil,
+			WithRetryPolicy(policy), WithDeliveryRepository(mockRepository), noSleep)
+
+		// when
+		r := l.Notify(testkube.Event{
+			Id:            "12345",
+			Type_:         testkube.EventStartTest,
+			TestExecution: exampleExecution(),
+		})
+		l.retrier.Wait()
+
+		// then
+		assert.NotEqual(t, "", r.Error())
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("persist pending retries until finished", func(t *testing.T) {
+		t.Parallel()
+		// given
+		testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		})
+
+		svr := httptest.NewServer(testHandler)
+		defer svr.Close()
+
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		var attempts []int
+		mockRepository := webhookdelivery.NewMockRepository(mockCtrl)
+		mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockRepository.EXPECT().UpsertRetry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, retry webhookdelivery.Retry) error {
+			assert.Equal(t, "l1", retry.WebhookName)
+			assert.Equal(t, "12345", retry.Event.Id)
+			assert.False(t, retry.AttemptTime.IsZero())
+			attempts = append(attempts, retry.Attempt)
+			return nil
+		}).Times(2)
+		mockRepository.EXPECT().DeleteRetry(gomock.Any(), gomock.Any()).Return(nil)
+
+		eventBus := newDeadLetterBus()
+		l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil, WithRetryPolicy(policy),
+			WithDeliveryRepository(mockRepository), WithRetrier(NewRetrier(log.DefaultLogger, mockRepository, eventBus)), noSleep)
+
+		// when
+		l.Notify(testkube.Event{
+			Id:            "12345",
+			Type_:         testkube.EventStartTest,
+			TestExecution: exampleExecution(),
+		})
+		l.retrier.Wait()
+
+		// then
+		assert.Equal(t, []int{2, 3}, attempts)
+		assert.Equal(t, []string{"deadletter.webhooks.l1"}, eventBus.topics)
+		assert.Equal(t, "12345", eventBus.events[0].Id)
+	})
+
+	t.Run("keep pending retry when stopped", func(t *testing.T) {
+		t.Parallel()
+		// given
+		testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		})
+
+		svr := httptest.NewServer(testHandler)
+		defer svr.Close()
+
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		saved := make(chan struct{})
+		mockRepository := webhookdelivery.NewMockRepository(mockCtrl)
+		mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockRepository.EXPECT().UpsertRetry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, retry webhookdelivery.Retry) error {
+			assert.Equal(t, 2, retry.Attempt)
+			close(saved)
+			return nil
+		})
+
+		retrier := NewRetrier(log.DefaultLogger, mockRepository, nil)
+		l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil,
+			WithRetryPolicy(RetryPolicy{MaxAttempts: 3, Backoff: time.Hour, MaxBackoff: time.Hour}),
+			WithDeliveryRepository(mockRepository), WithRetrier(retrier))
+
+		// when
+		l.Notify(testkube.Event{Type_: testkube.EventStartTest, TestExecution: exampleExecution()})
+		<-saved
+		retrier.Stop()
+
+		// then the delivery is not finished and the retry is not deleted
+	})
+
+	t.Run("persist dead letter when not retriable", func(t *testing.T) {
+		t.Parallel()
+		// given
+		testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		})
+
+		svr := httptest.NewServer(testHandler)
+		defer svr.Close()
+
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		mockRepository := webhookdelivery.NewMockRepository(mockCtrl)
+		mockRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, delivery testkube.WebhookDelivery) error {
+			assert.Equal(t, testkube.DEAD_WebhookDeliveryStatus, *delivery.Status)
+			assert.Len(t, delivery.Attempts, 1)
+			return nil
+		})
+
+		l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil,
+			WithRetryPolicy(policy), WithDeliveryRepository(mockRepository), noSleep)
+
+		// when
+		r := l.Notify(testkube.Event{
+			Type_:         testkube.EventStartTest,
+			TestExecution: exampleExecution(),
+		})
+
+		// then
+		assert.NotEqual(t, "", r.Error())
+	})
+}
+
+func TestWebhookListener_NotifySigned(t *testing.T) {
+	t.Parallel()
+	// given
+	secret := []byte("signing-secret")
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := thttp.VerifyRequest(r, secret, thttp.DefaultSignatureTolerance)
+		// then
+		assert.NoError(t, err)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+
+	svr := httptest.NewServer(testHandler)
+	defer svr.Close()
+
+	l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil, WithSigningSecret(secret))
+
+	// when
+	r := l.Notify(testkube.Event{
+		Type_:         testkube.EventStartTest,
+		TestExecution: exampleExecution(),
+	})
+
+	assert.Equal(t, "", r.Error())
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 10, Backoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	assert.Equal(t, time.Second, policy.Delay(1))
+	assert.Equal(t, 2*time.Second, policy.Delay(2))
+	assert.Equal(t, 4*time.Second, policy.Delay(3))
+	assert.Equal(t, 5*time.Second, policy.Delay(4))
+	assert.Equal(t, 5*time.Second, policy.Delay(9))
+}
+
+func TestWebhookListener_NotifyRedacted(t *testing.T) {
+	t.Parallel()
+	// given
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		// then
+		assert.NoError(t, err)
+		assert.NotContains(t, string(body), "secret-token-value")
+		assert.Contains(t, string(body), redact.Mask)
+	})
+
+	svr := httptest.NewServer(testHandler)
+	defer svr.Close()
+
+	resolver := redact.NewResolver(fake.NewSimpleClientset(), "testkube")
+	l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil, WithSecretResolver(resolver))
+
+	execution := exampleExecution()
+	execution.Variables = map[string]testkube.Variable{
+		"token": testkube.NewSecretVariable("token", "secret-token-value"),
+	}
+	execution.ExecutionResult = &testkube.ExecutionResult{Output: "token: secret-token-value"}
+
+	// when
+	r := l.Notify(testkube.Event{
+		Type_:         testkube.EventEndTestSuccess,
+		TestExecution: execution,
+	})
+
+	assert.Equal(t, "", r.Error())
+}
+
 func exampleExecution() *testkube.Execution {
 	execution := testkube.NewQueuedExecution()
 	execution.Id = executionID
 	return execution
 }
+
+// deadLetterBus records the events published to the topics
+type deadLetterBus struct {
+	*bus.EventBusMock
+	mu     sync.Mutex
+	topics []string
+	events []testkube.Event
+}
+
+func newDeadLetterBus() *deadLetterBus {
+	return &deadLetterBus{EventBusMock: bus.NewEventBusMock()}
+}
+
+func (b *deadLetterBus) PublishTopic(topic string, event testkube.Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.topics = append(b.topics, topic)
+	b.events = append(b.events, event)
+	return nil
+}
@@ -2,14 +2,31 @@ package webhook
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	executorsv1 "github.com/kubeshop/testkube-operator/api/executor/v1"
 	templatesclientv1 "github.com/kubeshop/testkube-operator/pkg/client/templates/v1"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	thttp "github.com/kubeshop/testkube/pkg/http"
 	"github.com/kubeshop/testkube/pkg/mapper/webhooks"
+	"github.com/kubeshop/testkube/pkg/redact"
+	"github.com/kubeshop/testkube/pkg/repository/webhookdelivery"
+	"github.com/kubeshop/testkube/pkg/secret"
+)
+
+const (
+	// DefaultSigningSecretKey is the secret key used for the HMAC signing key, when not provided
+	DefaultSigningSecretKey = "secret"
+	// CertificateSecretCertKey is the certificate secret key for the client certificate
+	CertificateSecretCertKey = "tls.crt"
+	// CertificateSecretKeyKey is the certificate secret key for the client private key
+	CertificateSecretKeyKey = "tls.key"
+	// CertificateSecretCAKey is the certificate secret key for the trusted CA certificate
+	CertificateSecretCAKey = "ca.crt"
 )
 
 var _ common.ListenerLoader = (*WebhooksLoader)(nil)
@@ -19,18 +36,28 @@ type WebhooksLister interface {
 	List(selector string) (*executorsv1.WebhookList, error)
 }
 
-func NewWebhookLoader(log *zap.SugaredLogger, webhooksClient WebhooksLister, templatesClient templatesclientv1.Interface) *WebhooksLoader {
+func NewWebhookLoader(log *zap.SugaredLogger, webhooksClient WebhooksLister, templatesClient templatesclientv1.Interface,
+	deliveryRepository webhookdelivery.Repository, secretClient secret.Interface,
+	secretResolver *redact.Resolver, retrier *Retrier) *WebhooksLoader {
 	return &WebhooksLoader{
-		log:             log,
-		WebhooksClient:  webhooksClient,
-		templatesClient: templatesClient,
+		log:                log,
+		WebhooksClient:     webhooksClient,
+		templatesClient:    templatesClient,
+		deliveryRepository: deliveryRepository,
+		secretClient:       secretClient,
+		secretResolver:     secretResolver,
+		retrier:            retrier,
 	}
 }
 
 type WebhooksLoader struct {
-	log             *zap.SugaredLogger
-	WebhooksClient  WebhooksLister
-	templatesClient templatesclientv1.Interface
+	log                *zap.SugaredLogger
+	WebhooksClient     WebhooksLister
+	templatesClient    templatesclientv1.Interface
+	deliveryRepository webhookdelivery.Repository
+	secretClient       secret.Interface
+	secretResolver     *redact.Resolver
+	retrier            *Retrier
 }
 
 func (r WebhooksLoader) Kind() string {
@@ -66,8 +93,115 @@ func (r WebhooksLoader) Load() (listeners common.Listeners, err error) {
 
 		types := webhooks.MapEventArrayToCRDEvents(webhook.Spec.Events)
 		name := fmt.Sprintf("%s.%s", webhook.ObjectMeta.Namespace, webhook.ObjectMeta.Name)
-		listeners = append(listeners, NewWebhookListener(name, webhook.Spec.Uri, webhook.Spec.Selector, types, webhook.Spec.PayloadObjectField, payloadTemplate, webhook.Spec.Headers))
+		opts := []Option{WithRetryPolicy(r.getRetryPolicy(name, webhooks.MapRetryPolicyCRDToAPI(webhook.Spec.RetryPolicy)))}
+		if r.deliveryRepository != nil {
+			opts = append(opts, WithDeliveryRepository(r.deliveryRepository))
+		}
+		if r.secretResolver != nil {
+			opts = append(opts, WithSecretResolver(r.secretResolver))
+		}
+		if r.retrier != nil {
+			opts = append(opts, WithRetrier(r.retrier))
+		}
+
+		securityOpts, err := r.getSecurityOptions(webhook)
+		if err != nil {
+			r.log.Errorw("webhook security configuration error, skipping webhook", "webhook", name, "error", err)
+			continue
+		}
+		opts = append(opts, securityOpts...)
+
+		listeners = append(listeners, NewWebhookListener(name, webhook.Spec.Uri, webhook.Spec.Selector, types,
+			webhook.Spec.PayloadObjectField, payloadTemplate, webhook.Spec.Headers, opts...))
+	}
+
+	// continue the retries persisted before the restart
+	if r.retrier != nil {
+		r.retrier.Resume(listeners)
 	}
 
 	return listeners, nil
 }
+
+func (r WebhooksLoader) getRetryPolicy(name string, policy *testkube.WebhookRetryPolicy) RetryPolicy {
+	result := DefaultRetryPolicy()
+	if policy == nil {
+		return result
+	}
+
+	if policy.MaxAttempts > 0 {
+		result.MaxAttempts = int(policy.MaxAttempts)
+	}
+
+	if policy.Backoff != "" {
+		backoff, err := time.ParseDuration(policy.Backoff)
+		if err != nil {
+			r.log.Warnw("invalid webhook retry backoff, using default", "webhook", name, "backoff", policy.Backoff, "error", err)
+		} else {
+			result.Backoff = backoff
+		}
+	}
+
+	if policy.MaxBackoff != "" {
+		maxBackoff, err := time.ParseDuration(policy.MaxBackoff)
+		if err != nil {
+			r.log.Warnw("invalid webhook retry max backoff, using default", "webhook", name, "maxBackoff", policy.MaxBackoff, "error", err)
+		} else {
+			result.MaxBackoff = maxBackoff
+		}
+	}
+
+	return result
+}
+
+// getSecurityOptions loads the HMAC signing key and the TLS configuration from the referenced secrets
+func (r WebhooksLoader) getSecurityOptions(webhook executorsv1.Webhook) (opts []Option, err error) {
+	signingSecret := webhook.Spec.SigningSecretRef
+	var certificateSecret string
+	if webhook.Spec.TLS != nil {
+		certificateSecret = webhook.Spec.TLS.SecretName
+	}
+	if (signingSecret == nil || signingSecret.Name == "") && certificateSecret == "" {
+		return nil, nil
+	}
+
+	if r.secretClient == nil {
+		return nil, errors.New("secret client is not configured")
+	}
+
+	if signingSecret != nil && signingSecret.Name != "" {
+		key := signingSecret.Key
+		if key == "" {
+			key = DefaultSigningSecretKey
+		}
+
+		data, err := r.secretClient.Get(signingSecret.Name, webhook.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting signing secret %s", signingSecret.Name)
+		}
+
+		value, ok := data[key]
+		if !ok || value == "" {
+			return nil, fmt.Errorf("signing secret %s has no %s key", signingSecret.Name, key)
+		}
+
+		opts = append(opts, WithSigningSecret([]byte(value)))
+	}
+
+	if certificateSecret != "" {
+		data, err := r.secretClient.Get(certificateSecret, webhook.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting certificate secret %s", certificateSecret)
+		}
+
+		tlsConfig, err := thttp.NewTLSConfig([]byte(data[CertificateSecretCertKey]),
+			[]byte(data[CertificateSecretKeyKey]), []byte(data[CertificateSecretCAKey]))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing certificate secret %s", certificateSecret)
+		}
+
+		opts = append(opts, WithTLSConfig(tlsConfig))
+	}
+
+	return opts, nil
+}
@@ -7,8 +7,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	executorsv1 "github.com/kubeshop/testkube-operator/api/executor/v1"
 	templatesclientv1 "github.com/kubeshop/testkube-operator/pkg/client/templates/v1"
+	"github.com/kubeshop/testkube/pkg/secret"
 )
 
 type DummyLoader struct {
@@ -29,9 +32,48 @@ func TestWebhookLoader(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockTemplatesClient := templatesclientv1.NewMockInterface(mockCtrl)
-	webhooksLoader := NewWebhookLoader(zap.NewNop().Sugar(), &DummyLoader{}, mockTemplatesClient)
+	webhooksLoader := NewWebhookLoader(zap.NewNop().Sugar(), &DummyLoader{}, mockTemplatesClient, nil, nil, nil, nil)
 	listeners, err := webhooksLoader.Load()
 
 	assert.Equal(t, 1, len(listeners))
 	assert.NoError(t, err)
 }
+
+type SignedLoader struct {
+}
+
+func (l SignedLoader) List(selector string) (*executorsv1.WebhookList, error) {
+	return &executorsv1.WebhookList{
+		Items: []executorsv1.Webhook{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "signed", Namespace: "testkube"},
+				Spec: executorsv1.WebhookSpec{Uri: "http://localhost:3333", Events: []executorsv1.EventType{"start-test"},
+					SigningSecretRef: &executorsv1.WebhookSecretRef{Name: "webhook-secret"}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: "testkube"},
+				Spec: executorsv1.WebhookSpec{Uri: "http://localhost:3333", Events: []executorsv1.EventType{"start-test"},
+					SigningSecretRef: &executorsv1.WebhookSecretRef{Name: "missing-secret"}},
+			},
+		},
+	}, nil
+}
+
+func TestWebhookLoader_SigningSecret(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockTemplatesClient := templatesclientv1.NewMockInterface(mockCtrl)
+	mockSecretClient := secret.NewMockInterface(mockCtrl)
+	mockSecretClient.EXPECT().Get("webhook-secret", "testkube").Return(map[string]string{DefaultSigningSecretKey: "key"}, nil)
+	mockSecretClient.EXPECT().Get("missing-secret", "testkube").Return(map[string]string{}, nil)
+
+	webhooksLoader := NewWebhookLoader(zap.NewNop().Sugar(), &SignedLoader{}, mockTemplatesClient, nil, mockSecretClient, nil, nil)
+	listeners, err := webhooksLoader.Load()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(listeners))
+	assert.Equal(t, []byte("key"), listeners[0].(*WebhookListener).signingSecret)
+}
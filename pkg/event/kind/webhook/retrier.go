@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/bus"
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/repository/webhookdelivery"
+)
+
+// Retrier runs the retries of the failed webhook deliveries in the background.
+// Pending retries are persisted, so they are resumed after the restart.
+type Retrier struct {
+	log        *zap.SugaredLogger
+	repository webhookdelivery.Repository
+	bus        bus.Bus
+	ctx        context.Context
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	resumeOnce sync.Once
+	sleep      func(ctx context.Context, d time.Duration) bool
+}
+
+// NewRetrier creates the retrier, persisting the pending retries in the repository (if any)
+// and publishing the dead letters to the bus (if any)
+func NewRetrier(log *zap.SugaredLogger, repository webhookdelivery.Repository, eventBus bus.Bus) *Retrier {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Retrier{
+		log:        log,
+		repository: repository,
+		bus:        eventBus,
+		ctx:        ctx,
+		cancel:     cancel,
+		sleep:      sleep,
+	}
+}
+
+// sleep waits for the provided duration, returning false when the context has been cancelled before
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Go runs the retry in the background
+func (r *Retrier) Go(fn func(ctx context.Context)) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		fn(r.ctx)
+	}()
+}
+
+// Wait waits until all the retries running in the background are finished
+func (r *Retrier) Wait() {
+	r.wg.Wait()
+}
+
+// Stop interrupts the retries and waits for them, leaving the pending ones persisted to be resumed
+func (r *Retrier) Stop() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+// Resume continues the persisted retries with the loaded listeners. It is run only once, after the first load.
+func (r *Retrier) Resume(listeners common.Listeners) {
+	r.resumeOnce.Do(func() {
+		if r.repository == nil {
+			return
+		}
+
+		retries, err := r.repository.GetRetries(r.ctx)
+		if err != nil {
+			r.log.Errorw("webhook retries loading error", "error", err)
+			return
+		}
+
+		webhooks := make(map[string]*WebhookListener)
+		for _, listener := range listeners {
+			if l, ok := listener.(*WebhookListener); ok {
+				webhooks[l.name] = l
+			}
+		}
+
+		for _, retry := range retries {
+			r.resume(webhooks[retry.WebhookName], retry)
+		}
+	})
+}
+
+func (r *Retrier) resume(l *WebhookListener, retry webhookdelivery.Retry) {
+	log := r.log.With("webhook", retry.WebhookName, "delivery", retry.DeliveryId)
+	delivery, err := r.repository.Get(r.ctx, retry.DeliveryId)
+	if err != nil {
+		log.Errorw("webhook delivery loading error, dropping retry", "error", err)
+		r.DeleteRetry(retry.DeliveryId)
+		return
+	}
+
+	var uri string
+	var headers http.Header
+	if l != nil {
+		uri, headers, err = l.request(retry.Event)
+	}
+	if l == nil || err != nil {
+		log.Warnw("webhook retry can't be resumed, moved to dead letters", "error", err)
+		delivery.Status = testkube.WebhookDeliveryStatusPtr(testkube.DEAD_WebhookDeliveryStatus)
+		delivery.EndTime = time.Now()
+		if err = r.repository.Update(r.ctx, delivery); err != nil {
+			log.Errorw("webhook delivery updating error", "error", err)
+		}
+		r.DeleteRetry(retry.DeliveryId)
+		r.DeadLetter(retry.WebhookName, retry.Event)
+		return
+	}
+
+	log.Infow("resuming webhook retry", "attempt", retry.Attempt)
+	r.Go(func(ctx context.Context) {
+		l.retry(ctx, retry.Event, delivery, uri, headers, []byte(delivery.Request), retry.Attempt, retry.AttemptTime)
+	})
+}
+
+// SaveRetry persists the pending retry
+func (r *Retrier) SaveRetry(retry webhookdelivery.Retry) {
+	if r.repository == nil {
+		return
+	}
+
+	if err := r.repository.UpsertRetry(context.Background(), retry); err != nil {
+		r.log.Errorw("webhook retry saving error", "webhook", retry.WebhookName, "error", err)
+	}
+}
+
+// DeleteRetry deletes the persisted retry, once it is finished
+func (r *Retrier) DeleteRetry(deliveryId string) {
+	if r.repository == nil {
+		return
+	}
+
+	if err := r.repository.DeleteRetry(context.Background(), deliveryId); err != nil {
+		r.log.Errorw("webhook retry deleting error", "delivery", deliveryId, "error", err)
+	}
+}
+
+// DeadLetter publishes the event that could not be delivered to the webhook dead letter topic
+func (r *Retrier) DeadLetter(webhookName string, event testkube.Event) {
+	if r.bus == nil {
+		return
+	}
+
+	if err := r.bus.PublishTopic(bus.WebhookDeadLetterTopic+"."+webhookName, event); err != nil {
+		r.log.Errorw("webhook dead letter publishing error", "webhook", webhookName, "error", err)
+	}
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/webhookdelivery"
+)
+
+func TestRetrier_Resume(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Minute}
+	event := testkube.Event{Id: "12345", Type_: testkube.EventStartTest, TestExecution: exampleExecution()}
+	delivery := testkube.WebhookDelivery{
+		Id:          "delivery-1",
+		WebhookName: "l1",
+		EventId:     event.Id,
+		Request:     `{"id":"12345"}`,
+		Status:      testkube.WebhookDeliveryStatusPtr(testkube.RETRYING_WebhookDeliveryStatus),
+		Attempts:    []testkube.WebhookDeliveryAttempt{{Number: 1, ResponseCode: http.StatusBadGateway}},
+	}
+
+	t.Run("resume persisted retry", func(t *testing.T) {
+		t.Parallel()
+		// given
+		var calls int32
+		testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		})
+
+		svr := httptest.NewServer(testHandler)
+		defer svr.Close()
+
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		mockRepository := webhookdelivery.NewMockRepository(mockCtrl)
+		mockRepository.EXPECT().GetRetries(gomock.Any()).Return([]webhookdelivery.Retry{
+			{DeliveryId: delivery.Id, WebhookName: "l1", Event: event, Attempt: 2, AttemptTime: time.Now()},
+		}, nil)
+		mockRepository.EXPECT().Get(gomock.Any(), delivery.Id).Return(delivery, nil)
+		mockRepository.EXPECT().UpsertRetry(gomock.Any(), gomock.Any()).Return(nil)
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, delivery testkube.WebhookDelivery) error {
+			assert.Equal(t, testkube.SUCCESS_WebhookDeliveryStatus, *delivery.Status)
+			assert.Len(t, delivery.Attempts, 2)
+			assert.Equal(t, int32(2), delivery.Attempts[1].Number)
+			return nil
+		})
+		mockRepository.EXPECT().DeleteRetry(gomock.Any(), delivery.Id).Return(nil)
+
+		retrier := NewRetrier(log.DefaultLogger, mockRepository, nil)
+		l := NewWebhookListener("l1", svr.URL, "", testEventTypes, "", "", nil,
+			WithRetryPolicy(policy), WithDeliveryRepository(mockRepository), WithRetrier(retrier))
+
+		// when
+		retrier.Resume(common.Listeners{l})
+		retrier.Resume(common.Listeners{l})
+		retrier.Wait()
+
+		// then
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("move retry of missing webhook to dead letters", func(t *testing.T) {
+		t.Parallel()
+		// given
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		mockRepository := webhookdelivery.NewMockRepository(mockCtrl)
+		mockRepository.EXPECT().GetRetries(gomock.Any()).Return([]webhookdelivery.Retry{
+			{DeliveryId: delivery.Id, WebhookName: "removed", Event: event, Attempt: 2, AttemptTime: time.Now()},
+		}, nil)
+		mockRepository.EXPECT().Get(gomock.Any(), delivery.Id).Return(delivery, nil)
+		mockRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, delivery testkube.WebhookDelivery) error {
+			assert.Equal(t, testkube.DEAD_WebhookDeliveryStatus, *delivery.Status)
+			assert.False(t, delivery.EndTime.IsZero())
+			return nil
+		})
+		mockRepository.EXPECT().DeleteRetry(gomock.Any(), delivery.Id).Return(nil)
+
+		eventBus := newDeadLetterBus()
+		retrier := NewRetrier(log.DefaultLogger, mockRepository, eventBus)
+		l := NewWebhookListener("l1", "http://localhost", "", testEventTypes, "", "", nil, WithRetrier(retrier))
+
+		// when
+		retrier.Resume(common.Listeners{l})
+		retrier.Wait()
+
+		// then
+		assert.Equal(t, []string{"deadletter.webhooks.removed"}, eventBus.topics)
+	})
+}
@@ -49,6 +49,7 @@ import (
 	testexecutionsmapper "github.com/kubeshop/testkube/pkg/mapper/testexecutions"
 	testsmapper "github.com/kubeshop/testkube/pkg/mapper/tests"
 	"github.com/kubeshop/testkube/pkg/telemetry"
+	"github.com/kubeshop/testkube/pkg/tracing"
 	"github.com/kubeshop/testkube/pkg/utils"
 )
 
@@ -228,6 +229,9 @@ func (c *JobExecutor) Logs(ctx context.Context, id, namespace string) (out chan
 // Execute starts new external test execution, reads data and returns ID
 // Execution is started asynchronously client can check later for results
 func (c *JobExecutor) Execute(ctx context.Context, execution *testkube.Execution, options ExecuteOptions) (result *testkube.ExecutionResult, err error) {
+	ctx, span := tracing.Start(ctx, "JobExecutor.Execute", tracing.ExecutionIDKey.String(execution.Id), tracing.TestTypeKey.String(execution.TestType))
+	defer func() { tracing.End(span, err) }()
+
 	result = testkube.NewRunningExecutionResult()
 	execution.ExecutionResult = result
 
@@ -243,7 +247,9 @@ func (c *JobExecutor) Execute(ctx context.Context, execution *testkube.Execution
 	}
 
 	podsClient := c.ClientSet.CoreV1().Pods(execution.TestNamespace)
-	pods, err := executor.GetJobPods(ctx, podsClient, execution.Id, 1, 10)
+	podsCtx, podsSpan := tracing.Start(ctx, "JobExecutor.GetJobPods")
+	pods, err := executor.GetJobPods(podsCtx, podsClient, execution.Id, 1, 10)
+	tracing.End(podsSpan, err)
 	if err != nil {
 		return result.Err(err), err
 	}
@@ -322,7 +328,10 @@ func (c *JobExecutor) MonitorJobForTimeout(ctx context.Context, jobName, namespa
 }
 
 // CreateJob creates new Kubernetes job based on execution and execute options
-func (c *JobExecutor) CreateJob(ctx context.Context, execution testkube.Execution, options ExecuteOptions) error {
+func (c *JobExecutor) CreateJob(ctx context.Context, execution testkube.Execution, options ExecuteOptions) (err error) {
+	ctx, span := tracing.Start(ctx, "JobExecutor.CreateJob", tracing.ExecutionIDKey.String(execution.Id))
+	defer func() { tracing.End(span, err) }()
+
 	jobs := c.ClientSet.BatchV1().Jobs(execution.TestNamespace)
 	jobOptions, err := NewJobOptions(c.Log, c.templatesClient, c.images, c.templates,
 		c.serviceAccountNames, c.registry, c.clusterID, c.apiURI, execution, options, c.natsURI, c.debug)
@@ -351,6 +360,9 @@ func (c *JobExecutor) CreateJob(ctx context.Context, execution testkube.Executio
 		return err
 	}
 
+	// continue the trace in the executor pod
+	tracing.InjectPodSpec(ctx, &jobSpec.Spec.Template.Spec)
+
 	_, err = jobs.Create(ctx, jobSpec, metav1.CreateOptions{})
 	return err
 }
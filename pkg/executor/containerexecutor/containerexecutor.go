@@ -39,6 +39,7 @@ import (
 	testexecutionsmapper "github.com/kubeshop/testkube/pkg/mapper/testexecutions"
 	testsmapper "github.com/kubeshop/testkube/pkg/mapper/tests"
 	"github.com/kubeshop/testkube/pkg/telemetry"
+	"github.com/kubeshop/testkube/pkg/tracing"
 )
 
 const (
@@ -248,7 +249,10 @@ func (c *ContainerExecutor) Logs(ctx context.Context, id, namespace string) (out
 
 // Execute starts new external test execution, reads data and returns ID
 // Execution is started asynchronously client can check later for results
-func (c *ContainerExecutor) Execute(ctx context.Context, execution *testkube.Execution, options client.ExecuteOptions) (*testkube.ExecutionResult, error) {
+func (c *ContainerExecutor) Execute(ctx context.Context, execution *testkube.Execution, options client.ExecuteOptions) (_ *testkube.ExecutionResult, err error) {
+	ctx, span := tracing.Start(ctx, "ContainerExecutor.Execute", tracing.ExecutionIDKey.String(execution.Id), tracing.TestTypeKey.String(execution.TestType))
+	defer func() { tracing.End(span, err) }()
+
 	executionResult := testkube.NewRunningExecutionResult()
 	execution.ExecutionResult = executionResult
 
@@ -259,7 +263,9 @@ func (c *ContainerExecutor) Execute(ctx context.Context, execution *testkube.Exe
 	}
 
 	podsClient := c.clientSet.CoreV1().Pods(execution.TestNamespace)
-	pods, err := executor.GetJobPods(ctx, podsClient, execution.Id, 1, 10)
+	podsCtx, podsSpan := tracing.Start(ctx, "ContainerExecutor.GetJobPods")
+	pods, err := executor.GetJobPods(podsCtx, podsClient, execution.Id, 1, 10)
+	tracing.End(podsSpan, err)
 	if err != nil {
 		executionResult.Err(err)
 		return executionResult, err
@@ -291,7 +297,10 @@ func (c *ContainerExecutor) Execute(ctx context.Context, execution *testkube.Exe
 }
 
 // createJob creates new Kubernetes job based on execution and execute options
-func (c *ContainerExecutor) createJob(ctx context.Context, execution testkube.Execution, options client.ExecuteOptions) (*JobOptions, error) {
+func (c *ContainerExecutor) createJob(ctx context.Context, execution testkube.Execution, options client.ExecuteOptions) (_ *JobOptions, err error) {
+	ctx, span := tracing.Start(ctx, "ContainerExecutor.CreateJob", tracing.ExecutionIDKey.String(execution.Id))
+	defer func() { tracing.End(span, err) }()
+
 	jobsClient := c.clientSet.BatchV1().Jobs(execution.TestNamespace)
 
 	// Fallback to one-time inspector when non-default namespace is needed
@@ -331,6 +340,9 @@ func (c *ContainerExecutor) createJob(ctx context.Context, execution testkube.Ex
 		return nil, err
 	}
 
+	// continue the trace in the executor pod
+	tracing.InjectPodSpec(ctx, &jobSpec.Spec.Template.Spec)
+
 	_, err = jobsClient.Create(ctx, jobSpec, metav1.CreateOptions{})
 	return jobOptions, err
 }
@@ -1,10 +1,8 @@
 package env
 
 import (
-	"bytes"
 	"fmt"
 	"os"
-	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -36,10 +34,6 @@ type Interface interface {
 	GetSecretEnvs() (secretEnvs map[string]string)
 	// GetReferenceVars gets reference vars
 	GetReferenceVars(variables map[string]testkube.Variable)
-	// ObfuscateSecrets obfuscates secret values
-	ObfuscateSecrets(p []byte) []byte
-	// ObfuscateStringSlice obfuscates string slice values
-	ObfuscateStringSlice(values []string) []string
 }
 
 // NewManager returns an implementation of the Manager
@@ -270,42 +264,3 @@ func (m Manager) GetReferenceVars(variables map[string]testkube.Variable) {
 
 	return
 }
-
-// ObfuscateSecrets obfuscates secret values
-func (m Manager) ObfuscateSecrets(p []byte) []byte {
-	if m.Variables == nil {
-		return p
-	}
-
-	for _, variable := range m.Variables {
-		if !variable.IsSecret() {
-			continue
-		}
-
-		p = bytes.ReplaceAll(p, []byte(variable.Value), []byte(strings.Repeat("*", len(variable.Value))))
-	}
-
-	return p
-}
-
-// ObfuscateStringSlice obfuscates string slice values
-func (m Manager) ObfuscateStringSlice(values []string) []string {
-	if m.Variables == nil {
-		return values
-	}
-
-	var results []string
-	for _, value := range values {
-		for _, variable := range m.Variables {
-			if !variable.IsSecret() {
-				continue
-			}
-
-			value = strings.ReplaceAll(value, variable.Value, strings.Repeat("*", len(variable.Value)))
-		}
-
-		results = append(results, value)
-	}
-
-	return results
-}
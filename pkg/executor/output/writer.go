@@ -4,27 +4,24 @@ import (
 	"encoding/json"
 	"io"
 
-	"github.com/kubeshop/testkube/pkg/executor/env"
+	"github.com/kubeshop/testkube/pkg/redact"
 )
 
 // NewJSONWrapWriter returns new NewJSONWrapWriter instance
-func NewJSONWrapWriter(writer io.Writer, envMngr env.Interface) *JSONWrapWriter {
+func NewJSONWrapWriter(writer io.Writer, redactor *redact.Redactor) *JSONWrapWriter {
 	return &JSONWrapWriter{
-		encoder:    json.NewEncoder(writer),
-		envManager: envMngr,
+		encoder:  json.NewEncoder(writer),
+		redactor: redactor,
 	}
 }
 
 // JSONWrapWriter wraps bytes stream into json Output of type line
 type JSONWrapWriter struct {
-	encoder    *json.Encoder
-	envManager env.Interface
+	encoder  *json.Encoder
+	redactor *redact.Redactor
 }
 
 // Write io.Writer method implementation
 func (w *JSONWrapWriter) Write(p []byte) (int, error) {
-	if w.envManager != nil {
-		p = w.envManager.ObfuscateSecrets(p)
-	}
-	return len(p), w.encoder.Encode(NewOutputLine(p))
+	return len(p), w.encoder.Encode(NewOutputLine(w.redactor.Bytes(p)))
 }
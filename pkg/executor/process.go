@@ -4,9 +4,9 @@ import (
 	"os"
 	"strings"
 
-	"github.com/kubeshop/testkube/pkg/executor/env"
 	"github.com/kubeshop/testkube/pkg/executor/output"
 	"github.com/kubeshop/testkube/pkg/process"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -15,13 +15,9 @@ import (
 // stdin <- testkube.Execution, stdout <- stream of json logs
 // LoggedExecuteInDir will put wrapped JSON output to stdout AND get RAW output into out var
 // json logs can be processed later on watch of pod logs
-func Run(dir string, command string, envMngr env.Interface, arguments ...string) (out []byte, err error) {
-	var obfuscatedArgs []byte
-	if envMngr != nil {
-		obfuscatedArgs = envMngr.ObfuscateSecrets([]byte(strings.Join(arguments, " ")))
-	}
-	output.PrintLogf("%s Executing in directory %s: \n $ %s %s", ui.IconMicroscope, dir, command, obfuscatedArgs)
-	out, err = process.LoggedExecuteInDir(dir, output.NewJSONWrapWriter(os.Stdout, envMngr), command, arguments...)
+func Run(dir string, command string, redactor *redact.Redactor, arguments ...string) (out []byte, err error) {
+	output.PrintLogf("%s Executing in directory %s: \n $ %s %s", ui.IconMicroscope, dir, command, redactor.String(strings.Join(arguments, " ")))
+	out, err = process.LoggedExecuteInDir(dir, output.NewJSONWrapWriter(os.Stdout, redactor), command, arguments...)
 	if err != nil {
 		output.PrintLogf("%s Execution failed: %s", ui.IconCross, err.Error())
 		return out, err
@@ -0,0 +1,167 @@
+// Package flaky detects test cases, which results are changing between the executions
+package flaky
+
+import (
+	"math"
+	"sort"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	// DefaultLimit is the default number of the latest executions to analyze
+	DefaultLimit = 20
+	// DefaultThreshold is the default minimum flip rate to consider the test case flaky
+	DefaultThreshold = 0.2
+
+	StatusPassed = "passed"
+	StatusFailed = "failed"
+)
+
+// Run contains the results of the test cases in a single execution
+type Run struct {
+	ExecutionId string
+	// Cases maps the test case name to its status (passed or failed)
+	Cases map[string]string
+}
+
+// RunFromExecution builds the run from the test execution steps,
+// and returns false when the execution has no test cases results
+func RunFromExecution(execution testkube.Execution) (Run, bool) {
+	if execution.ExecutionResult == nil || execution.ExecutionResult.Status == nil || !execution.ExecutionResult.IsCompleted() {
+		return Run{}, false
+	}
+	run := Run{ExecutionId: execution.Id, Cases: map[string]string{}}
+	for _, step := range execution.ExecutionResult.Steps {
+		if status, ok := normalizeStatus(step.Status); ok {
+			run.Cases[step.Name] = status
+		}
+	}
+	return run, len(run.Cases) > 0
+}
+
+// RunFromTestWorkflowExecution builds the run from the test reports of the TestWorkflow execution,
+// and returns false when the execution has no test cases results
+func RunFromTestWorkflowExecution(execution testkube.TestWorkflowExecution) (Run, bool) {
+	if execution.Result == nil || !execution.Result.IsFinished() {
+		return Run{}, false
+	}
+	run := Run{ExecutionId: execution.Id, Cases: map[string]string{}}
+	for _, report := range execution.Reports {
+		for _, c := range report.Cases {
+			name := c.Name
+			if c.Classname != "" {
+				name = c.Classname + "." + c.Name
+			}
+			if status, ok := normalizeStatus(c.Status); ok {
+				run.Cases[name] = status
+			}
+		}
+	}
+	return run, len(run.Cases) > 0
+}
+
+// RunsFromExecutions builds the runs from the test executions, skipping ones without test cases results
+func RunsFromExecutions(executions []testkube.Execution) []Run {
+	runs := make([]Run, 0, len(executions))
+	for _, execution := range executions {
+		if run, ok := RunFromExecution(execution); ok {
+			runs = append(runs, run)
+		}
+	}
+	return runs
+}
+
+// RunsFromTestWorkflowExecutions builds the runs from the TestWorkflow executions, skipping ones without test cases results
+func RunsFromTestWorkflowExecutions(executions []testkube.TestWorkflowExecution) []Run {
+	runs := make([]Run, 0, len(executions))
+	for _, execution := range executions {
+		if run, ok := RunFromTestWorkflowExecution(execution); ok {
+			runs = append(runs, run)
+		}
+	}
+	return runs
+}
+
+func normalizeStatus(status string) (string, bool) {
+	switch status {
+	case "passed", "success":
+		return StatusPassed, true
+	case "failed", "error", "failure":
+		return StatusFailed, true
+	}
+	return "", false
+}
+
+// Analyze computes the flakiness of the test cases, based on the runs ordered from the latest one.
+// It returns only the test cases that have changed the status at least once, the most flaky first.
+func Analyze(runs []Run, threshold float64) []testkube.FlakyTestCase {
+	cases := make(map[string]*testkube.FlakyTestCase)
+	previous := make(map[string]string)
+	for _, run := range runs {
+		for name, status := range run.Cases {
+			c, ok := cases[name]
+			if !ok {
+				c = &testkube.FlakyTestCase{Name: name, LastStatus: status}
+				cases[name] = c
+			}
+			c.Runs++
+			if status == StatusPassed {
+				c.Passed++
+			} else {
+				c.Failed++
+			}
+			if prev, ok := previous[name]; ok && prev != status {
+				c.Flips++
+			}
+			previous[name] = status
+		}
+	}
+
+	result := make([]testkube.FlakyTestCase, 0)
+	for _, c := range cases {
+		if c.Flips == 0 {
+			continue
+		}
+		c.FlipRate = math.Round(float64(c.Flips)/float64(c.Runs-1)*1000) / 1000
+		c.Flaky = c.FlipRate >= threshold
+		result = append(result, *c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].FlipRate != result[j].FlipRate {
+			return result[i].FlipRate > result[j].FlipRate
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// Report builds the flakiness report for the test or TestWorkflow
+func Report(name string, runs []Run, threshold float64) testkube.FlakyTestsReport {
+	return testkube.FlakyTestsReport{
+		Name:      name,
+		Runs:      int32(len(runs)),
+		Threshold: threshold,
+		Cases:     Analyze(runs, threshold),
+	}
+}
+
+// NewlyFlaky returns the test cases that became flaky with the latest run
+func NewlyFlaky(runs []Run, threshold float64) []testkube.FlakyTestCase {
+	if len(runs) < 2 {
+		return nil
+	}
+	before := make(map[string]struct{})
+	for _, c := range Analyze(runs[1:], threshold) {
+		if c.Flaky {
+			before[c.Name] = struct{}{}
+		}
+	}
+	result := make([]testkube.FlakyTestCase, 0)
+	for _, c := range Analyze(runs, threshold) {
+		if _, ok := before[c.Name]; c.Flaky && !ok {
+			result = append(result, c)
+		}
+	}
+	return result
+}
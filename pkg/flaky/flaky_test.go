@@ -0,0 +1,66 @@
+package flaky
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func run(id string, cases map[string]string) Run {
+	return Run{ExecutionId: id, Cases: cases}
+}
+
+func TestAnalyze(t *testing.T) {
+	runs := []Run{
+		run("5", map[string]string{"a": StatusFailed, "b": StatusPassed, "c": StatusFailed}),
+		run("4", map[string]string{"a": StatusPassed, "b": StatusPassed, "c": StatusFailed}),
+		run("3", map[string]string{"a": StatusFailed, "b": StatusPassed, "c": StatusFailed}),
+		run("2", map[string]string{"a": StatusPassed, "b": StatusPassed, "c": StatusFailed}),
+		run("1", map[string]string{"a": StatusPassed, "b": StatusFailed, "c": StatusFailed}),
+	}
+
+	result := Analyze(runs, DefaultThreshold)
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, "a", result[0].Name)
+	assert.Equal(t, int32(5), result[0].Runs)
+	assert.Equal(t, int32(3), result[0].Passed)
+	assert.Equal(t, int32(2), result[0].Failed)
+	assert.Equal(t, int32(3), result[0].Flips)
+	assert.Equal(t, 0.75, result[0].FlipRate)
+	assert.True(t, result[0].Flaky)
+	assert.Equal(t, StatusFailed, result[0].LastStatus)
+
+	assert.Equal(t, "b", result[1].Name)
+	assert.Equal(t, 0.25, result[1].FlipRate)
+	assert.True(t, result[1].Flaky)
+	assert.Equal(t, StatusPassed, result[1].LastStatus)
+}
+
+func TestAnalyzeThreshold(t *testing.T) {
+	runs := []Run{
+		run("3", map[string]string{"a": StatusPassed}),
+		run("2", map[string]string{"a": StatusPassed}),
+		run("1", map[string]string{"a": StatusFailed}),
+	}
+
+	result := Analyze(runs, 0.6)
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, 0.5, result[0].FlipRate)
+	assert.False(t, result[0].Flaky)
+}
+
+func TestNewlyFlaky(t *testing.T) {
+	runs := []Run{
+		run("3", map[string]string{"a": StatusFailed, "b": StatusPassed}),
+		run("2", map[string]string{"a": StatusPassed, "b": StatusFailed}),
+		run("1", map[string]string{"a": StatusPassed, "b": StatusPassed}),
+	}
+
+	result := NewlyFlaky(runs, DefaultThreshold)
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, "a", result[0].Name)
+	assert.Empty(t, NewlyFlaky(runs[:1], DefaultThreshold))
+}
@@ -2,6 +2,8 @@ package http
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"net"
 	"net/http"
 	"time"
@@ -21,6 +23,11 @@ func NewClient(insecure ...bool) *http.Client {
 		}
 	}
 
+	return NewTLSClient(tlsConfig)
+}
+
+// NewTLSClient is HTTP client using provided TLS configuration, e.g. with client certificates or custom CA
+func NewTLSClient(tlsConfig *tls.Config) *http.Client {
 	var netTransport = &http.Transport{
 		Dial: (&net.Dialer{
 			Timeout: NetDialTimeout,
@@ -52,3 +59,28 @@ func NewSSEClient(insecure ...bool) *http.Client {
 		Transport: netTransport,
 	}
 }
+
+// NewTLSConfig builds TLS configuration from PEM encoded client certificate, key and CA certificate,
+// all of them are optional
+func NewTLSConfig(certPEM, keyPEM, caPEM []byte) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+	if len(certPEM) != 0 || len(keyPEM) != 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if len(caPEM) != 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("failed to parse CA certificate")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
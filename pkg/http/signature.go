@@ -0,0 +1,95 @@
+package http
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader is the header with HMAC-SHA256 signature of the request body
+	SignatureHeader = "X-Testkube-Signature"
+	// SignatureTimestampHeader is the header with Unix timestamp included in the signature
+	SignatureTimestampHeader = "X-Testkube-Timestamp"
+	// DefaultSignatureTolerance is the maximum accepted age of the signed request
+	DefaultSignatureTolerance = 5 * time.Minute
+
+	signaturePrefix = "sha256="
+)
+
+var (
+	ErrMissingSignature = errors.New("missing signature")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrExpiredSignature = errors.New("signature timestamp outside of tolerance")
+)
+
+// Sign returns HMAC-SHA256 signature of "<timestamp>.<body>" in the "sha256=<hex>" format
+func Sign(secret []byte, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignRequest sets the signature and timestamp headers for the request body
+func SignRequest(request *http.Request, secret []byte, timestamp time.Time, body []byte) {
+	request.Header.Set(SignatureTimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+	request.Header.Set(SignatureHeader, Sign(secret, timestamp, body))
+}
+
+// VerifySignature checks if signature and timestamp match the body,
+// and the timestamp is not older than tolerance (when positive)
+func VerifySignature(secret []byte, signature, timestamp string, body []byte, tolerance time.Duration) error {
+	if signature == "" || timestamp == "" {
+		return ErrMissingSignature
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	ts := time.Unix(unix, 0)
+	if tolerance > 0 {
+		age := time.Since(ts)
+		if age < 0 {
+			age = -age
+		}
+
+		if age > tolerance {
+			return ErrExpiredSignature
+		}
+	}
+
+	if !strings.HasPrefix(signature, signaturePrefix) ||
+		!hmac.Equal([]byte(signature), []byte(Sign(secret, ts, body))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// VerifyRequest reads the request body and verifies its signature, the body is left readable for next handlers
+func VerifyRequest(request *http.Request, secret []byte, tolerance time.Duration) ([]byte, error) {
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	err = VerifySignature(secret, request.Header.Get(SignatureHeader), request.Header.Get(SignatureTimestampHeader), body, tolerance)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
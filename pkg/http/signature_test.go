@@ -0,0 +1,92 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifySignature(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"id":"1"}`)
+
+	t.Run("accepts valid signature", func(t *testing.T) {
+		// given
+		now := time.Now()
+		signature := Sign(secret, now, body)
+
+		// when
+		err := VerifySignature(secret, signature, strconv.FormatInt(now.Unix(), 10), body, DefaultSignatureTolerance)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects modified body", func(t *testing.T) {
+		// given
+		now := time.Now()
+		signature := Sign(secret, now, body)
+
+		// when
+		err := VerifySignature(secret, signature, strconv.FormatInt(now.Unix(), 10), []byte(`{"id":"2"}`), DefaultSignatureTolerance)
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("rejects different secret", func(t *testing.T) {
+		// given
+		now := time.Now()
+		signature := Sign([]byte("other"), now, body)
+
+		// when
+		err := VerifySignature(secret, signature, strconv.FormatInt(now.Unix(), 10), body, DefaultSignatureTolerance)
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("rejects expired timestamp", func(t *testing.T) {
+		// given
+		past := time.Now().Add(-time.Hour)
+		signature := Sign(secret, past, body)
+
+		// when
+		err := VerifySignature(secret, signature, strconv.FormatInt(past.Unix(), 10), body, DefaultSignatureTolerance)
+
+		// then
+		assert.ErrorIs(t, err, ErrExpiredSignature)
+	})
+
+	t.Run("rejects missing signature", func(t *testing.T) {
+		// when
+		err := VerifySignature(secret, "", "", body, DefaultSignatureTolerance)
+
+		// then
+		assert.ErrorIs(t, err, ErrMissingSignature)
+	})
+}
+
+func TestVerifyRequest(t *testing.T) {
+	// given
+	secret := []byte("secret")
+	body := []byte(`{"id":"1"}`)
+	request := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	SignRequest(request, secret, time.Now(), body)
+
+	// when
+	verified, err := VerifyRequest(request, secret, DefaultSignatureTolerance)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, body, verified)
+	rest, err := io.ReadAll(request.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, body, rest)
+}
@@ -6,9 +6,11 @@ import (
 	"path/filepath"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/tracing"
 )
 
 type inspector struct {
@@ -75,7 +77,10 @@ func (i *inspector) save(ctx context.Context, registry, image string, info *Info
 	}
 }
 
-func (i *inspector) Inspect(ctx context.Context, registry, image string, pullPolicy corev1.PullPolicy, pullSecretNames []string) (*Info, error) {
+func (i *inspector) Inspect(ctx context.Context, registry, image string, pullPolicy corev1.PullPolicy, pullSecretNames []string) (_ *Info, err error) {
+	ctx, span := tracing.Start(ctx, "ImageInspector.Inspect", attribute.String("image", image), attribute.String("registry", registry))
+	defer func() { tracing.End(span, err) }()
+
 	// Load from cache
 	if pullPolicy != corev1.PullAlways {
 		value := i.get(ctx, registry, image)
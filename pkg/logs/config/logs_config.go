@@ -40,6 +40,8 @@ type Config struct {
 	GrpcKeyFile      string `envconfig:"GRPC_KEY_FILE" default:""`
 	GrpcClientCAFile string `envconfig:"GRPC_CLIENT_CA_FILE" default:""`
 
+	StorageType            string `envconfig:"STORAGE_TYPE" default:"minio"`
+	StoragePath            string `envconfig:"STORAGE_PATH" default:"/data/storage"`
 	StorageEndpoint        string `envconfig:"STORAGE_ENDPOINT" default:"localhost:9000"`
 	StorageBucket          string `envconfig:"STORAGE_BUCKET" default:"testkube-logs"`
 	StorageExpiration      int    `envconfig:"STORAGE_EXPIRATION"`
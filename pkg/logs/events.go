@@ -15,6 +15,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/logs/adapter"
 	"github.com/kubeshop/testkube/pkg/logs/events"
 	"github.com/kubeshop/testkube/pkg/logs/state"
+	"github.com/kubeshop/testkube/pkg/redact"
 )
 
 const (
@@ -85,6 +86,8 @@ func (ls *LogsService) handleMessage(ctx context.Context, a adapter.Adapter, id
 			return
 		}
 
+		ls.redact(id, &logChunk)
+
 		err = a.Notify(ctx, id, logChunk)
 		if err != nil {
 			if err := msg.Nak(); err != nil {
@@ -113,6 +116,7 @@ func (ls *LogsService) handleStart(ctx context.Context, subject string) func(msg
 		log := ls.log.With("id", id, "event", "start")
 
 		ls.state.Put(ctx, id, state.LogStatePending)
+		ls.resolveRedactor(ctx, id, msg.Data)
 
 		s, err := ls.logStream.Init(ctx, id)
 		if err != nil {
@@ -214,6 +218,7 @@ func (ls *LogsService) handleStop(ctx context.Context, group string) func(msg *n
 
 		wg.Wait()
 		l.Debugw("wait completed")
+		ls.redactors.Delete(id)
 
 		if stopped > 0 {
 			ls.state.Put(ctx, event.ResourceId, state.LogStateFinished)
@@ -301,3 +306,38 @@ func (ls *LogsService) GetConsumersStats(ctx context.Context) (stats ConsumerSta
 
 	return
 }
+
+// resolveRedactor finds the secrets used by the execution passed in the start event, to mask them in the logs
+func (ls *LogsService) resolveRedactor(ctx context.Context, id string, data []byte) {
+	if ls.secretResolver == nil {
+		return
+	}
+
+	var event testkube.Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		ls.log.Warnw("can't read the execution for the secrets redaction", "id", id, "error", err)
+		return
+	}
+
+	redactor, err := ls.secretResolver.Event(ctx, event)
+	if err != nil {
+		// Redact at least the secrets that have been found
+		ls.log.Warnw("failed to resolve all the secrets to redact", "id", id, "error", err)
+	}
+	if !redactor.Empty() {
+		ls.redactors.Store(id, redactor)
+	}
+}
+
+// redact masks the secrets used by the execution in the log chunk
+func (ls *LogsService) redact(id string, chunk *events.Log) {
+	v, ok := ls.redactors.Load(id)
+	if !ok {
+		return
+	}
+	redactor := v.(*redact.Redactor)
+	chunk.Content = redactor.String(chunk.Content)
+	if chunk.V1 != nil && chunk.V1.Result != nil {
+		chunk.V1 = &testkube.LogV1{Result: redactor.ExecutionResult(chunk.V1.Result)}
+	}
+}
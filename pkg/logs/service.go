@@ -28,6 +28,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/logs/pb"
 	"github.com/kubeshop/testkube/pkg/logs/repository"
 	"github.com/kubeshop/testkube/pkg/logs/state"
+	"github.com/kubeshop/testkube/pkg/redact"
 )
 
 const (
@@ -79,6 +80,11 @@ type LogsService struct {
 	// each pod can have different executionId set of consumers
 	consumerInstances sync.Map
 
+	// secretResolver finds the secrets used by the execution, to mask them in the logs
+	secretResolver *redact.Resolver
+	// redactors is internal executionID => *redact.Redactor map for the running executions
+	redactors sync.Map
+
 	// state manager for keeping logs state (pending, finished)
 	// will allow to distiguish from where load data from in OSS
 	// cloud will be loading always them locally
@@ -182,6 +188,12 @@ func (ls *LogsService) WithRandomPort() *LogsService {
 	return ls
 }
 
+// WithSecretResolver enables masking the secrets used by the executions in the logs
+func (ls *LogsService) WithSecretResolver(resolver *redact.Resolver) *LogsService {
+	ls.secretResolver = resolver
+	return ls
+}
+
 func (ls *LogsService) WithLogsRepositoryFactory(f repository.Factory) *LogsService {
 	ls.logsRepositoryFactory = f
 	return ls
@@ -64,6 +64,11 @@ func MapCRToAPI(cr testsuitesv3.TestSuite) (test testkube.TestSuite) {
 		}
 	}
 
+	retryPolicies := MapAnnotationsToStepRetryPolicies(cr.Annotations)
+	applyStepRetryPolicies(retryPolicies, phaseBefore, test.Before)
+	applyStepRetryPolicies(retryPolicies, phaseSteps, test.Steps)
+	applyStepRetryPolicies(retryPolicies, phaseAfter, test.After)
+
 	test.Description = cr.Spec.Description
 	test.Repeats = int32(cr.Spec.Repeats)
 	test.Labels = cr.Labels
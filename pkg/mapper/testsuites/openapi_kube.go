@@ -138,11 +138,17 @@ func MapTestSuiteUpsertRequestToTestCRD(request testkube.TestSuiteUpsertRequest)
 		return testsuite, err
 	}
 
+	retryPolicies := make(map[string]testkube.ExecutionRetryPolicy)
+	setStepRetryPolicies(retryPolicies, phaseBefore, request.Before)
+	setStepRetryPolicies(retryPolicies, phaseSteps, request.Steps)
+	setStepRetryPolicies(retryPolicies, phaseAfter, request.After)
+
 	return testsuitesv3.TestSuite{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      request.Name,
-			Namespace: request.Namespace,
-			Labels:    request.Labels,
+			Name:        request.Name,
+			Namespace:   request.Namespace,
+			Labels:      request.Labels,
+			Annotations: MapStepRetryPoliciesToAnnotations(retryPolicies, nil),
 		},
 		Spec: testsuitesv3.TestSuiteSpec{
 			Repeats:          int(request.Repeats),
@@ -265,11 +271,14 @@ func MapTestSuiteUpdateRequestToTestCRD(request testkube.TestSuiteUpdateRequest,
 	}
 
 	var err error
+	retryPolicies := MapAnnotationsToStepRetryPolicies(testSuite.Annotations)
 	if request.Before != nil {
 		testSuite.Spec.Before, err = mapTestBatchStepsToCRD(*request.Before)
 		if err != nil {
 			return nil, err
 		}
+
+		setStepRetryPolicies(retryPolicies, phaseBefore, *request.Before)
 	}
 
 	if request.Steps != nil {
@@ -277,6 +286,8 @@ func MapTestSuiteUpdateRequestToTestCRD(request testkube.TestSuiteUpdateRequest,
 		if err != nil {
 			return nil, err
 		}
+
+		setStepRetryPolicies(retryPolicies, phaseSteps, *request.Steps)
 	}
 
 	if request.After != nil {
@@ -284,8 +295,12 @@ func MapTestSuiteUpdateRequestToTestCRD(request testkube.TestSuiteUpdateRequest,
 		if err != nil {
 			return nil, err
 		}
+
+		setStepRetryPolicies(retryPolicies, phaseAfter, *request.After)
 	}
 
+	testSuite.Annotations = MapStepRetryPoliciesToAnnotations(retryPolicies, testSuite.Annotations)
+
 	if request.Labels != nil {
 		testSuite.Labels = *request.Labels
 	}
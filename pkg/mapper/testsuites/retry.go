@@ -0,0 +1,90 @@
+package testsuites
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	// RetryPoliciesAnnotation is the TestSuite CRD annotation keeping retry policies of the test steps (JSON)
+	RetryPoliciesAnnotation = "testsuites.testkube.io/retry-policies"
+
+	phaseBefore = "before"
+	phaseSteps  = "steps"
+	phaseAfter  = "after"
+)
+
+// stepRetryPolicyKey builds the retry policy key for the step in the batch of the test suite phase
+func stepRetryPolicyKey(phase string, batch, step int) string {
+	return fmt.Sprintf("%s.%d.%d", phase, batch, step)
+}
+
+// MapAnnotationsToStepRetryPolicies maps TestSuite CRD annotations to retry policies of the test steps
+func MapAnnotationsToStepRetryPolicies(annotations map[string]string) map[string]testkube.ExecutionRetryPolicy {
+	policies := make(map[string]testkube.ExecutionRetryPolicy)
+	if annotations[RetryPoliciesAnnotation] == "" {
+		return policies
+	}
+
+	// ignore broken annotation, so the test suite is still readable
+	_ = json.Unmarshal([]byte(annotations[RetryPoliciesAnnotation]), &policies)
+	return policies
+}
+
+// MapStepRetryPoliciesToAnnotations maps retry policies of the test steps to TestSuite CRD annotations
+func MapStepRetryPoliciesToAnnotations(policies map[string]testkube.ExecutionRetryPolicy, annotations map[string]string) map[string]string {
+	delete(annotations, RetryPoliciesAnnotation)
+	if len(policies) == 0 {
+		return annotations
+	}
+
+	data, err := json.Marshal(policies)
+	if err != nil {
+		return annotations
+	}
+
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	annotations[RetryPoliciesAnnotation] = string(data)
+	return annotations
+}
+
+// setStepRetryPolicies replaces retry policies of the test suite phase with ones from the test steps
+func setStepRetryPolicies(policies map[string]testkube.ExecutionRetryPolicy, phase string, batches []testkube.TestSuiteBatchStep) {
+	for key := range policies {
+		if strings.HasPrefix(key, phase+".") {
+			delete(policies, key)
+		}
+	}
+
+	for i := range batches {
+		for j, step := range batches[i].Execute {
+			if step.ExecutionRequest != nil && step.ExecutionRequest.RetryPolicy != nil {
+				policies[stepRetryPolicyKey(phase, i, j)] = *step.ExecutionRequest.RetryPolicy
+			}
+		}
+	}
+}
+
+// applyStepRetryPolicies sets retry policies to the test steps of the test suite phase
+func applyStepRetryPolicies(policies map[string]testkube.ExecutionRetryPolicy, phase string, batches []testkube.TestSuiteBatchStep) {
+	for i := range batches {
+		for j := range batches[i].Execute {
+			policy, ok := policies[stepRetryPolicyKey(phase, i, j)]
+			if !ok || batches[i].Execute[j].Test == "" {
+				continue
+			}
+
+			if batches[i].Execute[j].ExecutionRequest == nil {
+				batches[i].Execute[j].ExecutionRequest = &testkube.TestSuiteStepExecutionRequest{}
+			}
+
+			batches[i].Execute[j].ExecutionRequest.RetryPolicy = &policy
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package testsuites
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestStepRetryPolicies_RoundTrip(t *testing.T) {
+	policy := testkube.ExecutionRetryPolicy{MaxAttempts: 3, Backoff: "10s"}
+	request := testkube.TestSuiteUpsertRequest{
+		Name: "suite",
+		Steps: []testkube.TestSuiteBatchStep{
+			{Execute: []testkube.TestSuiteStep{{Test: "first"}}},
+			{Execute: []testkube.TestSuiteStep{
+				{Delay: "1s"},
+				{Test: "second", ExecutionRequest: &testkube.TestSuiteStepExecutionRequest{RetryPolicy: &policy}},
+			}},
+		},
+	}
+
+	cr, err := MapTestSuiteUpsertRequestToTestCRD(request)
+	require.NoError(t, err)
+	assert.Equal(t, `{"steps.1.1":{"maxAttempts":3,"backoff":"10s"}}`, cr.Annotations[RetryPoliciesAnnotation])
+
+	testSuite := MapCRToAPI(cr)
+	assert.Nil(t, testSuite.Steps[0].Execute[0].ExecutionRequest)
+	require.NotNil(t, testSuite.Steps[1].Execute[1].ExecutionRequest)
+	assert.Equal(t, &policy, testSuite.Steps[1].Execute[1].ExecutionRequest.RetryPolicy)
+
+	steps := []testkube.TestSuiteBatchStep{{Execute: []testkube.TestSuiteStep{{Test: "first"}}}}
+	updated, err := MapTestSuiteUpdateRequestToTestCRD(testkube.TestSuiteUpdateRequest{Steps: &steps}, &cr)
+	require.NoError(t, err)
+	assert.NotContains(t, updated.Annotations, RetryPoliciesAnnotation)
+}
@@ -20,6 +20,81 @@ func MapCRDToAPI(item executorv1.Webhook) testkube.Webhook {
 		PayloadTemplate:          item.Spec.PayloadTemplate,
 		PayloadTemplateReference: item.Spec.PayloadTemplateReference,
 		Headers:                  item.Spec.Headers,
+		RetryPolicy:              MapRetryPolicyCRDToAPI(item.Spec.RetryPolicy),
+		SigningSecretRef:         MapSigningSecretRefCRDToAPI(item.Spec.SigningSecretRef),
+		Tls:                      MapTLSCRDToAPI(item.Spec.TLS),
+	}
+}
+
+// MapRetryPolicyCRDToAPI maps Webhook CRD WebhookRetryPolicy to OpenAPI spec WebhookRetryPolicy
+func MapRetryPolicyCRDToAPI(policy *executorv1.WebhookRetryPolicy) *testkube.WebhookRetryPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &testkube.WebhookRetryPolicy{
+		MaxAttempts: policy.MaxAttempts,
+		Backoff:     policy.Backoff,
+		MaxBackoff:  policy.MaxBackoff,
+	}
+}
+
+// MapRetryPolicyAPIToCRD maps OpenAPI spec WebhookRetryPolicy to Webhook CRD WebhookRetryPolicy
+func MapRetryPolicyAPIToCRD(policy *testkube.WebhookRetryPolicy) *executorv1.WebhookRetryPolicy {
+	if policy == nil || (policy.MaxAttempts == 0 && policy.Backoff == "" && policy.MaxBackoff == "") {
+		return nil
+	}
+
+	return &executorv1.WebhookRetryPolicy{
+		MaxAttempts: policy.MaxAttempts,
+		Backoff:     policy.Backoff,
+		MaxBackoff:  policy.MaxBackoff,
+	}
+}
+
+// MapSigningSecretRefCRDToAPI maps Webhook CRD WebhookSecretRef to OpenAPI spec SecretRef
+func MapSigningSecretRefCRDToAPI(secretRef *executorv1.WebhookSecretRef) *testkube.SecretRef {
+	if secretRef == nil {
+		return nil
+	}
+
+	return &testkube.SecretRef{
+		Name: secretRef.Name,
+		Key:  secretRef.Key,
+	}
+}
+
+// MapSigningSecretRefAPIToCRD maps OpenAPI spec SecretRef to Webhook CRD WebhookSecretRef
+func MapSigningSecretRefAPIToCRD(secretRef *testkube.SecretRef) *executorv1.WebhookSecretRef {
+	if secretRef == nil || secretRef.Name == "" {
+		return nil
+	}
+
+	return &executorv1.WebhookSecretRef{
+		Name: secretRef.Name,
+		Key:  secretRef.Key,
+	}
+}
+
+// MapTLSCRDToAPI maps Webhook CRD WebhookTLS to OpenAPI spec WebhookTls
+func MapTLSCRDToAPI(tls *executorv1.WebhookTLS) *testkube.WebhookTls {
+	if tls == nil {
+		return nil
+	}
+
+	return &testkube.WebhookTls{
+		SecretName: tls.SecretName,
+	}
+}
+
+// MapTLSAPIToCRD maps OpenAPI spec WebhookTls to Webhook CRD WebhookTLS
+func MapTLSAPIToCRD(tls *testkube.WebhookTls) *executorv1.WebhookTLS {
+	if tls == nil || tls.SecretName == "" {
+		return nil
+	}
+
+	return &executorv1.WebhookTLS{
+		SecretName: tls.SecretName,
 	}
 }
 
@@ -55,6 +130,9 @@ func MapAPIToCRD(request testkube.WebhookCreateRequest) executorv1.Webhook {
 			PayloadTemplate:          request.PayloadTemplate,
 			PayloadTemplateReference: request.PayloadTemplateReference,
 			Headers:                  request.Headers,
+			RetryPolicy:              MapRetryPolicyAPIToCRD(request.RetryPolicy),
+			SigningSecretRef:         MapSigningSecretRefAPIToCRD(request.SigningSecretRef),
+			TLS:                      MapTLSAPIToCRD(request.Tls),
 		},
 	}
 }
@@ -121,6 +199,18 @@ func MapUpdateToSpec(request testkube.WebhookUpdateRequest, webhook *executorv1.
 		webhook.Spec.Headers = *request.Headers
 	}
 
+	if request.RetryPolicy != nil {
+		webhook.Spec.RetryPolicy = MapRetryPolicyAPIToCRD(*request.RetryPolicy)
+	}
+
+	if request.SigningSecretRef != nil {
+		webhook.Spec.SigningSecretRef = MapSigningSecretRefAPIToCRD(*request.SigningSecretRef)
+	}
+
+	if request.Tls != nil {
+		webhook.Spec.TLS = MapTLSAPIToCRD(*request.Tls)
+	}
+
 	return webhook
 }
 
@@ -170,5 +260,14 @@ func MapSpecToUpdate(webhook *executorv1.Webhook) (request testkube.WebhookUpdat
 	request.Labels = &webhook.Labels
 	request.Headers = &webhook.Spec.Headers
 
+	retryPolicy := MapRetryPolicyCRDToAPI(webhook.Spec.RetryPolicy)
+	request.RetryPolicy = &retryPolicy
+
+	signingSecretRef := MapSigningSecretRefCRDToAPI(webhook.Spec.SigningSecretRef)
+	request.SigningSecretRef = &signingSecretRef
+
+	tls := MapTLSCRDToAPI(webhook.Spec.TLS)
+	request.Tls = &tls
+
 	return request
 }
@@ -0,0 +1,66 @@
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	executorv1 "github.com/kubeshop/testkube-operator/api/executor/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestMapAPIToCRD(t *testing.T) {
+	request := testkube.WebhookCreateRequest{
+		Name:             "webhook-1",
+		Namespace:        "testkube",
+		Uri:              "https://example.com",
+		Events:           []testkube.EventType{*testkube.EventStartTest},
+		RetryPolicy:      &testkube.WebhookRetryPolicy{MaxAttempts: 3},
+		SigningSecretRef: &testkube.SecretRef{Name: "webhook-secret", Key: "hmac"},
+		Tls:              &testkube.WebhookTls{SecretName: "webhook-tls"},
+	}
+
+	webhook := MapAPIToCRD(request)
+
+	assert.Empty(t, webhook.Annotations)
+	assert.Equal(t, &executorv1.WebhookSecretRef{Name: "webhook-secret", Key: "hmac"}, webhook.Spec.SigningSecretRef)
+	assert.Equal(t, &executorv1.WebhookTLS{SecretName: "webhook-tls"}, webhook.Spec.TLS)
+
+	result := MapCRDToAPI(webhook)
+
+	assert.Equal(t, request.SigningSecretRef, result.SigningSecretRef)
+	assert.Equal(t, request.Tls, result.Tls)
+	assert.Equal(t, request.RetryPolicy, result.RetryPolicy)
+}
+
+func TestMapUpdateToSpec(t *testing.T) {
+	webhook := MapAPIToCRD(testkube.WebhookCreateRequest{
+		Name:             "webhook-1",
+		Uri:              "https://example.com",
+		SigningSecretRef: &testkube.SecretRef{Name: "webhook-secret"},
+		Tls:              &testkube.WebhookTls{SecretName: "webhook-tls"},
+	})
+
+	t.Run("keep not provided fields", func(t *testing.T) {
+		request := MapSpecToUpdate(webhook.DeepCopy())
+		assert.Equal(t, &testkube.SecretRef{Name: "webhook-secret"}, *request.SigningSecretRef)
+		assert.Equal(t, &testkube.WebhookTls{SecretName: "webhook-tls"}, *request.Tls)
+
+		result := MapUpdateToSpec(testkube.WebhookUpdateRequest{}, webhook.DeepCopy())
+		assert.Equal(t, webhook.Spec.SigningSecretRef, result.Spec.SigningSecretRef)
+		assert.Equal(t, webhook.Spec.TLS, result.Spec.TLS)
+	})
+
+	t.Run("update signing secret and remove TLS", func(t *testing.T) {
+		signingSecretRef := &testkube.SecretRef{Name: "other-secret", Key: "hmac"}
+		var tls *testkube.WebhookTls
+
+		result := MapUpdateToSpec(testkube.WebhookUpdateRequest{
+			SigningSecretRef: &signingSecretRef,
+			Tls:              &tls,
+		}, webhook.DeepCopy())
+
+		assert.Equal(t, &executorv1.WebhookSecretRef{Name: "other-secret", Key: "hmac"}, result.Spec.SigningSecretRef)
+		assert.Nil(t, result.Spec.TLS)
+	})
+}
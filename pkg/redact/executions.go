@@ -0,0 +1,48 @@
+package redact
+
+import (
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// FromVariables creates the Redactor for the values of the secret variables
+func FromVariables(variables map[string]testkube.Variable) *Redactor {
+	var secrets []string
+	for _, variable := range variables {
+		if variable.IsSecret() {
+			secrets = append(secrets, variable.Value)
+		}
+	}
+	return New(secrets...)
+}
+
+// ExecutionResult returns the copy of the test execution result, with the secrets redacted in the output and error messages
+func (r *Redactor) ExecutionResult(result *testkube.ExecutionResult) *testkube.ExecutionResult {
+	if r.Empty() || result == nil {
+		return result
+	}
+	result = result.GetDeepCopy()
+	result.Output = r.String(result.Output)
+	result.ErrorMessage = r.String(result.ErrorMessage)
+	if len(result.Steps) > 0 {
+		steps := make([]testkube.ExecutionStepResult, len(result.Steps))
+		for i, step := range result.Steps {
+			steps[i] = step
+			if len(step.AssertionResults) == 0 {
+				continue
+			}
+			steps[i].AssertionResults = make([]testkube.AssertionResult, len(step.AssertionResults))
+			for j, assertion := range step.AssertionResults {
+				assertion.ErrorMessage = r.String(assertion.ErrorMessage)
+				steps[i].AssertionResults[j] = assertion
+			}
+		}
+		result.Steps = steps
+	}
+	return result
+}
+
+// Execution returns the copy of the test execution, with the secrets redacted in its result
+func (r *Redactor) Execution(execution testkube.Execution) testkube.Execution {
+	execution.ExecutionResult = r.ExecutionResult(execution.ExecutionResult)
+	return execution
+}
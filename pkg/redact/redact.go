@@ -0,0 +1,184 @@
+// Package redact masks the secret values in the logs, outputs and payloads,
+// before they are persisted or sent outside the cluster.
+package redact
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	// Mask is the text replacing the secret values
+	Mask = "********"
+	// MinSecretLength is the minimum length of the value to redact, so the short values are not masking everything
+	MinSecretLength = 4
+	// MaxReaderBuffer is the size of the long line part processed at once by the Reader
+	MaxReaderBuffer = 64 * 1024
+)
+
+// Redactor replaces the secret values, along with their base64, URL-encoded and JSON-escaped forms
+type Redactor struct {
+	values   []string
+	replacer *strings.Replacer
+}
+
+// New creates the Redactor for the provided secret values
+func New(secrets ...string) *Redactor {
+	unique := make(map[string]struct{})
+	for _, secret := range secrets {
+		for _, value := range variants(secret) {
+			if len(value) >= MinSecretLength {
+				unique[value] = struct{}{}
+			}
+		}
+	}
+	values := make([]string, 0, len(unique))
+	for value := range unique {
+		values = append(values, value)
+	}
+	// The longest values go first, so the replacer prefers them when the values overlap
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+
+	r := &Redactor{values: values}
+	if len(values) > 0 {
+		pairs := make([]string, 0, len(values)*2)
+		for _, value := range values {
+			pairs = append(pairs, value, Mask)
+		}
+		r.replacer = strings.NewReplacer(pairs...)
+	}
+	return r
+}
+
+// variants returns the forms in which the secret value may appear in the logs
+func variants(secret string) []string {
+	secret = strings.TrimSpace(secret)
+	if secret == "" {
+		return nil
+	}
+	return []string{
+		secret,
+		base64.StdEncoding.EncodeToString([]byte(secret)),
+		base64.RawStdEncoding.EncodeToString([]byte(secret)),
+		base64.URLEncoding.EncodeToString([]byte(secret)),
+		base64.RawURLEncoding.EncodeToString([]byte(secret)),
+		url.QueryEscape(secret),
+		url.PathEscape(secret),
+		jsonEscape(secret, true),
+		jsonEscape(secret, false),
+	}
+}
+
+// jsonEscape returns the secret value as it appears inside the JSON string
+func jsonEscape(secret string, escapeHTML bool) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(escapeHTML)
+	_ = encoder.Encode(secret)
+	return strings.TrimSuffix(strings.TrimPrefix(strings.TrimSuffix(buf.String(), "\n"), `"`), `"`)
+}
+
+// Empty checks if there is nothing to redact
+func (r *Redactor) Empty() bool {
+	return r == nil || r.replacer == nil
+}
+
+// String redacts the secrets in the text
+func (r *Redactor) String(s string) string {
+	if r.Empty() || s == "" {
+		return s
+	}
+	return r.replacer.Replace(s)
+}
+
+// Strings redacts the secrets in each of the values
+func (r *Redactor) Strings(values []string) []string {
+	if r.Empty() {
+		return values
+	}
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = r.String(value)
+	}
+	return result
+}
+
+// Bytes redacts the secrets in the data
+func (r *Redactor) Bytes(p []byte) []byte {
+	if r.Empty() || len(p) == 0 {
+		return p
+	}
+	return []byte(r.replacer.Replace(string(p)))
+}
+
+// Reader redacts the secrets in the stream. It is processing the stream line by line,
+// so the secrets split between the chunks are detected too. The long lines are processed in parts,
+// keeping the overlap of the longest secret length between them.
+func (r *Redactor) Reader(reader io.Reader) io.Reader {
+	if r.Empty() {
+		return reader
+	}
+	size := max(MaxReaderBuffer, 2*len(r.values[0]))
+	return &redactReader{redactor: r, src: bufio.NewReaderSize(reader, size)}
+}
+
+// boundary moves the cut after the secret crossing it, so the secret is not split between the parts
+func (r *Redactor) boundary(data []byte, cut int) int {
+	for _, value := range r.values {
+		for from := max(0, cut-len(value)+1); from < cut; {
+			i := bytes.Index(data[from:], []byte(value))
+			if i < 0 || from+i >= cut {
+				break
+			}
+			cut = max(cut, from+i+len(value))
+			from += i + 1
+		}
+	}
+	return cut
+}
+
+type redactReader struct {
+	redactor *Redactor
+	src      *bufio.Reader
+	// pending is the end of the long line, that may contain the beginning of the secret
+	pending []byte
+	buf     []byte
+	err     error
+}
+
+func (r *redactReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		r.fill()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	if len(r.buf) == 0 {
+		return n, r.err
+	}
+	return n, nil
+}
+
+func (r *redactReader) fill() {
+	chunk, err := r.src.ReadSlice('\n')
+	r.pending = append(r.pending, chunk...)
+	if err == bufio.ErrBufferFull {
+		cut := r.redactor.boundary(r.pending, len(r.pending)-len(r.redactor.values[0])+1)
+		r.buf = r.redactor.Bytes(r.pending[:cut])
+		r.pending = append([]byte(nil), r.pending[cut:]...)
+		return
+	}
+	r.buf = r.redactor.Bytes(r.pending)
+	r.pending = nil
+	r.err = err
+}
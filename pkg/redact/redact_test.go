@@ -0,0 +1,73 @@
+package redact
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactor_String(t *testing.T) {
+	secret := "p@ss word/1"
+	r := New(secret, "", "abc")
+
+	assert.Equal(t, "password="+Mask, r.String("password="+secret))
+	assert.Equal(t, "auth "+Mask, r.String("auth "+base64.StdEncoding.EncodeToString([]byte(secret))))
+	assert.Equal(t, "auth "+Mask, r.String("auth "+base64.RawURLEncoding.EncodeToString([]byte(secret))))
+	assert.Equal(t, "?q="+Mask, r.String("?q="+url.QueryEscape(secret)))
+	assert.Equal(t, "/"+Mask, r.String("/"+url.PathEscape(secret)))
+	// too short values are ignored
+	assert.Equal(t, "abc", r.String("abc"))
+}
+
+func TestRedactor_Overlapping(t *testing.T) {
+	r := New("token", "token-with-suffix")
+
+	assert.Equal(t, Mask+" "+Mask, r.String("token-with-suffix token"))
+}
+
+func TestRedactor_Empty(t *testing.T) {
+	var nilRedactor *Redactor
+
+	assert.True(t, nilRedactor.Empty())
+	assert.True(t, New().Empty())
+	assert.True(t, New("", " ").Empty())
+	assert.Equal(t, "text", nilRedactor.String("text"))
+	assert.Equal(t, []byte("text"), New().Bytes([]byte("text")))
+}
+
+func TestRedactor_Reader(t *testing.T) {
+	r := New("secret-value")
+	input := "line 1 secret-value\nline 2 secret-value\nlast secret-value"
+
+	// Read byte by byte, so the secrets are split between the chunks
+	result, err := io.ReadAll(r.Reader(iotest.OneByteReader(strings.NewReader(input))))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "line 1 "+Mask+"\nline 2 "+Mask+"\nlast "+Mask, string(result))
+}
+
+func TestRedactor_JSON(t *testing.T) {
+	secret := `p"ss\word<1>`
+	r := New(secret)
+
+	payload, _ := json.Marshal(map[string]string{"password": secret})
+	assert.Equal(t, `{"password":"`+Mask+`"}`, r.String(string(payload)))
+	assert.Equal(t, `{"password":"`+Mask+`"}`, r.String(`{"password":"p\"ss\\word<1>"}`))
+}
+
+func TestRedactor_ReaderLongLine(t *testing.T) {
+	r := New("secret-value")
+	// The secret is put on the boundaries of the buffer, so it is split between the parts
+	input := strings.Repeat("x", MaxReaderBuffer-5) + "secret-value" + strings.Repeat("y", MaxReaderBuffer-20) + "secret-value\n"
+
+	result, err := io.ReadAll(r.Reader(strings.NewReader(input)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("x", MaxReaderBuffer-5)+Mask+strings.Repeat("y", MaxReaderBuffer-20)+Mask+"\n", string(result))
+}
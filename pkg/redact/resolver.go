@@ -0,0 +1,268 @@
+package redact
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	errors2 "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// cacheTTL is how long the secret values are reused, so the frequent updates of the execution are not querying the cluster
+const cacheTTL = time.Minute
+
+// Resolver finds the values of all the secrets used by the execution:
+// the secrets referenced by its Job (secret envs, env secrets, secret variables and the TestWorkflow secretKeyRef envs),
+// along with the secret variables and references stored in the execution itself.
+type Resolver struct {
+	clientSet kubernetes.Interface
+	namespace string
+	now       func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedSecret
+}
+
+type cachedSecret struct {
+	data      map[string]string
+	err       error
+	expiresAt time.Time
+}
+
+// NewResolver creates the Resolver, using the namespace as a default for the Jobs and secrets
+func NewResolver(clientSet kubernetes.Interface, namespace string) *Resolver {
+	return &Resolver{
+		clientSet: clientSet,
+		namespace: namespace,
+		now:       time.Now,
+		cache:     make(map[string]cachedSecret),
+	}
+}
+
+// PodSpec builds the Redactor for the secrets referenced in the containers environment and volumes
+func (r *Resolver) PodSpec(ctx context.Context, namespace string, spec *corev1.PodSpec) (*Redactor, error) {
+	c := r.collector(ctx, namespace)
+	c.podSpec(spec)
+	return c.redactor()
+}
+
+// Execution builds the Redactor for the test execution
+func (r *Resolver) Execution(ctx context.Context, execution *testkube.Execution) (*Redactor, error) {
+	c := r.collector(ctx, common.GetOr(execution.TestNamespace, r.namespace))
+	c.execution(execution)
+	return c.redactor()
+}
+
+// TestSuiteExecution builds the Redactor for the test suite execution, along with all of its test executions
+func (r *Resolver) TestSuiteExecution(ctx context.Context, execution *testkube.TestSuiteExecution) (*Redactor, error) {
+	c := r.collector(ctx, r.namespace)
+	c.variables(execution.Variables)
+	for _, batch := range execution.ExecuteStepResults {
+		for _, step := range batch.Execute {
+			if step.Execution != nil && step.Execution.Id != "" {
+				c.withNamespace(common.GetOr(step.Execution.TestNamespace, r.namespace)).execution(step.Execution)
+			}
+		}
+	}
+	for _, step := range execution.StepResults {
+		if step.Execution != nil && step.Execution.Id != "" {
+			c.withNamespace(common.GetOr(step.Execution.TestNamespace, r.namespace)).execution(step.Execution)
+		}
+	}
+	return c.redactor()
+}
+
+// TestWorkflowExecution builds the Redactor for the TestWorkflow execution
+func (r *Resolver) TestWorkflowExecution(ctx context.Context, execution *testkube.TestWorkflowExecution) (*Redactor, error) {
+	c := r.collector(ctx, r.namespace)
+	c.job(execution.Id)
+	workflow := execution.ResolvedWorkflow
+	if workflow == nil {
+		workflow = execution.Workflow
+	}
+	if workflow != nil && workflow.Spec != nil {
+		var spec interface{}
+		if v, err := json.Marshal(workflow.Spec); err == nil && json.Unmarshal(v, &spec) == nil {
+			c.references(spec)
+		}
+	}
+	return c.redactor()
+}
+
+// Event builds the Redactor for the execution attached to the event
+func (r *Resolver) Event(ctx context.Context, event testkube.Event) (*Redactor, error) {
+	switch {
+	case event.TestExecution != nil:
+		return r.Execution(ctx, event.TestExecution)
+	case event.TestSuiteExecution != nil:
+		return r.TestSuiteExecution(ctx, event.TestSuiteExecution)
+	case event.TestWorkflowExecution != nil:
+		return r.TestWorkflowExecution(ctx, event.TestWorkflowExecution)
+	}
+	return New(), nil
+}
+
+func (r *Resolver) collector(ctx context.Context, namespace string) *collector {
+	return &collector{resolver: r, ctx: ctx, namespace: namespace, values: new([]string), errs: new([]error)}
+}
+
+// getSecret reads the secret data, caching it for a short time
+func (r *Resolver) getSecret(ctx context.Context, namespace, name string) (map[string]string, error) {
+	key := namespace + "/" + name
+	now := r.now()
+
+	r.mu.Lock()
+	cached, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.data, cached.err
+	}
+
+	var data map[string]string
+	secret, err := r.clientSet.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		data = make(map[string]string, len(secret.Data)+len(secret.StringData))
+		for k, v := range secret.Data {
+			data[k] = string(v)
+		}
+		for k, v := range secret.StringData {
+			data[k] = v
+		}
+	} else {
+		err = errors2.Wrapf(err, "getting secret %s", key)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, v := range r.cache {
+		if !now.Before(v.expiresAt) {
+			delete(r.cache, k)
+		}
+	}
+	r.cache[key] = cachedSecret{data: data, err: err, expiresAt: now.Add(cacheTTL)}
+	return data, err
+}
+
+// collector gathers the secret values, along with the errors of reading them
+type collector struct {
+	resolver  *Resolver
+	ctx       context.Context
+	namespace string
+	values    *[]string
+	errs      *[]error
+}
+
+func (c *collector) withNamespace(namespace string) *collector {
+	return &collector{resolver: c.resolver, ctx: c.ctx, namespace: namespace, values: c.values, errs: c.errs}
+}
+
+func (c *collector) redactor() (*Redactor, error) {
+	return New(*c.values...), errors.Join(*c.errs...)
+}
+
+func (c *collector) add(values ...string) {
+	*c.values = append(*c.values, values...)
+}
+
+func (c *collector) secret(namespace, name, key string) {
+	if name == "" {
+		return
+	}
+	data, err := c.resolver.getSecret(c.ctx, common.GetOr(namespace, c.namespace), name)
+	if err != nil {
+		*c.errs = append(*c.errs, err)
+		return
+	}
+	if key != "" {
+		c.add(data[key])
+		return
+	}
+	for _, value := range data {
+		c.add(value)
+	}
+}
+
+func (c *collector) execution(execution *testkube.Execution) {
+	c.job(execution.Id)
+	c.variables(execution.Variables)
+}
+
+func (c *collector) variables(variables map[string]testkube.Variable) {
+	for _, variable := range variables {
+		switch {
+		case variable.SecretRef != nil:
+			c.secret(variable.SecretRef.Namespace, variable.SecretRef.Name, variable.SecretRef.Key)
+		case variable.IsSecret():
+			c.add(variable.Value)
+		}
+	}
+}
+
+// job reads the secrets referenced by the execution Job. The Job may be already gone, so it is not an error.
+func (c *collector) job(name string) {
+	if name == "" {
+		return
+	}
+	job, err := c.resolver.clientSet.BatchV1().Jobs(c.namespace).Get(c.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			*c.errs = append(*c.errs, errors2.Wrapf(err, "getting job %s/%s", c.namespace, name))
+		}
+		return
+	}
+	c.podSpec(&job.Spec.Template.Spec)
+}
+
+func (c *collector) podSpec(spec *corev1.PodSpec) {
+	if spec == nil {
+		return
+	}
+	for _, container := range append(spec.InitContainers, spec.Containers...) {
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				c.secret("", env.ValueFrom.SecretKeyRef.Name, env.ValueFrom.SecretKeyRef.Key)
+			}
+		}
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil {
+				c.secret("", envFrom.SecretRef.Name, "")
+			}
+		}
+	}
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil {
+			c.secret("", volume.Secret.SecretName, "")
+		}
+	}
+}
+
+// references finds the "secretKeyRef" and "secretRef" objects in the generic specification
+func (c *collector) references(v interface{}) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for _, field := range []string{"secretKeyRef", "secretRef"} {
+			if ref, ok := value[field].(map[string]interface{}); ok {
+				name, _ := ref["name"].(string)
+				key, _ := ref["key"].(string)
+				namespace, _ := ref["namespace"].(string)
+				c.secret(namespace, name, key)
+			}
+		}
+		for _, item := range value {
+			c.references(item)
+		}
+	case []interface{}:
+		for _, item := range value {
+			c.references(item)
+		}
+	}
+}
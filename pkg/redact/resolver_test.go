@@ -0,0 +1,109 @@
+package redact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func newSecret(namespace, name string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func TestResolver_Execution(t *testing.T) {
+	clientSet := fake.NewSimpleClientset(
+		newSecret("tests", "secret-envs", map[string]string{"API_TOKEN": "secret-env-value", "OTHER": "not-used-value"}),
+		newSecret("tests", "env-secrets", map[string]string{"a": "env-secret-value"}),
+		newSecret("tests", "variables", map[string]string{"password": "variable-value"}),
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "execution-1", Namespace: "tests"},
+			Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name: "runner",
+					Env: []corev1.EnvVar{{
+						Name: "API_TOKEN",
+						ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "secret-envs"},
+							Key:                  "API_TOKEN",
+						}},
+					}},
+					EnvFrom: []corev1.EnvFromSource{{
+						SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "env-secrets"}},
+					}},
+				}},
+			}}},
+		},
+	)
+	resolver := NewResolver(clientSet, "testkube")
+
+	redactor, err := resolver.Execution(context.Background(), &testkube.Execution{
+		Id:            "execution-1",
+		TestNamespace: "tests",
+		Variables: map[string]testkube.Variable{
+			"password": testkube.NewSecretVariableReference("password", "variables", "password"),
+			"inline":   testkube.NewSecretVariable("inline", "inline-value"),
+			"basic":    testkube.NewBasicVariable("basic", "basic-value"),
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		Mask+" "+Mask+" "+Mask+" "+Mask+" not-used-value basic-value",
+		redactor.String("secret-env-value env-secret-value variable-value inline-value not-used-value basic-value"))
+}
+
+func TestResolver_ExecutionWithoutJob(t *testing.T) {
+	resolver := NewResolver(fake.NewSimpleClientset(), "testkube")
+
+	redactor, err := resolver.Execution(context.Background(), &testkube.Execution{
+		Id: "finished",
+		Variables: map[string]testkube.Variable{
+			"inline":  testkube.NewSecretVariable("inline", "inline-value"),
+			"missing": testkube.NewSecretVariableReference("missing", "missing", "key"),
+		},
+	})
+
+	// The missing secret is reported, but the known ones are still redacted
+	assert.Error(t, err)
+	assert.Equal(t, Mask, redactor.String("inline-value"))
+}
+
+func TestResolver_TestWorkflowExecution(t *testing.T) {
+	clientSet := fake.NewSimpleClientset(
+		newSecret("testkube", "credentials", map[string]string{"token": "workflow-token"}),
+	)
+	resolver := NewResolver(clientSet, "testkube")
+
+	redactor, err := resolver.TestWorkflowExecution(context.Background(), &testkube.TestWorkflowExecution{
+		Id: "execution-1",
+		ResolvedWorkflow: &testkube.TestWorkflow{Spec: &testkube.TestWorkflowSpec{
+			Steps: []testkube.TestWorkflowStep{{
+				Container: &testkube.TestWorkflowContainerConfig{
+					Env: []testkube.EnvVar{{
+						Name: "TOKEN",
+						ValueFrom: &testkube.EnvVarSource{
+							SecretKeyRef: &testkube.EnvVarSourceSecretKeyRef{Name: "credentials", Key: "token"},
+						},
+					}},
+				},
+			}},
+		}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Authorization: Bearer "+Mask, redactor.String("Authorization: Bearer workflow-token"))
+}
@@ -0,0 +1,39 @@
+package reports
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// ExecutionStatus maps the test case status to the status of the Test execution step
+func ExecutionStatus(status Status) string {
+	switch status {
+	case StatusPassed:
+		return string(testkube.PASSED_ExecutionStatus)
+	case StatusSkipped:
+		return string(testkube.SKIPPED_ExecutionStatus)
+	default:
+		return string(testkube.FAILED_ExecutionStatus)
+	}
+}
+
+// ExecutionSteps maps the test cases of the reports to the Test execution steps
+func ExecutionSteps(reports ...Report) []testkube.ExecutionStepResult {
+	steps := make([]testkube.ExecutionStepResult, 0)
+	for _, r := range reports {
+		for _, c := range r.Cases {
+			name := c.Name
+			if c.Suite != "" {
+				name = fmt.Sprintf("%s - %s", c.Suite, c.Name)
+			}
+			steps = append(steps, testkube.ExecutionStepResult{
+				Name:     name,
+				Duration: (time.Duration(c.DurationMs) * time.Millisecond).String(),
+				Status:   ExecutionStatus(c.Status),
+			})
+		}
+	}
+	return steps
+}
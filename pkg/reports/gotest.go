@@ -0,0 +1,63 @@
+package reports
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+type goTestEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// ParseGoTest reads the test cases from `go test -json` (test2json) output
+func ParseGoTest(reader io.Reader) ([]Case, error) {
+	cases := make([]Case, 0)
+	output := make(map[string]*strings.Builder)
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+		var event goTestEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			return nil, err
+		}
+		if event.Test == "" {
+			continue
+		}
+		key := event.Package + "/" + event.Test
+		switch event.Action {
+		case "output":
+			if output[key] == nil {
+				output[key] = &strings.Builder{}
+			}
+			output[key].WriteString(event.Output)
+		case "pass", "fail", "skip":
+			c := Case{
+				Name:       event.Test,
+				ClassName:  event.Package,
+				Status:     StatusPassed,
+				DurationMs: int64(event.Elapsed * 1000),
+			}
+			if event.Action == "fail" {
+				c.Status = StatusFailed
+			} else if event.Action == "skip" {
+				c.Status = StatusSkipped
+			}
+			if c.Status != StatusPassed && output[key] != nil {
+				c.Message = strings.TrimSpace(output[key].String())
+			}
+			delete(output, key)
+			cases = append(cases, c)
+		}
+	}
+	return cases, scanner.Err()
+}
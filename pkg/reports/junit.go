@@ -0,0 +1,55 @@
+package reports
+
+import (
+	"io"
+
+	"github.com/joshdk/go-junit"
+)
+
+// ParseJUnit reads the test cases from JUnit/xUnit XML report
+func ParseJUnit(reader io.Reader) ([]Case, error) {
+	suites, err := junit.IngestReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	cases := make([]Case, 0)
+	for _, suite := range suites {
+		cases = append(cases, junitCases(suite)...)
+	}
+	return cases, nil
+}
+
+func junitCases(suite junit.Suite) []Case {
+	cases := make([]Case, 0, len(suite.Tests))
+	for _, test := range suite.Tests {
+		c := Case{
+			Suite:      suite.Name,
+			Name:       test.Name,
+			ClassName:  test.Classname,
+			Status:     mapJUnitStatus(test.Status),
+			DurationMs: test.Duration.Milliseconds(),
+			Message:    test.Message,
+		}
+		if c.Message == "" && test.Error != nil {
+			c.Message = test.Error.Error()
+		}
+		cases = append(cases, c)
+	}
+	for _, nested := range suite.Suites {
+		cases = append(cases, junitCases(nested)...)
+	}
+	return cases
+}
+
+func mapJUnitStatus(status junit.Status) Status {
+	switch status {
+	case junit.StatusPassed:
+		return StatusPassed
+	case junit.StatusSkipped:
+		return StatusSkipped
+	case junit.StatusFailed:
+		return StatusFailed
+	default:
+		return StatusError
+	}
+}
@@ -0,0 +1,181 @@
+// Package reports parses test reports produced by test frameworks into a common structure
+package reports
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Format is the format of the test report
+type Format string
+
+const (
+	FormatJUnit  Format = "junit"
+	FormatTAP    Format = "tap"
+	FormatGoTest Format = "gotest"
+)
+
+// Status is the result of a single test case
+type Status string
+
+const (
+	StatusPassed  Status = "passed"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+	StatusError   Status = "error"
+)
+
+// Case is the result of a single test case
+type Case struct {
+	Suite      string `json:"suite,omitempty"`
+	Name       string `json:"name"`
+	ClassName  string `json:"classname,omitempty"`
+	Status     Status `json:"status"`
+	DurationMs int64  `json:"durationMs,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
+
+// Summary contains the totals for the test cases
+type Summary struct {
+	Tests      int   `json:"tests"`
+	Passed     int   `json:"passed"`
+	Failed     int   `json:"failed"`
+	Skipped    int   `json:"skipped"`
+	Errored    int   `json:"errored"`
+	DurationMs int64 `json:"durationMs"`
+}
+
+// Report is the parsed test report file
+type Report struct {
+	Format  Format  `json:"format"`
+	File    string  `json:"file,omitempty"`
+	Part    int     `json:"part,omitempty"`
+	Summary Summary `json:"summary"`
+	Cases   []Case  `json:"cases"`
+}
+
+// Parser reads the test cases from the report
+type Parser func(reader io.Reader) ([]Case, error)
+
+var parsers = map[Format]Parser{
+	FormatJUnit:  ParseJUnit,
+	FormatTAP:    ParseTAP,
+	FormatGoTest: ParseGoTest,
+}
+
+// IsSupported checks if the report format is supported
+func IsSupported(format Format) bool {
+	_, ok := parsers[format]
+	return ok
+}
+
+// Parse reads the report in selected format
+func Parse(format Format, reader io.Reader) (*Report, error) {
+	parser, ok := parsers[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported report format: %s", format)
+	}
+	cases, err := parser(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &Report{Format: format, Summary: Summarize(cases), Cases: cases}, nil
+}
+
+// ParseFile reads the report file in selected format
+func ParseFile(format Format, filePath string) (*Report, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	report, err := Parse(format, file)
+	if err != nil {
+		return nil, err
+	}
+	report.File = filePath
+	return report, nil
+}
+
+// ParseDir reads all the report files with selected extension in the directory tree,
+// the files that are not valid reports are ignored
+func ParseDir(format Format, dirPath string, ext string) ([]Report, error) {
+	result := make([]Report, 0)
+	err := filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ext {
+			return nil
+		}
+		report, err := ParseFile(format, path)
+		if err == nil {
+			result = append(result, *report)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// Summarize computes the totals for the test cases
+func Summarize(cases []Case) (summary Summary) {
+	for _, c := range cases {
+		summary.Tests++
+		summary.DurationMs += c.DurationMs
+		switch c.Status {
+		case StatusPassed:
+			summary.Passed++
+		case StatusFailed:
+			summary.Failed++
+		case StatusSkipped:
+			summary.Skipped++
+		case StatusError:
+			summary.Errored++
+		}
+	}
+	return summary
+}
+
+// FailedCases returns only the failed and errored test cases
+func (r *Report) FailedCases() []Case {
+	result := make([]Case, 0)
+	for _, c := range r.Cases {
+		if c.Status == StatusFailed || c.Status == StatusError {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Chunks splits the report into parts with limited number of test cases,
+// so each of them may be emitted separately. The summary of each part covers only its own test cases.
+func (r *Report) Chunks(size int) []Report {
+	if size <= 0 || len(r.Cases) <= size {
+		return []Report{*r}
+	}
+	result := make([]Report, 0, (len(r.Cases)+size-1)/size)
+	for i := 0; i < len(r.Cases); i += size {
+		cases := r.Cases[i:min(i+size, len(r.Cases))]
+		result = append(result, Report{
+			Format:  r.Format,
+			File:    r.File,
+			Part:    len(result),
+			Summary: Summarize(cases),
+			Cases:   cases,
+		})
+	}
+	return result
+}
+
+// Merge appends the next part of the same report
+func (r *Report) Merge(part Report) {
+	r.Summary.Tests += part.Summary.Tests
+	r.Summary.Passed += part.Summary.Passed
+	r.Summary.Failed += part.Summary.Failed
+	r.Summary.Skipped += part.Summary.Skipped
+	r.Summary.Errored += part.Summary.Errored
+	r.Summary.DurationMs += part.Summary.DurationMs
+	r.Cases = append(r.Cases, part.Cases...)
+}
@@ -0,0 +1,120 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestParseJUnit(t *testing.T) {
+	report := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="api" tests="4">
+    <testcase name="creates" classname="api.Users" time="0.5"/>
+    <testcase name="deletes" classname="api.Users" time="1">
+      <failure message="expected 204, got 500">stack</failure>
+    </testcase>
+    <testcase name="updates" classname="api.Users">
+      <skipped message="not implemented"/>
+    </testcase>
+    <testcase name="lists" classname="api.Users">
+      <error message="timeout"/>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+	got, err := Parse(FormatJUnit, strings.NewReader(report))
+
+	assert.NoError(t, err)
+	assert.Equal(t, Summary{Tests: 4, Passed: 1, Failed: 1, Skipped: 1, Errored: 1, DurationMs: 1500}, got.Summary)
+	assert.Equal(t, []Case{
+		{Suite: "api", Name: "deletes", ClassName: "api.Users", Status: StatusFailed, DurationMs: 1000, Message: "expected 204, got 500"},
+		{Suite: "api", Name: "lists", ClassName: "api.Users", Status: StatusError, Message: "timeout"},
+	}, got.FailedCases())
+}
+
+func TestParseTAP(t *testing.T) {
+	report := `TAP version 13
+1..4
+ok 1 - adds numbers
+not ok 2 - divides by zero
+  ---
+  message: failed
+  ...
+ok 3 - uploads file # SKIP no network
+not ok 4 handles unicode # TODO later
+`
+
+	got, err := Parse(FormatTAP, strings.NewReader(report))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Case{
+		{Name: "adds numbers", Status: StatusPassed},
+		{Name: "divides by zero", Status: StatusFailed},
+		{Name: "uploads file", Status: StatusSkipped, Message: "no network"},
+		{Name: "handles unicode", Status: StatusSkipped, Message: "later"},
+	}, got.Cases)
+}
+
+func TestParseGoTest(t *testing.T) {
+	report := `{"Action":"run","Package":"example.com/calc","Test":"TestAdd"}
+{"Action":"pass","Package":"example.com/calc","Test":"TestAdd","Elapsed":0.01}
+{"Action":"run","Package":"example.com/calc","Test":"TestDiv"}
+{"Action":"output","Package":"example.com/calc","Test":"TestDiv","Output":"    calc_test.go:12: division by zero\n"}
+{"Action":"fail","Package":"example.com/calc","Test":"TestDiv","Elapsed":0.02}
+{"Action":"skip","Package":"example.com/calc","Test":"TestMul","Elapsed":0}
+{"Action":"fail","Package":"example.com/calc","Elapsed":0.05}
+`
+
+	got, err := Parse(FormatGoTest, strings.NewReader(report))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Case{
+		{Name: "TestAdd", ClassName: "example.com/calc", Status: StatusPassed, DurationMs: 10},
+		{Name: "TestDiv", ClassName: "example.com/calc", Status: StatusFailed, DurationMs: 20, Message: "calc_test.go:12: division by zero"},
+		{Name: "TestMul", ClassName: "example.com/calc", Status: StatusSkipped},
+	}, got.Cases)
+	assert.Equal(t, Summary{Tests: 3, Passed: 1, Failed: 1, Skipped: 1, DurationMs: 30}, got.Summary)
+}
+
+func TestParseUnsupported(t *testing.T) {
+	_, err := Parse("unknown", strings.NewReader(""))
+
+	assert.Error(t, err)
+}
+
+func TestReportChunks(t *testing.T) {
+	report := &Report{Format: FormatTAP, File: "report.tap", Cases: []Case{
+		{Name: "a", Status: StatusPassed},
+		{Name: "b", Status: StatusFailed},
+		{Name: "c", Status: StatusSkipped},
+	}}
+	report.Summary = Summarize(report.Cases)
+
+	chunks := report.Chunks(2)
+
+	assert.Len(t, chunks, 2)
+	assert.Equal(t, 1, chunks[1].Part)
+	assert.Equal(t, Summary{Tests: 1, Skipped: 1}, chunks[1].Summary)
+
+	merged := chunks[0]
+	merged.Merge(chunks[1])
+	assert.Equal(t, *report, merged)
+}
+
+func TestExecutionSteps(t *testing.T) {
+	steps := ExecutionSteps(Report{Cases: []Case{
+		{Suite: "api", Name: "creates", Status: StatusPassed, DurationMs: 1500},
+		{Name: "deletes", Status: StatusError},
+		{Name: "updates", Status: StatusSkipped},
+	}})
+
+	assert.Equal(t, []testkube.ExecutionStepResult{
+		{Name: "api - creates", Duration: "1.5s", Status: "passed"},
+		{Name: "deletes", Duration: "0s", Status: "failed"},
+		{Name: "updates", Duration: "0s", Status: "skipped"},
+	}, steps)
+}
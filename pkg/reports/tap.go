@@ -0,0 +1,41 @@
+package reports
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var tapTestLineRe = regexp.MustCompile(`^(not ok|ok)\b\s*(\d+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(\w+)\b\s*(.*))?$`)
+
+// ParseTAP reads the test cases from the Test Anything Protocol output
+func ParseTAP(reader io.Reader) ([]Case, error) {
+	cases := make([]Case, 0)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		match := tapTestLineRe.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		c := Case{Name: match[3], Status: StatusPassed}
+		if c.Name == "" {
+			c.Name = match[2]
+		}
+		if match[1] == "not ok" {
+			c.Status = StatusFailed
+		}
+		switch strings.ToUpper(match[4]) {
+		case "SKIP":
+			c.Status = StatusSkipped
+			c.Message = match[5]
+		case "TODO":
+			// Failures of the TODO tests are expected
+			c.Status = StatusSkipped
+			c.Message = match[5]
+		}
+		cases = append(cases, c)
+	}
+	return cases, scanner.Err()
+}
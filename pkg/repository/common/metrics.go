@@ -19,6 +19,9 @@ func CalculateMetrics(executionsMetrics []testkube.ExecutionsMetricsExecutions)
 			metrics.FailedExecutions++
 		}
 		metrics.TotalExecutions++
+		if execution.Attempt > 1 {
+			metrics.RetriedExecutions++
+		}
 
 		// ignore empty and invalid durations
 		duration, err := time.ParseDuration(execution.Duration)
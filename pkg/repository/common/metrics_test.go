@@ -45,3 +45,16 @@ func Test_WhenNoDurations_ShouldReturn0FromCalculations(t *testing.T) {
 	assert(t, result.ExecutionDurationP95)
 	assert(t, result.ExecutionDurationP99)
 }
+
+func Test_ShouldCountRetriedExecutions(t *testing.T) {
+	executions := []testkube.ExecutionsMetricsExecutions{
+		{Status: string(testkube.FAILED_ExecutionStatus), Attempt: 1},
+		{Status: string(testkube.PASSED_ExecutionStatus), Attempt: 2},
+		{Status: string(testkube.PASSED_ExecutionStatus)},
+	}
+
+	result := CalculateMetrics(executions)
+	if result.RetriedExecutions != 1 {
+		t.Fatalf("Expected 1 retried execution but got %d", result.RetriedExecutions)
+	}
+}
@@ -0,0 +1,42 @@
+package executionqueue
+
+import (
+	"context"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// Item is a test execution waiting for the free capacity
+type Item struct {
+	Id            string                    `json:"id" bson:"id"`
+	Name          string                    `json:"name" bson:"name"`
+	TestName      string                    `json:"testName" bson:"testname"`
+	TestNamespace string                    `json:"testNamespace" bson:"testnamespace"`
+	TestType      string                    `json:"testType" bson:"testtype"`
+	Priority      int32                     `json:"priority" bson:"priority"`
+	EnqueuedAt    time.Time                 `json:"enqueuedAt" bson:"enqueuedat"`
+	Request       testkube.ExecutionRequest `json:"request" bson:"request"`
+	// ClaimedAt is set while the execution is being started
+	ClaimedAt *time.Time `json:"claimedAt,omitempty" bson:"claimedat,omitempty"`
+}
+
+// IsClaimed checks if the execution is being started, and the claim has not expired yet
+func (i Item) IsClaimed(expiredBefore time.Time) bool {
+	return i.ClaimedAt != nil && !i.ClaimedAt.Before(expiredBefore)
+}
+
+//go:generate mockgen -destination=./mock_repository.go -package=executionqueue "github.com/kubeshop/testkube/pkg/repository/executionqueue" Repository
+type Repository interface {
+	// Insert adds the execution to the queue
+	Insert(ctx context.Context, item Item) error
+	// List returns all queued executions, in the order they should be started
+	List(ctx context.Context) ([]Item, error)
+	// Claim marks the execution as being started, and returns false when it is already claimed,
+	// unless the previous claim has been made before the expiredBefore time
+	Claim(ctx context.Context, id string, expiredBefore time.Time) (bool, error)
+	// Release unmarks the claimed execution, so it may be started again
+	Release(ctx context.Context, id string) error
+	// Delete removes the execution from the queue, and returns false when it was already removed
+	Delete(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,41 @@
+package executionretry
+
+import (
+	"context"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// Retry is a finished test execution waiting for the next attempt
+type Retry struct {
+	Id            string                    `json:"id" bson:"id"`
+	Name          string                    `json:"name" bson:"name"`
+	TestName      string                    `json:"testName" bson:"testname"`
+	TestNamespace string                    `json:"testNamespace" bson:"testnamespace"`
+	TestType      string                    `json:"testType" bson:"testtype"`
+	ScheduledAt   time.Time                 `json:"scheduledAt" bson:"scheduledat"`
+	Request       testkube.ExecutionRequest `json:"request" bson:"request"`
+	// ClaimedAt is set while the next attempt is being started
+	ClaimedAt *time.Time `json:"claimedAt,omitempty" bson:"claimedat,omitempty"`
+}
+
+// IsClaimed checks if the next attempt is being started, and the claim has not expired yet
+func (r Retry) IsClaimed(expiredBefore time.Time) bool {
+	return r.ClaimedAt != nil && !r.ClaimedAt.Before(expiredBefore)
+}
+
+//go:generate mockgen -destination=./mock_repository.go -package=executionretry "github.com/kubeshop/testkube/pkg/repository/executionretry" Repository
+type Repository interface {
+	// Insert stores the retry of the test execution
+	Insert(ctx context.Context, retry Retry) error
+	// List returns all pending retries, in the order they have been scheduled
+	List(ctx context.Context) ([]Retry, error)
+	// Claim marks the retry as being started, and returns false when it is already claimed,
+	// unless the previous claim has been made before the expiredBefore time
+	Claim(ctx context.Context, id string, expiredBefore time.Time) (bool, error)
+	// Release unmarks the claimed retry, so it may be started again
+	Release(ctx context.Context, id string) error
+	// Delete removes the retry, and returns false when it was already removed
+	Delete(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,82 @@
+package executionretry
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ Repository = (*MongoRepository)(nil)
+
+const CollectionName = "executionretries"
+
+func NewMongoRepository(db *mongo.Database, opts ...Opt) *MongoRepository {
+	r := &MongoRepository{
+		Coll: db.Collection(CollectionName),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+type Opt func(*MongoRepository)
+
+func WithMongoRepositoryCollection(collection *mongo.Collection) Opt {
+	return func(r *MongoRepository) {
+		r.Coll = collection
+	}
+}
+
+type MongoRepository struct {
+	Coll *mongo.Collection
+}
+
+func (r *MongoRepository) Insert(ctx context.Context, retry Retry) (err error) {
+	_, err = r.Coll.InsertOne(ctx, retry)
+	return
+}
+
+func (r *MongoRepository) List(ctx context.Context) (result []Retry, err error) {
+	result = make([]Retry, 0)
+	opts := options.Find()
+	opts.SetSort(bson.D{{Key: "scheduledat", Value: 1}})
+
+	cursor, err := r.Coll.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return result, err
+	}
+
+	err = cursor.All(ctx, &result)
+	return
+}
+
+func (r *MongoRepository) Claim(ctx context.Context, id string, expiredBefore time.Time) (bool, error) {
+	filter := bson.M{"id": id, "$or": bson.A{
+		bson.M{"claimedat": nil},
+		bson.M{"claimedat": bson.M{"$lt": expiredBefore}},
+	}}
+	result, err := r.Coll.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"claimedat": time.Now()}})
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (r *MongoRepository) Release(ctx context.Context, id string) error {
+	_, err := r.Coll.UpdateOne(ctx, bson.M{"id": id}, bson.M{"$unset": bson.M{"claimedat": ""}})
+	return err
+}
+
+func (r *MongoRepository) Delete(ctx context.Context, id string) (bool, error) {
+	result, err := r.Coll.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
@@ -856,6 +856,7 @@ func (r *MongoRepository) GetTestMetrics(ctx context.Context, name string, limit
 				{Key: "duration", Value: 1},
 				{Key: "starttime", Value: 1},
 				{Key: "name", Value: 1},
+				{Key: "attempt", Value: 1},
 			},
 		},
 	})
@@ -0,0 +1,49 @@
+package result
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/redact"
+)
+
+var _ Repository = (*RedactedRepository)(nil)
+
+// RedactedRepository masks the secrets used by the execution in its output and error messages, before it is persisted
+type RedactedRepository struct {
+	Repository
+	resolver *redact.Resolver
+}
+
+// NewRedactedRepository wraps the results repository with the secret redaction
+func NewRedactedRepository(repository Repository, resolver *redact.Resolver) *RedactedRepository {
+	return &RedactedRepository{
+		Repository: repository,
+		resolver:   resolver,
+	}
+}
+
+func (r *RedactedRepository) redact(ctx context.Context, execution testkube.Execution) testkube.Execution {
+	if execution.ExecutionResult == nil {
+		return execution
+	}
+	redactor, err := r.resolver.Execution(ctx, &execution)
+	if err != nil {
+		// Redact at least the secrets that have been found
+		log.DefaultLogger.Warnw("failed to resolve all the secrets to redact", "id", execution.Id, "error", err)
+	}
+	return redactor.Execution(execution)
+}
+
+func (r *RedactedRepository) Insert(ctx context.Context, result testkube.Execution) error {
+	return r.Repository.Insert(ctx, r.redact(ctx, result))
+}
+
+func (r *RedactedRepository) Update(ctx context.Context, result testkube.Execution) error {
+	return r.Repository.Update(ctx, r.redact(ctx, result))
+}
+
+func (r *RedactedRepository) UpdateResult(ctx context.Context, id string, execution testkube.Execution) error {
+	return r.Repository.UpdateResult(ctx, id, r.redact(ctx, execution))
+}
@@ -0,0 +1,42 @@
+package webhookdelivery
+
+import (
+	"context"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const PageDefaultLimit int = 100
+
+// Retry is the pending retry of the webhook delivery, persisted so it can be resumed after the restart
+type Retry struct {
+	// DeliveryId is the id of the retried delivery
+	DeliveryId string `bson:"deliveryid"`
+	// WebhookName is the name of the webhook listener
+	WebhookName string `bson:"webhookname"`
+	// Event is the delivered event, used to build the request headers again
+	Event testkube.Event `bson:"event"`
+	// Attempt is the number of the next attempt
+	Attempt int `bson:"attempt"`
+	// AttemptTime is the time of the next attempt
+	AttemptTime time.Time `bson:"attempttime"`
+}
+
+//go:generate mockgen -destination=./mock_repository.go -package=webhookdelivery "github.com/kubeshop/testkube/pkg/repository/webhookdelivery" Repository
+type Repository interface {
+	// Insert inserts new webhook delivery
+	Insert(ctx context.Context, delivery testkube.WebhookDelivery) error
+	// Update updates the webhook delivery with the next attempts
+	Update(ctx context.Context, delivery testkube.WebhookDelivery) error
+	// Get gets the webhook delivery by id
+	Get(ctx context.Context, id string) (testkube.WebhookDelivery, error)
+	// GetByWebhook gets the page of latest deliveries of the webhook, optionally filtered by status
+	GetByWebhook(ctx context.Context, webhookName string, status *testkube.WebhookDeliveryStatus, page, pageSize int) ([]testkube.WebhookDelivery, error)
+	// UpsertRetry persists the pending retry of the delivery
+	UpsertRetry(ctx context.Context, retry Retry) error
+	// DeleteRetry deletes the pending retry of the delivery
+	DeleteRetry(ctx context.Context, deliveryId string) error
+	// GetRetries gets all the pending retries
+	GetRetries(ctx context.Context) ([]Retry, error)
+}
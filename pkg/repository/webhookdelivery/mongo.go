@@ -0,0 +1,112 @@
+package webhookdelivery
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+var _ Repository = (*MongoRepository)(nil)
+
+const (
+	CollectionName        = "webhookdeliveries"
+	RetriesCollectionName = "webhookretries"
+)
+
+func NewMongoRepository(db *mongo.Database, opts ...Opt) *MongoRepository {
+	r := &MongoRepository{
+		Coll:        db.Collection(CollectionName),
+		RetriesColl: db.Collection(RetriesCollectionName),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+type Opt func(*MongoRepository)
+
+func WithMongoRepositoryCollection(collection *mongo.Collection) Opt {
+	return func(r *MongoRepository) {
+		r.Coll = collection
+	}
+}
+
+func WithMongoRepositoryRetriesCollection(collection *mongo.Collection) Opt {
+	return func(r *MongoRepository) {
+		r.RetriesColl = collection
+	}
+}
+
+type MongoRepository struct {
+	Coll        *mongo.Collection
+	RetriesColl *mongo.Collection
+}
+
+func (r *MongoRepository) Insert(ctx context.Context, delivery testkube.WebhookDelivery) (err error) {
+	_, err = r.Coll.InsertOne(ctx, delivery)
+	return
+}
+
+func (r *MongoRepository) Update(ctx context.Context, delivery testkube.WebhookDelivery) (err error) {
+	_, err = r.Coll.ReplaceOne(ctx, bson.M{"id": delivery.Id}, delivery)
+	return
+}
+
+func (r *MongoRepository) Get(ctx context.Context, id string) (result testkube.WebhookDelivery, err error) {
+	err = r.Coll.FindOne(ctx, bson.M{"id": id}).Decode(&result)
+	return
+}
+
+func (r *MongoRepository) GetByWebhook(ctx context.Context, webhookName string, status *testkube.WebhookDeliveryStatus,
+	page, pageSize int) (result []testkube.WebhookDelivery, err error) {
+	result = make([]testkube.WebhookDelivery, 0)
+	if pageSize <= 0 {
+		pageSize = PageDefaultLimit
+	}
+
+	opts := options.Find()
+	opts.SetSort(bson.D{{Key: "starttime", Value: -1}})
+	opts.SetSkip(int64(page * pageSize))
+	opts.SetLimit(int64(pageSize))
+
+	query := bson.M{"webhookname": webhookName}
+	if status != nil {
+		query["status"] = *status
+	}
+
+	cursor, err := r.Coll.Find(ctx, query, opts)
+	if err != nil {
+		return result, err
+	}
+
+	err = cursor.All(ctx, &result)
+	return
+}
+
+func (r *MongoRepository) UpsertRetry(ctx context.Context, retry Retry) (err error) {
+	_, err = r.RetriesColl.ReplaceOne(ctx, bson.M{"deliveryid": retry.DeliveryId}, retry, options.Replace().SetUpsert(true))
+	return
+}
+
+func (r *MongoRepository) DeleteRetry(ctx context.Context, deliveryId string) (err error) {
+	_, err = r.RetriesColl.DeleteOne(ctx, bson.M{"deliveryid": deliveryId})
+	return
+}
+
+func (r *MongoRepository) GetRetries(ctx context.Context) (result []Retry, err error) {
+	result = make([]Retry, 0)
+	cursor, err := r.RetriesColl.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "attempttime", Value: 1}}))
+	if err != nil {
+		return result, err
+	}
+
+	err = cursor.All(ctx, &result)
+	return
+}
@@ -0,0 +1,360 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/executor/client"
+	"github.com/kubeshop/testkube/pkg/repository/executionqueue"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/tracing"
+)
+
+const (
+	// DefaultQueuePollInterval is the default interval between the execution queue checks
+	DefaultQueuePollInterval = 5 * time.Second
+
+	queueWaitInterval = time.Second
+	// queueClaimTimeout is the time after which the claimed execution may be claimed again,
+	// i.e. when the instance starting it has been stopped
+	queueClaimTimeout = 5 * time.Minute
+)
+
+// QueueLimits is the maximum number of the test executions running at the same time,
+// 0 means there is no limit
+type QueueLimits struct {
+	Global    int
+	Namespace int
+	Test      int
+	Executor  int
+}
+
+// Enabled checks if any limit is set
+func (l QueueLimits) Enabled() bool {
+	return l.Global > 0 || l.Namespace > 0 || l.Test > 0 || l.Executor > 0
+}
+
+// queueUsage counts the running test executions
+type queueUsage struct {
+	total      int
+	namespaces map[string]int
+	tests      map[string]int
+	executors  map[string]int
+}
+
+func newQueueUsage() *queueUsage {
+	return &queueUsage{
+		namespaces: make(map[string]int),
+		tests:      make(map[string]int),
+		executors:  make(map[string]int),
+	}
+}
+
+func (u *queueUsage) add(namespace, testName, testType string) {
+	u.total++
+	u.namespaces[namespace]++
+	u.tests[testName]++
+	u.executors[testType]++
+}
+
+// full checks if no more executions may be started
+func (u *queueUsage) full(limits QueueLimits) bool {
+	return limits.Global > 0 && u.total >= limits.Global
+}
+
+// allows checks if the execution may be started within the limits
+func (u *queueUsage) allows(limits QueueLimits, namespace, testName, testType string) bool {
+	return !u.full(limits) &&
+		(limits.Namespace <= 0 || u.namespaces[namespace] < limits.Namespace) &&
+		(limits.Test <= 0 || u.tests[testName] < limits.Test) &&
+		(limits.Executor <= 0 || u.executors[testType] < limits.Executor)
+}
+
+// WithExecutionQueue enables the execution queue for the Scheduler,
+// so the test executions are started only when the limits allow it
+func (s *Scheduler) WithExecutionQueue(executionQueue executionqueue.Repository, limits QueueLimits) *Scheduler {
+	if executionQueue != nil && limits.Enabled() {
+		s.executionQueue = executionQueue
+		s.queueLimits = limits
+	}
+	return s
+}
+
+// IsExecutionQueueEnabled checks if the test executions are queued
+func (s *Scheduler) IsExecutionQueueEnabled() bool {
+	return s.executionQueue != nil
+}
+
+// GetExecutionQueue returns the queued test executions, in the order they will be started
+func (s *Scheduler) GetExecutionQueue(ctx context.Context) ([]testkube.QueuedExecution, error) {
+	queue := make([]testkube.QueuedExecution, 0)
+	if s.executionQueue == nil {
+		return queue, nil
+	}
+
+	items, err := s.executionQueue.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range items {
+		queue = append(queue, testkube.QueuedExecution{
+			Id:            item.Id,
+			Name:          item.Name,
+			TestName:      item.TestName,
+			TestNamespace: item.TestNamespace,
+			TestType:      item.TestType,
+			Priority:      item.Priority,
+			Position:      int32(i + 1),
+			EnqueuedAt:    item.EnqueuedAt,
+		})
+	}
+	return queue, nil
+}
+
+// AbortQueuedExecution removes the test execution from the queue and stores it as aborted,
+// and returns false when the execution was not queued
+func (s *Scheduler) AbortQueuedExecution(ctx context.Context, execution *testkube.Execution) (bool, error) {
+	if s.executionQueue == nil || !execution.IsQueued() {
+		return false, nil
+	}
+
+	// claim it first, so it is not removed while it is being started
+	ok, err := s.executionQueue.Claim(ctx, execution.Id, time.Now().Add(-queueClaimTimeout))
+	if err != nil || !ok {
+		return false, err
+	}
+	if _, err = s.executionQueue.Delete(ctx, execution.Id); err != nil {
+		return false, err
+	}
+
+	execution.ExecutionResult.Abort()
+	if err = s.testResults.UpdateResult(ctx, execution.Id, *execution); err != nil {
+		return true, err
+	}
+
+	s.events.Notify(testkube.NewEventEndTestAborted(execution))
+	return true, nil
+}
+
+// RunExecutionQueue periodically starts the queued test executions, until the context is done.
+// As the queue is persisted, it also resumes the executions queued before the restart.
+func (s *Scheduler) RunExecutionQueue(ctx context.Context, interval time.Duration) {
+	if s.executionQueue == nil {
+		return
+	}
+	if interval <= 0 {
+		interval = DefaultQueuePollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.DispatchExecutionQueue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchExecutionQueue starts the queued test executions that fit in the limits
+func (s *Scheduler) DispatchExecutionQueue(ctx context.Context) {
+	for _, item := range s.claimQueuedExecutions(ctx) {
+		go s.startQueuedExecution(context.Background(), item)
+	}
+}
+
+// claimQueuedExecutions marks in the queue the test executions that may be started now,
+// they are removed from the queue only once they are started
+func (s *Scheduler) claimQueuedExecutions(ctx context.Context) (claimed []executionqueue.Item) {
+	if s.executionQueue == nil {
+		return nil
+	}
+
+	s.queueMutex.Lock()
+	defer s.queueMutex.Unlock()
+
+	items, err := s.executionQueue.List(ctx)
+	if err != nil {
+		s.logger.Errorw("can't list queued executions", "error", err)
+		return nil
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	// the executions being started are already counted as the active ones
+	expiredBefore := time.Now().Add(-queueClaimTimeout)
+	queued := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		if !item.IsClaimed(expiredBefore) {
+			queued[item.Id] = struct{}{}
+		}
+	}
+
+	// the executions already claimed from the queue are still stored as queued ones, so count them too
+	statuses := string(testkube.RUNNING_ExecutionStatus) + "," + string(testkube.QUEUED_ExecutionStatus)
+	active, err := s.testResults.GetExecutions(ctx, result.NewExecutionsFilter().WithStatus(statuses).WithPageSize(0))
+	if err != nil {
+		s.logger.Errorw("can't get active executions", "error", err)
+		return nil
+	}
+
+	usage := newQueueUsage()
+	for _, execution := range active {
+		if _, ok := queued[execution.Id]; !ok {
+			usage.add(execution.TestNamespace, execution.TestName, execution.TestType)
+		}
+	}
+
+	for _, item := range items {
+		if usage.full(s.queueLimits) {
+			break
+		}
+		if _, ok := queued[item.Id]; !ok {
+			continue
+		}
+		if !usage.allows(s.queueLimits, item.TestNamespace, item.TestName, item.TestType) {
+			continue
+		}
+
+		ok, err := s.executionQueue.Claim(ctx, item.Id, expiredBefore)
+		if err != nil {
+			s.logger.Errorw("can't claim execution from the queue", "executionId", item.Id, "error", err)
+			continue
+		}
+		// it has been claimed by another instance or aborted in the meantime
+		if !ok {
+			continue
+		}
+
+		usage.add(item.TestNamespace, item.TestName, item.TestType)
+		claimed = append(claimed, item)
+	}
+	return claimed
+}
+
+// enqueueExecution stores the test execution in the queue, to start it when the limits allow it
+func (s *Scheduler) enqueueExecution(ctx context.Context, request testkube.ExecutionRequest,
+	options client.ExecuteOptions, execution testkube.Execution) (testkube.Execution, error) {
+	if err := s.createSecretsReferences(&execution, &options); err != nil {
+		return s.handleExecutionError(ctx, execution, "can't create secret variables `Secret` references: %w", err)
+	}
+
+	execution.ExecutionResult = &testkube.ExecutionResult{Status: testkube.ExecutionStatusQueued}
+	if err := s.testResults.Insert(ctx, execution); err != nil {
+		return s.handleExecutionError(ctx, execution, "can't create new test execution, can't insert into storage: %w", err)
+	}
+
+	// store the obfuscated variables only, the secret values are already kept in the references
+	request.Id = execution.Id
+	request.Variables = execution.Variables
+	err := s.executionQueue.Insert(ctx, executionqueue.Item{
+		Id:            execution.Id,
+		Name:          execution.Name,
+		TestName:      execution.TestName,
+		TestNamespace: execution.TestNamespace,
+		TestType:      execution.TestType,
+		Priority:      request.Priority,
+		EnqueuedAt:    time.Now(),
+		Request:       request,
+	})
+	if err != nil {
+		return s.failExecution(ctx, execution, "can't add execution to the queue: %w", err)
+	}
+
+	s.logger.Infow("test execution queued", "executionId", execution.Id, "priority", request.Priority)
+	s.DispatchExecutionQueue(ctx)
+
+	if request.Sync {
+		return s.waitForExecution(ctx, execution.Id)
+	}
+	return s.testResults.Get(ctx, execution.Id)
+}
+
+// startQueuedExecution starts the test execution claimed from the queue,
+// and removes it from the queue once it is no longer stored as queued
+func (s *Scheduler) startQueuedExecution(ctx context.Context, item executionqueue.Item) {
+	ctx, span := tracing.Start(ctx, "Scheduler.StartQueuedExecution", tracing.ExecutionIDKey.String(item.Id), tracing.TestNameKey.String(item.TestName))
+	defer span.End()
+
+	execution, err := s.testResults.Get(ctx, item.Id)
+	if err != nil {
+		s.logger.Errorw("can't get queued execution", "executionId", item.Id, "error", err)
+		s.releaseQueuedExecution(ctx, item.Id)
+		return
+	}
+	defer s.removeQueuedExecution(ctx, item.Id)
+
+	// it has been already started before the claim expired, i.e. the instance has been stopped meanwhile
+	if !execution.IsQueued() {
+		return
+	}
+
+	options, err := s.getExecuteOptions(item.TestNamespace, item.TestName, item.Request)
+	if err != nil {
+		s.failExecution(ctx, execution, "can't get execute options: %w", err)
+		return
+	}
+	options.ID = execution.Id
+
+	// the secrets have been already created while queueing, so only the references are needed
+	if _, err = s.getSecretsReferences(&execution, &options); err != nil {
+		s.failExecution(ctx, execution, "can't get secret variables `Secret` references: %w", err)
+		return
+	}
+
+	s.events.Notify(testkube.NewEventStartTest(&execution))
+	if _, err = s.startExecution(ctx, options, execution); err != nil {
+		s.logger.Errorw("can't start queued execution", "executionId", item.Id, "error", err)
+	}
+}
+
+// releaseQueuedExecution unmarks the claimed test execution, so it will be started again
+func (s *Scheduler) releaseQueuedExecution(ctx context.Context, id string) {
+	if err := s.executionQueue.Release(ctx, id); err != nil {
+		s.logger.Errorw("can't release execution in the queue", "executionId", id, "error", err)
+	}
+}
+
+// removeQueuedExecution removes the started or failed test execution from the queue
+func (s *Scheduler) removeQueuedExecution(ctx context.Context, id string) {
+	if _, err := s.executionQueue.Delete(ctx, id); err != nil {
+		s.logger.Errorw("can't remove execution from the queue", "executionId", id, "error", err)
+	}
+}
+
+// failExecution stores the test execution as failed
+func (s *Scheduler) failExecution(ctx context.Context, execution testkube.Execution, msgTpl string, err error) (testkube.Execution, error) {
+	execution, _ = s.handleExecutionError(ctx, execution, msgTpl, err)
+	if uerr := s.testResults.UpdateResult(ctx, execution.Id, execution); uerr != nil {
+		s.logger.Errorw("can't update failed execution", "executionId", execution.Id, "error", uerr)
+	}
+	return execution, nil
+}
+
+// waitForExecution blocks until the test execution is finished
+func (s *Scheduler) waitForExecution(ctx context.Context, id string) (testkube.Execution, error) {
+	ticker := time.NewTicker(queueWaitInterval)
+	defer ticker.Stop()
+	for {
+		execution, err := s.testResults.Get(ctx, id)
+		if err != nil {
+			return execution, err
+		}
+		if execution.ExecutionResult == nil || execution.ExecutionResult.Status == nil ||
+			(!execution.ExecutionResult.IsQueued() && !execution.ExecutionResult.IsRunning()) {
+			return execution, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return execution, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
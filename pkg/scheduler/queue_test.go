@@ -0,0 +1,175 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/executionqueue"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+)
+
+func TestQueueUsage_Allows(t *testing.T) {
+	limits := QueueLimits{Global: 3, Namespace: 2, Test: 1, Executor: 2}
+	usage := newQueueUsage()
+	usage.add("ns1", "test1", "k6/script")
+
+	assert.False(t, usage.allows(limits, "ns1", "test1", "k6/script"))
+	assert.True(t, usage.allows(limits, "ns1", "test2", "k6/script"))
+
+	usage.add("ns1", "test2", "k6/script")
+	assert.False(t, usage.allows(limits, "ns1", "test3", "curl/test"))
+	assert.False(t, usage.allows(limits, "ns2", "test3", "k6/script"))
+	assert.True(t, usage.allows(limits, "ns2", "test3", "curl/test"))
+
+	usage.add("ns2", "test3", "curl/test")
+	assert.True(t, usage.full(limits))
+	assert.False(t, usage.allows(limits, "ns3", "test4", "postman/collection"))
+}
+
+func TestScheduler_ClaimQueuedExecutions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ctx := context.Background()
+	queueRepository := executionqueue.NewMockRepository(mockCtrl)
+	resultsRepository := result.NewMockRepository(mockCtrl)
+	sc := &Scheduler{
+		logger:      log.DefaultLogger,
+		testResults: resultsRepository,
+	}
+	sc.WithExecutionQueue(queueRepository, QueueLimits{Global: 3, Test: 1})
+
+	now := time.Now()
+	items := []executionqueue.Item{
+		{Id: "high", TestName: "test1", Priority: 10, EnqueuedAt: now},
+		{Id: "busy", TestName: "test2", EnqueuedAt: now},
+		{Id: "same-test", TestName: "test1", EnqueuedAt: now},
+		{Id: "next", TestName: "test3", EnqueuedAt: now},
+		{Id: "over-limit", TestName: "test4", EnqueuedAt: now},
+	}
+	queueRepository.EXPECT().List(gomock.Any()).Return(items, nil)
+	resultsRepository.EXPECT().GetExecutions(gomock.Any(), gomock.Any()).Return([]testkube.Execution{
+		{Id: "running", TestName: "test2"},
+		{Id: "busy", TestName: "test2"},
+	}, nil)
+	queueRepository.EXPECT().Claim(gomock.Any(), "high", gomock.Any()).Return(true, nil)
+	queueRepository.EXPECT().Claim(gomock.Any(), "next", gomock.Any()).Return(true, nil)
+
+	claimed := sc.claimQueuedExecutions(ctx)
+
+	assert.Len(t, claimed, 2)
+	assert.Equal(t, "high", claimed[0].Id)
+	assert.Equal(t, "next", claimed[1].Id)
+}
+
+func TestScheduler_ClaimQueuedExecutions_Claimed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ctx := context.Background()
+	queueRepository := executionqueue.NewMockRepository(mockCtrl)
+	resultsRepository := result.NewMockRepository(mockCtrl)
+	sc := &Scheduler{
+		logger:      log.DefaultLogger,
+		testResults: resultsRepository,
+	}
+	sc.WithExecutionQueue(queueRepository, QueueLimits{Global: 2})
+
+	now := time.Now()
+	expired := now.Add(-2 * queueClaimTimeout)
+	items := []executionqueue.Item{
+		{Id: "starting", TestName: "test1", EnqueuedAt: now, ClaimedAt: &now},
+		{Id: "expired", TestName: "test2", EnqueuedAt: now, ClaimedAt: &expired},
+		{Id: "next", TestName: "test3", EnqueuedAt: now},
+	}
+	queueRepository.EXPECT().List(gomock.Any()).Return(items, nil)
+	resultsRepository.EXPECT().GetExecutions(gomock.Any(), gomock.Any()).Return([]testkube.Execution{
+		{Id: "starting", TestName: "test1"},
+		{Id: "expired", TestName: "test2"},
+		{Id: "next", TestName: "test3"},
+	}, nil)
+	queueRepository.EXPECT().Claim(gomock.Any(), "expired", gomock.Any()).Return(true, nil)
+
+	claimed := sc.claimQueuedExecutions(ctx)
+
+	assert.Len(t, claimed, 1)
+	assert.Equal(t, "expired", claimed[0].Id)
+}
+
+func TestScheduler_StartQueuedExecution_Release(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	queueRepository := executionqueue.NewMockRepository(mockCtrl)
+	resultsRepository := result.NewMockRepository(mockCtrl)
+	sc := &Scheduler{
+		logger:      log.DefaultLogger,
+		testResults: resultsRepository,
+	}
+	sc.WithExecutionQueue(queueRepository, QueueLimits{Global: 1})
+
+	resultsRepository.EXPECT().Get(gomock.Any(), "id").Return(testkube.Execution{}, errors.New("connection error"))
+	queueRepository.EXPECT().Release(gomock.Any(), "id").Return(nil)
+
+	sc.startQueuedExecution(context.Background(), executionqueue.Item{Id: "id"})
+}
+
+func TestScheduler_StartQueuedExecution_AlreadyStarted(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	queueRepository := executionqueue.NewMockRepository(mockCtrl)
+	resultsRepository := result.NewMockRepository(mockCtrl)
+	sc := &Scheduler{
+		logger:      log.DefaultLogger,
+		testResults: resultsRepository,
+	}
+	sc.WithExecutionQueue(queueRepository, QueueLimits{Global: 1})
+
+	resultsRepository.EXPECT().Get(gomock.Any(), "id").Return(testkube.Execution{
+		Id:              "id",
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusRunning},
+	}, nil)
+	queueRepository.EXPECT().Delete(gomock.Any(), "id").Return(true, nil)
+
+	sc.startQueuedExecution(context.Background(), executionqueue.Item{Id: "id"})
+}
+
+func TestScheduler_GetExecutionQueue(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	queueRepository := executionqueue.NewMockRepository(mockCtrl)
+	sc := (&Scheduler{}).WithExecutionQueue(queueRepository, QueueLimits{Global: 1})
+	queueRepository.EXPECT().List(gomock.Any()).Return([]executionqueue.Item{
+		{Id: "first", Name: "test1-1", TestName: "test1", Priority: 5},
+		{Id: "second", Name: "test2-1", TestName: "test2"},
+	}, nil)
+
+	queue, err := sc.GetExecutionQueue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, queue, 2)
+	assert.Equal(t, int32(1), queue[0].Position)
+	assert.Equal(t, int32(5), queue[0].Priority)
+	assert.Equal(t, "second", queue[1].Id)
+	assert.Equal(t, int32(2), queue[1].Position)
+}
+
+func TestScheduler_WithExecutionQueue(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	queueRepository := executionqueue.NewMockRepository(mockCtrl)
+
+	assert.False(t, (&Scheduler{}).WithExecutionQueue(queueRepository, QueueLimits{}).IsExecutionQueueEnabled())
+	assert.False(t, (&Scheduler{}).WithExecutionQueue(nil, QueueLimits{Global: 1}).IsExecutionQueueEnabled())
+	assert.True(t, (&Scheduler{}).WithExecutionQueue(queueRepository, QueueLimits{Test: 1}).IsExecutionQueueEnabled())
+}
@@ -0,0 +1,275 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	testsmapper "github.com/kubeshop/testkube/pkg/mapper/tests"
+	"github.com/kubeshop/testkube/pkg/repository/executionretry"
+)
+
+// executeTestWithRetries executes test and retries it according to the request retry policy,
+// sync executions return the last attempt, async ones are retried in the background
+func (s *Scheduler) executeTestWithRetries(ctx context.Context, test testkube.Test, request testkube.ExecutionRequest) (
+	execution testkube.Execution, err error) {
+	if !request.RetryPolicy.IsEnabled() {
+		return s.executeTest(ctx, test, request)
+	}
+
+	if request.Attempt == 0 {
+		request.Attempt = 1
+	}
+
+	execution, err = s.executeTest(ctx, test, request)
+	if err != nil {
+		return execution, err
+	}
+
+	if request.Sync {
+		return s.retryExecution(ctx, test, request, execution)
+	}
+
+	if s.executionRetries != nil {
+		if err = s.scheduleRetry(ctx, request, execution); err != nil {
+			s.logger.Errorw("scheduling test execution retry error", "executionId", execution.Id, "error", err)
+		}
+		return execution, nil
+	}
+
+	// without the retries storage, keep the execution context values, but don't stop with the request
+	go func() {
+		if _, err := s.retryExecution(context.WithoutCancel(ctx), test, request, execution); err != nil {
+			s.logger.Errorw("retrying test execution error", "executionId", execution.Id, "error", err)
+		}
+	}()
+
+	return execution, nil
+}
+
+// WithExecutionRetries enables storing the retries of the async test executions,
+// so they are resumed after the restart, and may be cancelled with the execution abort
+func (s *Scheduler) WithExecutionRetries(executionRetries executionretry.Repository) *Scheduler {
+	s.executionRetries = executionRetries
+	return s
+}
+
+// IsExecutionRetriesEnabled checks if the retries of the async test executions are stored
+func (s *Scheduler) IsExecutionRetriesEnabled() bool {
+	return s.executionRetries != nil
+}
+
+// CancelExecutionRetry removes the pending retry of the test execution
+func (s *Scheduler) CancelExecutionRetry(ctx context.Context, id string) error {
+	if s.executionRetries == nil {
+		return nil
+	}
+
+	_, err := s.executionRetries.Delete(ctx, id)
+	return err
+}
+
+// scheduleRetry stores the test execution to retry it once it's finished
+func (s *Scheduler) scheduleRetry(ctx context.Context, request testkube.ExecutionRequest, execution testkube.Execution) error {
+	if request.Attempt >= request.RetryPolicy.MaxAttempts {
+		return nil
+	}
+	if request.RetryOf == "" {
+		request.RetryOf = execution.Id
+	}
+
+	// store the obfuscated variables only, the secret values are already kept in the references
+	request.Id = execution.Id
+	request.Variables = execution.Variables
+	return s.executionRetries.Insert(ctx, executionretry.Retry{
+		Id:            execution.Id,
+		Name:          execution.Name,
+		TestName:      execution.TestName,
+		TestNamespace: execution.TestNamespace,
+		TestType:      execution.TestType,
+		ScheduledAt:   time.Now(),
+		Request:       request,
+	})
+}
+
+// RunExecutionRetries periodically starts the next attempts of the finished test executions,
+// until the context is done. As the retries are persisted, it also resumes the ones scheduled before the restart.
+func (s *Scheduler) RunExecutionRetries(ctx context.Context, interval time.Duration) {
+	if s.executionRetries == nil {
+		return
+	}
+	if interval <= 0 {
+		interval = DefaultQueuePollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, item := range s.claimExecutionRetries(ctx) {
+			go s.startExecutionRetry(ctx, item)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// claimExecutionRetries marks the test executions which should be retried now
+func (s *Scheduler) claimExecutionRetries(ctx context.Context) (claimed []executionretry.Retry) {
+	items, err := s.executionRetries.List(ctx)
+	if err != nil {
+		s.logger.Errorw("can't list test execution retries", "error", err)
+		return nil
+	}
+
+	now := time.Now()
+	expiredBefore := now.Add(-queueClaimTimeout)
+	for _, item := range items {
+		if item.IsClaimed(expiredBefore) {
+			continue
+		}
+
+		execution, err := s.testResults.Get(ctx, item.Id)
+		if err != nil {
+			s.logger.Errorw("can't get test execution to retry", "executionId", item.Id, "error", err)
+			continue
+		}
+		if execution.ExecutionResult == nil || execution.ExecutionResult.IsQueued() || execution.ExecutionResult.IsRunning() {
+			continue
+		}
+
+		policy := item.Request.RetryPolicy
+		if !policy.IsRetriable(execution.ExecutionResult.Status) || item.Request.Attempt >= policy.MaxAttempts {
+			if _, err = s.executionRetries.Delete(ctx, item.Id); err != nil {
+				s.logger.Errorw("can't remove test execution retry", "executionId", item.Id, "error", err)
+			}
+			continue
+		}
+
+		backoff, err := policy.GetBackoff(item.Request.Attempt + 1)
+		if err != nil {
+			s.logger.Errorw("can't get test execution retry backoff", "executionId", item.Id, "error", err)
+			continue
+		}
+		finishedAt := execution.EndTime
+		if finishedAt.IsZero() {
+			finishedAt = item.ScheduledAt
+		}
+		if now.Before(finishedAt.Add(backoff)) {
+			continue
+		}
+
+		ok, err := s.executionRetries.Claim(ctx, item.Id, expiredBefore)
+		if err != nil {
+			s.logger.Errorw("can't claim test execution retry", "executionId", item.Id, "error", err)
+			continue
+		}
+		// it has been claimed by another instance or cancelled in the meantime
+		if !ok {
+			continue
+		}
+		claimed = append(claimed, item)
+	}
+	return claimed
+}
+
+// startExecutionRetry starts the next attempt of the claimed test execution,
+// and replaces the stored retry with the one for the started attempt
+func (s *Scheduler) startExecutionRetry(ctx context.Context, item executionretry.Retry) {
+	testCR, err := s.testsClient.Get(item.TestName)
+	if err != nil {
+		s.logger.Errorw("can't get test to retry", "executionId", item.Id, "test", item.TestName, "error", err)
+		if err = s.executionRetries.Release(ctx, item.Id); err != nil {
+			s.logger.Errorw("can't release test execution retry", "executionId", item.Id, "error", err)
+		}
+		return
+	}
+
+	attempt := item.Request.Attempt + 1
+	request := newRetryRequest(item.Request, item.Request.RetryOf, attempt)
+	s.logger.Infow("retrying test execution", "executionId", item.Id, "attempt", attempt,
+		"maxAttempts", request.RetryPolicy.MaxAttempts)
+	execution, err := s.executeTest(ctx, testsmapper.MapTestCRToAPI(*testCR), request)
+	if err != nil {
+		s.logger.Errorw("retrying test execution error", "executionId", item.Id, "attempt", attempt, "error", err)
+	}
+
+	// the attempt has not been created, so try again later
+	if execution.Id == "" {
+		if err = s.executionRetries.Release(ctx, item.Id); err != nil {
+			s.logger.Errorw("can't release test execution retry", "executionId", item.Id, "error", err)
+		}
+		return
+	}
+
+	// the next attempts are built from the original request, not from the renamed one
+	next := item.Request
+	next.Attempt = attempt
+	if err = s.scheduleRetry(ctx, next, execution); err != nil {
+		s.logger.Errorw("scheduling test execution retry error", "executionId", execution.Id, "error", err)
+	}
+	if _, err = s.executionRetries.Delete(ctx, item.Id); err != nil {
+		s.logger.Errorw("can't remove test execution retry", "executionId", item.Id, "error", err)
+	}
+}
+
+// retryExecution starts next attempts of the execution until it's not retriable anymore
+func (s *Scheduler) retryExecution(ctx context.Context, test testkube.Test, request testkube.ExecutionRequest,
+	execution testkube.Execution) (testkube.Execution, error) {
+	firstId := execution.Id
+	if request.RetryOf != "" {
+		firstId = request.RetryOf
+	}
+
+	for attempt := request.Attempt + 1; attempt <= request.RetryPolicy.MaxAttempts; attempt++ {
+		if execution.ExecutionResult == nil || execution.ExecutionResult.IsQueued() || execution.ExecutionResult.IsRunning() {
+			var err error
+			if execution, err = s.waitForExecution(ctx, execution.Id); err != nil {
+				return execution, err
+			}
+		}
+
+		if execution.ExecutionResult == nil || !request.RetryPolicy.IsRetriable(execution.ExecutionResult.Status) {
+			return execution, nil
+		}
+
+		backoff, err := request.RetryPolicy.GetBackoff(attempt)
+		if err != nil {
+			return execution, err
+		}
+
+		s.logger.Infow("retrying test execution", "executionId", execution.Id, "attempt", attempt,
+			"maxAttempts", request.RetryPolicy.MaxAttempts, "backoff", backoff)
+		if backoff != 0 {
+			select {
+			case <-ctx.Done():
+				return execution, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		execution, err = s.executeTest(ctx, test, newRetryRequest(request, firstId, attempt))
+		if err != nil {
+			return execution, err
+		}
+	}
+
+	return execution, nil
+}
+
+// newRetryRequest builds execution request for the next attempt
+func newRetryRequest(request testkube.ExecutionRequest, retryOf string, attempt int32) testkube.ExecutionRequest {
+	request.Id = ""
+	request.RetryOf = retryOf
+	request.Attempt = attempt
+	// test suite step names are suffixed with the execution number already
+	if request.Name != "" && request.TestSuiteName == "" {
+		request.Name = fmt.Sprintf("%s-attempt-%d", request.Name, attempt)
+	}
+
+	return request
+}
@@ -0,0 +1,148 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/executionretry"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+)
+
+func TestNewRetryRequest(t *testing.T) {
+	request := testkube.ExecutionRequest{Id: "first", Name: "custom", Attempt: 1}
+
+	retry := newRetryRequest(request, "first", 2)
+
+	assert.Empty(t, retry.Id)
+	assert.Equal(t, "first", retry.RetryOf)
+	assert.Equal(t, int32(2), retry.Attempt)
+	assert.Equal(t, "custom-attempt-2", retry.Name)
+
+	request.TestSuiteName = "suite"
+	retry = newRetryRequest(request, "first", 3)
+
+	assert.Equal(t, "custom", retry.Name)
+}
+
+func TestScheduler_RetryExecution_NotRetriable(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	resultsRepository := result.NewMockRepository(mockCtrl)
+	sc := &Scheduler{
+		logger:      log.DefaultLogger,
+		testResults: resultsRepository,
+	}
+	request := testkube.ExecutionRequest{
+		Sync:        true,
+		Attempt:     1,
+		RetryPolicy: &testkube.ExecutionRetryPolicy{MaxAttempts: 3},
+	}
+	resultsRepository.EXPECT().Get(gomock.Any(), "running").Return(testkube.Execution{
+		Id:              "running",
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusPassed},
+	}, nil)
+
+	execution, err := sc.retryExecution(context.Background(), testkube.Test{Name: "test"}, request, testkube.Execution{
+		Id:              "running",
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusRunning},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "running", execution.Id)
+	assert.True(t, execution.IsPassed())
+}
+
+func TestMergeStepRequest_RetryPolicy(t *testing.T) {
+	suitePolicy := &testkube.ExecutionRetryPolicy{MaxAttempts: 2}
+	stepPolicy := &testkube.ExecutionRetryPolicy{MaxAttempts: 5}
+
+	request := MergeStepRequest(&testkube.TestSuiteStepExecutionRequest{}, testkube.ExecutionRequest{RetryPolicy: suitePolicy})
+	assert.Equal(t, suitePolicy, request.RetryPolicy)
+
+	request = MergeStepRequest(&testkube.TestSuiteStepExecutionRequest{RetryPolicy: stepPolicy}, testkube.ExecutionRequest{RetryPolicy: suitePolicy})
+	assert.Equal(t, stepPolicy, request.RetryPolicy)
+}
+
+func TestScheduler_ScheduleRetry(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	retriesRepository := executionretry.NewMockRepository(mockCtrl)
+	sc := (&Scheduler{logger: log.DefaultLogger}).WithExecutionRetries(retriesRepository)
+	policy := &testkube.ExecutionRetryPolicy{MaxAttempts: 2}
+	execution := testkube.Execution{
+		Id:       "first",
+		TestName: "test",
+		Variables: map[string]testkube.Variable{
+			"secret": {Name: "secret", Type_: testkube.VariableTypeSecret, SecretRef: &testkube.SecretRef{Name: "first-vars", Key: "secret"}},
+		},
+	}
+
+	retriesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, retry executionretry.Retry) error {
+		assert.Equal(t, "first", retry.Id)
+		assert.Equal(t, "first", retry.Request.RetryOf)
+		assert.Equal(t, int32(1), retry.Request.Attempt)
+		assert.Equal(t, "first-vars", retry.Request.Variables["secret"].SecretRef.Name)
+		return nil
+	})
+
+	assert.NoError(t, sc.scheduleRetry(context.Background(), testkube.ExecutionRequest{Attempt: 1, RetryPolicy: policy,
+		Variables: map[string]testkube.Variable{"secret": {Name: "secret", Type_: testkube.VariableTypeSecret, Value: "value"}}}, execution))
+
+	// the last attempt is not retried anymore
+	assert.NoError(t, sc.scheduleRetry(context.Background(), testkube.ExecutionRequest{Attempt: 2, RetryPolicy: policy}, execution))
+}
+
+func TestScheduler_ClaimExecutionRetries(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	retriesRepository := executionretry.NewMockRepository(mockCtrl)
+	resultsRepository := result.NewMockRepository(mockCtrl)
+	sc := (&Scheduler{
+		logger:      log.DefaultLogger,
+		testResults: resultsRepository,
+	}).WithExecutionRetries(retriesRepository)
+
+	now := time.Now()
+	policy := &testkube.ExecutionRetryPolicy{MaxAttempts: 3, Backoff: "1h"}
+	retriesRepository.EXPECT().List(gomock.Any()).Return([]executionretry.Retry{
+		{Id: "running", Request: testkube.ExecutionRequest{Attempt: 1, RetryPolicy: policy}},
+		{Id: "passed", Request: testkube.ExecutionRequest{Attempt: 1, RetryPolicy: policy}},
+		{Id: "backoff", Request: testkube.ExecutionRequest{Attempt: 1, RetryPolicy: policy}},
+		{Id: "claimed", Request: testkube.ExecutionRequest{Attempt: 1, RetryPolicy: policy}, ClaimedAt: &now},
+		{Id: "ready", Request: testkube.ExecutionRequest{Attempt: 1, RetryPolicy: policy}},
+	}, nil)
+	resultsRepository.EXPECT().Get(gomock.Any(), "running").Return(testkube.Execution{
+		Id:              "running",
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusRunning},
+	}, nil)
+	resultsRepository.EXPECT().Get(gomock.Any(), "passed").Return(testkube.Execution{
+		Id:              "passed",
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusPassed},
+	}, nil)
+	retriesRepository.EXPECT().Delete(gomock.Any(), "passed").Return(true, nil)
+	resultsRepository.EXPECT().Get(gomock.Any(), "backoff").Return(testkube.Execution{
+		Id:              "backoff",
+		EndTime:         now,
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusFailed},
+	}, nil)
+	resultsRepository.EXPECT().Get(gomock.Any(), "ready").Return(testkube.Execution{
+		Id:              "ready",
+		EndTime:         now.Add(-2 * time.Hour),
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusFailed},
+	}, nil)
+	retriesRepository.EXPECT().Claim(gomock.Any(), "ready", gomock.Any()).Return(true, nil)
+
+	claimed := sc.claimExecutionRetries(context.Background())
+
+	assert.Len(t, claimed, 1)
+	assert.Equal(t, "ready", claimed[0].Id)
+}
@@ -1,10 +1,14 @@
 package scheduler
 
 import (
+	"sync"
+
 	"go.uber.org/zap"
 
 	"github.com/kubeshop/testkube/pkg/event/bus"
 	"github.com/kubeshop/testkube/pkg/repository/config"
+	"github.com/kubeshop/testkube/pkg/repository/executionqueue"
+	"github.com/kubeshop/testkube/pkg/repository/executionretry"
 
 	executorsv1 "github.com/kubeshop/testkube-operator/pkg/client/executors/v1"
 	testsv3 "github.com/kubeshop/testkube-operator/pkg/client/tests/v3"
@@ -46,6 +50,10 @@ type Scheduler struct {
 	subscriptionChecker       checktcl.SubscriptionChecker
 	namespace                 string
 	agentAPITLSSecret         string
+	executionQueue            executionqueue.Repository
+	queueLimits               QueueLimits
+	queueMutex                sync.Mutex
+	executionRetries          executionretry.Repository
 }
 
 func NewScheduler(
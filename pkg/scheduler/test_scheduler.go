@@ -20,6 +20,7 @@ import (
 	testsmapper "github.com/kubeshop/testkube/pkg/mapper/tests"
 	"github.com/kubeshop/testkube/pkg/tcl/checktcl"
 	"github.com/kubeshop/testkube/pkg/tcl/schedulertcl"
+	"github.com/kubeshop/testkube/pkg/tracing"
 	"github.com/kubeshop/testkube/pkg/workerpool"
 )
 
@@ -35,7 +36,7 @@ func (s *Scheduler) PrepareTestRequests(work []testsv3.Test, request testkube.Ex
 		requests[i] = workerpool.Request[testkube.Test, testkube.ExecutionRequest, testkube.Execution]{
 			Object:  testsmapper.MapTestCRToAPI(work[i]),
 			Options: request,
-			ExecFn:  s.executeTest,
+			ExecFn:  s.executeTestWithRetries,
 		}
 	}
 
@@ -44,6 +45,12 @@ func (s *Scheduler) PrepareTestRequests(work []testsv3.Test, request testkube.Ex
 
 func (s *Scheduler) executeTest(ctx context.Context, test testkube.Test, request testkube.ExecutionRequest) (
 	execution testkube.Execution, err error) {
+	ctx, span := tracing.Start(ctx, "Scheduler.ExecuteTest", tracing.TestNameKey.String(test.Name), tracing.TestTypeKey.String(test.Type_))
+	defer func() {
+		span.SetAttributes(tracing.ExecutionIDKey.String(execution.Id), tracing.ExecutionNameKey.String(execution.Name))
+		tracing.End(span, err)
+	}()
+
 	// generate random execution name in case there is no one set
 	// like for docker images
 	if request.Name == "" && test.ExecutionRequest != nil && test.ExecutionRequest.Name != "" {
@@ -87,6 +94,10 @@ func (s *Scheduler) executeTest(ctx context.Context, test testkube.Test, request
 
 	options.ID = execution.Id
 
+	if s.executionQueue != nil {
+		return s.enqueueExecution(ctx, request, options, execution)
+	}
+
 	s.events.Notify(testkube.NewEventStartTest(&execution))
 
 	if err := s.createSecretsReferences(&execution, &options); err != nil {
@@ -98,12 +109,17 @@ func (s *Scheduler) executeTest(ctx context.Context, test testkube.Test, request
 		return s.handleExecutionError(ctx, execution, "can't create new test execution, can't insert into storage: %w", err)
 	}
 
+	return s.startExecution(ctx, options, execution)
+}
+
+// startExecution starts the stored test execution with the executor
+func (s *Scheduler) startExecution(ctx context.Context, options client.ExecuteOptions, execution testkube.Execution) (testkube.Execution, error) {
 	s.logger.Infow("calling executor with options", "executionId", execution.Id, "options", options.Request)
 
 	execution.Start()
 
 	// update storage with current execution status
-	err = s.testResults.StartExecution(ctx, execution.Id, execution.StartTime)
+	err := s.testResults.StartExecution(ctx, execution.Id, execution.StartTime)
 	if err != nil {
 		return s.handleExecutionError(ctx, execution, "can't execute test, can't insert into storage error: %w", err)
 	}
@@ -160,6 +176,9 @@ func (s *Scheduler) handleExecutionError(ctx context.Context, execution testkube
 
 	}
 
+	// mark the trace as failed, as the error is stored in the execution
+	tracing.Fail(ctx, err)
+
 	// notify events that execution failed
 	s.events.Notify(testkube.NewEventEndTestFailed(&execution))
 
@@ -204,7 +223,28 @@ func (s *Scheduler) getNextExecutionNumber(testName string) int32 {
 
 // createSecretsReferences strips secrets from text and store it inside model as reference to secret
 func (s *Scheduler) createSecretsReferences(execution *testkube.Execution, options *client.ExecuteOptions) (err error) {
-	secrets := map[string]string{}
+	secrets, err := s.getSecretsReferences(execution, options)
+	if err != nil {
+		return err
+	}
+
+	labels := map[string]string{"executionID": execution.Id, "testName": execution.TestName}
+
+	if len(secrets) > 0 {
+		return s.secretClient.Create(
+			execution.Id+"-vars",
+			labels,
+			secrets,
+			execution.TestNamespace,
+		)
+	}
+
+	return nil
+}
+
+// getSecretsReferences replaces secrets with references to the execution secret, and returns the secret values to store
+func (s *Scheduler) getSecretsReferences(execution *testkube.Execution, options *client.ExecuteOptions) (secrets map[string]string, err error) {
+	secrets = map[string]string{}
 	secretName := execution.Id + "-vars"
 
 	for k, v := range execution.Variables {
@@ -244,12 +284,12 @@ func (s *Scheduler) createSecretsReferences(execution *testkube.Execution, optio
 
 		data, err := s.secretClient.Get(secretRef.Name)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		value, ok := data[secretRef.Key]
 		if !ok {
-			return fmt.Errorf("secret key %s not found for secret %s", secretRef.Key, secretRef.Name)
+			return nil, fmt.Errorf("secret key %s not found for secret %s", secretRef.Key, secretRef.Name)
 		}
 
 		secrets[gitCredentialPrefix+secretRef.Key] = value
@@ -257,18 +297,7 @@ func (s *Scheduler) createSecretsReferences(execution *testkube.Execution, optio
 		secretRef.Key = gitCredentialPrefix + secretRef.Key
 	}
 
-	labels := map[string]string{"executionID": execution.Id, "testName": execution.TestName}
-
-	if len(secrets) > 0 {
-		return s.secretClient.Create(
-			secretName,
-			labels,
-			secrets,
-			execution.TestNamespace,
-		)
-	}
-
-	return nil
+	return secrets, nil
 }
 
 func newExecutionFromExecutionOptions(subscriptionChecker *checktcl.SubscriptionChecker, options client.ExecuteOptions) (testkube.Execution, error) {
@@ -305,6 +334,9 @@ func newExecutionFromExecutionOptions(subscriptionChecker *checktcl.Subscription
 	execution.DownloadArtifactExecutionIDs = options.Request.DownloadArtifactExecutionIDs
 	execution.DownloadArtifactTestNames = options.Request.DownloadArtifactTestNames
 	execution.SlavePodRequest = options.Request.SlavePodRequest
+	execution.RetryPolicy = options.Request.RetryPolicy
+	execution.RetryOf = options.Request.RetryOf
+	execution.Attempt = options.Request.Attempt
 
 	// Pro edition only (tcl protected code)
 	if schedulertcl.HasExecutionNamespace(&options.Request) {
@@ -16,6 +16,7 @@ import (
 	testsuitesmapper "github.com/kubeshop/testkube/pkg/mapper/testsuites"
 
 	"github.com/kubeshop/testkube/pkg/telemetry"
+	"github.com/kubeshop/testkube/pkg/tracing"
 	"github.com/kubeshop/testkube/pkg/version"
 	"github.com/kubeshop/testkube/pkg/workerpool"
 )
@@ -50,6 +51,12 @@ func (s *Scheduler) PrepareTestSuiteRequests(work []testsuitesv3.TestSuite, requ
 
 func (s *Scheduler) executeTestSuite(ctx context.Context, testSuite testkube.TestSuite, request testkube.TestSuiteExecutionRequest) (
 	testsuiteExecution testkube.TestSuiteExecution, err error) {
+	ctx, span := tracing.Start(ctx, "Scheduler.ExecuteTestSuite", tracing.TestSuiteNameKey.String(testSuite.Name))
+	defer func() {
+		span.SetAttributes(tracing.ExecutionIDKey.String(testsuiteExecution.Id), tracing.ExecutionNameKey.String(testsuiteExecution.Name))
+		tracing.End(span, err)
+	}()
+
 	s.logger.Debugw("Got testsuite to execute", "test", testSuite)
 	secretUUID, err := s.testSuitesClient.GetCurrentSecretUUID(testSuite.Name)
 	if err != nil {
@@ -139,6 +146,13 @@ func (s *Scheduler) executeTestSuite(ctx context.Context, testSuite testkube.Tes
 }
 
 func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteExecution *testkube.TestSuiteExecution, request testkube.TestSuiteExecutionRequest) {
+	ctx, span := tracing.Start(ctx, "Scheduler.RunTestSuite", tracing.ExecutionIDKey.String(testsuiteExecution.Id))
+	defer func() {
+		if testsuiteExecution.Status != nil {
+			span.SetAttributes(tracing.ExecutionStatusKey.String(string(*testsuiteExecution.Status)))
+		}
+		span.End()
+	}()
 	defer s.runAfterEachStep(ctx, testsuiteExecution, wg)
 
 	s.logger.Infow("Running steps", "test", testsuiteExecution.Name)
@@ -152,6 +166,10 @@ func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteE
 
 	go s.timeoutCheck(ctx, testsuiteExecution, request.Timeout)
 
+	// the retries of the test steps are cancelled together with the test suite execution
+	retryCtx, cancelRetries := context.WithCancel(ctx)
+	defer cancelRetries()
+
 	err := s.eventsBus.SubscribeTopic(bus.InternalSubscribeTopic, testsuiteExecution.Name, func(event testkube.Event) error {
 		s.logger.Infow("test suite abortion event in runSteps", "event", event)
 		if event.TestSuiteExecution != nil &&
@@ -159,6 +177,7 @@ func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteE
 			event.Type_ != nil &&
 			(*event.Type_ == testkube.END_TESTSUITE_ABORTED_EventType || *event.Type_ == testkube.END_TESTSUITE_TIMEOUT_EventType) {
 			s.logger.Infow("Aborting test suite execution", "execution", testsuiteExecution.Id)
+			cancelRetries()
 
 			status := testkube.TestSuiteExecutionStatusAborting
 			if *event.Type_ == testkube.END_TESTSUITE_TIMEOUT_EventType {
@@ -215,7 +234,7 @@ func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteE
 			s.logger.Infow("Updating test execution", "error", err)
 		}
 
-		s.executeTestStep(ctx, *testsuiteExecution, request, batchStepResult, testsuiteExecution.ExecuteStepResults[:i])
+		s.executeTestStep(ctx, retryCtx, *testsuiteExecution, request, batchStepResult, testsuiteExecution.ExecuteStepResults[:i])
 
 		var results []*testkube.ExecutionResult
 		for j := range batchStepResult.Execute {
@@ -378,7 +397,7 @@ func (s *Scheduler) timeoutCheck(ctx context.Context, testsuiteExecution *testku
 	s.logger.Debugw("Timeout check, finished checking", "test", testsuiteExecution.Name)
 }
 
-func (s *Scheduler) executeTestStep(ctx context.Context, testsuiteExecution testkube.TestSuiteExecution,
+func (s *Scheduler) executeTestStep(ctx, retryCtx context.Context, testsuiteExecution testkube.TestSuiteExecution,
 	request testkube.TestSuiteExecutionRequest, result *testkube.TestSuiteBatchStepExecutionResult,
 	previousSteps []testkube.TestSuiteBatchStepExecutionResult) {
 
@@ -505,15 +524,19 @@ func (s *Scheduler) executeTestStep(ctx context.Context, testsuiteExecution test
 			DownloadArtifactExecutionIDs: executionIDs,
 		}
 
+		execFn := func(_ context.Context, test testkube.Test, request testkube.ExecutionRequest) (testkube.Execution, error) {
+			return s.executeTestWithRetries(retryCtx, test, request)
+		}
 		requests := make([]workerpool.Request[testkube.Test, testkube.ExecutionRequest, testkube.Execution], len(testTuples))
 		for i := range testTuples {
 			req.Name = fmt.Sprintf("%s-%s", testSuiteName, testTuples[i].test.Name)
 			req.Id = testTuples[i].executionID
+			req.RetryPolicy = request.RetryPolicy
 			req = MergeStepRequest(testTuples[i].stepRequest, req)
 			requests[i] = workerpool.Request[testkube.Test, testkube.ExecutionRequest, testkube.Execution]{
 				Object:  testTuples[i].test,
 				Options: req,
-				ExecFn:  s.executeTest,
+				ExecFn:  execFn,
 			}
 		}
 
@@ -544,7 +567,8 @@ func (s *Scheduler) executeTestStep(ctx context.Context, testsuiteExecution test
 					continue
 				}
 
-				if result.Execute[i].Execution.Id == r.Result.Id {
+				// retried steps are reported with the last attempt, linked to the first one
+				if result.Execute[i].Execution.Id == r.Result.Id || result.Execute[i].Execution.Id == r.Result.RetryOf {
 					result.Execute[i].Execution = &value
 
 					if err := s.testsuiteResults.Update(ctx, testsuiteExecution); err != nil {
@@ -661,6 +685,10 @@ func MergeStepRequest(stepRequest *testkube.TestSuiteStepExecutionRequest, execu
 	executionRequest.PvcTemplate = setStringField(executionRequest.PvcTemplate, stepRequest.PvcTemplate)
 	executionRequest.PvcTemplateReference = setStringField(executionRequest.PvcTemplate, stepRequest.PvcTemplateReference)
 
+	if stepRequest.RetryPolicy != nil {
+		executionRequest.RetryPolicy = stepRequest.RetryPolicy
+	}
+
 	if stepRequest.RunningContext != nil {
 		executionRequest.RunningContext = &testkube.RunningContext{
 			Type_:   string(stepRequest.RunningContext.Type_),
@@ -13,6 +13,7 @@ import (
 
 	"github.com/kubeshop/testkube/pkg/log"
 	"github.com/kubeshop/testkube/pkg/problem"
+	"github.com/kubeshop/testkube/pkg/tracing"
 )
 
 // NewServer returns new HTTP server instance, initializes logger and metrics
@@ -40,6 +41,9 @@ type HTTPServer struct {
 // Init initializes router and setting up basic routes for health and metrics
 func (s *HTTPServer) Init() {
 
+	// trace the requests, continuing the trace from the client
+	s.Mux.Use(tracing.Middleware("/health", "/metrics"))
+
 	// global log for requests
 	s.Mux.Use(func(c *fiber.Ctx) error {
 		s.Log.Debugw("request", "method", string(c.Request().Header.Method()), "path", c.Request().URI().String())
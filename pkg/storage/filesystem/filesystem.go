@@ -0,0 +1,560 @@
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/archive"
+	"github.com/kubeshop/testkube/pkg/executor/output"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+var _ storage.Client = (*Client)(nil)
+
+const (
+	// uploadFilePrefix is used for the temporary files, so the partially written objects are never visible
+	uploadFilePrefix = ".upload-"
+
+	// Metadata used by the MinIO to extract the uploaded tarball
+	autoExtractMetadata   = "X-Amz-Meta-Snowball-Auto-Extract"
+	extractPrefixMetadata = "X-Amz-Meta-Minio-Snowball-Prefix"
+)
+
+// ErrPresignNotConfigured is returned when the URLs can't be signed
+var ErrPresignNotConfigured = errors.New("filesystem storage: signed URLs are not configured")
+
+// Client for managing the storage in the local directory, i.e. mounted from PVC.
+// Each bucket is a directory in the root, and each object is a file in the bucket directory.
+type Client struct {
+	root   string
+	bucket string
+	signer *Signer
+	Log    *zap.SugaredLogger
+}
+
+// Option to configure the filesystem client
+type Option func(*Client)
+
+// WithSigner allows to build the signed URLs for the direct download and upload of the objects
+func WithSigner(signer *Signer) Option {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// NewClient returns new filesystem storage client
+func NewClient(root, bucket string, opts ...Option) *Client {
+	c := &Client{
+		root:   root,
+		bucket: bucket,
+		Log:    log.DefaultLogger,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Connect ensures that the directory for the bucket from the config exists
+func (c *Client) Connect() error {
+	bucketPath, err := c.objectPath(c.bucket, "")
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(bucketPath, 0755)
+}
+
+// Signer returns the signer used for the presigned URLs
+func (c *Client) Signer() *Signer {
+	return c.signer
+}
+
+// objectKey builds the object key in the bucket
+func objectKey(bucketFolder, file string) string {
+	if bucketFolder == "" {
+		return file
+	}
+	return strings.Trim(bucketFolder, "/") + "/" + file
+}
+
+// objectPath builds the path of the object in the bucket, ensuring it is not outside the bucket directory
+func (c *Client) objectPath(bucket, key string) (string, error) {
+	if bucket == "" || bucket == "." || bucket == ".." || strings.ContainsAny(bucket, `/\`) {
+		return "", fmt.Errorf("invalid bucket name: %q", bucket)
+	}
+	bucketPath := filepath.Join(c.root, bucket)
+	objectPath := filepath.Join(bucketPath, filepath.FromSlash(key))
+	if objectPath != bucketPath && !strings.HasPrefix(objectPath, bucketPath+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object key: %q", key)
+	}
+	return objectPath, nil
+}
+
+func (c *Client) bucketExists(bucket string) (bool, error) {
+	bucketPath, err := c.objectPath(bucket, "")
+	if err != nil {
+		return false, err
+	}
+	stat, err := os.Stat(bucketPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return stat.IsDir(), nil
+}
+
+// CreateBucket creates new bucket directory
+func (c *Client) CreateBucket(ctx context.Context, bucket string) error {
+	exists, err := c.bucketExists(bucket)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("bucket %q already exists", bucket)
+	}
+	bucketPath, err := c.objectPath(bucket, "")
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(bucketPath, 0755)
+}
+
+// DeleteBucket deletes bucket by name
+func (c *Client) DeleteBucket(ctx context.Context, bucket string, force bool) error {
+	bucketPath, err := c.objectPath(bucket, "")
+	if err != nil {
+		return err
+	}
+	if force {
+		return os.RemoveAll(bucketPath)
+	}
+	return os.Remove(bucketPath)
+}
+
+// ListBuckets lists available buckets
+func (c *Client) ListBuckets(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(c.root)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var toReturn []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			toReturn = append(toReturn, entry.Name())
+		}
+	}
+	return toReturn, nil
+}
+
+// walk lists the objects in the bucket folder, with the keys relative to the bucket
+func (c *Client) walk(bucket, bucketFolder string) ([]minio.ObjectInfo, error) {
+	exists, err := c.bucketExists(bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		c.Log.Debugw("bucket doesn't exist", "bucket", bucket)
+		return nil, storage.ErrArtifactsNotFound
+	}
+	bucketPath, _ := c.objectPath(bucket, "")
+	folderPath, err := c.objectPath(bucket, strings.Trim(bucketFolder, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []minio.ObjectInfo
+	err = filepath.WalkDir(folderPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if filePath == folderPath && os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), uploadFilePrefix) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		key, err := filepath.Rel(bucketPath, filePath)
+		if err != nil {
+			return err
+		}
+		result = append(result, minio.ObjectInfo{
+			Key:          filepath.ToSlash(key),
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing files in bucket %s folder %s", bucket, bucketFolder)
+	}
+	return result, nil
+}
+
+// ListFiles lists available files in the bucket from the config
+func (c *Client) ListFiles(ctx context.Context, bucketFolder string) ([]testkube.Artifact, error) {
+	c.Log.Infow("listing files", "bucket", c.bucket, "bucketFolder", bucketFolder)
+	objects, err := c.walk(c.bucket, bucketFolder)
+	if err != nil {
+		return nil, err
+	}
+	var toReturn []testkube.Artifact
+	for _, obj := range objects {
+		if bucketFolder != "" {
+			obj.Key = strings.TrimPrefix(obj.Key, strings.Trim(bucketFolder, "/")+"/")
+		}
+		toReturn = append(toReturn, testkube.Artifact{Name: obj.Key, Size: int32(obj.Size)})
+	}
+	return toReturn, nil
+}
+
+// ListObjects lists objects in the bucket folder from the config, along with their metadata
+func (c *Client) ListObjects(ctx context.Context, bucketFolder string) ([]minio.ObjectInfo, error) {
+	objects, err := c.walk(c.bucket, bucketFolder)
+	if errors.Is(err, storage.ErrArtifactsNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if bucketFolder != "" {
+		for i := range objects {
+			objects[i].Key = strings.TrimPrefix(objects[i].Key, strings.Trim(bucketFolder, "/")+"/")
+		}
+	}
+	return objects, nil
+}
+
+// write saves the object atomically, creating the bucket directory when needed
+func (c *Client) write(bucket, key string, reader io.Reader) error {
+	filePath, err := c.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return errors.Wrapf(err, "creating directory for %s", key)
+	}
+	file, err := os.CreateTemp(filepath.Dir(filePath), uploadFilePrefix+"*")
+	if err != nil {
+		return errors.Wrapf(err, "creating file for %s", key)
+	}
+	defer os.Remove(file.Name())
+	if _, err = io.Copy(file, reader); err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "writing file %s", key)
+	}
+	if err = file.Close(); err != nil {
+		return errors.Wrapf(err, "writing file %s", key)
+	}
+	return os.Rename(file.Name(), filePath)
+}
+
+// SaveFile saves file defined by local filePath to the bucket from the config
+func (c *Client) SaveFile(ctx context.Context, bucketFolder, filePath string) error {
+	c.Log.Debugw("SaveFile", "bucket", c.bucket, "bucketFolder", bucketFolder, "filePath", filePath)
+	object, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("filesystem saving file (%s) open error: %w", filePath, err)
+	}
+	defer object.Close()
+	return c.write(c.bucket, objectKey(bucketFolder, filepath.Base(filePath)), object)
+}
+
+// SaveFileDirect saves the file in the bucket from the config.
+// The tarball is extracted when it is requested with the MinIO metadata.
+func (c *Client) SaveFileDirect(ctx context.Context, folder, file string, data io.Reader, size int64, opts minio.PutObjectOptions) error {
+	return c.SaveFileDirectToBucket(ctx, c.bucket, folder, file, data, size, opts)
+}
+
+// SaveFileDirectToBucket saves the file in given bucket.
+// The tarball is extracted when it is requested with the MinIO metadata.
+func (c *Client) SaveFileDirectToBucket(ctx context.Context, bucket, folder, file string, data io.Reader, size int64, opts minio.PutObjectOptions) error {
+	if opts.UserMetadata[autoExtractMetadata] != "true" {
+		return c.write(bucket, objectKey(folder, file), data)
+	}
+
+	files, err := archive.NewTarballService().Extract(data)
+	if err != nil {
+		return errors.Wrapf(err, "extracting %s", file)
+	}
+	prefix := opts.UserMetadata[extractPrefixMetadata]
+	for _, f := range files {
+		if err = c.write(bucket, objectKey(prefix, f.Name), f.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// object is the file downloaded from the storage, closed automatically after it is read
+type object struct {
+	*os.File
+	info minio.ObjectInfo
+}
+
+func (o *object) Read(p []byte) (int, error) {
+	n, err := o.File.Read(p)
+	if err == io.EOF {
+		_ = o.File.Close()
+	}
+	return n, err
+}
+
+func (o *object) Stat() (minio.ObjectInfo, error) {
+	return o.info, nil
+}
+
+// downloadFile opens the file from the bucket
+func (c *Client) downloadFile(bucket, bucketFolder, file string) (*object, error) {
+	c.Log.Debugw("downloadFile", "bucket", bucket, "bucketFolder", bucketFolder, "file", file)
+	exists, err := c.bucketExists(bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		c.Log.Infow("bucket doesn't exist", "bucket", bucket)
+		return nil, storage.ErrArtifactsNotFound
+	}
+
+	key := objectKey(bucketFolder, file)
+	filePath, err := c.objectPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("filesystem DownloadFile open error: %w", err)
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("filesystem DownloadFile stat error: %w", err)
+	}
+	if stat.IsDir() {
+		_ = f.Close()
+		return nil, fmt.Errorf("filesystem DownloadFile error: %s is not a file", key)
+	}
+	return &object{File: f, info: minio.ObjectInfo{Key: key, Size: stat.Size(), LastModified: stat.ModTime()}}, nil
+}
+
+// DownloadFile downloads file from the bucket from the config
+func (c *Client) DownloadFile(ctx context.Context, bucketFolder, file string) (storage.Object, error) {
+	c.Log.Infow("Download file", "bucket", c.bucket, "bucketFolder", bucketFolder, "file", file)
+	obj, err := c.downloadFile(c.bucket, bucketFolder, file)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// DownloadFileFromBucket downloads file from given bucket
+func (c *Client) DownloadFileFromBucket(ctx context.Context, bucket, bucketFolder, file string) (io.Reader, minio.ObjectInfo, error) {
+	obj, err := c.downloadFile(bucket, bucketFolder, file)
+	if err != nil {
+		return nil, minio.ObjectInfo{}, err
+	}
+	return obj, obj.info, nil
+}
+
+// downloadArchive builds the tarball with the files from the bucket folder, matching any of the masks
+func (c *Client) downloadArchive(bucket, bucketFolder string, masks []string) (io.Reader, error) {
+	c.Log.Debugw("downloadArchive", "bucket", bucket, "bucketFolder", bucketFolder, "masks", masks)
+	var regexps []*regexp.Regexp
+	for _, mask := range masks {
+		for _, value := range strings.Split(mask, ",") {
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf("filesystem DownloadArchive regexp error: %w", err)
+			}
+			regexps = append(regexps, re)
+		}
+	}
+
+	objects, err := c.walk(bucket, bucketFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*archive.File
+	for _, obj := range objects {
+		found := len(regexps) == 0
+		for i := range regexps {
+			if found = regexps[i].MatchString(obj.Key); found {
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+
+		filePath, err := c.objectPath(bucket, obj.Key)
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("filesystem DownloadArchive read error: %w", err)
+		}
+		files = append(files, &archive.File{
+			Name:    obj.Key,
+			Size:    int64(len(data)),
+			Mode:    int64(os.ModePerm),
+			ModTime: obj.LastModified,
+			Data:    bytes.NewBuffer(data),
+		})
+	}
+
+	data := &bytes.Buffer{}
+	if err = archive.NewTarballService().Create(data, files); err != nil {
+		return nil, fmt.Errorf("filesystem DownloadArchive CreateArchive error: %w", err)
+	}
+	return data, nil
+}
+
+// DownloadArchive downloads archive from the bucket from the config
+func (c *Client) DownloadArchive(ctx context.Context, bucketFolder string, masks []string) (io.Reader, error) {
+	c.Log.Infow("Download archive", "bucket", c.bucket, "bucketFolder", bucketFolder, "masks", masks)
+	return c.downloadArchive(c.bucket, bucketFolder, masks)
+}
+
+// DownloadArchiveFromBucket downloads archive from given bucket
+func (c *Client) DownloadArchiveFromBucket(ctx context.Context, bucket, bucketFolder string, masks []string) (io.Reader, error) {
+	return c.downloadArchive(bucket, bucketFolder, masks)
+}
+
+// UploadFile saves a file to be copied into a running execution
+func (c *Client) UploadFile(ctx context.Context, bucketFolder, filePath string, reader io.Reader, objectSize int64) error {
+	return c.write(c.bucket, objectKey(bucketFolder, filePath), reader)
+}
+
+// UploadFileToBucket saves a file to be copied into a running execution
+func (c *Client) UploadFileToBucket(ctx context.Context, bucket, bucketFolder, filePath string, reader io.Reader, objectSize int64) error {
+	return c.write(bucket, objectKey(bucketFolder, filePath), reader)
+}
+
+// PlaceFiles saves the content of the buckets to the filesystem
+func (c *Client) PlaceFiles(ctx context.Context, bucketFolders []string, prefix string) error {
+	output.PrintLog(fmt.Sprintf("%s Getting the contents of bucket folders %s", ui.IconFile, bucketFolders))
+	exists, err := c.bucketExists(c.bucket)
+	if err != nil {
+		return fmt.Errorf("could not check if bucket already exists for files: %w", err)
+	}
+	if !exists {
+		output.PrintLog(fmt.Sprintf("%s Bucket %s does not exist", ui.IconFile, c.bucket))
+		return fmt.Errorf("bucket %s does not exist", c.bucket)
+	}
+	for _, folder := range bucketFolders {
+		files, err := c.ListFiles(ctx, folder)
+		if err != nil {
+			output.PrintLog(fmt.Sprintf("%s Could not list files in bucket %s folder %s", ui.IconWarning, c.bucket, folder))
+			return fmt.Errorf("could not list files in bucket %s folder %s", c.bucket, folder)
+		}
+
+		for _, f := range files {
+			output.PrintEvent(fmt.Sprintf("%s Downloading file %s", ui.IconFile, f.Name))
+			if err = c.placeFile(folder, f.Name, filepath.Join(prefix, f.Name)); err != nil {
+				output.PrintEvent(fmt.Sprintf("%s Could not download file %s", ui.IconCross, f.Name))
+				return fmt.Errorf("could not persist file %s from bucket %s, folder %s: %w", f.Name, c.bucket, folder, err)
+			}
+			output.PrintEvent(fmt.Sprintf("%s File %s successfully downloaded into %s", ui.IconCheckMark, f.Name, prefix))
+		}
+	}
+	return nil
+}
+
+func (c *Client) placeFile(bucketFolder, file, destination string) error {
+	obj, err := c.downloadFile(c.bucket, bucketFolder, file)
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+	if err = os.MkdirAll(filepath.Dir(destination), 0755); err != nil {
+		return err
+	}
+	target, err := os.Create(destination)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+	_, err = io.Copy(target, obj)
+	return err
+}
+
+// GetValidBucketName returns a bucket name compatible with the other storages
+func (c *Client) GetValidBucketName(parentType string, parentName string) string {
+	return storage.ValidBucketName(parentType, parentName)
+}
+
+func (c *Client) deleteFile(bucket, bucketFolder, file string) error {
+	exists, err := c.bucketExists(bucket)
+	if err != nil {
+		return fmt.Errorf("could not check if bucket already exists for delete file: %w", err)
+	}
+	if !exists {
+		c.Log.Warnf("bucket %s does not exist", bucket)
+		return storage.ErrArtifactsNotFound
+	}
+	filePath, err := c.objectPath(bucket, objectKey(bucketFolder, file))
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(filePath); err != nil {
+		return fmt.Errorf("filesystem DeleteFile error: %w", err)
+	}
+	return nil
+}
+
+// DeleteFile deletes a file from a bucket folder where bucket is provided by config
+func (c *Client) DeleteFile(ctx context.Context, bucketFolder, file string) error {
+	return c.deleteFile(c.bucket, bucketFolder, file)
+}
+
+// DeleteFileFromBucket deletes a file from a bucket folder
+func (c *Client) DeleteFileFromBucket(ctx context.Context, bucket, bucketFolder, file string) error {
+	return c.deleteFile(bucket, bucketFolder, file)
+}
+
+// IsConnectionPossible checks if the storage directory is available
+func (c *Client) IsConnectionPossible(ctx context.Context) (bool, error) {
+	if err := c.Connect(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// PresignDownloadFileFromBucket builds the signed API URL to download the file
+func (c *Client) PresignDownloadFileFromBucket(ctx context.Context, bucket, bucketFolder, file string, expires time.Duration) (string, error) {
+	if c.signer == nil {
+		return "", ErrPresignNotConfigured
+	}
+	return c.signer.Sign(http.MethodGet, bucket, objectKey(bucketFolder, file), expires)
+}
+
+// PresignUploadFileToBucket builds the signed API URL to upload the file
+func (c *Client) PresignUploadFileToBucket(ctx context.Context, bucket, bucketFolder, filePath string, expires time.Duration) (string, error) {
+	if c.signer == nil {
+		return "", ErrPresignNotConfigured
+	}
+	return c.signer.Sign(http.MethodPut, bucket, objectKey(bucketFolder, filePath), expires)
+}
@@ -0,0 +1,122 @@
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/archive"
+	"github.com/kubeshop/testkube/pkg/storage"
+)
+
+func TestClient_UploadDownload(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient(t.TempDir(), "testkube")
+	require.NoError(t, c.Connect())
+
+	require.NoError(t, c.UploadFile(ctx, "execution-1", "reports/report.xml", strings.NewReader("<xml/>"), -1))
+	require.NoError(t, c.UploadFile(ctx, "execution-1", "output.log", strings.NewReader("log"), 3))
+
+	files, err := c.ListFiles(ctx, "execution-1")
+	require.NoError(t, err)
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	assert.Equal(t, "output.log", files[0].Name)
+	assert.Equal(t, "reports/report.xml", files[1].Name)
+	assert.Equal(t, int32(6), files[1].Size)
+
+	object, err := c.DownloadFile(ctx, "execution-1", "reports/report.xml")
+	require.NoError(t, err)
+	defer object.Close()
+	data, err := io.ReadAll(object)
+	require.NoError(t, err)
+	assert.Equal(t, "<xml/>", string(data))
+	info, err := object.Stat()
+	require.NoError(t, err)
+	assert.Equal(t, "execution-1/reports/report.xml", info.Key)
+
+	require.NoError(t, c.DeleteFile(ctx, "execution-1", "output.log"))
+	_, err = c.DownloadFile(ctx, "execution-1", "output.log")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestClient_MissingBucket(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient(t.TempDir(), "testkube")
+
+	_, err := c.ListFiles(ctx, "execution-1")
+	assert.ErrorIs(t, err, storage.ErrArtifactsNotFound)
+	_, _, err = c.DownloadFileFromBucket(ctx, "logs", "", "id")
+	assert.ErrorIs(t, err, storage.ErrArtifactsNotFound)
+	objects, err := c.ListObjects(ctx, "execution-1")
+	assert.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestClient_OutsideOfBucket(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	c := NewClient(root, "testkube")
+
+	assert.Error(t, c.UploadFile(ctx, "", "../other/file", strings.NewReader("data"), 4))
+	assert.Error(t, c.UploadFileToBucket(ctx, "..", "", "file", strings.NewReader("data"), 4))
+	_, err := os.Stat(filepath.Join(root, "other"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestClient_DownloadArchive(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient(t.TempDir(), "testkube")
+	require.NoError(t, c.UploadFile(ctx, "execution-1", "report.xml", strings.NewReader("<xml/>"), -1))
+	require.NoError(t, c.UploadFile(ctx, "execution-1", "video.mp4", strings.NewReader("video"), -1))
+
+	reader, err := c.DownloadArchive(ctx, "execution-1", []string{".*\\.xml"})
+	require.NoError(t, err)
+
+	files, err := archive.NewTarballService().Extract(reader)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "execution-1/report.xml", files[0].Name)
+	assert.Equal(t, "<xml/>", files[0].Data.String())
+}
+
+func TestClient_SaveFileDirectExtract(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient(t.TempDir(), "testkube")
+	tarball := &bytes.Buffer{}
+	require.NoError(t, archive.NewTarballService().Create(tarball, []*archive.File{
+		{Name: "a/file.txt", Size: 4, Mode: 0644, ModTime: time.Now(), Data: bytes.NewBufferString("data")},
+	}))
+
+	err := c.SaveFileDirect(ctx, "execution-1", "artifacts.tar.gz", tarball, int64(tarball.Len()), minio.PutObjectOptions{
+		UserMetadata: map[string]string{autoExtractMetadata: "true", extractPrefixMetadata: "workflow/execution-1"},
+	})
+	require.NoError(t, err)
+
+	objects, err := c.ListObjects(ctx, "workflow/execution-1")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "a/file.txt", objects[0].Key)
+}
+
+func TestClient_Presign(t *testing.T) {
+	ctx := context.Background()
+	signer := NewSigner("http://testkube-api-server:8088/v1/storage", []byte("key"))
+	c := NewClient(t.TempDir(), "testkube", WithSigner(signer))
+
+	signedURL, err := c.PresignUploadFileToBucket(ctx, "logs", "testworkflows", "id 1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(signedURL, "http://testkube-api-server:8088/v1/storage/logs/testworkflows/id%201?"))
+
+	_, err = NewClient(t.TempDir(), "testkube").PresignDownloadFileFromBucket(ctx, "logs", "", "id", time.Minute)
+	assert.ErrorIs(t, err, ErrPresignNotConfigured)
+}
@@ -0,0 +1,78 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// remoteURLExpiration is how long the signed URL for the remote upload is valid
+const remoteURLExpiration = time.Hour
+
+// RemoteClient saves the objects in the filesystem storage of the API server, using the signed URLs.
+// It allows saving the files from the pods, that don't have the storage directory mounted.
+type RemoteClient struct {
+	signer *Signer
+	bucket string
+	client *http.Client
+}
+
+// NewRemoteClient returns new client for the filesystem storage exposed by the API server
+func NewRemoteClient(signer *Signer, bucket string) *RemoteClient {
+	return &RemoteClient{
+		signer: signer,
+		bucket: bucket,
+		client: http.DefaultClient,
+	}
+}
+
+// SaveFileDirect uploads the file to the bucket from the config
+func (c *RemoteClient) SaveFileDirect(ctx context.Context, folder, file string, data io.Reader, size int64, opts minio.PutObjectOptions) error {
+	key := objectKey(folder, file)
+	signedURL, err := c.signer.Sign(http.MethodPut, c.bucket, key, remoteURLExpiration)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, signedURL, data)
+	if err != nil {
+		return err
+	}
+	if size >= 0 {
+		req.ContentLength = size
+	}
+	if opts.ContentType != "" {
+		req.Header.Set("Content-Type", opts.ContentType)
+	}
+	for k, v := range opts.UserMetadata {
+		req.Header.Set(k, v)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("filesystem saving file (%s) request error: %w", key, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("filesystem saving file (%s) error: status %d: %s", key, res.StatusCode, string(body))
+	}
+	return nil
+}
+
+// PutObjectOptions reads the upload options sent along with the file by the RemoteClient
+func PutObjectOptions(header func(key string) string) minio.PutObjectOptions {
+	opts := minio.PutObjectOptions{ContentType: header("Content-Type")}
+	for _, key := range []string{autoExtractMetadata, extractPrefixMetadata} {
+		if value := header(key); value != "" {
+			if opts.UserMetadata == nil {
+				opts.UserMetadata = make(map[string]string)
+			}
+			opts.UserMetadata[key] = value
+		}
+	}
+	return opts
+}
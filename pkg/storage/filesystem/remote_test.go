@@ -0,0 +1,44 @@
+package filesystem
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteClient_SaveFileDirect(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient(t.TempDir(), "testkube")
+	var contentType string
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/storage/"), "/")
+		err := NewSigner("", []byte("key")).Verify(r.Method, bucket, key, r.URL.Query().Get(ExpiresParam), r.URL.Query().Get(SignatureParam))
+		if err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		contentType = PutObjectOptions(r.Header.Get).ContentType
+		assert.NoError(t, c.SaveFileDirectToBucket(r.Context(), bucket, "", key, r.Body, r.ContentLength, PutObjectOptions(r.Header.Get)))
+	}))
+	defer svr.Close()
+
+	remote := NewRemoteClient(NewSigner(svr.URL+"/v1/storage", []byte("key")), "testkube")
+	err := remote.SaveFileDirect(ctx, "execution-1", "report.xml", strings.NewReader("<xml/>"), 6, minio.PutObjectOptions{ContentType: "application/xml"})
+	require.NoError(t, err)
+	assert.Equal(t, "application/xml", contentType)
+
+	files, err := c.ListFiles(ctx, "execution-1")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "report.xml", files[0].Name)
+
+	invalid := NewRemoteClient(NewSigner(svr.URL+"/v1/storage", []byte("other")), "testkube")
+	err = invalid.SaveFileDirect(ctx, "execution-1", "other.xml", strings.NewReader("<xml/>"), 6, minio.PutObjectOptions{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package filesystem
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ExpiresParam is the query parameter with the Unix time when the signed URL expires
+	ExpiresParam = "X-Testkube-Expires"
+	// SignatureParam is the query parameter with the signature of the URL
+	SignatureParam = "X-Testkube-Signature"
+)
+
+var (
+	// ErrInvalidSignature is returned when the signature doesn't match the request
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrExpiredSignature is returned when the signed URL has already expired
+	ErrExpiredSignature = errors.New("signature has expired")
+)
+
+// Signer emulates the presigned storage URLs with the API URLs signed by the shared key
+type Signer struct {
+	baseURL string
+	key     []byte
+	now     func() time.Time
+}
+
+// NewSigner creates the signer for the storage API available under the base URL
+func NewSigner(baseURL string, key []byte) *Signer {
+	return &Signer{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		key:     key,
+		now:     time.Now,
+	}
+}
+
+func (s *Signer) signature(method, bucket, key, expires string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(method + "\n" + bucket + "\n" + key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign builds the URL to access the object with specified HTTP method, valid for the expiration time
+func (s *Signer) Sign(method, bucket, key string, expires time.Duration) (string, error) {
+	if len(s.key) == 0 {
+		return "", ErrPresignNotConfigured
+	}
+	expiresAt := strconv.FormatInt(s.now().Add(expires).Unix(), 10)
+	segments := strings.Split(key, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	query := url.Values{
+		ExpiresParam:   []string{expiresAt},
+		SignatureParam: []string{s.signature(method, bucket, key, expiresAt)},
+	}
+	return s.baseURL + "/" + url.PathEscape(bucket) + "/" + strings.Join(segments, "/") + "?" + query.Encode(), nil
+}
+
+// Verify checks if the signature is valid for accessing the object with specified HTTP method
+func (s *Signer) Verify(method, bucket, key, expires, signature string) error {
+	if len(s.key) == 0 {
+		return ErrPresignNotConfigured
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.signature(method, bucket, key, expires))) {
+		return ErrInvalidSignature
+	}
+	if s.now().Unix() > expiresAt {
+		return ErrExpiredSignature
+	}
+	return nil
+}
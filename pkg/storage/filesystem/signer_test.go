@@ -0,0 +1,48 @@
+package filesystem
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner_Verify(t *testing.T) {
+	signer := NewSigner("http://api/v1/storage/", []byte("key"))
+	signedURL, err := signer.Sign(http.MethodGet, "testkube", "execution-1/report #1.xml", time.Minute)
+	require.NoError(t, err)
+
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/storage/testkube/execution-1/report #1.xml", u.Path)
+	key := strings.TrimPrefix(u.Path, "/v1/storage/testkube/")
+	expires, signature := u.Query().Get(ExpiresParam), u.Query().Get(SignatureParam)
+
+	assert.NoError(t, signer.Verify(http.MethodGet, "testkube", key, expires, signature))
+	assert.ErrorIs(t, signer.Verify(http.MethodPut, "testkube", key, expires, signature), ErrInvalidSignature)
+	assert.ErrorIs(t, signer.Verify(http.MethodGet, "other", key, expires, signature), ErrInvalidSignature)
+	assert.ErrorIs(t, signer.Verify(http.MethodGet, "testkube", "execution-1/other.xml", expires, signature), ErrInvalidSignature)
+	assert.ErrorIs(t, NewSigner("http://api/v1/storage", []byte("other")).Verify(http.MethodGet, "testkube", key, expires, signature), ErrInvalidSignature)
+}
+
+func TestSigner_Expired(t *testing.T) {
+	signer := NewSigner("http://api/v1/storage", []byte("key"))
+	signer.now = func() time.Time { return time.Now().Add(-time.Hour) }
+	signedURL, err := signer.Sign(http.MethodGet, "testkube", "file", time.Minute)
+	require.NoError(t, err)
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+
+	signer.now = time.Now
+	err = signer.Verify(http.MethodGet, "testkube", "file", u.Query().Get(ExpiresParam), u.Query().Get(SignatureParam))
+	assert.ErrorIs(t, err, ErrExpiredSignature)
+}
+
+func TestSigner_WithoutKey(t *testing.T) {
+	_, err := NewSigner("http://api/v1/storage", nil).Sign(http.MethodGet, "testkube", "file", time.Minute)
+	assert.ErrorIs(t, err, ErrPresignNotConfigured)
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"hash/fnv"
 	"io"
 	"os"
 	"path/filepath"
@@ -29,7 +28,7 @@ import (
 var _ storage.Client = (*Client)(nil)
 
 // ErrArtifactsNotFound contains error for not existing artifacts
-var ErrArtifactsNotFound = errors.New("Execution doesn't have any artifacts associated with it")
+var ErrArtifactsNotFound = storage.ErrArtifactsNotFound
 
 // Client for managing MinIO storage server
 type Client struct {
@@ -170,6 +169,38 @@ func (c *Client) ListFiles(ctx context.Context, bucketFolder string) ([]testkube
 	return c.listFiles(ctx, c.bucket, bucketFolder)
 }
 
+// ListObjects lists objects in the bucket folder from the config, along with their metadata
+func (c *Client) ListObjects(ctx context.Context, bucketFolder string) ([]minio.ObjectInfo, error) {
+	if err := c.Connect(); err != nil {
+		return nil, err
+	}
+
+	exists, err := c.minioClient.BucketExists(ctx, c.bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	listOptions := minio.ListObjectsOptions{Recursive: true}
+	if bucketFolder != "" {
+		listOptions.Prefix = strings.Trim(bucketFolder, "/") + "/"
+	}
+
+	var result []minio.ObjectInfo
+	for obj := range c.minioClient.ListObjects(ctx, c.bucket, listOptions) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		if bucketFolder != "" {
+			obj.Key = strings.TrimPrefix(obj.Key, listOptions.Prefix)
+		}
+		result = append(result, obj)
+	}
+	return result, nil
+}
+
 // saveFile saves file defined by local filePath to S3 bucket
 func (c *Client) saveFile(ctx context.Context, bucket, bucketFolder, filePath string) error {
 	c.Log.Debugw("saving file", "bucket", bucket, "bucketFolder", bucketFolder, "filePath", filePath)
@@ -277,7 +308,7 @@ func (c *Client) downloadFile(ctx context.Context, bucket, bucketFolder, file st
 }
 
 // DownloadFile downloads file from bucket from the config
-func (c *Client) DownloadFile(ctx context.Context, bucketFolder, file string) (*minio.Object, error) {
+func (c *Client) DownloadFile(ctx context.Context, bucketFolder, file string) (storage.Object, error) {
 	c.Log.Infow("Download file", "bucket", c.bucket, "bucketFolder", bucketFolder, "file", file)
 	// TODO: this is for back compatibility, remove it sometime in the future
 	var objFirst *minio.Object
@@ -562,15 +593,7 @@ func (c *Client) PlaceFiles(ctx context.Context, bucketFolders []string, prefix
 
 // GetValidBucketName returns a minio-compatible bucket name
 func (c *Client) GetValidBucketName(parentType string, parentName string) string {
-	bucketName := fmt.Sprintf("%s-%s", parentType, parentName)
-	if len(bucketName) <= 63 {
-		return bucketName
-	}
-
-	h := fnv.New32a()
-	h.Write([]byte(bucketName))
-
-	return fmt.Sprintf("%s-%d", bucketName[:52], h.Sum32())
+	return storage.ValidBucketName(parentType, parentName)
 }
 
 func (c *Client) deleteFile(ctx context.Context, bucket, bucketFolder, file string) error {
@@ -2,6 +2,9 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
 	"io"
 	"time"
 
@@ -10,6 +13,22 @@ import (
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 )
 
+const (
+	// TypeMinio is the S3-compatible object storage
+	TypeMinio = "minio"
+	// TypeFilesystem is the local directory, i.e. mounted from PVC
+	TypeFilesystem = "filesystem"
+)
+
+// ErrArtifactsNotFound contains error for not existing artifacts
+var ErrArtifactsNotFound = errors.New("Execution doesn't have any artifacts associated with it")
+
+// Object is the downloaded file, along with its metadata
+type Object interface {
+	io.ReadCloser
+	Stat() (minio.ObjectInfo, error)
+}
+
 // Client is storage client abstraction
 //
 //go:generate mockgen -destination=./storage_mock.go -package=storage "github.com/kubeshop/testkube/pkg/storage" Client
@@ -23,7 +42,7 @@ type ClientImplicitBucket interface {
 	IsConnectionPossible(ctx context.Context) (bool, error)
 	ListFiles(ctx context.Context, bucketFolder string) ([]testkube.Artifact, error)
 	SaveFile(ctx context.Context, bucketFolder, filePath string) error
-	DownloadFile(ctx context.Context, bucketFolder, file string) (*minio.Object, error)
+	DownloadFile(ctx context.Context, bucketFolder, file string) (Object, error)
 	DownloadArchive(ctx context.Context, bucketFolder string, masks []string) (io.Reader, error)
 	UploadFile(ctx context.Context, bucketFolder string, filePath string, reader io.Reader, objectSize int64) error
 	PlaceFiles(ctx context.Context, bucketFolders []string, prefix string) error
@@ -43,3 +62,16 @@ type ClientBucket interface {
 	PresignDownloadFileFromBucket(ctx context.Context, bucket, bucketFolder, file string, expires time.Duration) (string, error)
 	PresignUploadFileToBucket(ctx context.Context, bucket, bucketFolder, filePath string, expires time.Duration) (string, error)
 }
+
+// ValidBucketName returns a bucket name that is compatible with S3 naming rules
+func ValidBucketName(parentType string, parentName string) string {
+	bucketName := fmt.Sprintf("%s-%s", parentType, parentName)
+	if len(bucketName) <= 63 {
+		return bucketName
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(bucketName))
+
+	return fmt.Sprintf("%s-%d", bucketName[:52], h.Sum32())
+}
@@ -125,10 +125,10 @@ func (mr *MockClientMockRecorder) DownloadArchiveFromBucket(arg0, arg1, arg2, ar
 }
 
 // DownloadFile mocks base method.
-func (m *MockClient) DownloadFile(arg0 context.Context, arg1, arg2 string) (*minio.Object, error) {
+func (m *MockClient) DownloadFile(arg0 context.Context, arg1, arg2 string) (Object, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DownloadFile", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*minio.Object)
+	ret0, _ := ret[0].(Object)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
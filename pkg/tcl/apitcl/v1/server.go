@@ -15,7 +15,6 @@ import (
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
-	kubeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	testworkflowsv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
 	apiv1 "github.com/kubeshop/testkube/internal/app/api/v1"
@@ -46,23 +45,23 @@ type ApiTCL interface {
 func NewApiTCL(
 	testkubeAPI apiv1.TestkubeAPI,
 	proContext *config.ProContext,
-	kubeClient kubeclient.Client,
 	imageInspector imageinspector.Inspector,
 	testWorkflowResults testworkflow.Repository,
 	testWorkflowOutput testworkflow.OutputRepository,
+	testWorkflowsClient testworkflowsv1.Interface,
+	testWorkflowTemplatesClient testworkflowsv1.TestWorkflowTemplatesInterface,
+	testWorkflowExecutor testworkflowexecutor.TestWorkflowExecutor,
 	apiUrl string,
 ) ApiTCL {
-	executor := testworkflowexecutor.New(testkubeAPI.Events, testkubeAPI.Clientset, testWorkflowResults, testWorkflowOutput, testkubeAPI.Namespace)
-	go executor.Recover(context.Background())
 	return &apiTCL{
 		TestkubeAPI:                 testkubeAPI,
 		ProContext:                  proContext,
 		ImageInspector:              imageInspector,
 		TestWorkflowResults:         testWorkflowResults,
 		TestWorkflowOutput:          testWorkflowOutput,
-		TestWorkflowsClient:         testworkflowsv1.NewClient(kubeClient, testkubeAPI.Namespace),
-		TestWorkflowTemplatesClient: testworkflowsv1.NewTestWorkflowTemplatesClient(kubeClient, testkubeAPI.Namespace),
-		TestWorkflowExecutor:        executor,
+		TestWorkflowsClient:         testWorkflowsClient,
+		TestWorkflowTemplatesClient: testWorkflowTemplatesClient,
+		TestWorkflowExecutor:        testWorkflowExecutor,
 		ApiUrl:                      apiUrl,
 	}
 }
@@ -104,24 +103,37 @@ func (s *apiTCL) AppendRoutes() {
 	testWorkflows.Get("/", s.pro(s.ListTestWorkflowsHandler()))
 	testWorkflows.Post("/", s.pro(s.CreateTestWorkflowHandler()))
 	testWorkflows.Delete("/", s.pro(s.DeleteTestWorkflowsHandler()))
+	testWorkflows.Post("/validate", s.pro(s.ValidateTestWorkflowHandler()))
 	testWorkflows.Get("/:id", s.pro(s.GetTestWorkflowHandler()))
 	testWorkflows.Put("/:id", s.pro(s.UpdateTestWorkflowHandler()))
 	testWorkflows.Delete("/:id", s.pro(s.DeleteTestWorkflowHandler()))
 	testWorkflows.Get("/:id/executions", s.pro(s.ListTestWorkflowExecutionsHandler()))
 	testWorkflows.Post("/:id/executions", s.pro(s.ExecuteTestWorkflowHandler()))
 	testWorkflows.Get("/:id/metrics", s.pro(s.GetTestWorkflowMetricsHandler()))
+	testWorkflows.Get("/:id/next-runs", s.pro(s.GetTestWorkflowNextRunsHandler()))
+	testWorkflows.Get("/:id/flaky", s.pro(s.GetTestWorkflowFlakyCasesHandler()))
 	testWorkflows.Get("/:id/executions/:executionID", s.pro(s.GetTestWorkflowExecutionHandler()))
 	testWorkflows.Post("/:id/abort", s.pro(s.AbortAllTestWorkflowExecutionsHandler()))
 	testWorkflows.Post("/:id/executions/:executionID/abort", s.pro(s.AbortTestWorkflowExecutionHandler()))
 	testWorkflows.Get("/:id/executions/:executionID/logs", s.pro(s.GetTestWorkflowExecutionLogsHandler()))
+	testWorkflows.Get("/:id/executions/:executionID/services/:service/logs", s.pro(s.GetTestWorkflowExecutionServiceLogsHandler()))
+	testWorkflows.Get("/:id/executions/:executionID/reports", s.pro(s.GetTestWorkflowExecutionReportsHandler()))
 
 	testWorkflowExecutions := root.Group("/test-workflow-executions")
 	testWorkflowExecutions.Get("/", s.pro(s.ListTestWorkflowExecutionsHandler()))
+	testWorkflowExecutions.Get("/compare", s.pro(s.CompareTestWorkflowExecutionsHandler()))
 	testWorkflowExecutions.Get("/:executionID", s.pro(s.GetTestWorkflowExecutionHandler()))
 	testWorkflowExecutions.Get("/:executionID/notifications", s.pro(s.StreamTestWorkflowExecutionNotificationsHandler()))
 	testWorkflowExecutions.Get("/:executionID/notifications/stream", s.pro(s.StreamTestWorkflowExecutionNotificationsWebSocketHandler()))
 	testWorkflowExecutions.Post("/:executionID/abort", s.pro(s.AbortTestWorkflowExecutionHandler()))
+	testWorkflowExecutions.Post("/:executionID/steps/:ref/abort", s.pro(s.AbortTestWorkflowExecutionStepHandler()))
+	testWorkflowExecutions.Post("/:executionID/steps/:ref/skip", s.pro(s.SkipTestWorkflowExecutionStepHandler()))
+	testWorkflowExecutions.Post("/:executionID/steps/:ref/approve", s.pro(s.ApproveTestWorkflowExecutionStepHandler()))
+	testWorkflowExecutions.Post("/:executionID/steps/:ref/reject", s.pro(s.RejectTestWorkflowExecutionStepHandler()))
+	testWorkflowExecutions.Post("/:executionID/steps/:ref/parallel", s.pro(s.ExecuteTestWorkflowParallelStepHandler()))
 	testWorkflowExecutions.Get("/:executionID/logs", s.pro(s.GetTestWorkflowExecutionLogsHandler()))
+	testWorkflowExecutions.Get("/:executionID/services/:service/logs", s.pro(s.GetTestWorkflowExecutionServiceLogsHandler()))
+	testWorkflowExecutions.Get("/:executionID/reports", s.pro(s.GetTestWorkflowExecutionReportsHandler()))
 	testWorkflowExecutions.Get("/:executionID/artifacts", s.pro(s.ListTestWorkflowExecutionArtifactsHandler()))
 	testWorkflowExecutions.Get("/:executionID/artifacts/:filename", s.pro(s.GetTestWorkflowArtifactHandler()))
 	testWorkflowExecutions.Get("/:executionID/artifact-archive", s.pro(s.GetTestWorkflowArtifactArchiveHandler()))
@@ -17,16 +17,21 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/websocket/v2"
 	"github.com/pkg/errors"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/compare"
 	"github.com/kubeshop/testkube/pkg/datefilter"
+	"github.com/kubeshop/testkube/pkg/flaky"
 	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
 	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowcontroller"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
 )
 
 func (s *apiTCL) StreamTestWorkflowExecutionNotificationsHandler() fiber.Handler {
@@ -161,6 +166,30 @@ func (s *apiTCL) GetTestWorkflowMetricsHandler() fiber.Handler {
 	}
 }
 
+func (s *apiTCL) GetTestWorkflowFlakyCasesHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		workflowName := c.Params("id")
+
+		last, err := strconv.Atoi(c.Query("last", strconv.Itoa(flaky.DefaultLimit)))
+		if err != nil || last <= 0 {
+			last = flaky.DefaultLimit
+		}
+
+		threshold, err := strconv.ParseFloat(c.Query("threshold", ""), 64)
+		if err != nil || threshold <= 0 {
+			threshold = flaky.DefaultThreshold
+		}
+
+		filter := testworkflow.NewExecutionsFilter().WithName(workflowName).WithPageSize(last)
+		executions, err := s.TestWorkflowResults.GetExecutions(c.Context(), filter)
+		if err != nil {
+			return s.ClientError(c, "get executions for workflow", err)
+		}
+
+		return c.JSON(flaky.Report(workflowName, flaky.RunsFromTestWorkflowExecutions(executions), threshold))
+	}
+}
+
 func (s *apiTCL) GetTestWorkflowExecutionHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		ctx := c.Context()
@@ -182,6 +211,27 @@ func (s *apiTCL) GetTestWorkflowExecutionHandler() fiber.Handler {
 	}
 }
 
+func (s *apiTCL) GetTestWorkflowExecutionReportsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		id := c.Params("id", "")
+		executionID := c.Params("executionID")
+
+		var execution testkube.TestWorkflowExecution
+		var err error
+		if id == "" {
+			execution, err = s.TestWorkflowResults.Get(ctx, executionID)
+		} else {
+			execution, err = s.TestWorkflowResults.GetByNameAndTestWorkflow(ctx, executionID, id)
+		}
+		if err != nil {
+			return s.ClientError(c, "get execution", err)
+		}
+
+		return c.JSON(execution.GetReports())
+	}
+}
+
 func (s *apiTCL) GetTestWorkflowExecutionLogsHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		ctx := c.Context()
@@ -210,6 +260,35 @@ func (s *apiTCL) GetTestWorkflowExecutionLogsHandler() fiber.Handler {
 	}
 }
 
+func (s *apiTCL) GetTestWorkflowExecutionServiceLogsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		id := c.Params("id", "")
+		executionID := c.Params("executionID")
+		service := c.Params("service")
+
+		var execution testkube.TestWorkflowExecution
+		var err error
+		if id == "" {
+			execution, err = s.TestWorkflowResults.Get(ctx, executionID)
+		} else {
+			execution, err = s.TestWorkflowResults.GetByNameAndTestWorkflow(ctx, executionID, id)
+		}
+		if err != nil {
+			return s.ClientError(c, "get execution", err)
+		}
+
+		reader, err := s.TestWorkflowOutput.ReadLog(ctx, testworkflowprocessor.ServiceLogId(execution.Id, service), execution.Workflow.Name)
+		if err != nil {
+			return s.InternalError(c, "can't get service log", executionID, err)
+		}
+
+		c.Context().SetContentType(mediaTypePlainText)
+		_, err = io.Copy(c.Response().BodyWriter(), reader)
+		return err
+	}
+}
+
 func (s *apiTCL) AbortTestWorkflowExecutionHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		ctx := c.Context()
@@ -232,16 +311,210 @@ func (s *apiTCL) AbortTestWorkflowExecutionHandler() fiber.Handler {
 			return s.BadRequest(c, errPrefix, "checking execution", errors.New("execution already finished"))
 		}
 
+		// Abort the execution
+		err = s.TestWorkflowExecutor.Abort(context.Background(), execution)
+		if err != nil {
+			return s.ClientError(c, "aborting test workflow execution", err)
+		}
+
+		c.Status(http.StatusNoContent)
+
+		return nil
+	}
+}
+
+// stepControl performs the action requested for the step with the controller
+type stepControl func(ctx context.Context, ctrl testworkflowcontroller.Controller, ref string) error
+
+// stepControlBuilder validates the request for the step, and builds the action to perform
+type stepControlBuilder func(c *fiber.Ctx, execution *testkube.TestWorkflowExecution, ref string) (stepControl, error)
+
+// staticStepControl builds the action that doesn't need any input
+func staticStepControl(fn stepControl) stepControlBuilder {
+	return func(*fiber.Ctx, *testkube.TestWorkflowExecution, string) (stepControl, error) {
+		return fn, nil
+	}
+}
+
+func (s *apiTCL) AbortTestWorkflowExecutionStepHandler() fiber.Handler {
+	return s.controlTestWorkflowExecutionStep("abort", staticStepControl(func(ctx context.Context, ctrl testworkflowcontroller.Controller, ref string) error {
+		return ctrl.AbortStep(ctx, ref)
+	}))
+}
+
+func (s *apiTCL) SkipTestWorkflowExecutionStepHandler() fiber.Handler {
+	return s.controlTestWorkflowExecutionStep("skip", staticStepControl(func(ctx context.Context, ctrl testworkflowcontroller.Controller, ref string) error {
+		return ctrl.SkipStep(ctx, ref)
+	}))
+}
+
+func (s *apiTCL) ApproveTestWorkflowExecutionStepHandler() fiber.Handler {
+	return s.controlTestWorkflowExecutionStep("approve", func(c *fiber.Ctx, execution *testkube.TestWorkflowExecution, ref string) (stepControl, error) {
+		approvalRef, request, err := getApprovalRequest(execution, ref)
+		if err != nil {
+			return nil, err
+		}
+		var body testkube.TestWorkflowApprovalRequest
+		if len(c.Body()) > 0 {
+			if err = c.BodyParser(&body); err != nil {
+				return nil, errors.Wrap(err, "invalid body")
+			}
+		}
+		if err = validateApprovalInputs(request.Inputs, body.Inputs); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, ctrl testworkflowcontroller.Controller, _ string) error {
+			return ctrl.ApproveStep(ctx, approvalRef, body.Inputs)
+		}, nil
+	})
+}
+
+func (s *apiTCL) RejectTestWorkflowExecutionStepHandler() fiber.Handler {
+	return s.controlTestWorkflowExecutionStep("reject", func(_ *fiber.Ctx, execution *testkube.TestWorkflowExecution, ref string) (stepControl, error) {
+		approvalRef, _, err := getApprovalRequest(execution, ref)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, ctrl testworkflowcontroller.Controller, _ string) error {
+			return ctrl.RejectStep(ctx, approvalRef)
+		}, nil
+	})
+}
+
+// getApprovalRequest reads the approval requested by the step from the execution outputs,
+// along with the reference of the container waiting for it - either the step itself, or its first container
+func getApprovalRequest(execution *testkube.TestWorkflowExecution, ref string) (approvalRef string, request testworkflowprocessor.ApprovalRequest, err error) {
+	refs := []string{ref}
+	if sig := findSignature(execution.Signature, ref); sig != nil {
+		for len(sig.Children) > 0 {
+			sig = &sig.Children[0]
+		}
+		refs = append(refs, sig.Ref)
+	}
+	for _, output := range execution.Output {
+		if !slices.Contains(refs, output.Ref) || output.Name != testworkflowprocessor.ApprovalOutputName {
+			continue
+		}
+		b, err := json.Marshal(output.Value)
+		if err == nil {
+			err = json.Unmarshal(b, &request)
+		}
+		return output.Ref, request, errors.Wrap(err, "reading approval request")
+	}
+	return "", request, errors.New("step is not waiting for the approval")
+}
+
+// findSignature finds the step signature by its reference
+func findSignature(signature []testkube.TestWorkflowSignature, ref string) *testkube.TestWorkflowSignature {
+	for i := range signature {
+		if signature[i].Ref == ref {
+			return &signature[i]
+		}
+		if sig := findSignature(signature[i].Children, ref); sig != nil {
+			return sig
+		}
+	}
+	return nil
+}
+
+// validateApprovalInputs ensures that the values provided by the approver match the expected inputs
+func validateApprovalInputs(specs []testworkflowprocessor.ApprovalInput, inputs map[string]string) error {
+	known := make(map[string]struct{}, len(specs))
+	for _, spec := range specs {
+		known[spec.Name] = struct{}{}
+		if _, ok := inputs[spec.Name]; !ok && spec.Default == nil {
+			return fmt.Errorf("missing required input: %s", spec.Name)
+		}
+	}
+	for name, value := range inputs {
+		if _, ok := known[name]; !ok {
+			return fmt.Errorf("unknown input: %s", name)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("input %s: multi-line values are not supported", name)
+		}
+	}
+	return nil
+}
+
+func (s *apiTCL) ExecuteTestWorkflowParallelStepHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		executionID := c.Params("executionID")
+		ref := c.Params("ref")
+		errPrefix := fmt.Sprintf("failed to execute parallel step '%s' of test workflow execution '%s'", ref, executionID)
+
+		var request testkube.TestWorkflowParallelExecutionRequest
+		err := c.BodyParser(&request)
+		if err != nil {
+			return s.BadRequest(c, errPrefix, "invalid body", err)
+		}
+		if len(request.Steps) == 0 {
+			return s.BadRequest(c, errPrefix, "checking request", errors.New("no steps provided"))
+		}
+
+		parent, err := s.TestWorkflowResults.Get(ctx, executionID)
+		if err != nil {
+			return s.ClientError(c, errPrefix, err)
+		}
+		if parent.Result != nil && parent.Result.IsFinished() {
+			return s.BadRequest(c, errPrefix, "checking execution", errors.New("execution already finished"))
+		}
+		if parent.Result != nil {
+			if _, ok := parent.Result.Steps[ref]; !ok {
+				return s.NotFound(c, errPrefix, "checking step", errors.New("step not found"))
+			}
+		}
+
+		execution, err := s.TestWorkflowExecutor.ExecuteParallel(context.Background(), parent, ref, request)
+		if err != nil {
+			return s.InternalError(c, errPrefix, "execution error", err)
+		}
+
+		return c.JSON(execution)
+	}
+}
+
+// controlTestWorkflowExecutionStep builds the handler requesting the action for a single step of the running execution
+func (s *apiTCL) controlTestWorkflowExecutionStep(action string, build stepControlBuilder) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		executionID := c.Params("executionID")
+		ref := c.Params("ref")
+		errPrefix := fmt.Sprintf("failed to %s step '%s' of test workflow execution '%s'", action, ref, executionID)
+
+		execution, err := s.TestWorkflowResults.Get(ctx, executionID)
+		if err != nil {
+			return s.ClientError(c, errPrefix, err)
+		}
+
+		if execution.Result != nil && execution.Result.IsFinished() {
+			return s.BadRequest(c, errPrefix, "checking execution", errors.New("execution already finished"))
+		}
+		if execution.Result != nil {
+			step, ok := execution.Result.Steps[ref]
+			if !ok {
+				return s.NotFound(c, errPrefix, "checking step", errors.New("step not found"))
+			}
+			if step.Status != nil && step.Status.Finished() {
+				return s.BadRequest(c, errPrefix, "checking step", errors.New("step already finished"))
+			}
+		}
+		fn, err := build(c, &execution, ref)
+		if err != nil {
+			return s.BadRequest(c, errPrefix, "checking request", err)
+		}
+
 		// Obtain the controller
 		ctrl, err := testworkflowcontroller.New(ctx, s.Clientset, s.Namespace, execution.Id, execution.ScheduledAt)
 		if err != nil {
 			return s.BadRequest(c, errPrefix, "fetching job", err)
 		}
 
-		// Abort the execution
-		err = ctrl.Abort(context.Background())
+		// Request the action for the step
+		err = fn(context.Background(), ctrl, ref)
 		if err != nil {
-			return s.ClientError(c, "aborting test workflow execution", err)
+			return s.ClientError(c, errPrefix, err)
 		}
 
 		c.Status(http.StatusNoContent)
@@ -268,14 +541,8 @@ func (s *apiTCL) AbortAllTestWorkflowExecutionsHandler() fiber.Handler {
 		}
 
 		for _, execution := range executions {
-			// Obtain the controller
-			ctrl, err := testworkflowcontroller.New(ctx, s.Clientset, s.Namespace, execution.Id, execution.ScheduledAt)
-			if err != nil {
-				return s.BadRequest(c, errPrefix, "fetching job", err)
-			}
-
 			// Abort the execution
-			err = ctrl.Abort(context.Background())
+			err = s.TestWorkflowExecutor.Abort(context.Background(), execution)
 			if err != nil {
 				return s.ClientError(c, errPrefix, err)
 			}
@@ -435,5 +702,50 @@ func getWorkflowExecutionsFilterFromRequest(c *fiber.Ctx) testworkflow.Filter {
 		filter = filter.WithSelector(selector)
 	}
 
+	parentExecutionId := c.Query("parentExecutionId")
+	if parentExecutionId != "" {
+		filter = filter.WithParentExecutionId(parentExecutionId)
+	}
+
 	return filter
 }
+
+func (s *apiTCL) CompareTestWorkflowExecutionsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		errPrefix := "failed to compare test workflow executions"
+		baseID, headID := c.Query("base"), c.Query("head")
+		if baseID == "" || headID == "" {
+			return s.BadRequest(c, errPrefix, "invalid query", errors.New("both base and head execution ids are required"))
+		}
+
+		threshold, err := strconv.ParseFloat(c.Query("threshold", ""), 64)
+		if err != nil || threshold <= 0 {
+			threshold = compare.DefaultThreshold
+		}
+
+		snapshots := make([]compare.Snapshot, 2)
+		for i, id := range []string{baseID, headID} {
+			execution, err := s.TestWorkflowResults.Get(ctx, id)
+			if err != nil {
+				return s.ClientError(c, fmt.Sprintf("%s: get execution %s", errPrefix, id), err)
+			}
+
+			// The logs are not critical for the comparison
+			logs, complete := "", true
+			if execution.Workflow != nil {
+				reader, err := s.TestWorkflowOutput.ReadLog(ctx, execution.Id, execution.Workflow.Name)
+				if err == nil {
+					logs, complete = compare.ReadLogs(reader)
+					// Release the download, as it may be not read fully
+					if closer, ok := reader.(io.Closer); ok {
+						_ = closer.Close()
+					}
+				}
+			}
+			snapshots[i] = compare.FromTestWorkflowExecution(execution, logs, complete)
+		}
+
+		return c.JSON(compare.Compare(snapshots[0], snapshots[1], threshold))
+	}
+}
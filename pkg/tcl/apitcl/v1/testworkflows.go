@@ -12,22 +12,23 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/pkg/errors"
-	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
 	"github.com/kubeshop/testkube/internal/common"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/imageinspector"
 	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
 	testworkflowmappers "github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowexecutor"
 	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
 	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowresolver"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowscheduler"
 )
 
 func (s *apiTCL) ListTestWorkflowsHandler() fiber.Handler {
@@ -262,10 +263,99 @@ func (s *apiTCL) PreviewTestWorkflowHandler() fiber.Handler {
 	}
 }
 
+func (s *apiTCL) ValidateTestWorkflowHandler() fiber.Handler {
+	errPrefix := "failed to validate test workflow"
+	return func(c *fiber.Ctx) (err error) {
+		// Deserialize resource
+		obj := new(testworkflowsv1.TestWorkflow)
+		if HasYAML(c) {
+			err = common.DeserializeCRD(obj, c.Body())
+			if err != nil {
+				return s.BadRequest(c, errPrefix, "invalid body", err)
+			}
+		} else {
+			var v *testkube.TestWorkflow
+			err = c.BodyParser(&v)
+			if err != nil {
+				return s.BadRequest(c, errPrefix, "invalid body", err)
+			}
+			obj = testworkflowmappers.MapAPIToKube(v)
+		}
+		if obj == nil {
+			return s.BadRequest(c, errPrefix, "invalid body", errors.New("body is required"))
+		}
+		obj.Namespace = s.Namespace
+
+		// Fetch the templates, the missing ones will be reported by the validation
+		tpls := testworkflowresolver.ListTemplates(obj)
+		tplsMap := make(map[string]testworkflowsv1.TestWorkflowTemplate, len(tpls))
+		for name := range tpls {
+			tpl, err := s.TestWorkflowTemplatesClient.Get(name)
+			if IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return s.InternalError(c, errPrefix, "fetching error", err)
+			}
+			tplsMap[name] = *tpl
+		}
+
+		// Provide the variables that are available while processing the execution
+		machines := []expressionstcl.Machine{
+			testworkflowexecutor.CreateExecutionMachine(s.ApiUrl, s.Namespace, obj.Name, ""),
+			testworkflowprocessor.CreateImageMachine(&imageinspector.Info{}),
+		}
+		resolved := obj.DeepCopy()
+		if testworkflowresolver.ApplyTemplates(resolved, tplsMap) == nil {
+			if services, err := testworkflowprocessor.GetServices(resolved); err == nil {
+				machines = append(machines, testworkflowprocessor.CreateServicesMachine(services))
+			}
+		}
+
+		result := testkube.TestWorkflowValidationResult{Valid: true, Issues: []testkube.TestWorkflowValidationIssue{}}
+		for _, issue := range testworkflowresolver.ValidateWorkflow(obj, tplsMap, machines...) {
+			if issue.Severity == testworkflowresolver.ValidationSeverityError {
+				result.Valid = false
+			}
+			result.Issues = append(result.Issues, testkube.TestWorkflowValidationIssue{
+				Severity: string(issue.Severity),
+				Path:     issue.Path,
+				Message:  issue.Message,
+			})
+		}
+		return c.JSON(result)
+	}
+}
+
+func (s *apiTCL) GetTestWorkflowNextRunsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) (err error) {
+		name := c.Params("id")
+		errPrefix := fmt.Sprintf("failed to get next runs of test workflow '%s'", name)
+		workflow, err := s.TestWorkflowsClient.Get(name)
+		if err != nil {
+			return s.ClientError(c, errPrefix, err)
+		}
+
+		count, err := strconv.Atoi(c.Query("count", strconv.Itoa(testworkflowscheduler.DefaultNextRunsCount)))
+		if err != nil || count <= 0 {
+			count = testworkflowscheduler.DefaultNextRunsCount
+		}
+		if count > testworkflowscheduler.MaxNextRunsCount {
+			count = testworkflowscheduler.MaxNextRunsCount
+		}
+
+		schedules, err := testworkflowscheduler.GetSchedules(workflow)
+		if err != nil {
+			return s.BadRequest(c, errPrefix, "invalid schedules", err)
+		}
+		return c.JSON(testworkflowscheduler.NextRuns(workflow.Name, schedules, time.Now(), count))
+	}
+}
+
 // TODO: Add metrics
 func (s *apiTCL) ExecuteTestWorkflowHandler() fiber.Handler {
 	return func(c *fiber.Ctx) (err error) {
-		ctx := c.Context()
+		ctx := c.UserContext()
 		name := c.Params("id")
 		errPrefix := fmt.Sprintf("failed to execute test workflow '%s'", name)
 		workflow, err := s.TestWorkflowsClient.Get(name)
@@ -273,12 +363,6 @@ func (s *apiTCL) ExecuteTestWorkflowHandler() fiber.Handler {
 			return s.ClientError(c, errPrefix, err)
 		}
 
-		// Delete unnecessary data
-		delete(workflow.Annotations, "kubectl.kubernetes.io/last-applied-configuration")
-
-		// Preserve initial workflow
-		initialWorkflow := workflow.DeepCopy()
-
 		// Load the execution request
 		var request testkube.TestWorkflowExecutionRequest
 		err = c.BodyParser(&request)
@@ -286,117 +370,11 @@ func (s *apiTCL) ExecuteTestWorkflowHandler() fiber.Handler {
 			return s.BadRequest(c, errPrefix, "invalid body", err)
 		}
 
-		// Fetch the templates
-		tpls := testworkflowresolver.ListTemplates(workflow)
-		tplsMap := make(map[string]testworkflowsv1.TestWorkflowTemplate, len(tpls))
-		for tplName := range tpls {
-			tpl, err := s.TestWorkflowTemplatesClient.Get(tplName)
-			if err != nil {
-				return s.BadRequest(c, errPrefix, "fetching error", err)
-			}
-			tplsMap[tplName] = *tpl
-		}
-
-		// Apply the configuration
-		_, err = testworkflowresolver.ApplyWorkflowConfig(workflow, testworkflowmappers.MapConfigValueAPIToKube(request.Config))
+		execution, err := s.TestWorkflowExecutor.Execute(ctx, *workflow, request)
 		if err != nil {
-			return s.BadRequest(c, errPrefix, "configuration", err)
+			return s.InternalError(c, errPrefix, "execution error", err)
 		}
 
-		// Resolve the TestWorkflow
-		err = testworkflowresolver.ApplyTemplates(workflow, tplsMap)
-		if err != nil {
-			return s.BadRequest(c, errPrefix, "resolving error", err)
-		}
-
-		// Build the basic Execution data
-		id := primitive.NewObjectID().Hex()
-		now := time.Now()
-		machine := expressionstcl.NewMachine().
-			RegisterStringMap("internal", map[string]string{
-				"storage.url":        os.Getenv("STORAGE_ENDPOINT"),
-				"storage.accessKey":  os.Getenv("STORAGE_ACCESSKEYID"),
-				"storage.secretKey":  os.Getenv("STORAGE_SECRETACCESSKEY"),
-				"storage.region":     os.Getenv("STORAGE_REGION"),
-				"storage.bucket":     os.Getenv("STORAGE_BUCKET"),
-				"storage.token":      os.Getenv("STORAGE_TOKEN"),
-				"storage.ssl":        common.GetOr(os.Getenv("STORAGE_SSL"), "false"),
-				"storage.skipVerify": common.GetOr(os.Getenv("STORAGE_SKIP_VERIFY"), "false"),
-				"storage.certFile":   os.Getenv("STORAGE_CERT_FILE"),
-				"storage.keyFile":    os.Getenv("STORAGE_KEY_FILE"),
-				"storage.caFile":     os.Getenv("STORAGE_CA_FILE"),
-
-				"cloud.enabled":         strconv.FormatBool(os.Getenv("TESTKUBE_PRO_API_KEY") != "" || os.Getenv("TESTKUBE_CLOUD_API_KEY") != ""),
-				"cloud.api.key":         common.GetOr(os.Getenv("TESTKUBE_PRO_API_KEY"), os.Getenv("TESTKUBE_CLOUD_API_KEY")),
-				"cloud.api.tlsInsecure": common.GetOr(os.Getenv("TESTKUBE_PRO_TLS_INSECURE"), os.Getenv("TESTKUBE_CLOUD_TLS_INSECURE"), "false"),
-				"cloud.api.skipVerify":  common.GetOr(os.Getenv("TESTKUBE_PRO_SKIP_VERIFY"), os.Getenv("TESTKUBE_CLOUD_SKIP_VERIFY"), "false"),
-				"cloud.api.url":         common.GetOr(os.Getenv("TESTKUBE_PRO_URL"), os.Getenv("TESTKUBE_CLOUD_URL")),
-
-				"dashboard.url": os.Getenv("TESTKUBE_DASHBOARD_URI"),
-				"api.url":       s.ApiUrl,
-				"namespace":     s.Namespace,
-			}).
-			RegisterStringMap("workflow", map[string]string{
-				"name": workflow.Name,
-			}).
-			RegisterStringMap("execution", map[string]string{
-				"id": id,
-			})
-
-		// Preserve resolved TestWorkflow
-		resolvedWorkflow := workflow.DeepCopy()
-
-		// Process the TestWorkflow
-		bundle, err := testworkflowprocessor.NewFullFeatured(s.ImageInspector).
-			Bundle(c.Context(), workflow, machine)
-		if err != nil {
-			return s.BadRequest(c, errPrefix, "processing error", err)
-		}
-
-		// Load execution identifier data
-		// TODO: Consider if that should not be shared (as now it is between Tests and Test Suites)
-		number, _ := s.ExecutionResults.GetNextExecutionNumber(context.Background(), workflow.Name)
-		executionName := request.Name
-		if executionName == "" {
-			executionName = fmt.Sprintf("%s-%d", workflow.Name, number)
-		}
-
-		// Ensure it is unique name
-		// TODO: Consider if we shouldn't make name unique across all TestWorkflows
-		next, _ := s.TestWorkflowResults.GetByNameAndTestWorkflow(ctx, executionName, workflow.Name)
-		if next.Name == executionName {
-			return s.BadRequest(c, errPrefix, "execution name already exists", errors.New(executionName))
-		}
-
-		// Build Execution entity
-		// TODO: Consider storing "config" as well
-		execution := testkube.TestWorkflowExecution{
-			Id:          id,
-			Name:        executionName,
-			Number:      number,
-			ScheduledAt: now,
-			StatusAt:    now,
-			Signature:   testworkflowprocessor.MapSignatureListToInternal(bundle.Signature),
-			Result: &testkube.TestWorkflowResult{
-				Status:          common.Ptr(testkube.QUEUED_TestWorkflowStatus),
-				PredictedStatus: common.Ptr(testkube.PASSED_TestWorkflowStatus),
-				Initialization: &testkube.TestWorkflowStepResult{
-					Status: common.Ptr(testkube.QUEUED_TestWorkflowStepStatus),
-				},
-				Steps: testworkflowprocessor.MapSignatureListToStepResults(bundle.Signature),
-			},
-			Output:           []testkube.TestWorkflowOutput{},
-			Workflow:         testworkflowmappers.MapKubeToAPI(initialWorkflow),
-			ResolvedWorkflow: testworkflowmappers.MapKubeToAPI(resolvedWorkflow),
-		}
-		err = s.TestWorkflowResults.Insert(ctx, execution)
-		if err != nil {
-			return s.InternalError(c, errPrefix, "inserting execution to storage", err)
-		}
-
-		// Schedule the execution
-		s.TestWorkflowExecutor.Schedule(bundle, execution)
-
 		return c.JSON(execution)
 	}
 }
@@ -23,6 +23,7 @@ const (
 	CmdTestWorkflowExecutionUpdate               executor.Command = "workflow_execution_update"
 	CmdTestWorkflowExecutionUpdateResult         executor.Command = "workflow_execution_update_result"
 	CmdTestWorkflowExecutionUpdateOutput         executor.Command = "workflow_execution_update_output"
+	CmdTestWorkflowExecutionUpdateReports        executor.Command = "workflow_execution_update_reports"
 	CmdTestWorkflowExecutionDeleteByWorkflow     executor.Command = "workflow_execution_delete_by_workflow"
 	CmdTestWorkflowExecutionDeleteAll            executor.Command = "workflow_execution_delete_all"
 	CmdTestWorkflowExecutionDeleteByWorkflows    executor.Command = "workflow_execution_delete_by_workflows"
@@ -59,6 +60,8 @@ func command(v interface{}) executor.Command {
 		return CmdTestWorkflowExecutionUpdateResult
 	case ExecutionUpdateOutputRequest:
 		return CmdTestWorkflowExecutionUpdateOutput
+	case ExecutionUpdateReportsRequest:
+		return CmdTestWorkflowExecutionUpdateReports
 	case ExecutionDeleteByWorkflowRequest:
 		return CmdTestWorkflowExecutionDeleteByWorkflow
 	case ExecutionDeleteAllRequest:
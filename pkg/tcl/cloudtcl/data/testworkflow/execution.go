@@ -114,6 +114,11 @@ func (r *CloudRepository) UpdateOutput(ctx context.Context, id string, output []
 	return passNoContent(r.executor, ctx, req)
 }
 
+func (r *CloudRepository) UpdateReports(ctx context.Context, id string, reports []testkube.TestWorkflowReport) (err error) {
+	req := ExecutionUpdateReportsRequest{ID: id, Reports: reports}
+	return passNoContent(r.executor, ctx, req)
+}
+
 // DeleteByTestWorkflow deletes execution results by workflow
 func (r *CloudRepository) DeleteByTestWorkflow(ctx context.Context, workflowName string) (err error) {
 	req := ExecutionDeleteByWorkflowRequest{WorkflowName: workflowName}
@@ -107,6 +107,14 @@ type ExecutionUpdateOutputRequest struct {
 type ExecutionUpdateOutputResponse struct {
 }
 
+type ExecutionUpdateReportsRequest struct {
+	ID      string                        `json:"id"`
+	Reports []testkube.TestWorkflowReport `json:"reports"`
+}
+
+type ExecutionUpdateReportsResponse struct {
+}
+
 type ExecutionDeleteByWorkflowRequest struct {
 	WorkflowName string `json:"workflowName"`
 }
@@ -174,7 +174,7 @@ func resolve(v reflect.Value, t tagData, m []Machine, force bool, finalize bool)
 			}
 			var vv string
 			if finalize {
-				expr2, err := expr.Resolve(FinalizerFail)
+				expr2, err := expr.Resolve(FinalStdLibMachine, FinalizerFail)
 				if err != nil {
 					return changed, errors.Wrap(err, "resolving the value")
 				}
@@ -197,7 +197,7 @@ func resolve(v reflect.Value, t tagData, m []Machine, force bool, finalize bool)
 			}
 			var vv string
 			if finalize {
-				expr2, err := expr.Resolve(FinalizerFail)
+				expr2, err := expr.Resolve(FinalStdLibMachine, FinalizerFail)
 				if err != nil {
 					return changed, errors.Wrap(err, "resolving the value")
 				}
@@ -146,6 +146,24 @@ func TestGenericNotMutateStringPointer(t *testing.T) {
 	assert.Equal(t, common.Ptr("200 + 10"), ptr)
 }
 
+func TestGenericNonFoldable(t *testing.T) {
+	got := testObj{
+		Expr: "now()",
+		Tmpl: "{{ dummy }}-{{ uuid() }}",
+	}
+	err := Simplify(&got, testMachine)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "now()", got.Expr)
+	assert.Equal(t, "test-{{uuid()}}", got.Tmpl)
+
+	err = Finalize(&got, testMachine)
+
+	assert.NoError(t, err)
+	assert.Regexp(t, `^\d{4}-\d{2}-\d{2}T`, got.Expr)
+	assert.Regexp(t, `^test-[0-9a-f-]{36}$`, got.Tmpl)
+}
+
 func TestGenericCompileError(t *testing.T) {
 	got := testObj{
 		Tmpl: "{{ 1 + 2 }}{{ 3",
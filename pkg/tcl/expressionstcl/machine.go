@@ -23,6 +23,8 @@ type MachineFn = func(values ...StaticValue) (interface{}, bool, error)
 type machine struct {
 	accessors []MachineAccessorExt
 	functions map[string]MachineFn
+	// variables are the registered names, the namespaces are ending with "."
+	variables []string
 }
 
 func NewMachine() *machine {
@@ -33,6 +35,7 @@ func NewMachine() *machine {
 }
 
 func (m *machine) Register(name string, value interface{}) *machine {
+	m.variables = append(m.variables, name)
 	return m.RegisterAccessor(func(n string) (interface{}, bool) {
 		if n == name {
 			return value, true
@@ -44,6 +47,11 @@ func (m *machine) Register(name string, value interface{}) *machine {
 func (m *machine) RegisterStringMap(prefix string, value map[string]string) *machine {
 	if len(prefix) > 0 {
 		prefix += "."
+		m.variables = append(m.variables, prefix)
+	} else {
+		for name := range value {
+			m.variables = append(m.variables, name)
+		}
 	}
 	return m.RegisterAccessor(func(n string) (interface{}, bool) {
 		if !strings.HasPrefix(n, prefix) {
@@ -54,6 +62,18 @@ func (m *machine) RegisterStringMap(prefix string, value map[string]string) *mac
 	})
 }
 
+// RegisterNamespace registers the accessor for "<prefix>.<name>" variables, the accessor receives just the name
+func (m *machine) RegisterNamespace(prefix string, fn MachineAccessorExt) *machine {
+	prefix += "."
+	m.variables = append(m.variables, prefix)
+	return m.RegisterAccessorExt(func(name string) (interface{}, bool, error) {
+		if !strings.HasPrefix(name, prefix) {
+			return nil, false, nil
+		}
+		return fn(name[len(prefix):])
+	})
+}
+
 func (m *machine) RegisterAccessorExt(fn MachineAccessorExt) *machine {
 	m.accessors = append(m.accessors, fn)
 	return m
@@ -71,6 +91,12 @@ func (m *machine) RegisterFunction(name string, fn MachineFn) *machine {
 	return m
 }
 
+// Variables lists the names and the namespaces (ending with ".") registered in the machine,
+// the ones provided by the custom accessors are not known
+func (m *machine) Variables() []string {
+	return m.variables
+}
+
 func (m *machine) Get(name string) (Expression, bool, error) {
 	for i := range m.accessors {
 		r, ok, err := m.accessors[i](name)
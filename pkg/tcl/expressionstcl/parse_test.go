@@ -243,6 +243,60 @@ a:
 	assert.Equal(t, `[""]`, MustCompile(`split(null)`).String())
 	assert.Equal(t, `["a","b","c"]`, MustCompile(`split("a,b,c")`).String())
 	assert.Equal(t, `["a","b","c"]`, MustCompile(`split("a---b---c", "---")`).String())
+	assert.Equal(t, `"abc"`, MustCompile(`tolower("AbC")`).String())
+	assert.Equal(t, `"ABC"`, MustCompile(`toupper("AbC")`).String())
+	assert.Equal(t, `true`, MustCompile(`contains("abcd", "bc")`).String())
+	assert.Equal(t, `false`, MustCompile(`contains(["a", "b"], "c")`).String())
+	assert.Equal(t, `true`, MustCompile(`contains([1, 2], 2.0)`).String())
+	assert.Equal(t, `true`, MustCompile(`startswith("abcd", "ab")`).String())
+	assert.Equal(t, `false`, MustCompile(`endswith("abcd", "ab")`).String())
+	assert.Equal(t, `true`, MustCompile(`regexmatch("v1.2.3", "^v\\d+")`).String())
+	assert.Equal(t, `"abc-X"`, MustCompile(`regexreplace("abc-123", "[0-9]+", "X")`).String())
+	assert.Equal(t, `"b-a"`, MustCompile(`regexreplace("a-b", "(\\w)-(\\w)", "$2-$1")`).String())
+	assert.Equal(t, `[2,4,6]`, MustCompile(`map([1, 2, 3], "_ * 2")`).String())
+	assert.Equal(t, `["0:a","1:b"]`, MustCompile(`map(["a", "b"], "string(_index, \":\", _)")`).String())
+	assert.Equal(t, `[{"a":5}]`, MustCompile(`filter([{"a": 1}, {"a": 5}], "_.a > 2")`).String())
+	assert.Equal(t, `[]`, MustCompile(`filter(null, "_")`).String())
+	assert.Equal(t, `1`, MustCompile(`min(3, 1, 2)`).String())
+	assert.Equal(t, `3.5`, MustCompile(`max([1, 3.5, 2])`).String())
+	assert.Equal(t, `6`, MustCompile(`sum([1, 2], 3)`).String())
+	assert.Equal(t, `0`, MustCompile(`sum([])`).String())
+	assert.Equal(t, `"2024-03-01"`, MustCompile(`formatdate("2024-03-01T10:00:00Z", "2006-01-02")`).String())
+	assert.Equal(t, `"2024-03-01T00:00:00Z"`, MustCompile(`parsedate("01.03.2024", "02.01.2006")`).String())
+	assert.Equal(t, `5400`, MustCompile(`duration("1h30m")`).String())
+	assert.Equal(t, `"2024-03-01T11:30:00Z"`, MustCompile(`dateadd("2024-03-01T10:00:00Z", "1h30m")`).String())
+	assert.Equal(t, `"2024-03-01T09:59:00Z"`, MustCompile(`dateadd("2024-03-01T10:00:00Z", -60)`).String())
+	assert.Equal(t, `-90`, MustCompile(`datediff("2024-03-01T10:00:00Z", "2024-03-01T10:01:30Z")`).String())
+	assert.Equal(t, `"YWJj"`, MustCompile(`tobase64("abc")`).String())
+	assert.Equal(t, `"abc"`, MustCompile(`base64("YWJj")`).String())
+	assert.Equal(t, `"616263"`, MustCompile(`tohex("abc")`).String())
+	assert.Equal(t, `"abc"`, MustCompile(`hex("616263")`).String())
+	assert.Equal(t, `"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"`, MustCompile(`sha256("abc")`).String())
+	assert.Len(t, must(must(EvalExpression(`uuid()`)).StringValue()), 36)
+	assert.Regexp(t, `^\d{4}-\d{2}-\d{2}T`, must(EvalTemplate(`{{now()}}`)))
+}
+
+func TestCompileNonFoldable(t *testing.T) {
+	assert.Equal(t, `uuid()`, MustCompile(`uuid()`).String())
+	assert.Equal(t, `"at "+now()`, MustCompile(`"at " + now()`).String())
+	assert.Equal(t, `now()`, must(MustCompile(`now()`).Resolve(NewMachine().Register("a", 1))).String())
+}
+
+func TestCompileStandardLibErrors(t *testing.T) {
+	assert.Error(t, errOnly(Compile(`regexmatch("abc", "[")`)))
+	assert.Error(t, errOnly(Compile(`map("abc", "_")`)))
+	assert.Error(t, errOnly(Compile(`filter([1], "_ +")`)))
+	assert.Error(t, errOnly(Compile(`min()`)))
+	assert.Error(t, errOnly(Compile(`formatdate("yesterday", "2006")`)))
+	assert.Error(t, errOnly(Compile(`dateadd("2024-03-01T10:00:00Z", "tomorrow")`)))
+	assert.Error(t, errOnly(Compile(`base64("%%%")`)))
+	assert.Error(t, errOnly(Compile(`hex("xyz")`)))
+}
+
+func TestCompileStandardLibSimplify(t *testing.T) {
+	machine := NewMachine().Register("browsers", []string{"chrome", "firefox"})
+	assert.Equal(t, `map(browsers,"toupper(_)")`, MustCompile(`map(browsers, "toupper(_)")`).String())
+	assert.Equal(t, `["CHROME","FIREFOX"]`, must(MustCompile(`map(browsers, "toupper(_)")`).Resolve(machine)).String())
 }
 
 func TestCompileDetectAccessors(t *testing.T) {
@@ -9,10 +9,17 @@
 package expressionstcl
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/kballard/go-shellquote"
 	"gopkg.in/yaml.v3"
 )
@@ -20,12 +27,20 @@ import (
 type StdFunction struct {
 	ReturnType Type
 	Handler    func(...StaticValue) (Expression, error)
+	// NonFoldable functions return different result on each call,
+	// so they are not computed while simplifying, but only when the final value is needed
+	NonFoldable bool
 }
 
-type stdMachine struct{}
+type stdMachine struct {
+	final bool
+}
 
 var StdLibMachine = &stdMachine{}
 
+// FinalStdLibMachine computes the non-foldable functions, when the final value of the expression is needed
+var FinalStdLibMachine = &stdMachine{final: true}
+
 var stdFunctions = map[string]StdFunction{
 	"string": {
 		ReturnType: TypeString,
@@ -207,6 +222,465 @@ var stdFunctions = map[string]StdFunction{
 			return NewValue(strings.TrimSpace(str)), nil
 		},
 	},
+	"tolower": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"tolower" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			return NewValue(strings.ToLower(str)), nil
+		},
+	},
+	"toupper": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"toupper" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			return NewValue(strings.ToUpper(str)), nil
+		},
+	},
+	"contains": {
+		ReturnType: TypeBool,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"contains" function expects 2 arguments, %d provided`, len(value))
+			}
+			if value[0].IsSlice() {
+				slice, err := value[0].SliceValue()
+				if err != nil {
+					return nil, fmt.Errorf(`"contains" function error: reading slice: %s`, err.Error())
+				}
+				for i := range slice {
+					if isEqual(slice[i], value[1].Value()) {
+						return NewValue(true), nil
+					}
+				}
+				return NewValue(false), nil
+			}
+			str, _ := value[0].StringValue()
+			substr, _ := value[1].StringValue()
+			return NewValue(strings.Contains(str, substr)), nil
+		},
+	},
+	"startswith": {
+		ReturnType: TypeBool,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"startswith" function expects 2 arguments, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			prefix, _ := value[1].StringValue()
+			return NewValue(strings.HasPrefix(str, prefix)), nil
+		},
+	},
+	"endswith": {
+		ReturnType: TypeBool,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"endswith" function expects 2 arguments, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			suffix, _ := value[1].StringValue()
+			return NewValue(strings.HasSuffix(str, suffix)), nil
+		},
+	},
+	"regexmatch": {
+		ReturnType: TypeBool,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"regexmatch" function expects 2 arguments, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			re, err := compileRegex("regexmatch", value[1])
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(re.MatchString(str)), nil
+		},
+	},
+	"regexreplace": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 3 {
+				return nil, fmt.Errorf(`"regexreplace" function expects 3 arguments, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			re, err := compileRegex("regexreplace", value[1])
+			if err != nil {
+				return nil, err
+			}
+			replacement, _ := value[2].StringValue()
+			return NewValue(re.ReplaceAllString(str, replacement)), nil
+		},
+	},
+	"map": {
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"map" function expects 2 arguments, %d provided`, len(value))
+			}
+			result := make([]interface{}, 0)
+			err := forEachItem("map", value[0], value[1], func(item interface{}, v StaticValue) error {
+				result = append(result, v.Value())
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(result), nil
+		},
+	},
+	"filter": {
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"filter" function expects 2 arguments, %d provided`, len(value))
+			}
+			result := make([]interface{}, 0)
+			err := forEachItem("filter", value[0], value[1], func(item interface{}, v StaticValue) error {
+				ok, err := v.BoolValue()
+				if err != nil {
+					return err
+				}
+				if ok {
+					result = append(result, item)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(result), nil
+		},
+	},
+	"min": {
+		Handler: func(value ...StaticValue) (Expression, error) {
+			return reduceNumbers("min", value, func(a, b float64) float64 {
+				if b < a {
+					return b
+				}
+				return a
+			})
+		},
+	},
+	"max": {
+		Handler: func(value ...StaticValue) (Expression, error) {
+			return reduceNumbers("max", value, func(a, b float64) float64 {
+				if b > a {
+					return b
+				}
+				return a
+			})
+		},
+	},
+	"sum": {
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(numbersOf(value)) == 0 {
+				return NewValue(0), nil
+			}
+			return reduceNumbers("sum", value, func(a, b float64) float64 {
+				return a + b
+			})
+		},
+	},
+	"now": {
+		ReturnType:  TypeString,
+		NonFoldable: true,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 0 {
+				return nil, fmt.Errorf(`"now" function expects 0 arguments, %d provided`, len(value))
+			}
+			return NewValue(time.Now().UTC().Format(time.RFC3339Nano)), nil
+		},
+	},
+	"formatdate": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"formatdate" function expects 2 arguments, %d provided`, len(value))
+			}
+			t, err := toTime("formatdate", value[0])
+			if err != nil {
+				return nil, err
+			}
+			layout, _ := value[1].StringValue()
+			return NewValue(t.Format(layout)), nil
+		},
+	},
+	"parsedate": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) == 0 || len(value) > 2 {
+				return nil, fmt.Errorf(`"parsedate" function expects 1-2 arguments, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			layout := time.RFC3339Nano
+			if len(value) == 2 {
+				layout, _ = value[1].StringValue()
+			}
+			t, err := time.Parse(layout, str)
+			if err != nil {
+				return nil, fmt.Errorf(`"parsedate" function error: %s`, err.Error())
+			}
+			return NewValue(t.UTC().Format(time.RFC3339Nano)), nil
+		},
+	},
+	"duration": {
+		ReturnType: TypeFloat64,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"duration" function expects 1 argument, %d provided`, len(value))
+			}
+			d, err := toDuration("duration", value[0])
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(d.Seconds()), nil
+		},
+	},
+	"dateadd": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"dateadd" function expects 2 arguments, %d provided`, len(value))
+			}
+			t, err := toTime("dateadd", value[0])
+			if err != nil {
+				return nil, err
+			}
+			d, err := toDuration("dateadd", value[1])
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(t.Add(d).Format(time.RFC3339Nano)), nil
+		},
+	},
+	"datediff": {
+		ReturnType: TypeFloat64,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 2 {
+				return nil, fmt.Errorf(`"datediff" function expects 2 arguments, %d provided`, len(value))
+			}
+			t1, err := toTime("datediff", value[0])
+			if err != nil {
+				return nil, err
+			}
+			t2, err := toTime("datediff", value[1])
+			if err != nil {
+				return nil, err
+			}
+			return NewValue(t1.Sub(t2).Seconds()), nil
+		},
+	},
+	"tobase64": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"tobase64" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			return NewValue(base64.StdEncoding.EncodeToString([]byte(str))), nil
+		},
+	},
+	"base64": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"base64" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			v, err := base64.StdEncoding.DecodeString(str)
+			if err != nil {
+				return nil, fmt.Errorf(`"base64" function had problem decoding: %s`, err.Error())
+			}
+			return NewValue(string(v)), nil
+		},
+	},
+	"tohex": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"tohex" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			return NewValue(hex.EncodeToString([]byte(str))), nil
+		},
+	},
+	"hex": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"hex" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			v, err := hex.DecodeString(str)
+			if err != nil {
+				return nil, fmt.Errorf(`"hex" function had problem decoding: %s`, err.Error())
+			}
+			return NewValue(string(v)), nil
+		},
+	},
+	"sha256": {
+		ReturnType: TypeString,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 1 {
+				return nil, fmt.Errorf(`"sha256" function expects 1 argument, %d provided`, len(value))
+			}
+			str, _ := value[0].StringValue()
+			sum := sha256.Sum256([]byte(str))
+			return NewValue(hex.EncodeToString(sum[:])), nil
+		},
+	},
+	"uuid": {
+		ReturnType:  TypeString,
+		NonFoldable: true,
+		Handler: func(value ...StaticValue) (Expression, error) {
+			if len(value) != 0 {
+				return nil, fmt.Errorf(`"uuid" function expects 0 arguments, %d provided`, len(value))
+			}
+			return NewValue(uuid.NewString()), nil
+		},
+	},
+}
+
+func compileRegex(fnName string, pattern StaticValue) (*regexp.Regexp, error) {
+	str, _ := pattern.StringValue()
+	re, err := regexp.Compile(str)
+	if err != nil {
+		return nil, fmt.Errorf(`"%s" function error: invalid pattern: %s`, fnName, err.Error())
+	}
+	return re, nil
+}
+
+// forEachItem evaluates the expression for each item of the list,
+// where the item is available as "_" and its position as "_index"
+func forEachItem(fnName string, list StaticValue, exp StaticValue, fn func(item interface{}, v StaticValue) error) error {
+	if list.IsNone() {
+		return nil
+	}
+	if !list.IsSlice() {
+		return fmt.Errorf(`"%s" function expects a slice as 1st argument: %v provided`, fnName, list.Value())
+	}
+	slice, err := list.SliceValue()
+	if err != nil {
+		return fmt.Errorf(`"%s" function error: reading slice: %s`, fnName, err.Error())
+	}
+	str, _ := exp.StringValue()
+	for i := range slice {
+		// Compile each time, as resolving is modifying the expression
+		expr, err := Compile(str)
+		if err != nil {
+			return fmt.Errorf(`"%s" function error: compiling expression: %s`, fnName, err.Error())
+		}
+		item := slice[i]
+		machine := NewMachine().
+			Register("_index", i).
+			RegisterAccessorExt(func(name string) (interface{}, bool, error) {
+				return getItemProperty(item, name)
+			})
+		v, err := expr.Resolve(machine)
+		if err != nil {
+			return fmt.Errorf(`"%s" function error: %s`, fnName, err.Error())
+		}
+		if v.Static() == nil {
+			return fmt.Errorf(`"%s" function error: expression should be static: %s`, fnName, v.String())
+		}
+		err = fn(item, v.Static())
+		if err != nil {
+			return fmt.Errorf(`"%s" function error: %s`, fnName, err.Error())
+		}
+	}
+	return nil
+}
+
+// getItemProperty reads "_" or "_.path.to.property" from the list item
+func getItemProperty(item interface{}, name string) (interface{}, bool, error) {
+	if name != "_" && !strings.HasPrefix(name, "_.") {
+		return nil, false, nil
+	}
+	path := strings.Split(name, ".")[1:]
+	for _, key := range path {
+		m, err := toMap(item)
+		if err != nil {
+			return nil, true, err
+		}
+		item = m[key]
+	}
+	return item, true, nil
+}
+
+// numbersOf flattens the arguments, so both variadic and slice arguments are supported
+func numbersOf(value []StaticValue) []interface{} {
+	result := make([]interface{}, 0, len(value))
+	for i := range value {
+		if value[i].IsSlice() {
+			slice, _ := value[i].SliceValue()
+			result = append(result, slice...)
+		} else if !value[i].IsNone() {
+			result = append(result, value[i].Value())
+		}
+	}
+	return result
+}
+
+func reduceNumbers(fnName string, value []StaticValue, fn func(a, b float64) float64) (Expression, error) {
+	numbers := numbersOf(value)
+	if len(numbers) == 0 {
+		return nil, fmt.Errorf(`"%s" function expects at least 1 number`, fnName)
+	}
+	ints := true
+	result := 0.0
+	for i := range numbers {
+		if !isInt(numbers[i]) {
+			ints = false
+		}
+		v, err := toFloat(numbers[i])
+		if err != nil {
+			return nil, fmt.Errorf(`"%s" function error: %s`, fnName, err.Error())
+		}
+		if i == 0 {
+			result = v
+		} else {
+			result = fn(result, v)
+		}
+	}
+	if ints {
+		return NewValue(int64(result)), nil
+	}
+	return NewValue(result), nil
+}
+
+func isEqual(a, b interface{}) bool {
+	if isNumber(a) && isNumber(b) {
+		v1, _ := toFloat(a)
+		v2, _ := toFloat(b)
+		return v1 == v2
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toTime(fnName string, value StaticValue) (time.Time, error) {
+	str, _ := value.StringValue()
+	t, err := time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return t, fmt.Errorf(`"%s" function expects RFC3339 date: %s`, fnName, err.Error())
+	}
+	return t.UTC(), nil
+}
+
+// toDuration reads the duration either as a Go duration string, or a number of seconds
+func toDuration(fnName string, value StaticValue) (time.Duration, error) {
+	if value.IsNumber() {
+		v, _ := value.FloatValue()
+		return time.Duration(v * float64(time.Second)), nil
+	}
+	str, _ := value.StringValue()
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, fmt.Errorf(`"%s" function expects duration: %s`, fnName, err.Error())
+	}
+	return d, nil
 }
 
 const (
@@ -259,9 +733,9 @@ func (*stdMachine) Get(name string) (Expression, bool, error) {
 	return nil, false, nil
 }
 
-func (*stdMachine) Call(name string, args ...StaticValue) (Expression, bool, error) {
+func (s *stdMachine) Call(name string, args ...StaticValue) (Expression, bool, error) {
 	fn, ok := stdFunctions[name]
-	if ok {
+	if ok && fn.NonFoldable == s.final {
 		exp, err := fn.Handler(args...)
 		return exp, true, err
 	}
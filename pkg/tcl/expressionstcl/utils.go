@@ -34,7 +34,7 @@ func EvalTemplate(tpl string, machines ...Machine) (string, error) {
 	if err != nil {
 		return "", errors.Wrap(err, "compiling")
 	}
-	expr, err = expr.Resolve(machines...)
+	expr, err = expr.Resolve(append(machines[:len(machines):len(machines)], FinalStdLibMachine)...)
 	if err != nil {
 		return "", errors.Wrap(err, "resolving")
 	}
@@ -49,7 +49,7 @@ func EvalExpression(str string, machines ...Machine) (StaticValue, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "compiling")
 	}
-	expr, err = expr.Resolve(machines...)
+	expr, err = expr.Resolve(append(machines[:len(machines):len(machines)], FinalStdLibMachine)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "resolving")
 	}
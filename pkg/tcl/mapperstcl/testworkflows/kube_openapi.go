@@ -433,6 +433,37 @@ func MapPodConfigKubeToAPI(v testworkflowsv1.PodConfig) testkube.TestWorkflowPod
 	}
 }
 
+func MapProbeKubeToAPI(v corev1.Probe) testkube.TestWorkflowProbe {
+	probe := testkube.TestWorkflowProbe{
+		InitialDelaySeconds: v.InitialDelaySeconds,
+		PeriodSeconds:       v.PeriodSeconds,
+		TimeoutSeconds:      v.TimeoutSeconds,
+		FailureThreshold:    v.FailureThreshold,
+	}
+	if v.Exec != nil {
+		probe.Exec = &testkube.TestWorkflowProbeExec{Command: v.Exec.Command}
+	}
+	if v.TCPSocket != nil {
+		probe.TcpSocket = &testkube.TestWorkflowProbeTcpSocket{Port: v.TCPSocket.Port.IntVal}
+	}
+	if v.HTTPGet != nil {
+		probe.HttpGet = &testkube.TestWorkflowProbeHttpGet{Path: v.HTTPGet.Path, Port: v.HTTPGet.Port.IntVal}
+	}
+	return probe
+}
+
+func MapServiceSpecKubeToAPI(v testworkflowsv1.ServiceSpec) testkube.TestWorkflowServiceSpec {
+	return testkube.TestWorkflowServiceSpec{
+		Image:          v.Image,
+		Command:        v.Command,
+		Args:           v.Args,
+		Env:            common.MapSlice(v.Env, MapEnvVarKubeToAPI),
+		Ports:          v.Ports,
+		ReadinessProbe: common.MapPtr(v.ReadinessProbe, MapProbeKubeToAPI),
+		Resources:      common.MapPtr(v.Resources, MapResourcesKubeToAPI),
+	}
+}
+
 func MapVolumeMountKubeToAPI(v corev1.VolumeMount) testkube.VolumeMount {
 	return testkube.VolumeMount{
 		Name:             v.Name,
@@ -485,6 +516,41 @@ func MapStepExecuteKubeToAPI(v testworkflowsv1.StepExecute) testkube.TestWorkflo
 	}
 }
 
+func MapApprovalInputKubeToAPI(v testworkflowsv1.ApprovalInput) testkube.TestWorkflowApprovalInput {
+	return testkube.TestWorkflowApprovalInput{
+		Name:        v.Name,
+		Description: v.Description,
+		Default_:    MapStringToBoxedString(v.Default),
+	}
+}
+
+func MapStepApprovalKubeToAPI(v testworkflowsv1.StepApproval) testkube.TestWorkflowStepApproval {
+	return testkube.TestWorkflowStepApproval{
+		Message:  v.Message,
+		Timeout:  v.Timeout,
+		Default_: string(v.Default),
+		Inputs:   common.MapSlice(v.Inputs, MapApprovalInputKubeToAPI),
+	}
+}
+
+func MapStepParallelKubeToAPI(v testworkflowsv1.StepParallel) testkube.TestWorkflowStepParallel {
+	return testkube.TestWorkflowStepParallel{
+		Parallelism: v.Parallelism,
+		Shards:      v.Shards,
+		Matrix:      v.Matrix,
+		Steps:       common.MapSlice(v.Steps, MapStepKubeToAPI),
+	}
+}
+
+func MapIndependentStepParallelKubeToAPI(v testworkflowsv1.IndependentStepParallel) testkube.TestWorkflowIndependentStepParallel {
+	return testkube.TestWorkflowIndependentStepParallel{
+		Parallelism: v.Parallelism,
+		Shards:      v.Shards,
+		Matrix:      v.Matrix,
+		Steps:       common.MapSlice(v.Steps, MapIndependentStepKubeToAPI),
+	}
+}
+
 func MapStepArtifactsCompressionKubeToAPI(v testworkflowsv1.ArtifactCompression) testkube.TestWorkflowStepArtifactsCompression {
 	return testkube.TestWorkflowStepArtifactsCompression{
 		Name: v.Name,
@@ -499,6 +565,22 @@ func MapStepArtifactsKubeToAPI(v testworkflowsv1.StepArtifacts) testkube.TestWor
 	}
 }
 
+func MapStepReportKubeToAPI(v testworkflowsv1.StepReport) testkube.TestWorkflowStepReport {
+	return testkube.TestWorkflowStepReport{
+		Format: v.Format,
+		Path:   v.Path,
+	}
+}
+
+func MapCacheSpecKubeToAPI(v testworkflowsv1.CacheSpec) testkube.TestWorkflowCacheSpec {
+	return testkube.TestWorkflowCacheSpec{
+		Key:     v.Key,
+		Paths:   v.Paths,
+		MaxSize: v.MaxSize,
+		Ttl:     v.Ttl,
+	}
+}
+
 func MapRetryPolicyKubeToAPI(v testworkflowsv1.RetryPolicy) testkube.TestWorkflowRetryPolicy {
 	return testkube.TestWorkflowRetryPolicy{
 		Count: v.Count,
@@ -508,45 +590,67 @@ func MapRetryPolicyKubeToAPI(v testworkflowsv1.RetryPolicy) testkube.TestWorkflo
 
 func MapStepKubeToAPI(v testworkflowsv1.Step) testkube.TestWorkflowStep {
 	return testkube.TestWorkflowStep{
-		Name:       v.Name,
-		Condition:  v.Condition,
-		Negative:   v.Negative,
-		Optional:   v.Optional,
-		Use:        common.MapSlice(v.Use, MapTemplateRefKubeToAPI),
-		Template:   common.MapPtr(v.Template, MapTemplateRefKubeToAPI),
-		Retry:      common.MapPtr(v.Retry, MapRetryPolicyKubeToAPI),
-		Timeout:    v.Timeout,
-		Delay:      v.Delay,
-		Content:    common.MapPtr(v.Content, MapContentKubeToAPI),
-		Shell:      v.Shell,
-		Run:        common.MapPtr(v.Run, MapStepRunKubeToAPI),
-		WorkingDir: MapStringToBoxedString(v.WorkingDir),
-		Container:  common.MapPtr(v.Container, MapContainerConfigKubeToAPI),
-		Execute:    common.MapPtr(v.Execute, MapStepExecuteKubeToAPI),
-		Artifacts:  common.MapPtr(v.Artifacts, MapStepArtifactsKubeToAPI),
-		Setup:      common.MapSlice(v.Setup, MapStepKubeToAPI),
-		Steps:      common.MapSlice(v.Steps, MapStepKubeToAPI),
+		Name:        v.Name,
+		Condition:   v.Condition,
+		Negative:    v.Negative,
+		Optional:    v.Optional,
+		Use:         common.MapSlice(v.Use, MapTemplateRefKubeToAPI),
+		Template:    common.MapPtr(v.Template, MapTemplateRefKubeToAPI),
+		Retry:       common.MapPtr(v.Retry, MapRetryPolicyKubeToAPI),
+		Timeout:     v.Timeout,
+		Delay:       v.Delay,
+		GracePeriod: v.GracePeriod,
+		Content:     common.MapPtr(v.Content, MapContentKubeToAPI),
+		Shell:       v.Shell,
+		Run:         common.MapPtr(v.Run, MapStepRunKubeToAPI),
+		WorkingDir:  MapStringToBoxedString(v.WorkingDir),
+		Container:   common.MapPtr(v.Container, MapContainerConfigKubeToAPI),
+		Execute:     common.MapPtr(v.Execute, MapStepExecuteKubeToAPI),
+		Approval:    common.MapPtr(v.Approval, MapStepApprovalKubeToAPI),
+		Artifacts:   common.MapPtr(v.Artifacts, MapStepArtifactsKubeToAPI),
+		Reports:     common.MapSlice(v.Reports, MapStepReportKubeToAPI),
+		Cache:       common.MapSlice(v.Cache, MapCacheSpecKubeToAPI),
+		Setup:       common.MapSlice(v.Setup, MapStepKubeToAPI),
+		Steps:       common.MapSlice(v.Steps, MapStepKubeToAPI),
+		Parallel:    common.MapPtr(v.Parallel, MapStepParallelKubeToAPI),
 	}
 }
 
 func MapIndependentStepKubeToAPI(v testworkflowsv1.IndependentStep) testkube.TestWorkflowIndependentStep {
 	return testkube.TestWorkflowIndependentStep{
-		Name:       v.Name,
-		Condition:  v.Condition,
-		Negative:   v.Negative,
-		Optional:   v.Optional,
-		Retry:      common.MapPtr(v.Retry, MapRetryPolicyKubeToAPI),
-		Timeout:    v.Timeout,
-		Delay:      v.Delay,
-		Content:    common.MapPtr(v.Content, MapContentKubeToAPI),
-		Shell:      v.Shell,
-		Run:        common.MapPtr(v.Run, MapStepRunKubeToAPI),
-		WorkingDir: MapStringToBoxedString(v.WorkingDir),
-		Container:  common.MapPtr(v.Container, MapContainerConfigKubeToAPI),
-		Execute:    common.MapPtr(v.Execute, MapStepExecuteKubeToAPI),
-		Artifacts:  common.MapPtr(v.Artifacts, MapStepArtifactsKubeToAPI),
-		Setup:      common.MapSlice(v.Setup, MapIndependentStepKubeToAPI),
-		Steps:      common.MapSlice(v.Steps, MapIndependentStepKubeToAPI),
+		Name:        v.Name,
+		Condition:   v.Condition,
+		Negative:    v.Negative,
+		Optional:    v.Optional,
+		Retry:       common.MapPtr(v.Retry, MapRetryPolicyKubeToAPI),
+		Timeout:     v.Timeout,
+		Delay:       v.Delay,
+		GracePeriod: v.GracePeriod,
+		Content:     common.MapPtr(v.Content, MapContentKubeToAPI),
+		Shell:       v.Shell,
+		Run:         common.MapPtr(v.Run, MapStepRunKubeToAPI),
+		WorkingDir:  MapStringToBoxedString(v.WorkingDir),
+		Container:   common.MapPtr(v.Container, MapContainerConfigKubeToAPI),
+		Execute:     common.MapPtr(v.Execute, MapStepExecuteKubeToAPI),
+		Approval:    common.MapPtr(v.Approval, MapStepApprovalKubeToAPI),
+		Artifacts:   common.MapPtr(v.Artifacts, MapStepArtifactsKubeToAPI),
+		Reports:     common.MapSlice(v.Reports, MapStepReportKubeToAPI),
+		Cache:       common.MapSlice(v.Cache, MapCacheSpecKubeToAPI),
+		Setup:       common.MapSlice(v.Setup, MapIndependentStepKubeToAPI),
+		Steps:       common.MapSlice(v.Steps, MapIndependentStepKubeToAPI),
+		Parallel:    common.MapPtr(v.Parallel, MapIndependentStepParallelKubeToAPI),
+	}
+}
+
+func MapScheduleKubeToAPI(v testworkflowsv1.Schedule) testkube.TestWorkflowSchedule {
+	return testkube.TestWorkflowSchedule{
+		Name:     v.Name,
+		Cron:     v.Cron,
+		Timezone: v.Timezone,
+		Jitter:   v.Jitter,
+		Config:   v.Config,
+		CatchUp:  string(v.CatchUp),
+		Disabled: v.Disabled,
 	}
 }
 
@@ -558,9 +662,12 @@ func MapSpecKubeToAPI(v testworkflowsv1.TestWorkflowSpec) testkube.TestWorkflowS
 		Container: common.MapPtr(v.Container, MapContainerConfigKubeToAPI),
 		Job:       common.MapPtr(v.Job, MapJobConfigKubeToAPI),
 		Pod:       common.MapPtr(v.Pod, MapPodConfigKubeToAPI),
+		Services:  common.MapMap(v.Services, MapServiceSpecKubeToAPI),
+		Cache:     common.MapSlice(v.Cache, MapCacheSpecKubeToAPI),
 		Setup:     common.MapSlice(v.Setup, MapStepKubeToAPI),
 		Steps:     common.MapSlice(v.Steps, MapStepKubeToAPI),
 		After:     common.MapSlice(v.After, MapStepKubeToAPI),
+		Schedules: common.MapSlice(v.Schedules, MapScheduleKubeToAPI),
 	}
 }
 
@@ -571,6 +678,8 @@ func MapTemplateSpecKubeToAPI(v testworkflowsv1.TestWorkflowTemplateSpec) testku
 		Container: common.MapPtr(v.Container, MapContainerConfigKubeToAPI),
 		Job:       common.MapPtr(v.Job, MapJobConfigKubeToAPI),
 		Pod:       common.MapPtr(v.Pod, MapPodConfigKubeToAPI),
+		Services:  common.MapMap(v.Services, MapServiceSpecKubeToAPI),
+		Cache:     common.MapSlice(v.Cache, MapCacheSpecKubeToAPI),
 		Setup:     common.MapSlice(v.Setup, MapIndependentStepKubeToAPI),
 		Steps:     common.MapSlice(v.Steps, MapIndependentStepKubeToAPI),
 		After:     common.MapSlice(v.After, MapIndependentStepKubeToAPI),
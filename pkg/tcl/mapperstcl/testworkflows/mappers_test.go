@@ -250,6 +250,12 @@ var (
 			},
 			Paths: []string{"/get", "/from/there"},
 		},
+		Reports: []testworkflowsv1.StepReport{
+			{Format: "junit", Path: "reports/*.xml"},
+		},
+		Cache: []testworkflowsv1.CacheSpec{
+			{Key: "npm", Paths: []string{"node_modules"}, MaxSize: "500Mi", Ttl: "24h"},
+		},
 	}
 	step = testworkflowsv1.Step{
 		StepBase: stepBase,
@@ -267,12 +273,32 @@ var (
 		Steps: []testworkflowsv1.Step{
 			{StepBase: testworkflowsv1.StepBase{Name: "xyz"}},
 		},
+		Parallel: &testworkflowsv1.StepParallel{
+			StepParallelBase: testworkflowsv1.StepParallelBase{
+				Parallelism: 2,
+				Shards:      3,
+				Matrix:      map[string][]string{"browser": {"chrome", "firefox"}},
+			},
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Name: "abc"}},
+			},
+		},
 	}
 	independentStep = testworkflowsv1.IndependentStep{
 		StepBase: stepBase,
 		Steps: []testworkflowsv1.IndependentStep{
 			{StepBase: testworkflowsv1.StepBase{Name: "xyz"}},
 		},
+		Parallel: &testworkflowsv1.IndependentStepParallel{
+			StepParallelBase: testworkflowsv1.StepParallelBase{
+				Parallelism: 2,
+				Shards:      3,
+				Matrix:      map[string][]string{"browser": {"chrome", "firefox"}},
+			},
+			Steps: []testworkflowsv1.IndependentStep{
+				{StepBase: testworkflowsv1.StepBase{Name: "abc"}},
+			},
+		},
 	}
 	workflowSpecBase = testworkflowsv1.TestWorkflowSpecBase{
 		Config: map[string]testworkflowsv1.ParameterSchema{
@@ -316,6 +342,30 @@ var (
 			Labels:             map[string]string{"some-key-4": "some-value"},
 			Annotations:        map[string]string{"some-key=5": "some-value-2"},
 		},
+		Services: map[string]testworkflowsv1.ServiceSpec{
+			"db": {
+				Image:   "postgres:16",
+				Command: []string{"docker-entrypoint.sh"},
+				Args:    []string{"postgres"},
+				Env:     []corev1.EnvVar{{Name: "POSTGRES_PASSWORD", Value: "secret"}},
+				Ports:   []int32{5432},
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler:     corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: []string{"pg_isready"}}},
+					PeriodSeconds:    1,
+					FailureThreshold: 30,
+				},
+				Resources: container.Resources,
+			},
+			"api": {
+				Image: "example/api:1.0",
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/health", Port: intstr.FromInt32(8080)}},
+				},
+			},
+		},
+		Cache: []testworkflowsv1.CacheSpec{
+			{Key: "go-{{sha256(file('go.sum'))}}", Paths: []string{".cache/go"}},
+		},
 	}
 )
 
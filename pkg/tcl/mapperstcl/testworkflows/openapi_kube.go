@@ -448,6 +448,37 @@ func MapPodConfigAPIToKube(v testkube.TestWorkflowPodConfig) testworkflowsv1.Pod
 	}
 }
 
+func MapProbeAPIToKube(v testkube.TestWorkflowProbe) corev1.Probe {
+	probe := corev1.Probe{
+		InitialDelaySeconds: v.InitialDelaySeconds,
+		PeriodSeconds:       v.PeriodSeconds,
+		TimeoutSeconds:      v.TimeoutSeconds,
+		FailureThreshold:    v.FailureThreshold,
+	}
+	if v.Exec != nil {
+		probe.Exec = &corev1.ExecAction{Command: v.Exec.Command}
+	}
+	if v.TcpSocket != nil {
+		probe.TCPSocket = &corev1.TCPSocketAction{Port: intstr.FromInt32(v.TcpSocket.Port)}
+	}
+	if v.HttpGet != nil {
+		probe.HTTPGet = &corev1.HTTPGetAction{Path: v.HttpGet.Path, Port: intstr.FromInt32(v.HttpGet.Port)}
+	}
+	return probe
+}
+
+func MapServiceSpecAPIToKube(v testkube.TestWorkflowServiceSpec) testworkflowsv1.ServiceSpec {
+	return testworkflowsv1.ServiceSpec{
+		Image:          v.Image,
+		Command:        v.Command,
+		Args:           v.Args,
+		Env:            common.MapSlice(v.Env, MapEnvVarAPIToKube),
+		Ports:          v.Ports,
+		ReadinessProbe: common.MapPtr(v.ReadinessProbe, MapProbeAPIToKube),
+		Resources:      common.MapPtr(v.Resources, MapResourcesAPIToKube),
+	}
+}
+
 func MapVolumeMountAPIToKube(v testkube.VolumeMount) corev1.VolumeMount {
 	return corev1.VolumeMount{
 		Name:             v.Name,
@@ -502,6 +533,45 @@ func MapStepExecuteAPIToKube(v testkube.TestWorkflowStepExecute) testworkflowsv1
 	}
 }
 
+func MapApprovalInputAPIToKube(v testkube.TestWorkflowApprovalInput) testworkflowsv1.ApprovalInput {
+	return testworkflowsv1.ApprovalInput{
+		Name:        v.Name,
+		Description: v.Description,
+		Default:     MapBoxedStringToString(v.Default_),
+	}
+}
+
+func MapStepApprovalAPIToKube(v testkube.TestWorkflowStepApproval) testworkflowsv1.StepApproval {
+	return testworkflowsv1.StepApproval{
+		Message: v.Message,
+		Timeout: v.Timeout,
+		Default: testworkflowsv1.ApprovalAction(v.Default_),
+		Inputs:  common.MapSlice(v.Inputs, MapApprovalInputAPIToKube),
+	}
+}
+
+func MapStepParallelAPIToKube(v testkube.TestWorkflowStepParallel) testworkflowsv1.StepParallel {
+	return testworkflowsv1.StepParallel{
+		StepParallelBase: testworkflowsv1.StepParallelBase{
+			Parallelism: v.Parallelism,
+			Shards:      v.Shards,
+			Matrix:      v.Matrix,
+		},
+		Steps: common.MapSlice(v.Steps, MapStepAPIToKube),
+	}
+}
+
+func MapIndependentStepParallelAPIToKube(v testkube.TestWorkflowIndependentStepParallel) testworkflowsv1.IndependentStepParallel {
+	return testworkflowsv1.IndependentStepParallel{
+		StepParallelBase: testworkflowsv1.StepParallelBase{
+			Parallelism: v.Parallelism,
+			Shards:      v.Shards,
+			Matrix:      v.Matrix,
+		},
+		Steps: common.MapSlice(v.Steps, MapIndependentStepAPIToKube),
+	}
+}
+
 func MapStepArtifactsCompressionAPIToKube(v testkube.TestWorkflowStepArtifactsCompression) testworkflowsv1.ArtifactCompression {
 	return testworkflowsv1.ArtifactCompression{
 		Name: v.Name,
@@ -516,6 +586,22 @@ func MapStepArtifactsAPIToKube(v testkube.TestWorkflowStepArtifacts) testworkflo
 	}
 }
 
+func MapStepReportAPIToKube(v testkube.TestWorkflowStepReport) testworkflowsv1.StepReport {
+	return testworkflowsv1.StepReport{
+		Format: v.Format,
+		Path:   v.Path,
+	}
+}
+
+func MapCacheSpecAPIToKube(v testkube.TestWorkflowCacheSpec) testworkflowsv1.CacheSpec {
+	return testworkflowsv1.CacheSpec{
+		Key:     v.Key,
+		Paths:   v.Paths,
+		MaxSize: v.MaxSize,
+		Ttl:     v.Ttl,
+	}
+}
+
 func MapRetryPolicyAPIToKube(v testkube.TestWorkflowRetryPolicy) testworkflowsv1.RetryPolicy {
 	return testworkflowsv1.RetryPolicy{
 		Count: v.Count,
@@ -526,48 +612,70 @@ func MapRetryPolicyAPIToKube(v testkube.TestWorkflowRetryPolicy) testworkflowsv1
 func MapStepAPIToKube(v testkube.TestWorkflowStep) testworkflowsv1.Step {
 	return testworkflowsv1.Step{
 		StepBase: testworkflowsv1.StepBase{
-			Name:       v.Name,
-			Condition:  v.Condition,
-			Negative:   v.Negative,
-			Optional:   v.Optional,
-			Retry:      common.MapPtr(v.Retry, MapRetryPolicyAPIToKube),
-			Timeout:    v.Timeout,
-			Delay:      v.Delay,
-			Content:    common.MapPtr(v.Content, MapContentAPIToKube),
-			Shell:      v.Shell,
-			Run:        common.MapPtr(v.Run, MapStepRunAPIToKube),
-			WorkingDir: MapBoxedStringToString(v.WorkingDir),
-			Container:  common.MapPtr(v.Container, MapContainerConfigAPIToKube),
-			Execute:    common.MapPtr(v.Execute, MapStepExecuteAPIToKube),
-			Artifacts:  common.MapPtr(v.Artifacts, MapStepArtifactsAPIToKube),
+			Name:        v.Name,
+			Condition:   v.Condition,
+			Negative:    v.Negative,
+			Optional:    v.Optional,
+			Retry:       common.MapPtr(v.Retry, MapRetryPolicyAPIToKube),
+			Timeout:     v.Timeout,
+			Delay:       v.Delay,
+			GracePeriod: v.GracePeriod,
+			Content:     common.MapPtr(v.Content, MapContentAPIToKube),
+			Shell:       v.Shell,
+			Run:         common.MapPtr(v.Run, MapStepRunAPIToKube),
+			WorkingDir:  MapBoxedStringToString(v.WorkingDir),
+			Container:   common.MapPtr(v.Container, MapContainerConfigAPIToKube),
+			Execute:     common.MapPtr(v.Execute, MapStepExecuteAPIToKube),
+			Approval:    common.MapPtr(v.Approval, MapStepApprovalAPIToKube),
+			Artifacts:   common.MapPtr(v.Artifacts, MapStepArtifactsAPIToKube),
+			Reports:     common.MapSlice(v.Reports, MapStepReportAPIToKube),
+			Cache:       common.MapSlice(v.Cache, MapCacheSpecAPIToKube),
 		},
 		Use:      common.MapSlice(v.Use, MapTemplateRefAPIToKube),
 		Template: common.MapPtr(v.Template, MapTemplateRefAPIToKube),
 		Setup:    common.MapSlice(v.Setup, MapStepAPIToKube),
 		Steps:    common.MapSlice(v.Steps, MapStepAPIToKube),
+		Parallel: common.MapPtr(v.Parallel, MapStepParallelAPIToKube),
 	}
 }
 
 func MapIndependentStepAPIToKube(v testkube.TestWorkflowIndependentStep) testworkflowsv1.IndependentStep {
 	return testworkflowsv1.IndependentStep{
 		StepBase: testworkflowsv1.StepBase{
-			Name:       v.Name,
-			Condition:  v.Condition,
-			Negative:   v.Negative,
-			Optional:   v.Optional,
-			Retry:      common.MapPtr(v.Retry, MapRetryPolicyAPIToKube),
-			Timeout:    v.Timeout,
-			Delay:      v.Delay,
-			Content:    common.MapPtr(v.Content, MapContentAPIToKube),
-			Shell:      v.Shell,
-			Run:        common.MapPtr(v.Run, MapStepRunAPIToKube),
-			WorkingDir: MapBoxedStringToString(v.WorkingDir),
-			Container:  common.MapPtr(v.Container, MapContainerConfigAPIToKube),
-			Execute:    common.MapPtr(v.Execute, MapStepExecuteAPIToKube),
-			Artifacts:  common.MapPtr(v.Artifacts, MapStepArtifactsAPIToKube),
+			Name:        v.Name,
+			Condition:   v.Condition,
+			Negative:    v.Negative,
+			Optional:    v.Optional,
+			Retry:       common.MapPtr(v.Retry, MapRetryPolicyAPIToKube),
+			Timeout:     v.Timeout,
+			Delay:       v.Delay,
+			GracePeriod: v.GracePeriod,
+			Content:     common.MapPtr(v.Content, MapContentAPIToKube),
+			Shell:       v.Shell,
+			Run:         common.MapPtr(v.Run, MapStepRunAPIToKube),
+			WorkingDir:  MapBoxedStringToString(v.WorkingDir),
+			Container:   common.MapPtr(v.Container, MapContainerConfigAPIToKube),
+			Execute:     common.MapPtr(v.Execute, MapStepExecuteAPIToKube),
+			Approval:    common.MapPtr(v.Approval, MapStepApprovalAPIToKube),
+			Artifacts:   common.MapPtr(v.Artifacts, MapStepArtifactsAPIToKube),
+			Reports:     common.MapSlice(v.Reports, MapStepReportAPIToKube),
+			Cache:       common.MapSlice(v.Cache, MapCacheSpecAPIToKube),
 		},
-		Setup: common.MapSlice(v.Setup, MapIndependentStepAPIToKube),
-		Steps: common.MapSlice(v.Steps, MapIndependentStepAPIToKube),
+		Setup:    common.MapSlice(v.Setup, MapIndependentStepAPIToKube),
+		Steps:    common.MapSlice(v.Steps, MapIndependentStepAPIToKube),
+		Parallel: common.MapPtr(v.Parallel, MapIndependentStepParallelAPIToKube),
+	}
+}
+
+func MapScheduleAPIToKube(v testkube.TestWorkflowSchedule) testworkflowsv1.Schedule {
+	return testworkflowsv1.Schedule{
+		Name:     v.Name,
+		Cron:     v.Cron,
+		Timezone: v.Timezone,
+		Jitter:   v.Jitter,
+		Config:   v.Config,
+		CatchUp:  testworkflowsv1.ScheduleCatchUpPolicy(v.CatchUp),
+		Disabled: v.Disabled,
 	}
 }
 
@@ -579,11 +687,14 @@ func MapSpecAPIToKube(v testkube.TestWorkflowSpec) testworkflowsv1.TestWorkflowS
 			Container: common.MapPtr(v.Container, MapContainerConfigAPIToKube),
 			Job:       common.MapPtr(v.Job, MapJobConfigAPIToKube),
 			Pod:       common.MapPtr(v.Pod, MapPodConfigAPIToKube),
+			Services:  common.MapMap(v.Services, MapServiceSpecAPIToKube),
+			Cache:     common.MapSlice(v.Cache, MapCacheSpecAPIToKube),
 		},
-		Use:   common.MapSlice(v.Use, MapTemplateRefAPIToKube),
-		Setup: common.MapSlice(v.Setup, MapStepAPIToKube),
-		Steps: common.MapSlice(v.Steps, MapStepAPIToKube),
-		After: common.MapSlice(v.After, MapStepAPIToKube),
+		Use:       common.MapSlice(v.Use, MapTemplateRefAPIToKube),
+		Setup:     common.MapSlice(v.Setup, MapStepAPIToKube),
+		Steps:     common.MapSlice(v.Steps, MapStepAPIToKube),
+		After:     common.MapSlice(v.After, MapStepAPIToKube),
+		Schedules: common.MapSlice(v.Schedules, MapScheduleAPIToKube),
 	}
 }
 
@@ -595,6 +706,8 @@ func MapTemplateSpecAPIToKube(v testkube.TestWorkflowTemplateSpec) testworkflows
 			Container: common.MapPtr(v.Container, MapContainerConfigAPIToKube),
 			Job:       common.MapPtr(v.Job, MapJobConfigAPIToKube),
 			Pod:       common.MapPtr(v.Pod, MapPodConfigAPIToKube),
+			Services:  common.MapMap(v.Services, MapServiceSpecAPIToKube),
+			Cache:     common.MapSlice(v.Cache, MapCacheSpecAPIToKube),
 		},
 		Setup: common.MapSlice(v.Setup, MapIndependentStepAPIToKube),
 		Steps: common.MapSlice(v.Steps, MapIndependentStepAPIToKube),
@@ -15,15 +15,16 @@ import (
 )
 
 type FilterImpl struct {
-	FName       string
-	FLastNDays  int
-	FStartDate  *time.Time
-	FEndDate    *time.Time
-	FStatuses   []testkube.TestWorkflowStatus
-	FPage       int
-	FPageSize   int
-	FTextSearch string
-	FSelector   string
+	FName              string
+	FLastNDays         int
+	FStartDate         *time.Time
+	FEndDate           *time.Time
+	FStatuses          []testkube.TestWorkflowStatus
+	FPage              int
+	FPageSize          int
+	FTextSearch        string
+	FSelector          string
+	FParentExecutionId string
 }
 
 func NewExecutionsFilter() *FilterImpl {
@@ -79,6 +80,11 @@ func (f *FilterImpl) WithSelector(selector string) *FilterImpl {
 	return f
 }
 
+func (f *FilterImpl) WithParentExecutionId(parentExecutionId string) *FilterImpl {
+	f.FParentExecutionId = parentExecutionId
+	return f
+}
+
 func (f FilterImpl) Name() string {
 	return f.FName
 }
@@ -138,3 +144,11 @@ func (f FilterImpl) TextSearch() string {
 func (f FilterImpl) Selector() string {
 	return f.FSelector
 }
+
+func (f FilterImpl) ParentExecutionIdDefined() bool {
+	return f.FParentExecutionId != ""
+}
+
+func (f FilterImpl) ParentExecutionId() string {
+	return f.FParentExecutionId
+}
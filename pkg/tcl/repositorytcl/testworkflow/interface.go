@@ -34,6 +34,8 @@ type Filter interface {
 	TextSearchDefined() bool
 	TextSearch() string
 	Selector() string
+	ParentExecutionIdDefined() bool
+	ParentExecutionId() string
 }
 
 //go:generate mockgen -destination=./mock_repository.go -package=testworkflow "github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow" Repository
@@ -62,6 +64,8 @@ type Repository interface {
 	UpdateResult(ctx context.Context, id string, result *testkube.TestWorkflowResult) (err error)
 	// UpdateOutput updates list of output references in the execution result
 	UpdateOutput(ctx context.Context, id string, output []testkube.TestWorkflowOutput) (err error)
+	// UpdateReports updates list of the test reports parsed from the execution steps
+	UpdateReports(ctx context.Context, id string, reports []testkube.TestWorkflowReport) (err error)
 	// DeleteByTestWorkflow deletes execution results by workflow
 	DeleteByTestWorkflow(ctx context.Context, workflowName string) error
 	// DeleteAll deletes all execution results
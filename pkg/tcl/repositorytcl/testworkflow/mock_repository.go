@@ -259,6 +259,20 @@ func (mr *MockRepositoryMockRecorder) UpdateOutput(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutput", reflect.TypeOf((*MockRepository)(nil).UpdateOutput), arg0, arg1, arg2)
 }
 
+// UpdateReports mocks base method.
+func (m *MockRepository) UpdateReports(arg0 context.Context, arg1 string, arg2 []testkube.TestWorkflowReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReports", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReports indicates an expected call of UpdateReports.
+func (mr *MockRepositoryMockRecorder) UpdateReports(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReports", reflect.TypeOf((*MockRepository)(nil).UpdateReports), arg0, arg1, arg2)
+}
+
 // UpdateResult mocks base method.
 func (m *MockRepository) UpdateResult(arg0 context.Context, arg1 string, arg2 *testkube.TestWorkflowResult) error {
 	m.ctrl.T.Helper()
@@ -291,6 +291,11 @@ func (r *MongoRepository) UpdateOutput(ctx context.Context, id string, refs []te
 	return
 }
 
+func (r *MongoRepository) UpdateReports(ctx context.Context, id string, reports []testkube.TestWorkflowReport) (err error) {
+	_, err = r.Coll.UpdateOne(ctx, bson.M{"id": id}, bson.M{"$set": bson.M{"reports": reports}})
+	return
+}
+
 func composeQueryAndOpts(filter Filter) (bson.M, *options.FindOptions) {
 	query := bson.M{}
 	opts := options.Find()
@@ -300,6 +305,10 @@ func composeQueryAndOpts(filter Filter) (bson.M, *options.FindOptions) {
 		query["workflow.name"] = filter.Name()
 	}
 
+	if filter.ParentExecutionIdDefined() {
+		query["parentexecutionid"] = filter.ParentExecutionId()
+	}
+
 	if filter.TextSearchDefined() {
 		query["name"] = bson.M{"$regex": primitive.Regex{Pattern: filter.TextSearch(), Options: "i"}}
 	}
@@ -0,0 +1,22 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestComposeQueryAndOpts_ParentExecutionId(t *testing.T) {
+	query, _ := composeQueryAndOpts(NewExecutionsFilter().WithName("example").WithParentExecutionId("parent-id"))
+
+	assert.Equal(t, bson.M{"workflow.name": "example", "parentexecutionid": "parent-id"}, query)
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowcontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+)
+
+var ErrStepControlUnavailable = errors.New("step control is not available yet")
+
+// findStepRefs finds the step in the signature, and returns its reference along with all the nested steps
+func findStepRefs(sig []testworkflowprocessor.Signature, ref string) []string {
+	for _, s := range sig {
+		if s.Ref() == ref {
+			return append([]string{ref}, collectStepRefs(s.Children())...)
+		}
+		if refs := findStepRefs(s.Children(), ref); refs != nil {
+			return refs
+		}
+	}
+	return nil
+}
+
+func collectStepRefs(sig []testworkflowprocessor.Signature) (refs []string) {
+	for _, s := range sig {
+		refs = append(refs, s.Ref())
+		refs = append(refs, collectStepRefs(s.Children())...)
+	}
+	return refs
+}
+
+// appendControlAction requests the action for the step and all its nested steps,
+// in the serialized map of actions that is read by the init process.
+func appendControlAction(current string, sig []testworkflowprocessor.Signature, ref, action string) (string, error) {
+	refs := findStepRefs(sig, ref)
+	if len(refs) == 0 {
+		return "", fmt.Errorf("step %s not found", ref)
+	}
+	actions := make(map[string]string)
+	if current != "" {
+		if err := json.Unmarshal([]byte(current), &actions); err != nil {
+			return "", errors.Wrap(err, "reading step actions")
+		}
+	}
+	for _, r := range refs {
+		actions[r] = action
+	}
+	result, err := json.Marshal(actions)
+	if err != nil {
+		return "", errors.Wrap(err, "building step actions")
+	}
+	return string(result), nil
+}
+
+// appendApprovalDecision stores the decision for the step waiting for the approval,
+// in the serialized map of decisions that is read by the step.
+func appendApprovalDecision(current string, sig []testworkflowprocessor.Signature, ref string, decision data.ApprovalDecision) (string, error) {
+	if len(findStepRefs(sig, ref)) == 0 {
+		return "", fmt.Errorf("step %s not found", ref)
+	}
+	decisions := make(map[string]data.ApprovalDecision)
+	if current != "" {
+		if err := json.Unmarshal([]byte(current), &decisions); err != nil {
+			return "", errors.Wrap(err, "reading approval decisions")
+		}
+	}
+	if _, ok := decisions[ref]; ok {
+		return "", fmt.Errorf("step %s has been already decided", ref)
+	}
+	decisions[ref] = decision
+	result, err := json.Marshal(decisions)
+	if err != nil {
+		return "", errors.Wrap(err, "building approval decisions")
+	}
+	return string(result), nil
+}
+
+// controlStep requests the action for the step, by annotating the pod that projects it to the containers
+func (c *controller) controlStep(ctx context.Context, ref, action string) error {
+	return c.patchPodAnnotation(ctx, testworkflowprocessor.ControlAnnotationName, func(current string) (string, error) {
+		return appendControlAction(current, c.signature, ref, action)
+	})
+}
+
+// decideApproval stores the approval decision for the step, by annotating the pod that projects it to the containers
+func (c *controller) decideApproval(ctx context.Context, ref string, decision data.ApprovalDecision) error {
+	return c.patchPodAnnotation(ctx, testworkflowprocessor.ApprovalDecisionsAnnotationName, func(current string) (string, error) {
+		return appendApprovalDecision(current, c.signature, ref, decision)
+	})
+}
+
+// patchPodAnnotation updates the annotation of the main pod, with the value built from the current one
+func (c *controller) patchPodAnnotation(ctx context.Context, name string, update func(current string) (string, error)) error {
+	pods, err := c.clientSet.CoreV1().Pods(c.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: testworkflowprocessor.ExecutionIdMainPodLabelName + "=" + c.id,
+	})
+	if err != nil {
+		return errors.Wrap(err, "fetching pod")
+	}
+	if len(pods.Items) == 0 {
+		return ErrStepControlUnavailable
+	}
+	pod := pods.Items[0]
+	value, err := update(pod.Annotations[name])
+	if err != nil {
+		return err
+	}
+
+	// Include the resource version, to avoid overriding the concurrent changes
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": pod.ResourceVersion,
+			"annotations": map[string]string{
+				name: value,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.clientSet.CoreV1().Pods(c.namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return errors.Wrap(err, "annotating pod")
+}
@@ -14,13 +14,19 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/constants"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
 	"github.com/kubeshop/testkube/internal/common"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/redact"
 	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+	"github.com/kubeshop/testkube/pkg/tracing"
 )
 
 const (
@@ -35,11 +41,22 @@ var (
 
 type Controller interface {
 	Abort(ctx context.Context) error
+	// AbortStep stops the step along with its nested steps, while the rest of the execution continues
+	AbortStep(ctx context.Context, ref string) error
+	// SkipStep skips the step along with its nested steps, while the rest of the execution continues
+	SkipStep(ctx context.Context, ref string) error
+	// ApproveStep resumes the step waiting for the approval, with the values provided by the approver
+	ApproveStep(ctx context.Context, ref string, inputs map[string]string) error
+	// RejectStep fails the step waiting for the approval
+	RejectStep(ctx context.Context, ref string) error
 	Cleanup(ctx context.Context) error
 	Watch(ctx context.Context) Watcher[Notification]
 }
 
-func New(parentCtx context.Context, clientSet kubernetes.Interface, namespace, id string, scheduledAt time.Time) (Controller, error) {
+func New(parentCtx context.Context, clientSet kubernetes.Interface, namespace, id string, scheduledAt time.Time) (_ Controller, err error) {
+	_, span := tracing.Start(parentCtx, "TestWorkflowController.Connect", tracing.ExecutionIDKey.String(id))
+	defer func() { tracing.End(span, err) }()
+
 	// Create local context for stopping all the processes
 	ctx, ctxCancel := context.WithCancel(parentCtx)
 
@@ -52,7 +69,7 @@ func New(parentCtx context.Context, clientSet kubernetes.Interface, namespace, i
 	// Ensure the main Job exists in the cluster,
 	// and obtain the signature
 	var sig []testworkflowprocessor.Signature
-	var err error
+	var redactor *redact.Redactor
 	select {
 	case j := <-job.Any(ctx):
 		if j.Error != nil {
@@ -64,6 +81,13 @@ func New(parentCtx context.Context, clientSet kubernetes.Interface, namespace, i
 			ctxCancel()
 			return nil, errors.Wrap(err, "invalid job signature")
 		}
+
+		// Find the secrets used by the execution, so they are masked in the logs and outputs
+		redactor, err = redact.NewResolver(clientSet, namespace).PodSpec(ctx, namespace, &j.Value.Spec.Template.Spec)
+		if err != nil {
+			// Redact at least the secrets that have been found
+			log.DefaultLogger.Warnw("failed to resolve all the secrets to redact", "id", id, "error", err)
+		}
 	case <-time.After(JobRetrievalTimeout):
 		select {
 		case ev := <-jobEvents.Any(context.Background()):
@@ -85,6 +109,7 @@ func New(parentCtx context.Context, clientSet kubernetes.Interface, namespace, i
 		namespace:   namespace,
 		scheduledAt: scheduledAt,
 		signature:   sig,
+		redactor:    redactor,
 		clientSet:   clientSet,
 		ctx:         ctx,
 		ctxCancel:   ctxCancel,
@@ -100,6 +125,7 @@ type controller struct {
 	namespace   string
 	scheduledAt time.Time
 	signature   []testworkflowprocessor.Signature
+	redactor    *redact.Redactor
 	clientSet   kubernetes.Interface
 	ctx         context.Context
 	ctxCancel   context.CancelFunc
@@ -113,35 +139,48 @@ func (c *controller) Abort(ctx context.Context) error {
 	return c.Cleanup(ctx)
 }
 
+func (c *controller) AbortStep(ctx context.Context, ref string) error {
+	return c.controlStep(ctx, ref, constants.ControlActionAbort)
+}
+
+func (c *controller) SkipStep(ctx context.Context, ref string) error {
+	return c.controlStep(ctx, ref, constants.ControlActionSkip)
+}
+
+func (c *controller) ApproveStep(ctx context.Context, ref string, inputs map[string]string) error {
+	return c.decideApproval(ctx, ref, data.ApprovalDecision{Approved: true, Inputs: inputs})
+}
+
+func (c *controller) RejectStep(ctx context.Context, ref string) error {
+	return c.decideApproval(ctx, ref, data.ApprovalDecision{Approved: false})
+}
+
+// isPodTerminating checks if the pod is deleted, so the whole execution has been aborted,
+// even if the init process has finished the step gracefully
+func (c *controller) isPodTerminating(ctx context.Context) bool {
+	v := <-c.pod.Any(ctx)
+	return v.Value == nil || v.Value.DeletionTimestamp != nil
+}
+
 func (c *controller) Cleanup(ctx context.Context) error {
 	return Cleanup(ctx, c.clientSet, c.namespace, c.id)
 }
 
 func (c *controller) Watch(parentCtx context.Context) Watcher[Notification] {
 	ctx, ctxCancel := context.WithCancel(parentCtx)
-	w := newWatcher[Notification](ctx, 0)
+	w := newRedactedWatcher(newWatcher[Notification](ctx, 0), c.redactor)
+
+	// Mark the execution phases in the trace of the caller
+	span := trace.SpanFromContext(parentCtx)
 
 	go func() {
 		defer w.Close()
 		defer ctxCancel()
 
-		sig := make([]testkube.TestWorkflowSignature, len(c.signature))
-		for i, s := range c.signature {
-			sig[i] = s.ToInternal()
-		}
-
-		// Build initial result
-		result := testkube.TestWorkflowResult{
-			Status:          common.Ptr(testkube.QUEUED_TestWorkflowStatus),
-			PredictedStatus: common.Ptr(testkube.PASSED_TestWorkflowStatus),
-			Initialization: &testkube.TestWorkflowStepResult{
-				Status: common.Ptr(testkube.QUEUED_TestWorkflowStepStatus),
-			},
-			Steps: testworkflowprocessor.MapSignatureListToStepResults(c.signature),
-		}
-
 		// Emit initial empty result
-		w.SendValue(Notification{Result: result.Clone()})
+		e := newExecutionWatcher(w, c.signature, c.scheduledAt)
+		result := &e.result
+		e.SendResult()
 
 		// Wait for the pod creation
 		for v := range WatchJobPreEvents(ctx, c.jobEvents, 0).Stream(ctx).Channel() {
@@ -166,7 +205,8 @@ func (c *controller) Watch(parentCtx context.Context) Watcher[Notification] {
 			w.SendError(errors.New("job is in unknown state"))
 			return
 		}
-		w.SendValue(Notification{Result: result.Clone()})
+		span.AddEvent("job created", trace.WithTimestamp(result.QueuedAt))
+		e.SendResult()
 
 		// Wait for the pod initialization
 		for v := range WatchPodPreEvents(ctx, c.podEvents, 0).Stream(ctx).Channel() {
@@ -192,7 +232,8 @@ func (c *controller) Watch(parentCtx context.Context) Watcher[Notification] {
 			w.SendError(errors.New("pod is in unknown state"))
 			return
 		}
-		w.SendValue(Notification{Result: result.Clone()})
+		span.AddEvent("pod scheduled", trace.WithTimestamp(result.StartedAt))
+		e.SendResult()
 
 		// Wait for the initialization container
 		for v := range WatchContainerPreEvents(ctx, c.podEvents, "tktw-init", 0, true).Stream(ctx).Channel() {
@@ -220,7 +261,8 @@ func (c *controller) Watch(parentCtx context.Context) Watcher[Notification] {
 			w.SendError(errors.New("init container is in unknown state"))
 			return
 		}
-		w.SendValue(Notification{Result: result.Clone()})
+		span.AddEvent("initialization started", trace.WithTimestamp(result.Initialization.StartedAt))
+		e.SendResult()
 
 		// Watch the initialization container logs
 		lastTs := result.Initialization.StartedAt
@@ -240,25 +282,13 @@ func (c *controller) Watch(parentCtx context.Context) Watcher[Notification] {
 			})
 		}
 
-		// Update the initialization container status
+		// Update the initialization container status, and cancel when it has failed
 		status, err := GetFinalContainerResult(ctx, c.pod, "tktw-init")
 		if err != nil {
 			w.SendError(err)
 			return
 		}
-		result.Initialization.FinishedAt = status.FinishedAt
-		if lastTs.After(result.Initialization.FinishedAt) {
-			result.Initialization.FinishedAt = lastTs
-		}
-		result.Initialization.Status = common.Ptr(status.Status)
-		if status.Status != testkube.PASSED_TestWorkflowStepStatus {
-			result.Status = common.Ptr(testkube.FAILED_TestWorkflowStatus)
-			result.PredictedStatus = result.Status
-		}
-		w.SendValue(Notification{Result: result.Clone()})
-
-		// Cancel when the initialization has failed
-		if status.Status != testkube.PASSED_TestWorkflowStepStatus {
+		if !e.FinishInitialization(status, lastTs) {
 			return
 		}
 
@@ -266,36 +296,31 @@ func (c *controller) Watch(parentCtx context.Context) Watcher[Notification] {
 		lastTs = result.Initialization.FinishedAt
 		for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
 			// Ignore not-standard TestWorkflow containers
-			if _, ok := result.Steps[container.Name]; !ok {
+			if !e.HasStep(container.Name) {
 				continue
 			}
 
 			// Send the step queued time
-			stepResult := result.Steps[container.Name]
-			stepResult = result.UpdateStepResult(sig, container.Name, testkube.TestWorkflowStepResult{
+			stepResult := e.UpdateStep(container.Name, testkube.TestWorkflowStepResult{
 				QueuedAt: lastTs.UTC(),
-			}, c.scheduledAt)
-			w.SendValue(Notification{Result: result.Clone()})
+			})
+			e.SendResult()
 
 			// Watch for the container events
-			lastEvTs := time.Time{}
 			for v := range WatchContainerPreEvents(ctx, c.podEvents, container.Name, 0, false).Stream(ctx).Channel() {
 				if v.Error != nil {
 					w.SendError(v.Error)
 					continue
 				}
-				if lastEvTs.Before(v.Value.CreationTimestamp.Time) {
-					lastEvTs = v.Value.CreationTimestamp.Time
-				}
 				if v.Value.Reason == "Created" {
-					stepResult = result.UpdateStepResult(sig, container.Name, testkube.TestWorkflowStepResult{
+					stepResult = e.UpdateStep(container.Name, testkube.TestWorkflowStepResult{
 						QueuedAt: v.Value.CreationTimestamp.Time.UTC(),
-					}, c.scheduledAt)
+					})
 				} else if v.Value.Reason == "Started" {
-					stepResult = result.UpdateStepResult(sig, container.Name, testkube.TestWorkflowStepResult{
+					stepResult = e.UpdateStep(container.Name, testkube.TestWorkflowStepResult{
 						StartedAt: v.Value.CreationTimestamp.Time.UTC(),
 						Status:    common.Ptr(testkube.RUNNING_TestWorkflowStepStatus),
-					}, c.scheduledAt)
+					})
 				}
 				if v.Value.Type == "Normal" {
 					continue
@@ -312,7 +337,7 @@ func (c *controller) Watch(parentCtx context.Context) Watcher[Notification] {
 				w.SendError(errors.New("step container is in unknown state"))
 				break
 			}
-			w.SendValue(Notification{Result: result.Clone()})
+			e.SendResult()
 
 			// Watch for the container logs, outputs and statuses
 			for v := range WatchContainerLogs(ctx, c.clientSet, c.podEvents, c.namespace, pod.Name, container.Name).Stream(ctx).Channel() {
@@ -320,37 +345,7 @@ func (c *controller) Watch(parentCtx context.Context) Watcher[Notification] {
 					w.SendError(v.Error)
 					continue
 				}
-				if v.Value.Hint != nil {
-					if v.Value.Hint.Name == "start" && v.Value.Hint.Ref == container.Name {
-						if v.Value.Time.After(stepResult.StartedAt) {
-							stepResult = result.UpdateStepResult(sig, container.Name, testkube.TestWorkflowStepResult{
-								StartedAt: v.Value.Time.UTC(),
-							}, c.scheduledAt)
-						}
-					} else if v.Value.Hint.Name == "status" {
-						status := testkube.TestWorkflowStepStatus(v.Value.Hint.Value.(string))
-						if status == "" {
-							status = testkube.PASSED_TestWorkflowStepStatus
-						}
-						if _, ok := result.Steps[v.Value.Hint.Ref]; ok {
-							stepResult = result.UpdateStepResult(sig, v.Value.Hint.Ref, testkube.TestWorkflowStepResult{
-								Status: &status,
-							}, c.scheduledAt)
-						}
-					}
-					continue
-				}
-				if v.Value.Output != nil {
-					if _, ok := result.Steps[v.Value.Output.Ref]; ok {
-						w.SendValue(Notification{
-							Timestamp: v.Value.Time,
-							Ref:       v.Value.Output.Ref,
-							Output:    v.Value.Output,
-						})
-					}
-					continue
-				}
-				w.SendValue(Notification{Timestamp: v.Value.Time, Ref: container.Name, Log: string(v.Value.Log)})
+				e.HandleLog(container.Name, v.Value)
 			}
 
 			// Watch container status
@@ -359,51 +354,28 @@ func (c *controller) Watch(parentCtx context.Context) Watcher[Notification] {
 				w.SendError(err)
 				break
 			}
-			finishedAt := status.FinishedAt.UTC()
-			if !finishedAt.IsZero() && lastTs.After(finishedAt) {
-				finishedAt = lastTs.UTC()
-			}
-			stepResult = result.UpdateStepResult(sig, container.Name, testkube.TestWorkflowStepResult{
-				FinishedAt: finishedAt,
-				ExitCode:   float64(status.ExitCode),
-				Status:     common.Ptr(status.Status),
-			}, c.scheduledAt)
-			w.SendValue(Notification{Result: result.Clone()})
 
-			// Update the last timestamp
-			lastTs = finishedAt
-
-			// Break the function if the step has been aborted.
+			// Break the function if the execution has been aborted.
 			// Breaking only to the loop is not enough,
 			// because due to GKE bug, the Job is still pending,
 			// so it will get stuck there.
-			if status.Status == testkube.ABORTED_TestWorkflowStepStatus {
-				result.Recompute(sig, c.scheduledAt)
-				abortTs := result.Steps[container.Name].FinishedAt
-				if status.Details == "" {
-					status.Details = "Manual"
-				}
-
-				w.SendValue(Notification{
-					Timestamp: abortTs,
-					Ref:       container.Name,
-					Log:       fmt.Sprintf("\n%s Aborted (%s)", abortTs.Format(KubernetesLogTimeFormat), status.Details),
-				})
-				w.SendValue(Notification{Result: result.Clone()})
+			var aborted bool
+			lastTs, aborted = e.FinishStep(container.Name, status, lastTs)
+			if aborted || (status.Status == testkube.ABORTED_TestWorkflowStepStatus && c.isPodTerminating(ctx)) {
 				return
 			}
 		}
 
 		// Read the pod finish time
+		var finishedAt time.Time
 		for v := range c.job.Stream(ctx).Channel() {
 			if v.Value != nil && v.Value.Status.CompletionTime != nil {
-				result.FinishedAt = v.Value.Status.CompletionTime.Time
+				finishedAt = v.Value.Status.CompletionTime.Time
 			}
 		}
 
 		// Compute the TestWorkflow status and dates
-		result.Recompute(sig, c.scheduledAt)
-		w.SendValue(Notification{Result: result.Clone()})
+		e.Finish(finishedAt)
 	}()
 
 	return w
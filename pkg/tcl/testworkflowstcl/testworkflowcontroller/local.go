@@ -0,0 +1,945 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowcontroller
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	errors2 "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/constants"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowdocker"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+	"github.com/kubeshop/testkube/pkg/utils"
+)
+
+const (
+	localPodContainerName     = "pod"
+	localPrepareContainerName = "prepare"
+	localVolumesPath          = "/tktw-volumes"
+	localTerminationLogPath   = "/dev/termination-log"
+	localNamespace            = "default"
+)
+
+// LocalController is the Controller for the execution running with the local Docker daemon
+type LocalController interface {
+	Controller
+	// Start runs the execution in the background, so it may be observed with Watch
+	Start() error
+}
+
+// localEvent is the change of the local container state, like the Kubernetes events.
+// The event without the container name is about preparing the resources shared by the containers.
+type localEvent struct {
+	Container string
+	Time      time.Time
+	// Result is set when the container has finished, otherwise it has just started
+	Result *ContainerResult
+	Error  error
+}
+
+// localEvents records the state changes of the local containers, so they may be watched at any time
+type localEvents struct {
+	mu      sync.Mutex
+	list    []localEvent
+	updated chan struct{}
+	closed  bool
+}
+
+func newLocalEvents() *localEvents {
+	return &localEvents{updated: make(chan struct{})}
+}
+
+func (e *localEvents) Send(ev localEvent) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return
+	}
+	e.list = append(e.list, ev)
+	close(e.updated)
+	e.updated = make(chan struct{})
+}
+
+func (e *localEvents) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.closed {
+		e.closed = true
+		close(e.updated)
+	}
+}
+
+// Stream emits all the recorded events, and the next ones until it is closed
+func (e *localEvents) Stream(ctx context.Context) <-chan localEvent {
+	ch := make(chan localEvent)
+	go func() {
+		defer close(ch)
+		for index := 0; ; index++ {
+			e.mu.Lock()
+			for index >= len(e.list) && !e.closed {
+				updated := e.updated
+				e.mu.Unlock()
+				select {
+				case <-ctx.Done():
+					return
+				case <-updated:
+				}
+				e.mu.Lock()
+			}
+			if index >= len(e.list) {
+				e.mu.Unlock()
+				return
+			}
+			ev := e.list[index]
+			e.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- ev:
+			}
+		}
+	}()
+	return ch
+}
+
+type localController struct {
+	id          string
+	scheduledAt time.Time
+	bundle      *testworkflowprocessor.Bundle
+	client      testworkflowdocker.Client
+	dir         string
+	ctx         context.Context
+	ctxCancel   context.CancelFunc
+
+	mu           sync.Mutex
+	events       *localEvents
+	containers   []string
+	volumes      []string
+	controlPaths map[string]string
+}
+
+// NewLocal creates the controller running the TestWorkflow bundle with the local Docker daemon,
+// instead of scheduling the Job in the cluster. The execution is run with the Start call.
+func NewLocal(parentCtx context.Context, client testworkflowdocker.Client, bundle *testworkflowprocessor.Bundle, id string, scheduledAt time.Time) (LocalController, error) {
+	dir, err := os.MkdirTemp("", "testworkflow-"+id)
+	if err != nil {
+		return nil, errors2.Wrap(err, "creating temporary directory")
+	}
+
+	var ctx context.Context
+	var ctxCancel context.CancelFunc
+	if deadline := bundle.Job.Spec.ActiveDeadlineSeconds; deadline != nil {
+		ctx, ctxCancel = context.WithTimeout(parentCtx, time.Duration(*deadline)*time.Second)
+	} else {
+		ctx, ctxCancel = context.WithCancel(parentCtx)
+	}
+
+	return &localController{
+		id:          id,
+		scheduledAt: scheduledAt,
+		bundle:      bundle,
+		client:      client,
+		dir:         dir,
+		ctx:         ctx,
+		ctxCancel:   ctxCancel,
+	}, nil
+}
+
+func (c *localController) Abort(ctx context.Context) error {
+	c.ctxCancel()
+	for _, name := range c.trackedContainers() {
+		_ = c.client.KillContainer(ctx, name)
+	}
+	return nil
+}
+
+func (c *localController) AbortStep(_ context.Context, ref string) error {
+	return c.controlStep(ref, constants.ControlActionAbort)
+}
+
+func (c *localController) SkipStep(_ context.Context, ref string) error {
+	return c.controlStep(ref, constants.ControlActionSkip)
+}
+
+func (c *localController) ApproveStep(_ context.Context, ref string, inputs map[string]string) error {
+	return c.decideApproval(ref, data.ApprovalDecision{Approved: true, Inputs: inputs})
+}
+
+func (c *localController) RejectStep(_ context.Context, ref string) error {
+	return c.decideApproval(ref, data.ApprovalDecision{Approved: false})
+}
+
+// controlStep requests the action for the step, by updating the file projected from the pod annotation in the cluster
+func (c *localController) controlStep(ref, action string) error {
+	return c.updateControlFile(testworkflowprocessor.ControlAnnotationName, func(current string) (string, error) {
+		return appendControlAction(current, c.bundle.Signature, ref, action)
+	})
+}
+
+// decideApproval stores the approval decision, in the file projected from the pod annotation in the cluster
+func (c *localController) decideApproval(ref string, decision data.ApprovalDecision) error {
+	return c.updateControlFile(testworkflowprocessor.ApprovalDecisionsAnnotationName, func(current string) (string, error) {
+		return appendApprovalDecision(current, c.bundle.Signature, ref, decision)
+	})
+}
+
+// updateControlFile updates the file with the pod annotation, with the value built from the current one
+func (c *localController) updateControlFile(annotation string, update func(current string) (string, error)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	path, ok := c.controlPaths[annotation]
+	if !ok {
+		return ErrStepControlUnavailable
+	}
+	current, err := os.ReadFile(path)
+	if err != nil {
+		return errors2.Wrap(err, "reading control file")
+	}
+	value, err := update(string(current))
+	if err != nil {
+		return err
+	}
+	return writeFile(path, []byte(value), 0644)
+}
+
+func (c *localController) Cleanup(ctx context.Context) error {
+	c.ctxCancel()
+
+	var errs []error
+	containers := c.trackedContainers()
+	for i := len(containers) - 1; i >= 0; i-- {
+		if err := c.client.RemoveContainer(ctx, containers[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	c.mu.Lock()
+	volumes := c.volumes
+	c.mu.Unlock()
+	for _, name := range volumes {
+		if err := c.client.RemoveVolume(ctx, name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := os.RemoveAll(c.dir); err != nil {
+		errs = append(errs, err)
+	}
+	return errors2.Wrap(errors.Join(errs...), "cleaning up local resources")
+}
+
+// Start runs the containers one by one in the background, like the pod does in the cluster,
+// and records their state changes for watching them
+func (c *localController) Start() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.events != nil {
+		return errors.New("execution has been already started")
+	}
+	c.events = newLocalEvents()
+	go c.run(c.events)
+	return nil
+}
+
+func (c *localController) run(events *localEvents) {
+	defer events.Close()
+
+	// Prepare the volumes and the shared network, like the pod does
+	pod := c.bundle.Job.Spec.Template.Spec
+	if err := c.prepare(pod); err != nil {
+		events.Send(localEvent{Time: time.Now(), Error: err})
+		return
+	}
+	events.Send(localEvent{Time: time.Now()})
+
+	steps := testworkflowprocessor.MapSignatureListToStepResults(c.bundle.Signature)
+	containers := append(append([]corev1.Container{}, pod.InitContainers...), pod.Containers...)
+	for i, container := range containers {
+		// Start the services in background, like the sidecar containers
+		if _, ok := testworkflowprocessor.GetServiceName(container.Name); ok && i > 0 {
+			if err := c.startContainer(container); err != nil {
+				events.Send(localEvent{Container: container.Name, Time: time.Now(), Error: err})
+			}
+			continue
+		}
+
+		// Ignore not-standard TestWorkflow containers
+		if _, ok := steps[container.Name]; !ok && i > 0 {
+			continue
+		}
+
+		status, err := c.runContainer(events, container)
+		events.Send(localEvent{Container: container.Name, Time: time.Now(), Result: &status, Error: err})
+
+		// Stop when the initialization has failed, or the execution has been aborted.
+		// The step aborted on request is finished by the init process, so the next steps are still run.
+		if (i == 0 && status.Status != testkube.PASSED_TestWorkflowStepStatus) || (status.Status == testkube.ABORTED_TestWorkflowStepStatus && !status.Reported) {
+			return
+		}
+	}
+}
+
+func (c *localController) Watch(parentCtx context.Context) Watcher[Notification] {
+	ctx, ctxCancel := context.WithCancel(parentCtx)
+	w := newWatcher[Notification](ctx, 0)
+
+	go func() {
+		defer w.Close()
+		defer ctxCancel()
+
+		// Emit initial empty result
+		e := newExecutionWatcher(w, c.bundle.Signature, c.scheduledAt)
+		result := &e.result
+		result.QueuedAt = c.scheduledAt.UTC()
+		e.SendResult()
+
+		c.mu.Lock()
+		events := c.events
+		c.mu.Unlock()
+		if events == nil {
+			w.SendError(errors.New("execution has not been started"))
+			return
+		}
+
+		// Follow the state changes of the containers
+		initName := ""
+		if len(c.bundle.Job.Spec.Template.Spec.InitContainers) > 0 {
+			initName = c.bundle.Job.Spec.Template.Spec.InitContainers[0].Name
+		}
+		var lastTs time.Time
+		for ev := range events.Stream(ctx) {
+			switch {
+			case ev.Container == "" && ev.Error != nil:
+				// Fail when the resources could not be prepared
+				ts := ev.Time.UTC()
+				result.Initialization.ErrorMessage = ev.Error.Error()
+				result.Initialization.Status = common.Ptr(testkube.FAILED_TestWorkflowStepStatus)
+				result.Initialization.FinishedAt = ts
+				result.Status = common.Ptr(testkube.FAILED_TestWorkflowStatus)
+				result.PredictedStatus = result.Status
+				result.FinishedAt = ts
+				e.SendResult()
+				return
+			case ev.Container == "":
+				// Emit the result with start time
+				result.StartedAt = ev.Time.UTC()
+				result.Status = common.Ptr(testkube.RUNNING_TestWorkflowStatus)
+				result.Initialization.QueuedAt = result.StartedAt
+				lastTs = result.StartedAt
+				e.SendResult()
+			case ev.Container == initName && ev.Result == nil:
+				result.Initialization.StartedAt = ev.Time.UTC()
+				result.Initialization.Status = common.Ptr(testkube.RUNNING_TestWorkflowStepStatus)
+				e.SendResult()
+
+				// Watch the initialization container logs
+				c.watchLogs(ctx, w, ev.Container, func(log ContainerLog) {
+					if log.Time.After(lastTs) {
+						lastTs = log.Time
+					}
+					w.SendValue(Notification{
+						Timestamp: log.Time,
+						Log:       fmt.Sprintf("%s %s\n", log.Time.Format(KubernetesLogTimeFormat), string(log.Log)),
+					})
+				})
+			case ev.Container == initName:
+				if ev.Error != nil {
+					w.SendError(ev.Error)
+				}
+
+				// Cancel when the initialization has failed
+				if !e.FinishInitialization(*ev.Result, lastTs) {
+					result.FinishedAt = result.Initialization.FinishedAt
+					e.SendResult()
+					return
+				}
+				lastTs = result.Initialization.FinishedAt
+			case ev.Result == nil && ev.Error != nil:
+				// The service could not be started
+				w.SendError(ev.Error)
+			case !e.HasStep(ev.Container):
+				continue
+			case ev.Result == nil:
+				// Send the step start time
+				e.UpdateStep(ev.Container, testkube.TestWorkflowStepResult{
+					QueuedAt:  lastTs.UTC(),
+					StartedAt: ev.Time.UTC(),
+					Status:    common.Ptr(testkube.RUNNING_TestWorkflowStepStatus),
+				})
+				e.SendResult()
+
+				// Watch the container logs, outputs and statuses
+				c.watchLogs(ctx, w, ev.Container, func(log ContainerLog) {
+					e.HandleLog(ev.Container, log)
+				})
+			default:
+				if ev.Error != nil {
+					w.SendError(ev.Error)
+				}
+
+				// Stop watching if the execution has been aborted
+				var aborted bool
+				lastTs, aborted = e.FinishStep(ev.Container, *ev.Result, lastTs)
+				if aborted {
+					return
+				}
+			}
+		}
+
+		// Compute the TestWorkflow status and dates
+		e.Finish(time.Now().UTC())
+	}()
+
+	return w
+}
+
+// watchLogs streams the logs of the started container, until it is finished
+func (c *localController) watchLogs(ctx context.Context, w notificationSender, name string, fn func(log ContainerLog)) {
+	stream, err := c.client.ContainerLogs(ctx, c.resourceName(name))
+	if err != nil {
+		w.SendError(err)
+		return
+	}
+	for v := range watchLocalContainerLogs(ctx, stream).Stream(ctx).Channel() {
+		if v.Error != nil {
+			w.SendError(v.Error)
+			continue
+		}
+		fn(v.Value)
+	}
+}
+
+// prepare creates the volumes and the container holding the network shared by all the containers
+func (c *localController) prepare(pod corev1.PodSpec) error {
+	var emptyDirs []string
+	for _, volume := range pod.Volumes {
+		var err error
+		switch {
+		case volume.EmptyDir != nil:
+			name := c.resourceName(volume.Name)
+			err = c.client.CreateVolume(c.ctx, name, c.labels())
+			if err == nil {
+				c.mu.Lock()
+				c.volumes = append(c.volumes, name)
+				c.mu.Unlock()
+				emptyDirs = append(emptyDirs, volume.Name)
+			}
+		case volume.ConfigMap != nil:
+			err = c.writeConfigMapVolume(volume)
+		case volume.Secret != nil:
+			err = c.writeSecretVolume(volume)
+		case volume.DownwardAPI != nil:
+			err = c.writeDownwardAPIVolume(volume)
+		case volume.HostPath != nil:
+		default:
+			err = errors.New("only emptyDir, configMap, secret, downwardAPI and hostPath volumes are supported locally")
+		}
+		if err != nil {
+			return errors2.Wrapf(err, "preparing volume %s", volume.Name)
+		}
+	}
+
+	if len(pod.InitContainers) == 0 {
+		return errors.New("missing initialization container")
+	}
+	image := pod.InitContainers[0].Image
+
+	// Make the volumes writable for all the users, like the empty directories in the cluster
+	if len(emptyDirs) > 0 {
+		options := testworkflowdocker.ContainerOptions{
+			Name:       c.resourceName(localPrepareContainerName),
+			Image:      image,
+			PullPolicy: "missing",
+			Entrypoint: []string{"/bin/sh", "-c"},
+			Args:       []string{fmt.Sprintf("chmod 777 %s/*", localVolumesPath)},
+			User:       "0",
+			Labels:     c.labels(),
+		}
+		for _, name := range emptyDirs {
+			options.Mounts = append(options.Mounts, testworkflowdocker.Mount{
+				Type:   "volume",
+				Source: c.resourceName(name),
+				Target: filepath.Join(localVolumesPath, name),
+			})
+		}
+		if err := c.createContainer(options); err != nil {
+			return err
+		}
+		if err := c.client.StartContainer(c.ctx, options.Name); err != nil {
+			return err
+		}
+		state, err := c.client.WaitContainer(c.ctx, options.Name)
+		if err != nil {
+			return err
+		}
+		if state.ExitCode != 0 {
+			return fmt.Errorf("preparing volumes: exit code %d", state.ExitCode)
+		}
+	}
+
+	// Start the container holding the network, so all the containers may communicate through localhost
+	options := testworkflowdocker.ContainerOptions{
+		Name:       c.resourceName(localPodContainerName),
+		Image:      image,
+		PullPolicy: "missing",
+		Entrypoint: []string{"/bin/sh", "-c"},
+		Args:       []string{"trap 'exit 0' TERM INT; while true; do sleep 1; done"},
+		Labels:     c.labels(),
+	}
+	for _, alias := range pod.HostAliases {
+		for _, hostname := range alias.Hostnames {
+			options.Hosts = append(options.Hosts, hostname+":"+alias.IP)
+		}
+	}
+	if err := c.createContainer(options); err != nil {
+		return err
+	}
+	return c.client.StartContainer(c.ctx, options.Name)
+}
+
+// runContainer runs the container until it is finished, recording when it has started
+func (c *localController) runContainer(events *localEvents, container corev1.Container) (ContainerResult, error) {
+	if err := c.startContainer(container); err != nil {
+		return ContainerResult{Status: testkube.FAILED_TestWorkflowStepStatus, ExitCode: -1, FinishedAt: time.Now()}, err
+	}
+	events.Send(localEvent{Container: container.Name, Time: time.Now()})
+
+	name := c.resourceName(container.Name)
+	state, err := c.client.WaitContainer(c.ctx, name)
+	if c.ctx.Err() != nil {
+		details := "Manual"
+		if errors.Is(c.ctx.Err(), context.DeadlineExceeded) {
+			details = "DeadlineExceeded"
+		}
+		return ContainerResult{Status: testkube.ABORTED_TestWorkflowStepStatus, Details: details, ExitCode: -1, FinishedAt: time.Now()}, nil
+	} else if err != nil {
+		return UnknownContainerResult, err
+	}
+
+	reason := "Completed"
+	if state.OOMKilled {
+		reason = "OOMKilled"
+	} else if state.ExitCode != 0 {
+		reason = "Error"
+	}
+	message, _ := os.ReadFile(c.terminationLogPath(container.Name))
+	return GetContainerResult(corev1.ContainerStatus{
+		State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{
+				ExitCode:   int32(state.ExitCode),
+				Reason:     reason,
+				Message:    string(message),
+				FinishedAt: metav1.NewTime(state.FinishedAt),
+			},
+		},
+	}), nil
+}
+
+// startContainer creates the container in the shared network and starts it
+func (c *localController) startContainer(container corev1.Container) error {
+	options, err := c.containerOptions(container)
+	if err != nil {
+		return errors2.Wrapf(err, "container %s", container.Name)
+	}
+	if err = c.createContainer(options); err != nil {
+		return err
+	}
+	return c.client.StartContainer(c.ctx, options.Name)
+}
+
+func (c *localController) createContainer(options testworkflowdocker.ContainerOptions) error {
+	if err := c.client.CreateContainer(c.ctx, options); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.containers = append(c.containers, options.Name)
+	c.mu.Unlock()
+	return nil
+}
+
+// containerOptions maps the Kubernetes container to the Docker one
+func (c *localController) containerOptions(container corev1.Container) (options testworkflowdocker.ContainerOptions, err error) {
+	pod := c.bundle.Job.Spec.Template.Spec
+	options = testworkflowdocker.ContainerOptions{
+		Name:       c.resourceName(container.Name),
+		Image:      container.Image,
+		PullPolicy: mapPullPolicy(container.ImagePullPolicy),
+		Entrypoint: container.Command,
+		Args:       container.Args,
+		WorkingDir: container.WorkingDir,
+		Network:    "container:" + c.resourceName(localPodContainerName),
+		Labels:     c.labels(),
+	}
+	if pod.SecurityContext != nil && pod.SecurityContext.FSGroup != nil {
+		options.GroupAdd = append(options.GroupAdd, strconv.FormatInt(*pod.SecurityContext.FSGroup, 10))
+	}
+	if sc := container.SecurityContext; sc != nil {
+		if sc.RunAsUser != nil {
+			options.User = strconv.FormatInt(*sc.RunAsUser, 10)
+			if sc.RunAsGroup != nil {
+				options.User += ":" + strconv.FormatInt(*sc.RunAsGroup, 10)
+			}
+		}
+		options.Privileged = sc.Privileged != nil && *sc.Privileged
+	}
+
+	options.Env, err = c.env(container)
+	if err != nil {
+		return options, err
+	}
+
+	for _, mount := range container.VolumeMounts {
+		volume := findVolume(pod.Volumes, mount.Name)
+		if volume == nil {
+			return options, fmt.Errorf("volume %s not found", mount.Name)
+		}
+		switch {
+		case volume.EmptyDir != nil:
+			if mount.SubPath != "" {
+				return options, fmt.Errorf("volume %s: sub-paths of empty directories are not supported locally", mount.Name)
+			}
+			options.Mounts = append(options.Mounts, testworkflowdocker.Mount{
+				Type:     "volume",
+				Source:   c.resourceName(volume.Name),
+				Target:   mount.MountPath,
+				ReadOnly: mount.ReadOnly,
+			})
+		case volume.HostPath != nil:
+			options.Mounts = append(options.Mounts, testworkflowdocker.Mount{
+				Type:     "bind",
+				Source:   filepath.Join(volume.HostPath.Path, mount.SubPath),
+				Target:   mount.MountPath,
+				ReadOnly: mount.ReadOnly,
+			})
+		default:
+			options.Mounts = append(options.Mounts, testworkflowdocker.Mount{
+				Type:     "bind",
+				Source:   filepath.Join(c.volumePath(volume.Name), mount.SubPath),
+				Target:   mount.MountPath,
+				ReadOnly: true,
+			})
+		}
+	}
+
+	// Provide the termination log, to read the step status the same way as in the cluster
+	terminationLogPath := c.terminationLogPath(container.Name)
+	if err = writeFile(terminationLogPath, nil, 0666); err != nil {
+		return options, errors2.Wrap(err, "creating termination log")
+	}
+	options.Mounts = append(options.Mounts, testworkflowdocker.Mount{
+		Type:   "bind",
+		Source: terminationLogPath,
+		Target: localTerminationLogPath,
+	})
+
+	return options, nil
+}
+
+// env resolves the container environment variables, using the resources from the bundle
+func (c *localController) env(container corev1.Container) (env []string, err error) {
+	for _, source := range container.EnvFrom {
+		var data map[string]string
+		switch {
+		case source.ConfigMapRef != nil:
+			data, err = c.configMapData(source.ConfigMapRef.Name, source.ConfigMapRef.Optional)
+		case source.SecretRef != nil:
+			data, err = c.secretData(source.SecretRef.Name, source.SecretRef.Optional)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range sortedKeys(data) {
+			env = append(env, source.Prefix+key+"="+data[key])
+		}
+	}
+
+	for _, e := range container.Env {
+		if e.ValueFrom == nil {
+			env = append(env, e.Name+"="+e.Value)
+			continue
+		}
+
+		var data map[string]string
+		var key string
+		switch {
+		case e.ValueFrom.ConfigMapKeyRef != nil:
+			key = e.ValueFrom.ConfigMapKeyRef.Key
+			data, err = c.configMapData(e.ValueFrom.ConfigMapKeyRef.Name, e.ValueFrom.ConfigMapKeyRef.Optional)
+		case e.ValueFrom.SecretKeyRef != nil:
+			key = e.ValueFrom.SecretKeyRef.Key
+			data, err = c.secretData(e.ValueFrom.SecretKeyRef.Name, e.ValueFrom.SecretKeyRef.Optional)
+		case e.ValueFrom.FieldRef != nil:
+			key = e.ValueFrom.FieldRef.FieldPath
+			data = map[string]string{
+				"metadata.name":      c.id,
+				"metadata.namespace": localNamespace,
+				"status.podIP":       "127.0.0.1",
+			}
+			if _, ok := data[key]; !ok {
+				err = fmt.Errorf("env %s: field %s is not supported locally", e.Name, key)
+			}
+		default:
+			err = fmt.Errorf("env %s: resource fields are not supported locally", e.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if value, ok := data[key]; ok {
+			env = append(env, e.Name+"="+value)
+		}
+	}
+	return env, nil
+}
+
+func (c *localController) configMapData(name string, optional *bool) (map[string]string, error) {
+	for _, configMap := range c.bundle.ConfigMaps {
+		if configMap.Name == name {
+			data := make(map[string]string, len(configMap.Data)+len(configMap.BinaryData))
+			for k, v := range configMap.BinaryData {
+				data[k] = string(v)
+			}
+			for k, v := range configMap.Data {
+				data[k] = v
+			}
+			return data, nil
+		}
+	}
+	if optional != nil && *optional {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("config map %s is not available locally", name)
+}
+
+func (c *localController) secretData(name string, optional *bool) (map[string]string, error) {
+	for _, secret := range c.bundle.Secrets {
+		if secret.Name == name {
+			data := make(map[string]string, len(secret.Data)+len(secret.StringData))
+			for k, v := range secret.Data {
+				data[k] = string(v)
+			}
+			for k, v := range secret.StringData {
+				data[k] = v
+			}
+			return data, nil
+		}
+	}
+	if optional != nil && *optional {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("secret %s is not available locally", name)
+}
+
+func (c *localController) writeConfigMapVolume(volume corev1.Volume) error {
+	data, err := c.configMapData(volume.ConfigMap.Name, volume.ConfigMap.Optional)
+	if err != nil {
+		return err
+	}
+	return c.writeVolumeFiles(volume.Name, data, volume.ConfigMap.Items, volume.ConfigMap.DefaultMode)
+}
+
+func (c *localController) writeSecretVolume(volume corev1.Volume) error {
+	data, err := c.secretData(volume.Secret.SecretName, volume.Secret.Optional)
+	if err != nil {
+		return err
+	}
+	return c.writeVolumeFiles(volume.Name, data, volume.Secret.Items, volume.Secret.DefaultMode)
+}
+
+// writeDownwardAPIVolume stores the pod metadata in the directory mounted to the containers.
+// The control annotations are kept in the files, so the steps may be controlled locally too.
+func (c *localController) writeDownwardAPIVolume(volume corev1.Volume) error {
+	meta := c.bundle.Job.Spec.Template.ObjectMeta
+	values := make(map[string]string)
+	var items []corev1.KeyToPath
+	for _, item := range volume.DownwardAPI.Items {
+		if item.FieldRef == nil {
+			return fmt.Errorf("%s: only field references are supported locally", item.Path)
+		}
+		switch field := item.FieldRef.FieldPath; field {
+		case "metadata.name":
+			values[field] = c.resourceName(localPodContainerName)
+		case "metadata.namespace":
+			values[field] = localNamespace
+		default:
+			annotation, ok := getControlAnnotation(field)
+			if !ok {
+				return fmt.Errorf("%s: field %s is not supported locally", item.Path, field)
+			}
+			c.mu.Lock()
+			if c.controlPaths == nil {
+				c.controlPaths = make(map[string]string)
+			}
+			c.controlPaths[annotation] = filepath.Join(c.volumePath(volume.Name), item.Path)
+			c.mu.Unlock()
+			values[field] = meta.Annotations[annotation]
+		}
+		items = append(items, corev1.KeyToPath{Key: item.FieldRef.FieldPath, Path: item.Path, Mode: item.Mode})
+	}
+	return c.writeVolumeFiles(volume.Name, values, items, volume.DownwardAPI.DefaultMode)
+}
+
+// getControlAnnotation detects the pod annotation used for controlling the steps in the field reference
+func getControlAnnotation(field string) (string, bool) {
+	for _, name := range []string{testworkflowprocessor.ControlAnnotationName, testworkflowprocessor.ApprovalDecisionsAnnotationName} {
+		if field == fmt.Sprintf("metadata.annotations['%s']", name) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// writeVolumeFiles stores the config map or secret data in the directory mounted to the containers
+func (c *localController) writeVolumeFiles(name string, data map[string]string, items []corev1.KeyToPath, defaultMode *int32) error {
+	mode := os.FileMode(0644)
+	if defaultMode != nil {
+		mode = os.FileMode(*defaultMode)
+	}
+	if len(items) == 0 {
+		for _, key := range sortedKeys(data) {
+			items = append(items, corev1.KeyToPath{Key: key, Path: key})
+		}
+	}
+
+	dir := c.volumePath(name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, item := range items {
+		itemMode := mode
+		if item.Mode != nil {
+			itemMode = os.FileMode(*item.Mode)
+		}
+		if err := writeFile(filepath.Join(dir, item.Path), []byte(data[item.Key]), itemMode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *localController) trackedContainers() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string{}, c.containers...)
+}
+
+func (c *localController) resourceName(name string) string {
+	return c.id + "-" + name
+}
+
+func (c *localController) labels() map[string]string {
+	return map[string]string{testworkflowprocessor.ExecutionIdLabelName: c.id}
+}
+
+func (c *localController) volumePath(name string) string {
+	return filepath.Join(c.dir, "volumes", name)
+}
+
+func (c *localController) terminationLogPath(name string) string {
+	return filepath.Join(c.dir, "termination", name)
+}
+
+// watchLocalContainerLogs parses the logs of the local container, timestamping them like Kubernetes does
+func watchLocalContainerLogs(ctx context.Context, stream io.ReadCloser) Watcher[ContainerLog] {
+	w := newWatcher[ContainerLog](ctx, 0)
+
+	go func() {
+		defer w.Close()
+
+		go func() {
+			<-w.Done()
+			_ = stream.Close()
+		}()
+
+		readContainerLogs(w, timestampLogs(stream))
+	}()
+
+	return w
+}
+
+// timestampLogs prefixes each line with the current time, in the Kubernetes logs format
+func timestampLogs(stream io.Reader) io.Reader {
+	reader, writer := io.Pipe()
+	go func() {
+		buf := bufio.NewReader(stream)
+		for {
+			line, err := utils.ReadLongLine(buf)
+			if err == nil || len(line) > 0 {
+				prefix := time.Now().UTC().Format(KubernetesLogTimeFormat) + " "
+				if _, werr := writer.Write(append(append([]byte(prefix), line...), '\n')); werr != nil {
+					return
+				}
+			}
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				_ = writer.CloseWithError(err)
+				return
+			}
+		}
+	}()
+	return reader
+}
+
+func mapPullPolicy(policy corev1.PullPolicy) string {
+	switch policy {
+	case corev1.PullAlways:
+		return "always"
+	case corev1.PullNever:
+		return "never"
+	default:
+		return "missing"
+	}
+}
+
+func findVolume(volumes []corev1.Volume, name string) *corev1.Volume {
+	for i := range volumes {
+		if volumes[i].Name == name {
+			return &volumes[i]
+		}
+	}
+	return nil
+}
+
+func writeFile(path string, data []byte, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, mode); err != nil {
+		return err
+	}
+	// Avoid the umask, so the containers running as different users may access the file
+	return os.Chmod(path, mode)
+}
+
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
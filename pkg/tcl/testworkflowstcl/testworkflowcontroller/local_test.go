@@ -0,0 +1,277 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowcontroller
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/constants"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowdocker"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+)
+
+type fakeContainer struct {
+	logs        string
+	termination string
+	exitCode    int
+}
+
+func localTestBundle(t *testing.T) *testworkflowprocessor.Bundle {
+	sig, err := testworkflowprocessor.GetSignatureFromJSON([]byte(`[{"ref":"r1","name":"first"},{"ref":"r2","name":"second"}]`))
+	require.NoError(t, err)
+
+	volumeMounts := []corev1.VolumeMount{{Name: "internal", MountPath: "/.tktw"}}
+	return &testworkflowprocessor.Bundle{
+		ConfigMaps: []corev1.ConfigMap{{
+			ObjectMeta: metav1.ObjectMeta{Name: "exec-files"},
+			Data:       map[string]string{"file": "content"},
+		}},
+		Secrets: []corev1.Secret{{
+			ObjectMeta: metav1.ObjectMeta{Name: "exec-secret"},
+			Data:       map[string][]byte{"token": []byte("secret-value")},
+		}},
+		Signature: sig,
+		Job: batchv1.Job{Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{Name: "internal", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				{Name: "files", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "exec-files"},
+				}}},
+			},
+			HostAliases: []corev1.HostAlias{{IP: "127.0.0.1", Hostnames: []string{"db"}}},
+			InitContainers: []corev1.Container{
+				{Name: "tktw-init", Image: "init:latest", VolumeMounts: volumeMounts},
+				{Name: "r1", Image: "busybox", VolumeMounts: append(volumeMounts, corev1.VolumeMount{
+					Name: "files", MountPath: "/data/file.txt", SubPath: "file",
+				}), Env: []corev1.EnvVar{
+					{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "exec-secret"},
+						Key:                  "token",
+					}}},
+				}},
+			},
+			Containers: []corev1.Container{
+				{Name: "r2", Image: "busybox", VolumeMounts: volumeMounts},
+			},
+		}}}},
+	}
+}
+
+func runLocal(t *testing.T, bundle *testworkflowprocessor.Bundle, containers map[string]fakeContainer) (*testkube.TestWorkflowResult, []string, map[string]testworkflowdocker.ContainerOptions) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var mu sync.Mutex
+	created := make(map[string]testworkflowdocker.ContainerOptions)
+	client := testworkflowdocker.NewMockClient(ctrl)
+	client.EXPECT().CreateVolume(gomock.Any(), "exec-internal", gomock.Any()).Return(nil)
+	client.EXPECT().RemoveVolume(gomock.Any(), "exec-internal").Return(nil)
+	client.EXPECT().CreateContainer(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, options testworkflowdocker.ContainerOptions) error {
+			mu.Lock()
+			defer mu.Unlock()
+			created[options.Name] = options
+			return nil
+		})
+	client.EXPECT().StartContainer(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+	client.EXPECT().RemoveContainer(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+	client.EXPECT().ContainerLogs(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, name string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(containers[strings.TrimPrefix(name, "exec-")].logs)), nil
+		})
+	client.EXPECT().WaitContainer(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, name string) (testworkflowdocker.ContainerState, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			container := containers[strings.TrimPrefix(name, "exec-")]
+			for _, mount := range created[name].Mounts {
+				if mount.Target == localTerminationLogPath {
+					require.NoError(t, os.WriteFile(mount.Source, []byte(container.termination), 0666))
+				}
+			}
+			return testworkflowdocker.ContainerState{
+				ExitCode:   container.exitCode,
+				FinishedAt: time.Now(),
+			}, nil
+		})
+
+	ctrlr, err := NewLocal(context.Background(), client, bundle, "exec", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, ctrlr.Start())
+	assert.Error(t, ctrlr.Start())
+
+	// The execution is running without watching it
+	c := ctrlr.(*localController)
+	for range c.events.Stream(context.Background()) {
+	}
+
+	var result *testkube.TestWorkflowResult
+	var logs []string
+	for v := range ctrlr.Watch(context.Background()).Stream(context.Background()).Channel() {
+		require.NoError(t, v.Error)
+		if v.Value.Result != nil {
+			result = v.Value.Result
+		}
+		if v.Value.Log != "" {
+			logs = append(logs, v.Value.Log)
+		}
+	}
+	require.NoError(t, ctrlr.Cleanup(context.Background()))
+	return result, logs, created
+}
+
+func TestLocalController_Watch(t *testing.T) {
+	result, logs, created := runLocal(t, localTestBundle(t), map[string]fakeContainer{
+		"prepare":   {},
+		"tktw-init": {logs: "Done\n", termination: ",0"},
+		"r1":        {logs: data.SprintHint("r1", "start") + "hello\n" + data.SprintHintDetails("r1", "status", "passed"), termination: "passed,0"},
+		"r2":        {logs: "world\n", termination: "failed,1"},
+	})
+
+	require.NotNil(t, result)
+	assert.Equal(t, testkube.PASSED_TestWorkflowStepStatus, *result.Initialization.Status)
+	assert.Equal(t, testkube.PASSED_TestWorkflowStepStatus, *result.Steps["r1"].Status)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStepStatus, *result.Steps["r2"].Status)
+	assert.Equal(t, float64(1), result.Steps["r2"].ExitCode)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStatus, *result.Status)
+	assert.False(t, result.FinishedAt.IsZero())
+
+	joined := strings.Join(logs, "")
+	assert.Contains(t, joined, "hello")
+	assert.Contains(t, joined, "world")
+	assert.NotContains(t, joined, data.InstructionPrefix)
+
+	assert.Equal(t, []string{"db:127.0.0.1"}, created["exec-pod"].Hosts)
+	step := created["exec-r1"]
+	assert.Equal(t, "container:exec-pod", step.Network)
+	assert.Equal(t, []string{"TOKEN=secret-value"}, step.Env)
+	assert.Equal(t, testworkflowdocker.Mount{Type: "volume", Source: "exec-internal", Target: "/.tktw"}, step.Mounts[0])
+	assert.Equal(t, "bind", step.Mounts[1].Type)
+	assert.Equal(t, "/data/file.txt", step.Mounts[1].Target)
+	assert.True(t, strings.HasSuffix(step.Mounts[1].Source, "/volumes/files/file"))
+}
+
+func TestLocalController_Watch_StepAborted(t *testing.T) {
+	result, logs, created := runLocal(t, localTestBundle(t), map[string]fakeContainer{
+		"prepare":   {},
+		"tktw-init": {logs: "Done\n", termination: ",0"},
+		"r1":        {logs: "The step was aborted.\n", termination: "aborted,137"},
+		"r2":        {logs: "cleanup\n", termination: "passed,0"},
+	})
+
+	require.NotNil(t, result)
+	assert.Equal(t, testkube.ABORTED_TestWorkflowStepStatus, *result.Steps["r1"].Status)
+	assert.Equal(t, testkube.PASSED_TestWorkflowStepStatus, *result.Steps["r2"].Status)
+	assert.Contains(t, created, "exec-r2")
+	assert.False(t, result.FinishedAt.IsZero())
+	assert.Contains(t, strings.Join(logs, ""), "cleanup")
+}
+
+func TestLocalController_Watch_InitFailure(t *testing.T) {
+	result, _, created := runLocal(t, localTestBundle(t), map[string]fakeContainer{
+		"prepare":   {},
+		"tktw-init": {logs: "cp: can't create\n", termination: "failed,1"},
+	})
+
+	require.NotNil(t, result)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStepStatus, *result.Initialization.Status)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStatus, *result.Status)
+	assert.NotContains(t, created, "exec-r1")
+}
+
+func TestLocalController_Watch_MissingSecret(t *testing.T) {
+	bundle := localTestBundle(t)
+	bundle.Job.Spec.Template.Spec.Volumes = append(bundle.Job.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name:         "credentials",
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "cluster-secret"}},
+	})
+
+	result, _, _ := runLocal(t, bundle, nil)
+
+	require.NotNil(t, result)
+	assert.Contains(t, result.Initialization.ErrorMessage, "secret cluster-secret is not available locally")
+	assert.Equal(t, testkube.FAILED_TestWorkflowStatus, *result.Status)
+}
+
+func TestLocalController_ControlStep(t *testing.T) {
+	sig, err := testworkflowprocessor.GetSignatureFromJSON([]byte(`[{"ref":"r1","children":[{"ref":"r11"},{"ref":"r12"}]},{"ref":"r2"}]`))
+	require.NoError(t, err)
+	bundle := &testworkflowprocessor.Bundle{Signature: sig}
+	bundle.Job.Spec.Template.Spec.Volumes = []corev1.Volume{{
+		Name: "control",
+		VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{
+			Items: []corev1.DownwardAPIVolumeFile{{
+				Path:     constants.ControlFileName,
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations['" + testworkflowprocessor.ControlAnnotationName + "']"},
+			}},
+		}},
+	}}
+
+	ctrlr, err := NewLocal(context.Background(), nil, bundle, "exec", time.Now())
+	require.NoError(t, err)
+	c := ctrlr.(*localController)
+	defer os.RemoveAll(c.dir)
+
+	assert.ErrorIs(t, ctrlr.SkipStep(context.Background(), "r2"), ErrStepControlUnavailable)
+	require.NoError(t, c.writeDownwardAPIVolume(bundle.Job.Spec.Template.Spec.Volumes[0]))
+
+	require.NoError(t, ctrlr.SkipStep(context.Background(), "r2"))
+	require.NoError(t, ctrlr.AbortStep(context.Background(), "r1"))
+	assert.Error(t, ctrlr.AbortStep(context.Background(), "unknown"))
+
+	actions, err := os.ReadFile(filepath.Join(c.volumePath("control"), constants.ControlFileName))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"r1":"abort","r11":"abort","r12":"abort","r2":"skip"}`, string(actions))
+}
+
+func TestLocalController_ApproveStep(t *testing.T) {
+	sig, err := testworkflowprocessor.GetSignatureFromJSON([]byte(`[{"ref":"r1"},{"ref":"r2"}]`))
+	require.NoError(t, err)
+	bundle := &testworkflowprocessor.Bundle{Signature: sig}
+	volume := corev1.Volume{
+		Name: "control",
+		VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{
+			Items: []corev1.DownwardAPIVolumeFile{{
+				Path:     constants.ApprovalsFileName,
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations['" + testworkflowprocessor.ApprovalDecisionsAnnotationName + "']"},
+			}},
+		}},
+	}
+
+	ctrlr, err := NewLocal(context.Background(), nil, bundle, "exec", time.Now())
+	require.NoError(t, err)
+	c := ctrlr.(*localController)
+	defer os.RemoveAll(c.dir)
+	require.NoError(t, c.writeDownwardAPIVolume(volume))
+
+	require.NoError(t, ctrlr.ApproveStep(context.Background(), "r1", map[string]string{"version": "1.2.3"}))
+	require.NoError(t, ctrlr.RejectStep(context.Background(), "r2"))
+	assert.ErrorContains(t, ctrlr.RejectStep(context.Background(), "r1"), "already decided")
+	assert.ErrorIs(t, ctrlr.AbortStep(context.Background(), "r1"), ErrStepControlUnavailable)
+
+	decisions, err := os.ReadFile(filepath.Join(c.volumePath("control"), constants.ApprovalsFileName))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"r1":{"approved":true,"inputs":{"version":"1.2.3"}},"r2":{"approved":false}}`, string(decisions))
+}
@@ -76,6 +76,9 @@ type ContainerResult struct {
 	Details    string
 	ExitCode   int
 	FinishedAt time.Time
+	// Reported is set when the result has been persisted by the init process,
+	// so the step has finished on its own, even when it has been aborted on request
+	Reported bool
 }
 
 var UnknownContainerResult = ContainerResult{
@@ -108,7 +111,7 @@ func GetContainerResult(c corev1.ContainerStatus) ContainerResult {
 	if status == "" {
 		status = testkube.PASSED_TestWorkflowStepStatus
 	}
-	return ContainerResult{Status: status, ExitCode: exitCode, FinishedAt: c.State.Terminated.FinishedAt.Time}
+	return ContainerResult{Status: status, ExitCode: exitCode, FinishedAt: c.State.Terminated.FinishedAt.Time, Reported: true}
 }
 
 func GetFinalContainerResult(ctx context.Context, pod Watcher[*corev1.Pod], containerName string) (ContainerResult, error) {
@@ -223,95 +226,102 @@ func WatchContainerLogs(ctx context.Context, clientSet kubernetes.Interface, pod
 		}()
 
 		// Parse and return the logs
-		reader := bufio.NewReader(stream)
-		var tsPrefix, tmpTsPrefix []byte
-		isNewLine := false
-		isStarted := false
-		var ts, tmpTs time.Time
-		for {
-			var prepend []byte
-
-			// Read next timestamp
-			tmpTs, tmpTsPrefix, err = ReadTimestamp(reader)
-			if err == nil {
-				ts = tmpTs
-				tsPrefix = tmpTsPrefix
-			} else if err == io.EOF {
-				return
-			} else {
-				// Edge case: Kubernetes may send critical errors without timestamp (like ionotify)
-				if len(tmpTsPrefix) > 0 {
-					prepend = tmpTsPrefix
-				}
-				w.SendError(err)
-			}
+		readContainerLogs(w, stream)
+	}()
 
-			// Check for the next part
-			line, err := utils.ReadLongLine(reader)
-			if len(prepend) > 0 {
-				line = append(prepend, line...)
+	return w
+}
+
+// readContainerLogs parses the timestamped container logs,
+// extracting the instructions emitted by the TestWorkflow init process
+func readContainerLogs(w *watcher[ContainerLog], stream io.Reader) {
+	reader := bufio.NewReader(stream)
+	var err error
+	var tsPrefix, tmpTsPrefix []byte
+	isNewLine := false
+	isStarted := false
+	var ts, tmpTs time.Time
+	for {
+		var prepend []byte
+
+		// Read next timestamp
+		tmpTs, tmpTsPrefix, err = ReadTimestamp(reader)
+		if err == nil {
+			ts = tmpTs
+			tsPrefix = tmpTsPrefix
+		} else if err == io.EOF {
+			return
+		} else {
+			// Edge case: Kubernetes may send critical errors without timestamp (like ionotify)
+			if len(tmpTsPrefix) > 0 {
+				prepend = tmpTsPrefix
 			}
-			commentRe := regexp.MustCompile(fmt.Sprintf(`^%s(%s)?([^%s]+)%s([a-zA-Z0-9-_.]+)(?:%s([^\n]+))?%s$`,
-				data.InstructionPrefix, data.HintPrefix, data.InstructionSeparator, data.InstructionSeparator, data.InstructionValueSeparator, data.InstructionSeparator))
-
-			// Process the received line
-			if len(line) > 0 {
-				hadComment := false
-				// Fast check to avoid regexes
-				if len(line) >= 4 && string(line[:len(data.InstructionPrefix)]) == data.InstructionPrefix {
-					v := commentRe.FindSubmatch(line)
-					if v != nil {
-						isHint := string(v[1]) == data.HintPrefix
-						ref := string(v[2])
-						name := string(v[3])
-						result := Instruction{Ref: ref, Name: name}
-						log := ContainerLog{Time: ts}
-						if isHint {
-							log.Hint = &result
-						} else {
-							log.Output = &result
-						}
-						if len(v) > 4 && v[4] != nil {
-							err := json.Unmarshal(v[4], &result.Value)
-							if err == nil {
-								isNewLine = false
-								hadComment = true
-								w.SendValue(log)
-							}
-						} else {
+			w.SendError(err)
+		}
+
+		// Check for the next part
+		line, err := utils.ReadLongLine(reader)
+		if len(prepend) > 0 {
+			line = append(prepend, line...)
+		}
+		commentRe := regexp.MustCompile(fmt.Sprintf(`^%s(%s)?([^%s]+)%s([a-zA-Z0-9-_.]+)(?:%s([^\n]+))?%s$`,
+			data.InstructionPrefix, data.HintPrefix, data.InstructionSeparator, data.InstructionSeparator, data.InstructionValueSeparator, data.InstructionSeparator))
+
+		// Process the received line
+		if len(line) > 0 {
+			hadComment := false
+			// Fast check to avoid regexes
+			if len(line) >= 4 && string(line[:len(data.InstructionPrefix)]) == data.InstructionPrefix {
+				v := commentRe.FindSubmatch(line)
+				if v != nil {
+					isHint := string(v[1]) == data.HintPrefix
+					ref := string(v[2])
+					name := string(v[3])
+					result := Instruction{Ref: ref, Name: name}
+					log := ContainerLog{Time: ts}
+					if isHint {
+						log.Hint = &result
+					} else {
+						log.Output = &result
+					}
+					if len(v) > 4 && v[4] != nil {
+						err := json.Unmarshal(v[4], &result.Value)
+						if err == nil {
 							isNewLine = false
 							hadComment = true
 							w.SendValue(log)
 						}
+					} else {
+						isNewLine = false
+						hadComment = true
+						w.SendValue(log)
 					}
 				}
-
-				// Append as regular log if expected
-				if !hadComment {
-					if isNewLine {
-						line = append(append([]byte("\n"), tsPrefix...), line...)
-					} else if !isStarted {
-						line = append(tsPrefix, line...)
-						isStarted = true
-					}
-					w.SendValue(ContainerLog{Time: ts, Log: line})
-					isNewLine = true
-				}
-			} else if isStarted {
-				w.SendValue(ContainerLog{Time: ts, Log: append([]byte("\n"), tsPrefix...)})
 			}
 
-			// Handle the error
-			if err != nil {
-				if err != io.EOF {
-					w.SendError(err)
+			// Append as regular log if expected
+			if !hadComment {
+				if isNewLine {
+					line = append(append([]byte("\n"), tsPrefix...), line...)
+				} else if !isStarted {
+					line = append(tsPrefix, line...)
+					isStarted = true
 				}
-				return
+				w.SendValue(ContainerLog{Time: ts, Log: line})
+				isNewLine = true
 			}
+		} else if isStarted {
+			w.SendValue(ContainerLog{Time: ts, Log: append([]byte("\n"), tsPrefix...)})
 		}
-	}()
 
-	return w
+		// Handle the error
+		if err != nil {
+			if err != io.EOF {
+				w.SendError(err)
+			}
+			return
+		}
+	}
 }
 
 func ReadTimestamp(reader *bufio.Reader) (time.Time, []byte, error) {
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/redact"
 )
 
 type Notification struct {
@@ -31,3 +32,47 @@ func (n *Notification) ToInternal() testkube.TestWorkflowExecutionNotification {
 		Output: n.Output.ToInternal(),
 	}
 }
+
+// redactedWatcher masks the secrets in the logs and outputs of the notifications
+type redactedWatcher struct {
+	*watcher[Notification]
+	redactor *redact.Redactor
+}
+
+func newRedactedWatcher(w *watcher[Notification], redactor *redact.Redactor) *redactedWatcher {
+	return &redactedWatcher{watcher: w, redactor: redactor}
+}
+
+func (w *redactedWatcher) SendValue(value Notification) {
+	if !w.redactor.Empty() {
+		value.Log = w.redactor.String(value.Log)
+		if value.Output != nil {
+			value.Output = &Instruction{
+				Ref:   value.Output.Ref,
+				Name:  value.Output.Name,
+				Value: redactValue(w.redactor, value.Output.Value),
+			}
+		}
+	}
+	w.watcher.SendValue(value)
+}
+
+func redactValue(redactor *redact.Redactor, v interface{}) interface{} {
+	switch value := v.(type) {
+	case string:
+		return redactor.String(value)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			result[k] = redactValue(redactor, item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, item := range value {
+			result[i] = redactValue(redactor, item)
+		}
+		return result
+	}
+	return v
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowcontroller
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	errors2 "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/redact"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+)
+
+// SaveServiceLogs reads the logs of each service running next to the TestWorkflow execution.
+// It has to be called before the resources are cleaned up.
+func SaveServiceLogs(ctx context.Context, clientSet kubernetes.Interface, namespace, id string,
+	save func(name string, reader io.Reader) error) error {
+	pods, err := clientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: testworkflowprocessor.ExecutionIdMainPodLabelName + "=" + id,
+	})
+	if err != nil {
+		return errors2.Wrap(err, "listing pods")
+	}
+
+	var errs []error
+	resolver := redact.NewResolver(clientSet, namespace)
+	for _, pod := range pods.Items {
+		// Avoid overriding the logs stored before the pod has been deleted
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		// Mask the secrets used by the services
+		redactor, err := resolver.PodSpec(ctx, namespace, &pod.Spec)
+		if err != nil {
+			// Redact at least the secrets that have been found
+			log.DefaultLogger.Warnw("failed to resolve all the secrets to redact", "id", id, "error", err)
+		}
+		for _, container := range pod.Spec.InitContainers {
+			name, ok := testworkflowprocessor.GetServiceName(container.Name)
+			if !ok {
+				continue
+			}
+			stream, err := clientSet.CoreV1().Pods(namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container: container.Name,
+			}).Stream(ctx)
+			if err != nil {
+				errs = append(errs, errors2.Wrapf(err, "reading logs of '%s' service", name))
+				continue
+			}
+			err = save(name, redactor.Reader(stream))
+			_ = stream.Close()
+			if err != nil {
+				errs = append(errs, errors2.Wrapf(err, "saving logs of '%s' service", name))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
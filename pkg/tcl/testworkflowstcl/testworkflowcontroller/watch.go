@@ -0,0 +1,194 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowcontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+)
+
+type notificationSender interface {
+	SendValue(value Notification)
+	SendError(err error)
+}
+
+// executionWatcher builds the TestWorkflow result from the container states and logs,
+// and emits it along with the logs and outputs. It is shared by the cluster and the local controllers.
+type executionWatcher struct {
+	w           notificationSender
+	sig         []testkube.TestWorkflowSignature
+	scheduledAt time.Time
+	result      testkube.TestWorkflowResult
+}
+
+func newExecutionWatcher(w notificationSender, signature []testworkflowprocessor.Signature, scheduledAt time.Time) *executionWatcher {
+	sig := make([]testkube.TestWorkflowSignature, len(signature))
+	for i, s := range signature {
+		sig[i] = s.ToInternal()
+	}
+
+	return &executionWatcher{
+		w:           w,
+		sig:         sig,
+		scheduledAt: scheduledAt,
+		result: testkube.TestWorkflowResult{
+			Status:          common.Ptr(testkube.QUEUED_TestWorkflowStatus),
+			PredictedStatus: common.Ptr(testkube.PASSED_TestWorkflowStatus),
+			Initialization: &testkube.TestWorkflowStepResult{
+				Status: common.Ptr(testkube.QUEUED_TestWorkflowStepStatus),
+			},
+			Steps: testworkflowprocessor.MapSignatureListToStepResults(signature),
+		},
+	}
+}
+
+// SendResult emits the current result
+func (e *executionWatcher) SendResult() {
+	e.w.SendValue(Notification{Result: e.result.Clone()})
+}
+
+// SendError emits the error
+func (e *executionWatcher) SendError(err error) {
+	e.w.SendError(err)
+}
+
+// HasStep checks if the container is a standard TestWorkflow step
+func (e *executionWatcher) HasStep(ref string) bool {
+	_, ok := e.result.Steps[ref]
+	return ok
+}
+
+// UpdateStep updates the step result, recomputing the parent steps
+func (e *executionWatcher) UpdateStep(ref string, value testkube.TestWorkflowStepResult) testkube.TestWorkflowStepResult {
+	return e.result.UpdateStepResult(e.sig, ref, value, e.scheduledAt)
+}
+
+// FinishInitialization updates the initialization result with the final container status,
+// and returns false when it has failed
+func (e *executionWatcher) FinishInitialization(status ContainerResult, lastTs time.Time) bool {
+	e.result.Initialization.FinishedAt = status.FinishedAt
+	if lastTs.After(e.result.Initialization.FinishedAt) {
+		e.result.Initialization.FinishedAt = lastTs
+	}
+	e.result.Initialization.Status = common.Ptr(status.Status)
+	if status.Status != testkube.PASSED_TestWorkflowStepStatus {
+		e.result.Status = common.Ptr(testkube.FAILED_TestWorkflowStatus)
+		e.result.PredictedStatus = e.result.Status
+	}
+	e.SendResult()
+	return status.Status == testkube.PASSED_TestWorkflowStepStatus
+}
+
+// HandleLog emits the container log line, or applies the hint and the output from it
+func (e *executionWatcher) HandleLog(ref string, log ContainerLog) {
+	if log.Hint != nil {
+		if !e.HasStep(log.Hint.Ref) {
+			return
+		}
+		switch log.Hint.Name {
+		case "start":
+			if log.Hint.Ref == ref && log.Time.After(e.result.Steps[ref].StartedAt) {
+				e.UpdateStep(ref, testkube.TestWorkflowStepResult{StartedAt: log.Time.UTC()})
+			}
+		case "status":
+			status, _ := log.Hint.Value.(string)
+			if status == "" {
+				status = string(testkube.PASSED_TestWorkflowStepStatus)
+			}
+			e.UpdateStep(log.Hint.Ref, testkube.TestWorkflowStepResult{
+				Status: common.Ptr(testkube.TestWorkflowStepStatus(status)),
+			})
+		case "outputs":
+			outputs := map[string]string{}
+			if values, ok := log.Hint.Value.(map[string]interface{}); ok {
+				for k, value := range values {
+					outputs[k] = fmt.Sprintf("%v", value)
+				}
+			}
+			e.UpdateStep(log.Hint.Ref, testkube.TestWorkflowStepResult{Outputs: outputs})
+		}
+		return
+	}
+	if log.Output != nil {
+		if e.HasStep(log.Output.Ref) {
+			e.w.SendValue(Notification{Timestamp: log.Time, Ref: log.Output.Ref, Output: log.Output})
+			e.handleParallelOutput(log.Output)
+		}
+		return
+	}
+	e.w.SendValue(Notification{Timestamp: log.Time, Ref: ref, Log: string(log.Log)})
+}
+
+// handleParallelOutput aggregates the results of the executions spawned by the parallel step
+func (e *executionWatcher) handleParallelOutput(output *Instruction) {
+	if output.Name != testworkflowprocessor.ParallelStartOutputName && output.Name != testworkflowprocessor.ParallelEndOutputName {
+		return
+	}
+	var execution testworkflowprocessor.ParallelExecution
+	b, err := json.Marshal(output.Value)
+	if err == nil {
+		err = json.Unmarshal(b, &execution)
+	}
+	if err != nil || execution.Id == "" {
+		return
+	}
+	e.UpdateStep(output.Ref, testkube.TestWorkflowStepResult{
+		Parallel: &testkube.TestWorkflowParallelStepResult{
+			Executions: []testkube.TestWorkflowParallelExecutionResult{execution.ToInternal()},
+		},
+	})
+	e.SendResult()
+}
+
+// FinishStep updates the step result with the final container status,
+// and returns the time when it has finished, along with the information if the execution has been aborted.
+// The step aborted on request is finished by the init process, so the execution continues with the next steps.
+func (e *executionWatcher) FinishStep(ref string, status ContainerResult, lastTs time.Time) (time.Time, bool) {
+	finishedAt := status.FinishedAt.UTC()
+	if !finishedAt.IsZero() && lastTs.After(finishedAt) {
+		finishedAt = lastTs.UTC()
+	}
+	e.UpdateStep(ref, testkube.TestWorkflowStepResult{
+		FinishedAt: finishedAt,
+		ExitCode:   float64(status.ExitCode),
+		Status:     common.Ptr(status.Status),
+	})
+	e.SendResult()
+
+	if status.Status != testkube.ABORTED_TestWorkflowStepStatus {
+		return finishedAt, false
+	}
+
+	e.result.Recompute(e.sig, e.scheduledAt)
+	abortTs := e.result.Steps[ref].FinishedAt
+	if status.Details == "" {
+		status.Details = "Manual"
+	}
+	e.w.SendValue(Notification{
+		Timestamp: abortTs,
+		Ref:       ref,
+		Log:       fmt.Sprintf("\n%s Aborted (%s)", abortTs.Format(KubernetesLogTimeFormat), status.Details),
+	})
+	e.SendResult()
+	return finishedAt, !status.Reported
+}
+
+// Finish computes the final TestWorkflow status and dates, and emits the result
+func (e *executionWatcher) Finish(finishedAt time.Time) {
+	if !finishedAt.IsZero() {
+		e.result.FinishedAt = finishedAt
+	}
+	e.result.Recompute(e.sig, e.scheduledAt)
+	e.SendResult()
+}
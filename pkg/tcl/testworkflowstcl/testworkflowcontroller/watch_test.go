@@ -0,0 +1,119 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowcontroller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+)
+
+type fakeNotificationSender struct {
+	values []Notification
+	errors []error
+}
+
+func (f *fakeNotificationSender) SendValue(value Notification) {
+	f.values = append(f.values, value)
+}
+
+func (f *fakeNotificationSender) SendError(err error) {
+	f.errors = append(f.errors, err)
+}
+
+func TestExecutionWatcher_HandleLog(t *testing.T) {
+	sig, err := testworkflowprocessor.GetSignatureFromJSON([]byte(`[{"ref":"r1"},{"ref":"r2"}]`))
+	require.NoError(t, err)
+	w := &fakeNotificationSender{}
+	e := newExecutionWatcher(w, sig, time.Now())
+	ts := time.Now()
+
+	e.HandleLog("r1", ContainerLog{Time: ts, Hint: &Instruction{Ref: "r1", Name: "start"}})
+	e.HandleLog("r1", ContainerLog{Time: ts, Hint: &Instruction{Ref: "r1", Name: "status", Value: "failed"}})
+	e.HandleLog("r1", ContainerLog{Time: ts, Hint: &Instruction{Ref: "r1", Name: "outputs", Value: map[string]interface{}{"count": 2}}})
+	e.HandleLog("r1", ContainerLog{Time: ts, Hint: &Instruction{Ref: "unknown", Name: "status", Value: "failed"}})
+	e.HandleLog("r1", ContainerLog{Time: ts, Output: &Instruction{Ref: "r1", Name: "value"}})
+	e.HandleLog("r1", ContainerLog{Time: ts, Log: []byte("hello\n")})
+
+	assert.Equal(t, ts.UTC(), e.result.Steps["r1"].StartedAt)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStepStatus, *e.result.Steps["r1"].Status)
+	assert.Equal(t, map[string]string{"count": "2"}, e.result.Steps["r1"].Outputs)
+	require.Len(t, w.values, 2)
+	assert.Equal(t, "value", w.values[0].Output.Name)
+	assert.Equal(t, "hello\n", w.values[1].Log)
+}
+
+func TestExecutionWatcher_HandleLog_Parallel(t *testing.T) {
+	sig, err := testworkflowprocessor.GetSignatureFromJSON([]byte(`[{"ref":"r1"}]`))
+	require.NoError(t, err)
+	w := &fakeNotificationSender{}
+	e := newExecutionWatcher(w, sig, time.Now())
+	ts := time.Now()
+
+	e.HandleLog("r1", ContainerLog{Time: ts, Output: &Instruction{Ref: "r1", Name: testworkflowprocessor.ParallelStartOutputName,
+		Value: map[string]interface{}{"id": "id-1", "name": "example-1", "index": 0}}})
+	e.HandleLog("r1", ContainerLog{Time: ts, Output: &Instruction{Ref: "r1", Name: testworkflowprocessor.ParallelStartOutputName,
+		Value: map[string]interface{}{"id": "id-2", "name": "example-2", "index": 1}}})
+	e.HandleLog("r1", ContainerLog{Time: ts, Output: &Instruction{Ref: "r1", Name: testworkflowprocessor.ParallelEndOutputName,
+		Value: map[string]interface{}{"id": "id-1", "index": 0, "status": "passed"}}})
+
+	parallel := e.result.Steps["r1"].Parallel
+	require.NotNil(t, parallel)
+	assert.Equal(t, testkube.RUNNING_TestWorkflowStatus, *parallel.Status)
+	assert.Equal(t, []testkube.TestWorkflowParallelExecutionResult{
+		{Id: "id-1", Name: "example-1", Index: 0, Status: common.Ptr(testkube.PASSED_TestWorkflowStatus)},
+		{Id: "id-2", Name: "example-2", Index: 1},
+	}, parallel.Executions)
+
+	e.HandleLog("r1", ContainerLog{Time: ts, Output: &Instruction{Ref: "r1", Name: testworkflowprocessor.ParallelEndOutputName,
+		Value: map[string]interface{}{"id": "id-2", "index": 1, "status": "failed"}}})
+	e.FinishStep("r1", ContainerResult{Status: testkube.PASSED_TestWorkflowStepStatus, FinishedAt: ts}, ts)
+	e.Finish(ts)
+
+	assert.Equal(t, testkube.FAILED_TestWorkflowStatus, *e.result.Steps["r1"].Parallel.Status)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStepStatus, *e.result.Steps["r1"].Status)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStatus, *e.result.Status)
+	assert.Equal(t, testkube.FAILED_TestWorkflowStatus, *w.values[len(w.values)-1].Result.Steps["r1"].Parallel.Status)
+}
+
+func TestExecutionWatcher_FinishStep(t *testing.T) {
+	sig, err := testworkflowprocessor.GetSignatureFromJSON([]byte(`[{"ref":"r1"},{"ref":"r2"}]`))
+	require.NoError(t, err)
+	w := &fakeNotificationSender{}
+	e := newExecutionWatcher(w, sig, time.Now())
+	ts := time.Now()
+
+	finishedAt, aborted := e.FinishStep("r1", ContainerResult{Status: testkube.PASSED_TestWorkflowStepStatus, FinishedAt: ts}, ts.Add(time.Second))
+	assert.False(t, aborted)
+	assert.Equal(t, ts.Add(time.Second).UTC(), finishedAt)
+	assert.Equal(t, testkube.PASSED_TestWorkflowStepStatus, *e.result.Steps["r1"].Status)
+
+	_, aborted = e.FinishStep("r2", ContainerResult{Status: testkube.ABORTED_TestWorkflowStepStatus, ExitCode: -1, FinishedAt: ts}, ts)
+	assert.True(t, aborted)
+	assert.Contains(t, w.values[len(w.values)-2].Log, "Aborted (Manual)")
+}
+
+func TestExecutionWatcher_FinishStep_StepAborted(t *testing.T) {
+	sig, err := testworkflowprocessor.GetSignatureFromJSON([]byte(`[{"ref":"r1"},{"ref":"r2"}]`))
+	require.NoError(t, err)
+	w := &fakeNotificationSender{}
+	e := newExecutionWatcher(w, sig, time.Now())
+	ts := time.Now()
+
+	_, aborted := e.FinishStep("r1", ContainerResult{Status: testkube.ABORTED_TestWorkflowStepStatus, ExitCode: 137, FinishedAt: ts, Reported: true}, ts)
+	assert.False(t, aborted)
+	assert.Equal(t, testkube.ABORTED_TestWorkflowStepStatus, *e.result.Steps["r1"].Status)
+	assert.Contains(t, w.values[len(w.values)-2].Log, "Aborted (Manual)")
+}
@@ -0,0 +1,690 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowconverter
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1 "github.com/kubeshop/testkube-operator/api/common/v1"
+	executorv1 "github.com/kubeshop/testkube-operator/api/executor/v1"
+	testsv3 "github.com/kubeshop/testkube-operator/api/tests/v3"
+	testsuitesv3 "github.com/kubeshop/testkube-operator/api/testsuite/v3"
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+)
+
+const (
+	// KindTest is the kind of the legacy test resource
+	KindTest = "Test"
+	// KindTestSuite is the kind of the legacy test suite resource
+	KindTestSuite = "TestSuite"
+
+	repositoryPath = "/data/repo"
+	testFilePath   = "/data/test-file"
+	artifactsPath  = "/data"
+	downloadImage  = "curlimages/curl:8.6.0"
+	mainStepName   = "Run tests"
+)
+
+// Issue describes part of the legacy resource which couldn't be translated
+type Issue struct {
+	Kind    string
+	Name    string
+	Field   string
+	Message string
+}
+
+// Issues is a report of the conversion
+type Issues []Issue
+
+// Table returns issues as table data
+func (issues Issues) Table() (header []string, output [][]string) {
+	header = []string{"Kind", "Name", "Field", "Problem"}
+	for _, issue := range issues {
+		output = append(output, []string{issue.Kind, issue.Name, issue.Field, issue.Message})
+	}
+
+	return
+}
+
+// Converter translates legacy Tests and TestSuites into TestWorkflows
+type Converter struct {
+	executors        []executorv1.Executor
+	executeWorkflows bool
+}
+
+// New creates converter using the executors to resolve test types
+func New(executors []executorv1.Executor) *Converter {
+	return &Converter{executors: executors}
+}
+
+// WithExecuteWorkflows makes converted test suites execute workflows converted from the tests,
+// instead of the legacy tests
+func (c *Converter) WithExecuteWorkflows(enabled bool) *Converter {
+	c.executeWorkflows = enabled
+	return c
+}
+
+// conversion collects issues of the single resource conversion
+type conversion struct {
+	kind   string
+	name   string
+	issues Issues
+	// stepNames counts the generated step names, to keep them unique
+	stepNames map[string]int
+}
+
+func (c *conversion) report(field, format string, args ...interface{}) {
+	c.issues = append(c.issues, Issue{Kind: c.kind, Name: c.name, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// uniqueStepName adds the sequence number to the repeated step name, so the steps can be distinguished
+func (c *conversion) uniqueStepName(name string) string {
+	if c.stepNames == nil {
+		c.stepNames = make(map[string]int)
+	}
+	c.stepNames[name]++
+	if c.stepNames[name] == 1 {
+		return name
+	}
+	return fmt.Sprintf("%s (%d)", name, c.stepNames[name])
+}
+
+// ConvertTest converts the legacy Test into the TestWorkflow
+func (c *Converter) ConvertTest(test testsv3.Test) (testworkflowsv1.TestWorkflow, Issues) {
+	conv := &conversion{kind: KindTest, name: test.Name}
+	workflow := testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      test.Name,
+			Namespace: test.Namespace,
+			Labels:    test.Labels,
+		},
+		Description: test.Spec.Description,
+		Spec:        c.convertTestSpec(conv, test),
+	}
+	common.AppendTypeMeta("TestWorkflow", testworkflowsv1.GroupVersion, &workflow)
+
+	return workflow, conv.issues
+}
+
+// ConvertTestToTemplate converts the legacy Test into the TestWorkflowTemplate
+func (c *Converter) ConvertTestToTemplate(test testsv3.Test) (testworkflowsv1.TestWorkflowTemplate, Issues) {
+	conv := &conversion{kind: KindTest, name: test.Name}
+	spec := c.convertTestSpec(conv, test)
+	template := testworkflowsv1.TestWorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      test.Name,
+			Namespace: test.Namespace,
+			Labels:    test.Labels,
+		},
+		Description: test.Spec.Description,
+		Spec: testworkflowsv1.TestWorkflowTemplateSpec{
+			TestWorkflowSpecBase: spec.TestWorkflowSpecBase,
+			Setup:                toIndependentSteps(spec.Setup),
+			Steps:                toIndependentSteps(spec.Steps),
+			After:                toIndependentSteps(spec.After),
+		},
+	}
+	common.AppendTypeMeta("TestWorkflowTemplate", testworkflowsv1.GroupVersion, &template)
+
+	return template, conv.issues
+}
+
+// ConvertTestSuite converts the legacy TestSuite into the TestWorkflow with execute steps
+func (c *Converter) ConvertTestSuite(suite testsuitesv3.TestSuite) (testworkflowsv1.TestWorkflow, Issues) {
+	conv := &conversion{kind: KindTestSuite, name: suite.Name}
+	workflow := testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      suite.Name,
+			Namespace: suite.Namespace,
+			Labels:    suite.Labels,
+		},
+		Description: suite.Spec.Description,
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Setup: c.convertBatches(conv, "before", suite.Spec.Before),
+			Steps: c.convertBatches(conv, "steps", suite.Spec.Steps),
+			After: c.convertBatches(conv, "after", suite.Spec.After),
+		},
+	}
+	common.AppendTypeMeta("TestWorkflow", testworkflowsv1.GroupVersion, &workflow)
+
+	if suite.Spec.Schedule != "" {
+		conv.report("schedule", "schedule %s is not converted", suite.Spec.Schedule)
+	}
+	if suite.Spec.Repeats > 1 {
+		conv.report("repeats", "repeating the test suite %d times is not supported", suite.Spec.Repeats)
+	}
+	if suite.Spec.ExecutionRequest != nil {
+		conv.report("executionRequest", "test suite execution request is not converted")
+	}
+
+	return workflow, conv.issues
+}
+
+func (c *Converter) convertBatches(conv *conversion, phase string, batches []testsuitesv3.TestSuiteBatchStep) (steps []testworkflowsv1.Step) {
+	for i, batch := range batches {
+		field := fmt.Sprintf("%s[%d]", phase, i)
+		execute := &testworkflowsv1.StepExecute{}
+		var names []string
+		var delay metav1.Duration
+		for j, step := range batch.Execute {
+			if step.Delay.Duration > delay.Duration {
+				delay = step.Delay
+			}
+			if step.Test == "" {
+				continue
+			}
+
+			names = append(names, step.Test)
+			if c.executeWorkflows {
+				execute.Workflows = append(execute.Workflows, testworkflowsv1.StepExecuteWorkflow{Name: step.Test})
+			} else {
+				execute.Tests = append(execute.Tests, testworkflowsv1.StepExecuteTest{Name: step.Test})
+			}
+
+			if step.ExecutionRequest != nil {
+				conv.report(fmt.Sprintf("%s.execute[%d].executionRequest", field, j), "step execution request of %s is not converted", step.Test)
+			}
+		}
+
+		if batch.DownloadArtifacts != nil {
+			conv.report(field+".downloadArtifacts", "downloading artifacts of previous steps is not supported")
+		}
+
+		if len(names) > 0 {
+			step := testworkflowsv1.Step{StepBase: testworkflowsv1.StepBase{
+				Name:     conv.uniqueStepName("Run " + strings.Join(names, ", ")),
+				Optional: !batch.StopOnFailure,
+				Execute:  execute,
+			}}
+			steps = append(steps, step)
+		}
+
+		if delay.Duration > 0 {
+			steps = append(steps, testworkflowsv1.Step{StepBase: testworkflowsv1.StepBase{
+				Name:  conv.uniqueStepName("Delay " + delay.Duration.String()),
+				Shell: fmt.Sprintf("sleep %d", int(math.Ceil(delay.Duration.Seconds()))),
+			}})
+		}
+	}
+
+	return steps
+}
+
+func (c *Converter) findExecutor(testType string) *executorv1.Executor {
+	for i := range c.executors {
+		for _, t := range c.executors[i].Spec.Types {
+			if t == testType {
+				return &c.executors[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Converter) convertTestSpec(conv *conversion, test testsv3.Test) (spec testworkflowsv1.TestWorkflowSpec) {
+	request := test.Spec.ExecutionRequest
+	if request == nil {
+		request = &testsv3.ExecutionRequest{}
+	}
+
+	executor := c.findExecutor(test.Spec.Type_)
+	tool, hasTool := findTool(test.Spec.Type_)
+	isContainer := executor != nil && executor.Spec.ExecutorType == executorv1.ExecutorTypeContainer
+	if isContainer {
+		hasTool = false
+	}
+
+	runPath, workingDir := convertContent(conv, &spec, test.Spec.Content, tool.Extension)
+	if hasTool && tool.Directory && workingDir == "" {
+		workingDir = runPath
+	}
+
+	spec.Container = &testworkflowsv1.ContainerConfig{}
+	spec.Container.Env = convertEnv(conv, request)
+	convertEnvReferences(conv, &spec, request)
+
+	image := request.Image
+	step := testworkflowsv1.Step{StepBase: testworkflowsv1.StepBase{
+		Name:     mainStepName,
+		Negative: request.NegativeTest,
+	}}
+	switch {
+	case isContainer:
+		if image == "" {
+			image = executor.Spec.Image
+		}
+
+		command := request.Command
+		if len(command) == 0 {
+			command = executor.Spec.Command
+		}
+
+		args := mergeArgs(executor.Spec.Args, request.Args, request.ArgsMode)
+		args = replacePlaceholders(conv, args, runPath)
+		step.Run = &testworkflowsv1.StepRun{ContainerConfig: testworkflowsv1.ContainerConfig{Image: image}}
+		if len(command) > 0 {
+			step.Run.Command = common.Ptr(escapeAll(command))
+		}
+		if len(args) > 0 {
+			step.Run.Args = common.Ptr(args)
+		}
+	case hasTool:
+		if image == "" {
+			image = tool.Image
+		}
+
+		if len(request.Command) > 0 {
+			step.Run = &testworkflowsv1.StepRun{ContainerConfig: testworkflowsv1.ContainerConfig{
+				Image:   image,
+				Command: common.Ptr(escapeAll(request.Command)),
+				Args:    common.Ptr(replacePlaceholders(conv, request.Args, runPath)),
+			}}
+			break
+		}
+
+		// the shell is escaped as a whole, as the quoting would break the escaped expressions
+		args := resolvePlaceholders(conv, request.Args, runPath)
+
+		shell := tool.Shell
+		if !tool.Directory {
+			if runPath == "" {
+				conv.report("content", "test content is required to run %s", test.Spec.Type_)
+			}
+			shell += " " + shellquote.Join(runPath)
+		}
+		if len(args) > 0 {
+			shell += " " + shellquote.Join(args...)
+		}
+
+		step.Shell = expressionstcl.Escape(shell)
+		step.Container = &testworkflowsv1.ContainerConfig{Image: image}
+	default:
+		if executor == nil {
+			conv.report("type", "executor for %s type not found", test.Spec.Type_)
+		} else {
+			if image == "" {
+				image = executor.Spec.Image
+			}
+			conv.report("type", "executor %s uses the legacy executor protocol, the container needs to be adjusted manually", executor.Name)
+		}
+
+		args := replacePlaceholders(conv, request.Args, runPath)
+		step.Run = &testworkflowsv1.StepRun{ContainerConfig: testworkflowsv1.ContainerConfig{Image: image}}
+		if len(request.Command) > 0 {
+			step.Run.Command = common.Ptr(escapeAll(request.Command))
+		}
+		if len(args) > 0 {
+			step.Run.Args = common.Ptr(args)
+		}
+	}
+
+	if workingDir != "" {
+		step.WorkingDir = common.Ptr(expressionstcl.Escape(workingDir))
+	}
+	if request.ActiveDeadlineSeconds > 0 {
+		step.Timeout = fmt.Sprintf("%ds", request.ActiveDeadlineSeconds)
+	}
+
+	if request.PreRunScript != "" {
+		spec.Setup = append(spec.Setup, scriptStep("Pre-run script", request.PreRunScript, image, workingDir, ""))
+	}
+	spec.Steps = append(spec.Steps, step)
+	if request.PostRunScript != "" {
+		spec.Steps = append(spec.Steps, scriptStep("Post-run script", request.PostRunScript, image, workingDir, "always"))
+	}
+	if request.ArtifactRequest != nil {
+		if artifacts := convertArtifacts(conv, &spec, request.ArtifactRequest); artifacts != nil {
+			spec.Steps = append(spec.Steps, *artifacts)
+		}
+	}
+
+	var pullSecrets []corev1.LocalObjectReference
+	pullSecrets = append(pullSecrets, request.ImagePullSecrets...)
+	if executor != nil {
+		pullSecrets = append(pullSecrets, executor.Spec.ImagePullSecrets...)
+	}
+	if len(pullSecrets) > 0 {
+		if spec.Pod == nil {
+			spec.Pod = &testworkflowsv1.PodConfig{}
+		}
+		spec.Pod.ImagePullSecrets = pullSecrets
+	}
+
+	reportUnsupported(conv, test, request, executor)
+	return spec
+}
+
+// convertContent sets the workflow content, returning the path to the test and the working directory
+func convertContent(conv *conversion, spec *testworkflowsv1.TestWorkflowSpec, content *testsv3.TestContent, extension string) (runPath, workingDir string) {
+	if content == nil {
+		return "", ""
+	}
+
+	switch content.Type_ {
+	case testsv3.TestContentTypeGit, testsv3.TestContentTypeGitDir, testsv3.TestContentTypeGitFile:
+		repository := content.Repository
+		if repository == nil {
+			conv.report("content.repository", "missing repository for %s content", content.Type_)
+			return "", ""
+		}
+
+		git := &testworkflowsv1.ContentGit{
+			Uri:       expressionstcl.Escape(repository.Uri),
+			Revision:  expressionstcl.Escape(repository.Commit),
+			AuthType:  repository.AuthType,
+			MountPath: repositoryPath,
+		}
+		if git.Revision == "" {
+			git.Revision = expressionstcl.Escape(repository.Branch)
+		}
+		if repository.UsernameSecret != nil {
+			git.UsernameFrom = secretKeyRef(repository.UsernameSecret.Name, repository.UsernameSecret.Key)
+		}
+		if repository.TokenSecret != nil {
+			git.TokenFrom = secretKeyRef(repository.TokenSecret.Name, repository.TokenSecret.Key)
+		}
+		if repository.CertificateSecret != "" {
+			conv.report("content.repository.certificateSecret", "git certificate secret %s is not supported", repository.CertificateSecret)
+		}
+
+		spec.Content = &testworkflowsv1.Content{Git: git}
+		runPath = filepath.Join(repositoryPath, repository.Path)
+		if repository.WorkingDir != "" {
+			workingDir = filepath.Join(repositoryPath, repository.WorkingDir)
+		}
+	case testsv3.TestContentTypeString:
+		runPath = testFilePath + extension
+		spec.Content = &testworkflowsv1.Content{Files: []testworkflowsv1.ContentFile{{Path: runPath, Content: expressionstcl.Escape(content.Data)}}}
+	case testsv3.TestContentTypeFileURI:
+		runPath = testFilePath + extension
+		spec.Setup = append(spec.Setup, testworkflowsv1.Step{StepBase: testworkflowsv1.StepBase{
+			Name:      "Download test file",
+			Container: &testworkflowsv1.ContainerConfig{Image: downloadImage},
+			Shell:     expressionstcl.Escape("curl -fsSL -o " + shellquote.Join(runPath, content.Uri)),
+		}})
+	case "":
+	default:
+		conv.report("content.type", "unsupported content type %s", content.Type_)
+	}
+
+	return runPath, workingDir
+}
+
+// convertEnv maps environment variables and test variables to container environment,
+// the values are escaped, as they are not expressions in the legacy tests
+func convertEnv(conv *conversion, request *testsv3.ExecutionRequest) (env []corev1.EnvVar) {
+	for _, name := range sortedKeys(request.Envs) {
+		env = append(env, corev1.EnvVar{Name: name, Value: expressionstcl.Escape(request.Envs[name])})
+	}
+
+	if request.HttpProxy != "" {
+		env = append(env, corev1.EnvVar{Name: "HTTP_PROXY", Value: expressionstcl.Escape(request.HttpProxy)})
+	}
+	if request.HttpsProxy != "" {
+		env = append(env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: expressionstcl.Escape(request.HttpsProxy)})
+	}
+
+	for _, name := range sortedKeys(request.Variables) {
+		variable := request.Variables[name]
+		valueFrom := variable.ValueFrom
+		if valueFrom.SecretKeyRef != nil || valueFrom.ConfigMapKeyRef != nil || valueFrom.FieldRef != nil || valueFrom.ResourceFieldRef != nil {
+			env = append(env, corev1.EnvVar{Name: name, ValueFrom: &valueFrom})
+			continue
+		}
+
+		if variable.Type_ == commonv1.VariableTypeSecret {
+			conv.report("executionRequest.variables."+name, "secret variable without secret reference is stored as plain value")
+		}
+		env = append(env, corev1.EnvVar{Name: name, Value: expressionstcl.Escape(variable.Value)})
+	}
+
+	if len(request.SecretEnvs) > 0 {
+		conv.report("executionRequest.secretEnvs", "deprecated secret envs are not converted, use secret variables instead")
+	}
+
+	return env
+}
+
+// convertEnvReferences maps config maps and secrets used by the test
+func convertEnvReferences(conv *conversion, spec *testworkflowsv1.TestWorkflowSpec, request *testsv3.ExecutionRequest) {
+	references := []struct {
+		field      string
+		references []testsv3.EnvReference
+		secret     bool
+	}{
+		{field: "envConfigMaps", references: request.EnvConfigMaps},
+		{field: "envSecrets", references: request.EnvSecrets, secret: true},
+	}
+
+	for _, group := range references {
+		for _, reference := range group.references {
+			if reference.MapToVariables {
+				source := corev1.EnvFromSource{}
+				if group.secret {
+					source.SecretRef = &corev1.SecretEnvSource{LocalObjectReference: reference.LocalObjectReference}
+				} else {
+					source.ConfigMapRef = &corev1.ConfigMapEnvSource{LocalObjectReference: reference.LocalObjectReference}
+				}
+				spec.Container.EnvFrom = append(spec.Container.EnvFrom, source)
+			}
+
+			if !reference.Mount {
+				continue
+			}
+
+			if reference.MountPath == "" {
+				conv.report("executionRequest."+group.field, "missing mount path for %s", reference.Name)
+				continue
+			}
+
+			volume := corev1.Volume{Name: "env-" + reference.Name}
+			if group.secret {
+				volume.Secret = &corev1.SecretVolumeSource{SecretName: reference.Name}
+			} else {
+				volume.ConfigMap = &corev1.ConfigMapVolumeSource{LocalObjectReference: reference.LocalObjectReference}
+			}
+			if spec.Pod == nil {
+				spec.Pod = &testworkflowsv1.PodConfig{}
+			}
+			spec.Pod.Volumes = append(spec.Pod.Volumes, volume)
+			spec.Container.VolumeMounts = append(spec.Container.VolumeMounts, corev1.VolumeMount{
+				Name:      volume.Name,
+				MountPath: reference.MountPath,
+			})
+		}
+	}
+}
+
+// convertArtifacts builds the step saving the artifacts
+func convertArtifacts(conv *conversion, spec *testworkflowsv1.TestWorkflowSpec, request *testsv3.ArtifactRequest) *testworkflowsv1.Step {
+	if request.StorageBucket != "" {
+		conv.report("executionRequest.artifactRequest.storageBucket", "custom storage bucket %s is not supported", request.StorageBucket)
+	}
+	if request.OmitFolderPerExecution {
+		conv.report("executionRequest.artifactRequest.omitFolderPerExecution", "artifacts are always stored per execution")
+	}
+	if request.SharedBetweenPods {
+		conv.report("executionRequest.artifactRequest.sharedBetweenPods", "sharing artifacts volume between pods is not supported")
+	}
+	if len(request.Masks) > 0 {
+		conv.report("executionRequest.artifactRequest.masks", "artifact masks are regular expressions, they need to be converted to glob paths manually")
+	}
+
+	workingDir := artifactsPath
+	if request.VolumeMountPath != "" {
+		workingDir = request.VolumeMountPath
+		if request.StorageClassName != "" {
+			conv.report("executionRequest.artifactRequest.storageClassName", "artifacts volume is replaced with empty directory")
+		}
+		if spec.Pod == nil {
+			spec.Pod = &testworkflowsv1.PodConfig{}
+		}
+		spec.Pod.Volumes = append(spec.Pod.Volumes, corev1.Volume{
+			Name:         "artifacts",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+		spec.Container.VolumeMounts = append(spec.Container.VolumeMounts, corev1.VolumeMount{
+			Name:      "artifacts",
+			MountPath: request.VolumeMountPath,
+		})
+	}
+
+	var paths []string
+	for _, dir := range request.Dirs {
+		if strings.Contains(dir, "*") {
+			paths = append(paths, expressionstcl.Escape(dir))
+		} else {
+			paths = append(paths, expressionstcl.Escape(filepath.Join(dir, "**/*")))
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	return &testworkflowsv1.Step{StepBase: testworkflowsv1.StepBase{
+		Name:      "Save artifacts",
+		Condition: "always",
+		Artifacts: &testworkflowsv1.StepArtifacts{
+			WorkingDir: common.Ptr(expressionstcl.Escape(workingDir)),
+			Paths:      paths,
+		},
+	}}
+}
+
+// reportUnsupported adds issues for the legacy features without TestWorkflow equivalent
+func reportUnsupported(conv *conversion, test testsv3.Test, request *testsv3.ExecutionRequest, executor *executorv1.Executor) {
+	fields := []struct {
+		field string
+		set   bool
+	}{
+		{"schedule", test.Spec.Schedule != ""},
+		{"source", test.Spec.Source != ""},
+		{"uploads", len(test.Spec.Uploads) > 0},
+		{"executionRequest.executionLabels", len(request.ExecutionLabels) > 0},
+		{"executionRequest.variablesFile", request.VariablesFile != ""},
+		{"executionRequest.jobTemplate", request.JobTemplate != "" || request.JobTemplateReference != ""},
+		{"executionRequest.cronJobTemplate", request.CronJobTemplate != "" || request.CronJobTemplateReference != ""},
+		{"executionRequest.scraperTemplate", request.ScraperTemplate != "" || request.ScraperTemplateReference != ""},
+		{"executionRequest.pvcTemplate", request.PvcTemplate != "" || request.PvcTemplateReference != ""},
+		{"executionRequest.slavePodRequest", request.SlavePodRequest != nil},
+		{"executionRequest.executionNamespace", request.ExecutionNamespace != ""},
+	}
+	if executor != nil {
+		fields = append(fields, []struct {
+			field string
+			set   bool
+		}{
+			{"executor.jobTemplate", executor.Spec.JobTemplate != "" || executor.Spec.JobTemplateReference != ""},
+			{"executor.slaves", executor.Spec.Slaves != nil},
+		}...)
+	}
+
+	for _, field := range fields {
+		if field.set {
+			conv.report(field.field, "not supported in test workflows")
+		}
+	}
+}
+
+// mergeArgs merges executor arguments with the test ones, according to args mode
+func mergeArgs(executorArgs, args []string, mode testsv3.ArgsModeType) []string {
+	if mode == testsv3.ArgsModeType(commonv1.ArgsModeTypeOverride) || mode == testsv3.ArgsModeType(commonv1.ArgsModeTypeReplace) {
+		return args
+	}
+
+	return append(append([]string{}, executorArgs...), args...)
+}
+
+// replacePlaceholders resolves the legacy executor placeholders in the arguments,
+// and escapes them, as they are not expressions in the legacy tests
+func replacePlaceholders(conv *conversion, args []string, runPath string) []string {
+	return escapeAll(resolvePlaceholders(conv, args, runPath))
+}
+
+// resolvePlaceholders resolves the legacy executor placeholders in the arguments
+func resolvePlaceholders(conv *conversion, args []string, runPath string) (result []string) {
+	for _, arg := range args {
+		switch {
+		case arg == "<envVars>":
+			// variables are passed as container environment
+			continue
+		case strings.Contains(arg, "<runPath>"):
+			if runPath == "" {
+				conv.report("executionRequest.args", "no test content to replace <runPath> placeholder")
+			}
+			arg = strings.ReplaceAll(arg, "<runPath>", runPath)
+		case strings.HasPrefix(arg, "<") && strings.HasSuffix(arg, ">"):
+			conv.report("executionRequest.args", "unknown placeholder %s", arg)
+		}
+		result = append(result, arg)
+	}
+
+	return result
+}
+
+func scriptStep(name, script, image, workingDir, condition string) testworkflowsv1.Step {
+	step := testworkflowsv1.Step{StepBase: testworkflowsv1.StepBase{
+		Name:      name,
+		Condition: condition,
+		Shell:     expressionstcl.Escape(script),
+	}}
+	if image != "" {
+		step.Container = &testworkflowsv1.ContainerConfig{Image: image}
+	}
+	if workingDir != "" {
+		step.WorkingDir = common.Ptr(expressionstcl.Escape(workingDir))
+	}
+
+	return step
+}
+
+// escapeAll escapes the legacy values, so they are not evaluated as expressions
+func escapeAll(values []string) []string {
+	return common.MapSlice(values, expressionstcl.Escape)
+}
+
+func secretKeyRef(name, key string) *corev1.EnvVarSource {
+	return &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  key,
+	}}
+}
+
+func toIndependentSteps(steps []testworkflowsv1.Step) (result []testworkflowsv1.IndependentStep) {
+	for _, step := range steps {
+		result = append(result, testworkflowsv1.IndependentStep{
+			StepBase: step.StepBase,
+			Setup:    toIndependentSteps(step.Setup),
+			Steps:    toIndependentSteps(step.Steps),
+		})
+	}
+
+	return result
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
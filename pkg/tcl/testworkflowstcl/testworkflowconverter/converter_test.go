@@ -0,0 +1,283 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowconverter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1 "github.com/kubeshop/testkube-operator/api/common/v1"
+	executorv1 "github.com/kubeshop/testkube-operator/api/executor/v1"
+	testsv3 "github.com/kubeshop/testkube-operator/api/tests/v3"
+	testsuitesv3 "github.com/kubeshop/testkube-operator/api/testsuite/v3"
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+)
+
+var executors = []executorv1.Executor{
+	{
+		ObjectMeta: metav1.ObjectMeta{Name: "k6-executor"},
+		Spec: executorv1.ExecutorSpec{
+			Types:        []string{"k6/script"},
+			ExecutorType: executorv1.ExecutorTypeJob,
+			Image:        "kubeshop/testkube-k6-executor:latest",
+		},
+	},
+	{
+		ObjectMeta: metav1.ObjectMeta{Name: "curl-container"},
+		Spec: executorv1.ExecutorSpec{
+			Types:        []string{"curl-container/test"},
+			ExecutorType: executorv1.ExecutorTypeContainer,
+			Image:        "curlimages/curl:8.6.0",
+			Command:      []string{"curl"},
+			Args:         []string{"-f", "<envVars>"},
+		},
+	},
+	{
+		ObjectMeta: metav1.ObjectMeta{Name: "custom-executor"},
+		Spec: executorv1.ExecutorSpec{
+			Types:        []string{"custom/test"},
+			ExecutorType: executorv1.ExecutorTypeJob,
+			Image:        "example/custom-executor:1.0",
+		},
+	},
+}
+
+func TestConverter_ConvertTest_GitTool(t *testing.T) {
+	test := testsv3.Test{
+		ObjectMeta: metav1.ObjectMeta{Name: "k6-test", Namespace: "testkube", Labels: map[string]string{"app": "k6"}},
+		Spec: testsv3.TestSpec{
+			Type_:       "k6/script",
+			Description: "k6 load test",
+			Content: &testsv3.TestContent{
+				Type_: testsv3.TestContentTypeGitFile,
+				Repository: &testsv3.Repository{
+					Uri:         "https://github.com/kubeshop/testkube.git",
+					Branch:      "main",
+					Path:        "test/k6/script.js",
+					TokenSecret: &testsv3.SecretRef{Name: "git-secret", Key: "token"},
+				},
+			},
+			ExecutionRequest: &testsv3.ExecutionRequest{
+				Args: []string{"--vus", "10"},
+				Envs: map[string]string{"B": "2", "A": "1"},
+				Variables: map[string]testsv3.Variable{
+					"PASSWORD": {
+						Type_:     commonv1.VariableTypeSecret,
+						Name:      "PASSWORD",
+						ValueFrom: corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "password"}},
+					},
+				},
+				PreRunScript:          "echo pre",
+				PostRunScript:         "echo post",
+				ActiveDeadlineSeconds: 60,
+				ArtifactRequest:       &testsv3.ArtifactRequest{Dirs: []string{"reports"}},
+			},
+		},
+	}
+
+	workflow, issues := New(executors).ConvertTest(test)
+
+	assert.Empty(t, issues)
+	assert.Equal(t, "TestWorkflow", workflow.Kind)
+	assert.Equal(t, "k6-test", workflow.Name)
+	assert.Equal(t, "testkube", workflow.Namespace)
+	assert.Equal(t, "k6 load test", workflow.Description)
+	assert.Equal(t, "main", workflow.Spec.Content.Git.Revision)
+	assert.Equal(t, "/data/repo", workflow.Spec.Content.Git.MountPath)
+	assert.Equal(t, "git-secret", workflow.Spec.Content.Git.TokenFrom.SecretKeyRef.Name)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "A", Value: "1"},
+		{Name: "B", Value: "2"},
+		{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "password"}}},
+	}, workflow.Spec.Container.Env)
+
+	require.Len(t, workflow.Spec.Setup, 1)
+	assert.Equal(t, "echo pre", workflow.Spec.Setup[0].Shell)
+
+	require.Len(t, workflow.Spec.Steps, 3)
+	main := workflow.Spec.Steps[0]
+	assert.Equal(t, "k6 run /data/repo/test/k6/script.js --vus 10", main.Shell)
+	assert.Equal(t, "grafana/k6:0.49.0", main.Container.Image)
+	assert.Equal(t, "60s", main.Timeout)
+	assert.Equal(t, "echo post", workflow.Spec.Steps[1].Shell)
+	assert.Equal(t, "always", workflow.Spec.Steps[1].Condition)
+	assert.Equal(t, []string{"reports/**/*"}, workflow.Spec.Steps[2].Artifacts.Paths)
+	assert.Equal(t, "/data", *workflow.Spec.Steps[2].Artifacts.WorkingDir)
+}
+
+func TestConverter_ConvertTest_ContainerExecutor(t *testing.T) {
+	test := testsv3.Test{
+		ObjectMeta: metav1.ObjectMeta{Name: "curl-test"},
+		Spec: testsv3.TestSpec{
+			Type_:   "curl-container/test",
+			Content: &testsv3.TestContent{Type_: testsv3.TestContentTypeString, Data: "data"},
+			ExecutionRequest: &testsv3.ExecutionRequest{
+				Args:             []string{"<runPath>", "https://testkube.io"},
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+				NegativeTest:     true,
+			},
+		},
+	}
+
+	workflow, issues := New(executors).ConvertTest(test)
+
+	assert.Empty(t, issues)
+	assert.Equal(t, []testworkflowsv1.ContentFile{{Path: "/data/test-file", Content: "data"}}, workflow.Spec.Content.Files)
+	require.Len(t, workflow.Spec.Steps, 1)
+	run := workflow.Spec.Steps[0].Run
+	assert.Equal(t, "curlimages/curl:8.6.0", run.Image)
+	assert.Equal(t, []string{"curl"}, *run.Command)
+	assert.Equal(t, []string{"-f", "/data/test-file", "https://testkube.io"}, *run.Args)
+	assert.True(t, workflow.Spec.Steps[0].Negative)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "registry"}}, workflow.Spec.Pod.ImagePullSecrets)
+}
+
+func TestConverter_ConvertTest_Issues(t *testing.T) {
+	test := testsv3.Test{
+		ObjectMeta: metav1.ObjectMeta{Name: "custom-test"},
+		Spec: testsv3.TestSpec{
+			Type_:    "custom/test",
+			Schedule: "* * * * *",
+			Content:  &testsv3.TestContent{Type_: testsv3.TestContentTypeFileURI, Uri: "https://example.com/test"},
+			ExecutionRequest: &testsv3.ExecutionRequest{
+				Args:        []string{"<reportFile>"},
+				JobTemplate: "spec: {}",
+			},
+		},
+	}
+
+	workflow, issues := New(executors).ConvertTest(test)
+
+	fields := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		assert.Equal(t, KindTest, issue.Kind)
+		assert.Equal(t, "custom-test", issue.Name)
+		fields = append(fields, issue.Field)
+	}
+	assert.Equal(t, []string{"type", "executionRequest.args", "schedule", "executionRequest.jobTemplate"}, fields)
+
+	require.Len(t, workflow.Spec.Setup, 1)
+	assert.Equal(t, "curl -fsSL -o /data/test-file https://example.com/test", workflow.Spec.Setup[0].Shell)
+	assert.Equal(t, "example/custom-executor:1.0", workflow.Spec.Steps[0].Run.Image)
+}
+
+func TestConverter_ConvertTest_EscapeExpressions(t *testing.T) {
+	test := testsv3.Test{
+		ObjectMeta: metav1.ObjectMeta{Name: "k6-test"},
+		Spec: testsv3.TestSpec{
+			Type_:   "k6/script",
+			Content: &testsv3.TestContent{Type_: testsv3.TestContentTypeString, Data: "http.get('{{baseUrl}}/api')"},
+			ExecutionRequest: &testsv3.ExecutionRequest{
+				Args:         []string{"-e", "URL={{baseUrl}}"},
+				Envs:         map[string]string{"BASE_URL": "{{baseUrl}}"},
+				Variables:    map[string]testsv3.Variable{"TOKEN": {Name: "TOKEN", Value: "{{token}}"}},
+				PreRunScript: "echo {{var}}",
+			},
+		},
+	}
+
+	workflow, issues := New(executors).ConvertTest(test)
+	assert.Empty(t, issues)
+
+	evaluate := func(tpl string) string {
+		v, err := expressionstcl.EvalTemplate(tpl)
+		require.NoError(t, err)
+		return v
+	}
+	assert.Equal(t, "http.get('{{baseUrl}}/api')", evaluate(workflow.Spec.Content.Files[0].Content))
+	assert.Equal(t, "{{baseUrl}}", evaluate(workflow.Spec.Container.Env[0].Value))
+	assert.Equal(t, "{{token}}", evaluate(workflow.Spec.Container.Env[1].Value))
+	assert.Equal(t, "echo {{var}}", evaluate(workflow.Spec.Setup[0].Shell))
+	assert.Equal(t, `k6 run /data/test-file.js -e URL=\{\{baseUrl}}`, evaluate(workflow.Spec.Steps[0].Shell))
+}
+
+func TestConverter_ConvertTestToTemplate(t *testing.T) {
+	test := testsv3.Test{
+		ObjectMeta: metav1.ObjectMeta{Name: "k6-test"},
+		Spec: testsv3.TestSpec{
+			Type_:            "k6/script",
+			Content:          &testsv3.TestContent{Type_: testsv3.TestContentTypeString, Data: "export default () => {}"},
+			ExecutionRequest: &testsv3.ExecutionRequest{PreRunScript: "echo pre"},
+		},
+	}
+
+	template, issues := New(executors).ConvertTestToTemplate(test)
+
+	assert.Empty(t, issues)
+	assert.Equal(t, "TestWorkflowTemplate", template.Kind)
+	assert.Equal(t, "/data/test-file.js", template.Spec.Content.Files[0].Path)
+	require.Len(t, template.Spec.Setup, 1)
+	require.Len(t, template.Spec.Steps, 1)
+	assert.Equal(t, "k6 run /data/test-file.js", template.Spec.Steps[0].Shell)
+}
+
+func TestConverter_ConvertTestSuite(t *testing.T) {
+	suite := testsuitesv3.TestSuite{
+		ObjectMeta: metav1.ObjectMeta{Name: "suite"},
+		Spec: testsuitesv3.TestSuiteSpec{
+			Before: []testsuitesv3.TestSuiteBatchStep{
+				{StopOnFailure: true, Execute: []testsuitesv3.TestSuiteStepSpec{{Test: "setup"}}},
+			},
+			Steps: []testsuitesv3.TestSuiteBatchStep{
+				{Execute: []testsuitesv3.TestSuiteStepSpec{{Test: "first"}, {Test: "second"}}},
+				{Execute: []testsuitesv3.TestSuiteStepSpec{{Delay: metav1.Duration{Duration: 1500 * time.Millisecond}}}},
+			},
+			Schedule: "* * * * *",
+		},
+	}
+
+	workflow, issues := New(nil).WithExecuteWorkflows(true).ConvertTestSuite(suite)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "schedule", issues[0].Field)
+
+	require.Len(t, workflow.Spec.Setup, 1)
+	assert.False(t, workflow.Spec.Setup[0].Optional)
+	assert.Equal(t, []testworkflowsv1.StepExecuteWorkflow{{Name: "setup"}}, workflow.Spec.Setup[0].Execute.Workflows)
+
+	require.Len(t, workflow.Spec.Steps, 2)
+	assert.True(t, workflow.Spec.Steps[0].Optional)
+	assert.Equal(t, "Run first, second", workflow.Spec.Steps[0].Name)
+	assert.Len(t, workflow.Spec.Steps[0].Execute.Workflows, 2)
+	assert.Equal(t, "sleep 2", workflow.Spec.Steps[1].Shell)
+
+	workflow, _ = New(nil).ConvertTestSuite(suite)
+	assert.Equal(t, []testworkflowsv1.StepExecuteTest{{Name: "setup"}}, workflow.Spec.Setup[0].Execute.Tests)
+}
+
+func TestConverter_ConvertTestSuite_UniqueStepNames(t *testing.T) {
+	suite := testsuitesv3.TestSuite{
+		ObjectMeta: metav1.ObjectMeta{Name: "suite"},
+		Spec: testsuitesv3.TestSuiteSpec{
+			Before: []testsuitesv3.TestSuiteBatchStep{
+				{Execute: []testsuitesv3.TestSuiteStepSpec{{Test: "first"}}},
+			},
+			Steps: []testsuitesv3.TestSuiteBatchStep{
+				{Execute: []testsuitesv3.TestSuiteStepSpec{{Test: "first"}}},
+				{Execute: []testsuitesv3.TestSuiteStepSpec{{Test: "second"}}},
+				{Execute: []testsuitesv3.TestSuiteStepSpec{{Test: "first"}}},
+			},
+		},
+	}
+
+	workflow, _ := New(nil).ConvertTestSuite(suite)
+
+	require.Len(t, workflow.Spec.Setup, 1)
+	assert.Equal(t, "Run first", workflow.Spec.Setup[0].Name)
+	require.Len(t, workflow.Spec.Steps, 3)
+	assert.Equal(t, "Run first (2)", workflow.Spec.Steps[0].Name)
+	assert.Equal(t, "Run second", workflow.Spec.Steps[1].Name)
+	assert.Equal(t, "Run first (3)", workflow.Spec.Steps[2].Name)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowconverter
+
+import "strings"
+
+// tool describes how to run the tests of the legacy executor type directly with the testing tool
+type tool struct {
+	// Image is the official image of the testing tool
+	Image string
+	// Shell is the command running the tests
+	Shell string
+	// Directory marks tools running in the test directory instead of the test file path
+	Directory bool
+	// Extension is the extension of the test file created from the string content
+	Extension string
+}
+
+// tools are the testing tools replacing the legacy job executors, by the test type prefix
+var tools = map[string]tool{
+	"postman":    {Image: "postman/newman:6-alpine", Shell: "newman run", Extension: ".json"},
+	"k6":         {Image: "grafana/k6:0.49.0", Shell: "k6 run", Extension: ".js"},
+	"jmeter":     {Image: "justb4/jmeter:5.5", Shell: "jmeter -n -t", Extension: ".jmx"},
+	"artillery":  {Image: "artilleryio/artillery:2.0.6", Shell: "artillery run", Extension: ".yaml"},
+	"cypress":    {Image: "cypress/included:13.6.4", Shell: "npm ci && npx cypress run", Directory: true},
+	"playwright": {Image: "mcr.microsoft.com/playwright:v1.41.2", Shell: "npm ci && npx playwright test", Directory: true},
+	"maven":      {Image: "maven:3.9.6-eclipse-temurin-17", Shell: "mvn test", Directory: true},
+	"gradle":     {Image: "gradle:8.5.0-jdk17", Shell: "gradle test", Directory: true},
+}
+
+// findTool returns the testing tool for the legacy test type, like k6/script
+func findTool(testType string) (tool, bool) {
+	prefix, _, _ := strings.Cut(testType, "/")
+	t, ok := tools[prefix]
+	return t, ok
+}
@@ -0,0 +1,250 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowdocker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const defaultBinary = "docker"
+
+// Mount describes volume or host path mounted in the container
+type Mount struct {
+	// Type is either "volume" or "bind"
+	Type     string
+	Source   string
+	Target   string
+	ReadOnly bool
+}
+
+// ContainerOptions describes the container to create
+type ContainerOptions struct {
+	Name       string
+	Image      string
+	PullPolicy string
+	Entrypoint []string
+	Args       []string
+	Env        []string
+	WorkingDir string
+	User       string
+	GroupAdd   []string
+	Privileged bool
+	Mounts     []Mount
+	Network    string
+	Hosts      []string
+	Labels     map[string]string
+}
+
+// ContainerState is the state of the container reported by the Docker daemon
+type ContainerState struct {
+	Status     string    `json:"Status"`
+	Running    bool      `json:"Running"`
+	OOMKilled  bool      `json:"OOMKilled"`
+	ExitCode   int       `json:"ExitCode"`
+	Error      string    `json:"Error"`
+	StartedAt  time.Time `json:"StartedAt"`
+	FinishedAt time.Time `json:"FinishedAt"`
+}
+
+// ImageConfig is the configuration of the image
+type ImageConfig struct {
+	Entrypoint []string `json:"Entrypoint"`
+	Cmd        []string `json:"Cmd"`
+	WorkingDir string   `json:"WorkingDir"`
+	User       string   `json:"User"`
+}
+
+//go:generate mockgen -destination=./mock_client.go -package=testworkflowdocker "github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowdocker" Client
+type Client interface {
+	CreateVolume(ctx context.Context, name string, labels map[string]string) error
+	RemoveVolume(ctx context.Context, name string) error
+	CreateContainer(ctx context.Context, options ContainerOptions) error
+	StartContainer(ctx context.Context, name string) error
+	ContainerLogs(ctx context.Context, name string) (io.ReadCloser, error)
+	WaitContainer(ctx context.Context, name string) (ContainerState, error)
+	KillContainer(ctx context.Context, name string) error
+	RemoveContainer(ctx context.Context, name string) error
+	InspectImage(ctx context.Context, image string, pull bool) (ImageConfig, []string, error)
+}
+
+type client struct {
+	binary string
+}
+
+// NewClient creates the client using the Docker CLI available in the system
+func NewClient() Client {
+	return &client{binary: defaultBinary}
+}
+
+func (c *client) exec(ctx context.Context, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.binary, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return out, fmt.Errorf("docker %s: %s", args[0], msg)
+	}
+	return out, nil
+}
+
+func (c *client) CreateVolume(ctx context.Context, name string, labels map[string]string) error {
+	args := []string{"volume", "create"}
+	for _, label := range formatLabels(labels) {
+		args = append(args, "--label", label)
+	}
+	_, err := c.exec(ctx, append(args, name)...)
+	return err
+}
+
+func (c *client) RemoveVolume(ctx context.Context, name string) error {
+	_, err := c.exec(ctx, "volume", "rm", "-f", name)
+	return err
+}
+
+func (c *client) CreateContainer(ctx context.Context, options ContainerOptions) error {
+	args := []string{"create", "--name", options.Name}
+	if options.PullPolicy != "" {
+		args = append(args, "--pull", options.PullPolicy)
+	}
+	if len(options.Entrypoint) > 0 {
+		args = append(args, "--entrypoint", options.Entrypoint[0])
+	}
+	for _, env := range options.Env {
+		args = append(args, "--env", env)
+	}
+	if options.WorkingDir != "" {
+		args = append(args, "--workdir", options.WorkingDir)
+	}
+	if options.User != "" {
+		args = append(args, "--user", options.User)
+	}
+	for _, group := range options.GroupAdd {
+		args = append(args, "--group-add", group)
+	}
+	if options.Privileged {
+		args = append(args, "--privileged")
+	}
+	for _, mount := range options.Mounts {
+		value := fmt.Sprintf("type=%s,source=%s,target=%s", mount.Type, mount.Source, mount.Target)
+		if mount.ReadOnly {
+			value += ",readonly"
+		}
+		args = append(args, "--mount", value)
+	}
+	if options.Network != "" {
+		args = append(args, "--network", options.Network)
+	}
+	for _, host := range options.Hosts {
+		args = append(args, "--add-host", host)
+	}
+	for _, label := range formatLabels(options.Labels) {
+		args = append(args, "--label", label)
+	}
+	args = append(args, options.Image)
+	if len(options.Entrypoint) > 1 {
+		args = append(args, options.Entrypoint[1:]...)
+	}
+	args = append(args, options.Args...)
+
+	_, err := c.exec(ctx, args...)
+	return err
+}
+
+func (c *client) StartContainer(ctx context.Context, name string) error {
+	_, err := c.exec(ctx, "start", name)
+	return err
+}
+
+func (c *client) ContainerLogs(ctx context.Context, name string) (io.ReadCloser, error) {
+	reader, writer := io.Pipe()
+	cmd := exec.CommandContext(ctx, c.binary, "logs", "--follow", name)
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Wrap(err, "docker logs")
+	}
+	go func() {
+		_ = writer.CloseWithError(cmd.Wait())
+	}()
+	return reader, nil
+}
+
+func (c *client) WaitContainer(ctx context.Context, name string) (state ContainerState, err error) {
+	if _, err = c.exec(ctx, "wait", name); err != nil {
+		return state, err
+	}
+	out, err := c.exec(ctx, "inspect", "--format", "{{json .State}}", name)
+	if err != nil {
+		return state, err
+	}
+	err = json.Unmarshal(out, &state)
+	return state, errors.Wrap(err, "reading container state")
+}
+
+func (c *client) KillContainer(ctx context.Context, name string) error {
+	_, err := c.exec(ctx, "kill", name)
+	return err
+}
+
+func (c *client) RemoveContainer(ctx context.Context, name string) error {
+	_, err := c.exec(ctx, "rm", "--force", "--volumes", name)
+	return err
+}
+
+// InspectImage returns the image configuration along with the commands used to build its layers, latest first
+func (c *client) InspectImage(ctx context.Context, image string, pull bool) (config ImageConfig, history []string, err error) {
+	out, err := c.exec(ctx, "image", "inspect", "--format", "{{json .Config}}", image)
+	if err != nil && pull {
+		if _, err = c.exec(ctx, "pull", "--quiet", image); err != nil {
+			return config, nil, err
+		}
+		out, err = c.exec(ctx, "image", "inspect", "--format", "{{json .Config}}", image)
+	}
+	if err != nil {
+		return config, nil, err
+	}
+	if err = json.Unmarshal(out, &config); err != nil {
+		return config, nil, errors.Wrap(err, "reading image configuration")
+	}
+
+	out, err = c.exec(ctx, "history", "--no-trunc", "--format", "{{json .CreatedBy}}", image)
+	if err != nil {
+		return config, nil, err
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if v, err := strconv.Unquote(line); err == nil {
+			history = append(history, v)
+		}
+	}
+	return config, history, nil
+}
+
+func formatLabels(labels map[string]string) []string {
+	result := make([]string, 0, len(labels))
+	for k, v := range labels {
+		result = append(result, k+"="+v)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowdocker
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/testkube/pkg/imageinspector"
+)
+
+var shellRe = regexp.MustCompile(`/bin/([a-z]*)sh`)
+
+type inspector struct {
+	client Client
+	mu     sync.Mutex
+	cache  map[string]*imageinspector.Info
+}
+
+// NewInspector creates image inspector reading the images from the local Docker daemon,
+// using the credentials configured for it instead of the pull secrets
+func NewInspector(client Client) imageinspector.Inspector {
+	return &inspector{client: client, cache: make(map[string]*imageinspector.Info)}
+}
+
+func (i *inspector) Inspect(ctx context.Context, _, image string, pullPolicy corev1.PullPolicy, _ []string) (*imageinspector.Info, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if info, ok := i.cache[image]; ok {
+		return info, nil
+	}
+
+	config, history, err := i.client.InspectImage(ctx, image, pullPolicy != corev1.PullNever)
+	if err != nil {
+		return nil, errors.Wrapf(err, "inspecting '%s' image", image)
+	}
+
+	var shell string
+	for _, command := range history {
+		if shell = shellRe.FindString(command); shell != "" {
+			break
+		}
+	}
+
+	user, group := parseUser(config.User)
+	info := &imageinspector.Info{
+		FetchedAt:  time.Now(),
+		Entrypoint: config.Entrypoint,
+		Cmd:        config.Cmd,
+		Shell:      shell,
+		WorkingDir: config.WorkingDir,
+		User:       user,
+		Group:      group,
+	}
+	i.cache[image] = info
+	return info, nil
+}
+
+// parseUser reads numeric user and group from the image configuration, like "1001:1001"
+func parseUser(value string) (int64, int64) {
+	userStr, groupStr, _ := strings.Cut(value, ":")
+	if groupStr == "" {
+		groupStr = userStr
+	}
+	user, _ := strconv.Atoi(userStr)
+	group, _ := strconv.Atoi(groupStr)
+	return int64(user), int64(group)
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowexecutor
+
+import (
+	"encoding/json"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+)
+
+// mapOutputToArtifactsSummary reads the summary of the artifacts uploaded by the step
+func mapOutputToArtifactsSummary(output testkube.TestWorkflowOutput) (*testworkflowprocessor.ArtifactsSummary, error) {
+	b, err := json.Marshal(output.Value)
+	if err != nil {
+		return nil, err
+	}
+	var summary testworkflowprocessor.ArtifactsSummary
+	err = json.Unmarshal(b, &summary)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
@@ -11,61 +11,129 @@ package testworkflowexecutor
 import (
 	"bufio"
 	"context"
+	"fmt"
 	"io"
+	"os"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/client-go/kubernetes"
 
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	testworkflowsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
 	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
+	"github.com/kubeshop/testkube/internal/common"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/event"
+	"github.com/kubeshop/testkube/pkg/imageinspector"
+	"github.com/kubeshop/testkube/pkg/k8sclient"
 	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+	testworkflowmappers "github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
 	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
 	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowcontroller"
 	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowresolver"
+	"github.com/kubeshop/testkube/pkg/tracing"
 )
 
+// minServicesKubernetesVersion is the first Kubernetes version supporting native sidecar containers
+var minServicesKubernetesVersion = version.MajorMinor(1, 28)
+
 //go:generate mockgen -destination=./mock_executor.go -package=testworkflowexecutor "github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowexecutor" TestWorkflowExecutor
 type TestWorkflowExecutor interface {
-	Schedule(bundle *testworkflowprocessor.Bundle, execution testkube.TestWorkflowExecution)
+	Schedule(ctx context.Context, bundle *testworkflowprocessor.Bundle, execution testkube.TestWorkflowExecution)
 	Control(ctx context.Context, execution testkube.TestWorkflowExecution)
 	Recover(ctx context.Context)
+	Execute(ctx context.Context, workflow testworkflowsv1.TestWorkflow, request testkube.TestWorkflowExecutionRequest) (
+		execution testkube.TestWorkflowExecution, err error)
+	ExecuteParallel(ctx context.Context, parent testkube.TestWorkflowExecution, ref string, request testkube.TestWorkflowParallelExecutionRequest) (
+		execution testkube.TestWorkflowExecution, err error)
+	Abort(ctx context.Context, execution testkube.TestWorkflowExecution) error
+}
+
+// ExecutionMetrics records the metrics of the TestWorkflow executions
+type ExecutionMetrics interface {
+	ObserveTestWorkflowExecution(execution testkube.TestWorkflowExecution)
+	ObserveTestWorkflowStep(workflowName, stepName string, result testkube.TestWorkflowStepResult)
+	ObserveTestWorkflowScheduling(workflowName string, scheduledAt, podScheduledAt time.Time)
+	ObserveTestWorkflowArtifacts(workflowName string, size uint64)
 }
 
 type executor struct {
-	emitter    *event.Emitter
-	clientSet  kubernetes.Interface
-	repository testworkflow.Repository
-	output     testworkflow.OutputRepository
-	namespace  string
+	emitter                     *event.Emitter
+	clientSet                   kubernetes.Interface
+	repository                  testworkflow.Repository
+	output                      testworkflow.OutputRepository
+	testWorkflowTemplatesClient testworkflowsclientv1.TestWorkflowTemplatesInterface
+	imageInspector              imageinspector.Inspector
+	executionResults            result.Repository
+	metrics                     ExecutionMetrics
+	namespace                   string
+	apiUrl                      string
 }
 
-func New(emitter *event.Emitter, clientSet kubernetes.Interface, repository testworkflow.Repository, output testworkflow.OutputRepository, namespace string) TestWorkflowExecutor {
+func New(emitter *event.Emitter,
+	clientSet kubernetes.Interface,
+	repository testworkflow.Repository,
+	output testworkflow.OutputRepository,
+	testWorkflowTemplatesClient testworkflowsclientv1.TestWorkflowTemplatesInterface,
+	imageInspector imageinspector.Inspector,
+	executionResults result.Repository,
+	metrics ExecutionMetrics,
+	namespace, apiUrl string) TestWorkflowExecutor {
 	return &executor{
-		emitter:    emitter,
-		clientSet:  clientSet,
-		repository: repository,
-		output:     output,
-		namespace:  namespace,
+		emitter:                     emitter,
+		clientSet:                   clientSet,
+		repository:                  repository,
+		output:                      output,
+		testWorkflowTemplatesClient: testWorkflowTemplatesClient,
+		imageInspector:              imageInspector,
+		executionResults:            executionResults,
+		metrics:                     metrics,
+		namespace:                   namespace,
+		apiUrl:                      apiUrl,
 	}
 }
 
-func (e *executor) Schedule(bundle *testworkflowprocessor.Bundle, execution testkube.TestWorkflowExecution) {
+func (e *executor) Schedule(ctx context.Context, bundle *testworkflowprocessor.Bundle, execution testkube.TestWorkflowExecution) {
 	// Inform about execution start
 	e.emitter.Notify(testkube.NewEventQueueTestWorkflow(&execution))
 
+	// Trace the whole execution, detached from the request lifetime
+	_, span := tracing.Start(ctx, "TestWorkflowExecutor.Run",
+		tracing.ExecutionIDKey.String(execution.Id),
+		tracing.ExecutionNameKey.String(execution.Name),
+		tracing.TestWorkflowNameKey.String(execution.Workflow.Name))
+	ctx = trace.ContextWithSpan(context.Background(), span)
+
+	// Continue the trace in the execution pod
+	tracing.InjectPodSpec(ctx, &bundle.Job.Spec.Template.Spec)
+
 	// Deploy required resources
-	err := e.Deploy(context.Background(), bundle)
+	deployCtx, deploySpan := tracing.Start(ctx, "TestWorkflowExecutor.Deploy")
+	err := e.Deploy(deployCtx, bundle)
+	tracing.End(deploySpan, err)
 	if err != nil {
+		tracing.End(span, err)
 		e.handleFatalError(execution, err, time.Time{})
+		go e.cleanup(context.Background(), execution)
 		return
 	}
 
 	// Start to control the results
-	go e.Control(context.Background(), execution)
+	go func() {
+		defer span.End()
+		e.Control(ctx, execution)
+	}()
 }
 
 func (e *executor) Deploy(ctx context.Context, bundle *testworkflowprocessor.Bundle) (err error) {
@@ -105,7 +173,36 @@ func (e *executor) handleFatalError(execution testkube.TestWorkflowExecution, er
 		log.DefaultLogger.Errorf("failed to save fatal error for execution %s: %v", execution.Id, err)
 	}
 	e.emitter.Notify(testkube.NewEventEndTestWorkflowFailed(&execution))
-	go testworkflowcontroller.Cleanup(context.Background(), e.clientSet, e.namespace, execution.Id)
+}
+
+// Abort stops the execution, keeping the logs of its services
+func (e *executor) Abort(ctx context.Context, execution testkube.TestWorkflowExecution) error {
+	ctrl, err := testworkflowcontroller.New(ctx, e.clientSet, e.namespace, execution.Id, execution.ScheduledAt)
+	if err != nil {
+		return errors.Wrap(err, "fetching job")
+	}
+	e.saveServiceLogs(ctx, execution)
+	return ctrl.Abort(ctx)
+}
+
+// saveServiceLogs stores the logs of the services, so it has to be called before the resources are deleted
+func (e *executor) saveServiceLogs(ctx context.Context, execution testkube.TestWorkflowExecution) {
+	err := testworkflowcontroller.SaveServiceLogs(ctx, e.clientSet, e.namespace, execution.Id, func(name string, reader io.Reader) error {
+		return e.output.SaveLog(context.Background(), testworkflowprocessor.ServiceLogId(execution.Id, name), execution.Workflow.Name, reader)
+	})
+	if err != nil {
+		log.DefaultLogger.Errorw("failed to save TestWorkflow services log output", "id", execution.Id, "error", err)
+	}
+}
+
+// cleanup stops the executions of the parallel steps, and deletes the resources after storing the services' logs
+func (e *executor) cleanup(ctx context.Context, execution testkube.TestWorkflowExecution) {
+	e.abortChildren(ctx, execution)
+	e.saveServiceLogs(ctx, execution)
+	err := testworkflowcontroller.Cleanup(ctx, e.clientSet, e.namespace, execution.Id)
+	if err != nil {
+		log.DefaultLogger.Errorw("failed to cleanup TestWorkflow resources", "id", execution.Id, "error", err)
+	}
 }
 
 func (e *executor) Recover(ctx context.Context) {
@@ -113,8 +210,15 @@ func (e *executor) Recover(ctx context.Context) {
 	if err != nil {
 		return
 	}
+	// Control all the executions at once, as the parallel steps are waiting for their children
 	for _, execution := range list {
-		e.Control(context.Background(), execution)
+		go func(execution testkube.TestWorkflowExecution) {
+			ctx, span := tracing.Start(context.Background(), "TestWorkflowExecutor.Recover",
+				tracing.ExecutionIDKey.String(execution.Id),
+				tracing.ExecutionNameKey.String(execution.Name))
+			e.Control(ctx, execution)
+			span.End()
+		}(execution)
 	}
 }
 
@@ -122,6 +226,7 @@ func (e *executor) Control(ctx context.Context, execution testkube.TestWorkflowE
 	ctrl, err := testworkflowcontroller.New(ctx, e.clientSet, e.namespace, execution.Id, execution.ScheduledAt)
 	if err != nil {
 		e.handleFatalError(execution, err, time.Time{})
+		e.cleanup(ctx, execution)
 		return
 	}
 
@@ -139,9 +244,36 @@ func (e *executor) Control(ctx context.Context, execution testkube.TestWorkflowE
 			if v.Error != nil {
 				continue
 			}
-			if v.Value.Output != nil {
+			if v.Value.Output != nil && v.Value.Output.Name == testworkflowprocessor.ReportOutputName {
+				var err error
+				execution.Reports, err = appendReport(execution.Reports, *v.Value.Output.ToInternal())
+				if err != nil {
+					log.DefaultLogger.Warnw("invalid test report passed from TestWorkflow", "id", execution.Id, "error", err)
+				}
+			} else if v.Value.Output != nil && v.Value.Output.Name == testworkflowprocessor.ApprovalOutputName {
+				// The approval may be already known, when the execution has been recovered
+				approval := *v.Value.Output.ToInternal()
+				if !hasOutput(execution.Output, approval.Ref, approval.Name) {
+					execution.Output = append(execution.Output, approval)
+					err := e.repository.UpdateOutput(ctx, execution.Id, execution.Output)
+					if err != nil {
+						log.DefaultLogger.Error(errors.Wrap(err, "error saving test workflow approval request"))
+					}
+					e.emitter.Notify(testkube.NewEventPauseTestWorkflow(&execution, &approval))
+				}
+			} else if v.Value.Output != nil && v.Value.Output.Name == testworkflowprocessor.ArtifactsOutputName {
+				// The artifacts may be already known, when the execution has been recovered
+				artifacts := *v.Value.Output.ToInternal()
+				if !hasOutput(execution.Output, artifacts.Ref, artifacts.Name) {
+					execution.Output = append(execution.Output, artifacts)
+					if summary, err := mapOutputToArtifactsSummary(artifacts); err == nil {
+						e.metrics.ObserveTestWorkflowArtifacts(execution.Workflow.Name, summary.Size)
+					}
+				}
+			} else if v.Value.Output != nil {
 				execution.Output = append(execution.Output, *v.Value.Output.ToInternal())
 			} else if v.Value.Result != nil {
+				e.observeResult(execution, v.Value.Result)
 				execution.Result = v.Value.Result
 				if execution.Result.IsFinished() {
 					execution.StatusAt = execution.Result.FinishedAt
@@ -208,7 +340,14 @@ func (e *executor) Control(ctx context.Context, execution testkube.TestWorkflowE
 
 		// TODO: Consider AppendOutput ($push) instead
 		_ = e.repository.UpdateOutput(ctx, execution.Id, execution.Output)
+		if len(execution.Reports) > 0 {
+			err = e.repository.UpdateReports(ctx, execution.Id, execution.Reports)
+			if err != nil {
+				log.DefaultLogger.Errorw("failed to save TestWorkflow test reports", "id", execution.Id, "error", err)
+			}
+		}
 		if execution.Result.IsFinished() {
+			e.metrics.ObserveTestWorkflowExecution(execution)
 			if execution.Result.IsPassed() {
 				e.emitter.Notify(testkube.NewEventEndTestWorkflowSuccess(&execution))
 			} else if execution.Result.IsAborted() {
@@ -227,8 +366,221 @@ func (e *executor) Control(ctx context.Context, execution testkube.TestWorkflowE
 
 	wg.Wait()
 
-	err = testworkflowcontroller.Cleanup(ctx, e.clientSet, e.namespace, execution.Id)
+	e.cleanup(ctx, execution)
+}
+
+// observeResult records the metrics for the changes in the execution result
+func (e *executor) observeResult(execution testkube.TestWorkflowExecution, next *testkube.TestWorkflowResult) {
+	prev := execution.Result
+	if prev == nil {
+		prev = &testkube.TestWorkflowResult{}
+	}
+	if prev.StartedAt.IsZero() && !next.StartedAt.IsZero() {
+		e.metrics.ObserveTestWorkflowScheduling(execution.Workflow.Name, execution.ScheduledAt, next.StartedAt)
+	}
+	walkSignature(execution.Signature, func(sig testkube.TestWorkflowSignature) {
+		step, ok := next.Steps[sig.Ref]
+		if !ok || step.Status == nil || !step.Status.Finished() {
+			return
+		}
+		if prevStep, ok := prev.Steps[sig.Ref]; ok && prevStep.Status != nil && prevStep.Status.Finished() {
+			return
+		}
+		name := sig.Name
+		if name == "" {
+			name = sig.Category
+		}
+		e.metrics.ObserveTestWorkflowStep(execution.Workflow.Name, name, step)
+	})
+}
+
+// walkSignature calls the function for each step in the signature, including the nested ones
+func walkSignature(sig []testkube.TestWorkflowSignature, fn func(sig testkube.TestWorkflowSignature)) {
+	for _, s := range sig {
+		fn(s)
+		walkSignature(s.Children, fn)
+	}
+}
+
+// hasOutput checks if the output of the step has been already stored
+func hasOutput(outputs []testkube.TestWorkflowOutput, ref, name string) bool {
+	for i := range outputs {
+		if outputs[i].Ref == ref && outputs[i].Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *executor) Execute(ctx context.Context, workflow testworkflowsv1.TestWorkflow, request testkube.TestWorkflowExecutionRequest) (
+	execution testkube.TestWorkflowExecution, err error) {
+	ctx, span := tracing.Start(ctx, "TestWorkflowExecutor.Execute", tracing.TestWorkflowNameKey.String(workflow.Name))
+	defer func() {
+		span.SetAttributes(tracing.ExecutionIDKey.String(execution.Id), tracing.ExecutionNameKey.String(execution.Name))
+		tracing.End(span, err)
+	}()
+
+	// Delete unnecessary data
+	delete(workflow.Annotations, "kubectl.kubernetes.io/last-applied-configuration")
+
+	// Load execution identifier data
+	// TODO: Consider if that should not be shared (as now it is between Tests and Test Suites)
+	number, _ := e.executionResults.GetNextExecutionNumber(context.Background(), workflow.Name)
+	executionName := request.Name
+	if executionName == "" {
+		executionName = fmt.Sprintf("%s-%d", workflow.Name, number)
+	}
+
+	// Ensure it is unique name
+	// TODO: Consider if we shouldn't make name unique across all TestWorkflows
+	next, _ := e.repository.GetByNameAndTestWorkflow(ctx, executionName, workflow.Name)
+	if next.Name == executionName {
+		return execution, errors.Wrap(errors.New(executionName), "execution name already exists")
+	}
+
+	// Build the execution
+	prepareCtx, prepareSpan := tracing.Start(ctx, "TestWorkflowExecutor.Prepare")
+	execution, bundle, err := e.prepare(prepareCtx, workflow, request, executionName, number)
+	tracing.End(prepareSpan, err)
 	if err != nil {
-		log.DefaultLogger.Errorw("failed to cleanup TestWorkflow resources", "id", execution.Id, "error", err)
+		return execution, err
+	}
+
+	err = e.repository.Insert(ctx, execution)
+	if err != nil {
+		return execution, errors.Wrap(err, "inserting execution to storage")
+	}
+
+	// Schedule the execution
+	e.Schedule(ctx, bundle, execution)
+	return execution, nil
+}
+
+// prepare resolves and processes the TestWorkflow, building the Execution entity and its resources
+func (e *executor) prepare(ctx context.Context, workflow testworkflowsv1.TestWorkflow, request testkube.TestWorkflowExecutionRequest,
+	executionName string, number int32, machines ...expressionstcl.Machine) (execution testkube.TestWorkflowExecution, bundle *testworkflowprocessor.Bundle, err error) {
+	// Preserve initial workflow
+	initialWorkflow := workflow.DeepCopy()
+
+	// Fetch the templates
+	tpls := testworkflowresolver.ListTemplates(&workflow)
+	tplsMap := make(map[string]testworkflowsv1.TestWorkflowTemplate, len(tpls))
+	for tplName := range tpls {
+		tpl, err := e.testWorkflowTemplatesClient.Get(tplName)
+		if err != nil {
+			return execution, nil, errors.Wrap(err, "fetching error")
+		}
+		tplsMap[tplName] = *tpl
+	}
+
+	// Apply the configuration
+	_, err = testworkflowresolver.ApplyWorkflowConfig(&workflow, testworkflowmappers.MapConfigValueAPIToKube(request.Config))
+	if err != nil {
+		return execution, nil, errors.Wrap(err, "configuration")
+	}
+
+	// Resolve the TestWorkflow
+	err = testworkflowresolver.ApplyTemplates(&workflow, tplsMap)
+	if err != nil {
+		return execution, nil, errors.Wrap(err, "resolving error")
+	}
+
+	// Build the basic Execution data
+	id := primitive.NewObjectID().Hex()
+	now := time.Now()
+	machine := CreateExecutionMachine(e.apiUrl, e.namespace, workflow.Name, id)
+
+	// Ensure the services may run as the sidecars in the cluster
+	if len(workflow.Spec.Services) > 0 {
+		if err = e.checkServicesSupport(); err != nil {
+			return execution, nil, err
+		}
+	}
+
+	// Preserve resolved TestWorkflow
+	resolvedWorkflow := workflow.DeepCopy()
+
+	// Process the TestWorkflow
+	bundle, err = testworkflowprocessor.NewFullFeatured(e.imageInspector).
+		Bundle(ctx, &workflow, append([]expressionstcl.Machine{machine}, machines...)...)
+	if err != nil {
+		return execution, nil, errors.Wrap(err, "processing error")
+	}
+
+	// Build Execution entity
+	execution = testkube.TestWorkflowExecution{
+		Id:          id,
+		Name:        executionName,
+		Number:      number,
+		ScheduledAt: now,
+		StatusAt:    now,
+		Signature:   testworkflowprocessor.MapSignatureListToInternal(bundle.Signature),
+		Result: &testkube.TestWorkflowResult{
+			Status:          common.Ptr(testkube.QUEUED_TestWorkflowStatus),
+			PredictedStatus: common.Ptr(testkube.PASSED_TestWorkflowStatus),
+			Initialization: &testkube.TestWorkflowStepResult{
+				Status: common.Ptr(testkube.QUEUED_TestWorkflowStepStatus),
+			},
+			Steps: testworkflowprocessor.MapSignatureListToStepResults(bundle.Signature),
+		},
+		Output:           []testkube.TestWorkflowOutput{},
+		Config:           request.Config,
+		Workflow:         testworkflowmappers.MapKubeToAPI(initialWorkflow),
+		ResolvedWorkflow: testworkflowmappers.MapKubeToAPI(resolvedWorkflow),
 	}
+	return execution, bundle, nil
+}
+
+// checkServicesSupport verifies if the cluster supports native sidecar containers, that the services are built on.
+// They are available since Kubernetes 1.28 with SidecarContainers feature gate, and enabled by default since 1.29.
+func (e *executor) checkServicesSupport() error {
+	clusterVersion, err := k8sclient.GetClusterVersion(e.clientSet)
+	if err != nil {
+		log.DefaultLogger.Warnw("failed to detect Kubernetes version for TestWorkflow services", "error", err)
+		return nil
+	}
+	v, err := version.ParseGeneric(clusterVersion)
+	if err != nil {
+		log.DefaultLogger.Warnw("failed to parse Kubernetes version for TestWorkflow services", "version", clusterVersion, "error", err)
+		return nil
+	}
+	if !v.AtLeast(minServicesKubernetesVersion) {
+		return fmt.Errorf("services require Kubernetes %s+ with native sidecar containers, but the cluster is %s", minServicesKubernetesVersion, clusterVersion)
+	}
+	return nil
+}
+
+// CreateExecutionMachine exposes the "internal.<name>", "workflow.<name>" and "execution.<name>" variables of the execution
+func CreateExecutionMachine(apiUrl, namespace, workflowName, executionId string) expressionstcl.Machine {
+	return expressionstcl.NewMachine().
+		RegisterStringMap("internal", map[string]string{
+			"storage.type":       common.GetOr(os.Getenv("STORAGE_TYPE"), storage.TypeMinio),
+			"storage.url":        os.Getenv("STORAGE_ENDPOINT"),
+			"storage.accessKey":  os.Getenv("STORAGE_ACCESSKEYID"),
+			"storage.secretKey":  os.Getenv("STORAGE_SECRETACCESSKEY"),
+			"storage.region":     os.Getenv("STORAGE_REGION"),
+			"storage.bucket":     os.Getenv("STORAGE_BUCKET"),
+			"storage.token":      os.Getenv("STORAGE_TOKEN"),
+			"storage.ssl":        common.GetOr(os.Getenv("STORAGE_SSL"), "false"),
+			"storage.skipVerify": common.GetOr(os.Getenv("STORAGE_SKIP_VERIFY"), "false"),
+			"storage.certFile":   os.Getenv("STORAGE_CERT_FILE"),
+			"storage.keyFile":    os.Getenv("STORAGE_KEY_FILE"),
+			"storage.caFile":     os.Getenv("STORAGE_CA_FILE"),
+
+			"cloud.enabled":         strconv.FormatBool(os.Getenv("TESTKUBE_PRO_API_KEY") != "" || os.Getenv("TESTKUBE_CLOUD_API_KEY") != ""),
+			"cloud.api.key":         common.GetOr(os.Getenv("TESTKUBE_PRO_API_KEY"), os.Getenv("TESTKUBE_CLOUD_API_KEY")),
+			"cloud.api.tlsInsecure": common.GetOr(os.Getenv("TESTKUBE_PRO_TLS_INSECURE"), os.Getenv("TESTKUBE_CLOUD_TLS_INSECURE"), "false"),
+			"cloud.api.skipVerify":  common.GetOr(os.Getenv("TESTKUBE_PRO_SKIP_VERIFY"), os.Getenv("TESTKUBE_CLOUD_SKIP_VERIFY"), "false"),
+			"cloud.api.url":         common.GetOr(os.Getenv("TESTKUBE_PRO_URL"), os.Getenv("TESTKUBE_CLOUD_URL")),
+
+			"dashboard.url": os.Getenv("TESTKUBE_DASHBOARD_URI"),
+			"api.url":       apiUrl,
+			"namespace":     namespace,
+		}).
+		RegisterStringMap("workflow", map[string]string{
+			"name": workflowName,
+		}).
+		RegisterStringMap("execution", map[string]string{
+			"id": executionId,
+		})
 }
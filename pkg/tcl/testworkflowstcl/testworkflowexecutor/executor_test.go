@@ -0,0 +1,101 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowexecutor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	k8sversion "k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+type fakeMetrics struct {
+	steps      []string
+	scheduling []time.Duration
+}
+
+func (f *fakeMetrics) ObserveTestWorkflowExecution(execution testkube.TestWorkflowExecution) {}
+
+func (f *fakeMetrics) ObserveTestWorkflowStep(workflowName, stepName string, result testkube.TestWorkflowStepResult) {
+	f.steps = append(f.steps, workflowName+"/"+stepName)
+}
+
+func (f *fakeMetrics) ObserveTestWorkflowScheduling(workflowName string, scheduledAt, podScheduledAt time.Time) {
+	f.scheduling = append(f.scheduling, podScheduledAt.Sub(scheduledAt))
+}
+
+func (f *fakeMetrics) ObserveTestWorkflowArtifacts(workflowName string, size uint64) {}
+
+func TestExecutor_ObserveResult(t *testing.T) {
+	metrics := &fakeMetrics{}
+	e := &executor{metrics: metrics}
+	scheduledAt := time.Now()
+	execution := testkube.TestWorkflowExecution{
+		ScheduledAt: scheduledAt,
+		Workflow:    &testkube.TestWorkflow{Name: "e2e"},
+		Signature: []testkube.TestWorkflowSignature{
+			{Ref: "r1", Name: "Setup"},
+			{Ref: "r2", Category: "Run tests", Children: []testkube.TestWorkflowSignature{
+				{Ref: "r3", Name: "Shard"},
+			}},
+		},
+	}
+	running := &testkube.TestWorkflowResult{
+		StartedAt: scheduledAt.Add(5 * time.Second),
+		Steps: map[string]testkube.TestWorkflowStepResult{
+			"r1": {Status: common.Ptr(testkube.PASSED_TestWorkflowStepStatus)},
+			"r2": {Status: common.Ptr(testkube.RUNNING_TestWorkflowStepStatus)},
+			"r3": {Status: common.Ptr(testkube.RUNNING_TestWorkflowStepStatus)},
+		},
+	}
+	finished := &testkube.TestWorkflowResult{
+		StartedAt: running.StartedAt,
+		Steps: map[string]testkube.TestWorkflowStepResult{
+			"r1": {Status: common.Ptr(testkube.PASSED_TestWorkflowStepStatus)},
+			"r2": {Status: common.Ptr(testkube.FAILED_TestWorkflowStepStatus)},
+			"r3": {Status: common.Ptr(testkube.FAILED_TestWorkflowStepStatus)},
+		},
+	}
+
+	e.observeResult(execution, running)
+	execution.Result = running
+	e.observeResult(execution, finished)
+
+	assert.Equal(t, []string{"e2e/Setup", "e2e/Run tests", "e2e/Shard"}, metrics.steps)
+	assert.Equal(t, []time.Duration{5 * time.Second}, metrics.scheduling)
+}
+
+func TestCheckServicesSupport(t *testing.T) {
+	tests := map[string]bool{
+		"v1.27.3":         false,
+		"v1.28.0":         true,
+		"v1.29.2-eks-123": true,
+	}
+	for gitVersion, supported := range tests {
+		t.Run(gitVersion, func(t *testing.T) {
+			clientSet := fake.NewSimpleClientset()
+			clientSet.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &k8sversion.Info{GitVersion: gitVersion}
+			e := &executor{clientSet: clientSet}
+
+			err := e.checkServicesSupport()
+
+			if supported {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, "services require Kubernetes 1.28+")
+			}
+		})
+	}
+}
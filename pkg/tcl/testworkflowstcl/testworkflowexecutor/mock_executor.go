@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
 	testkube "github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	testworkflowprocessor "github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowprocessor"
 )
@@ -36,6 +37,20 @@ func (m *MockTestWorkflowExecutor) EXPECT() *MockTestWorkflowExecutorMockRecorde
 	return m.recorder
 }
 
+// Abort mocks base method.
+func (m *MockTestWorkflowExecutor) Abort(arg0 context.Context, arg1 testkube.TestWorkflowExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Abort", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Abort indicates an expected call of Abort.
+func (mr *MockTestWorkflowExecutorMockRecorder) Abort(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abort", reflect.TypeOf((*MockTestWorkflowExecutor)(nil).Abort), arg0, arg1)
+}
+
 // Control mocks base method.
 func (m *MockTestWorkflowExecutor) Control(arg0 context.Context, arg1 testkube.TestWorkflowExecution) {
 	m.ctrl.T.Helper()
@@ -48,6 +63,36 @@ func (mr *MockTestWorkflowExecutorMockRecorder) Control(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Control", reflect.TypeOf((*MockTestWorkflowExecutor)(nil).Control), arg0, arg1)
 }
 
+// Execute mocks base method.
+func (m *MockTestWorkflowExecutor) Execute(arg0 context.Context, arg1 v1.TestWorkflow, arg2 testkube.TestWorkflowExecutionRequest) (testkube.TestWorkflowExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1, arg2)
+	ret0, _ := ret[0].(testkube.TestWorkflowExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockTestWorkflowExecutorMockRecorder) Execute(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockTestWorkflowExecutor)(nil).Execute), arg0, arg1, arg2)
+}
+
+// ExecuteParallel mocks base method.
+func (m *MockTestWorkflowExecutor) ExecuteParallel(arg0 context.Context, arg1 testkube.TestWorkflowExecution, arg2 string, arg3 testkube.TestWorkflowParallelExecutionRequest) (testkube.TestWorkflowExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteParallel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(testkube.TestWorkflowExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteParallel indicates an expected call of ExecuteParallel.
+func (mr *MockTestWorkflowExecutorMockRecorder) ExecuteParallel(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteParallel", reflect.TypeOf((*MockTestWorkflowExecutor)(nil).ExecuteParallel), arg0, arg1, arg2, arg3)
+}
+
 // Recover mocks base method.
 func (m *MockTestWorkflowExecutor) Recover(arg0 context.Context) {
 	m.ctrl.T.Helper()
@@ -61,13 +106,13 @@ func (mr *MockTestWorkflowExecutorMockRecorder) Recover(arg0 interface{}) *gomoc
 }
 
 // Schedule mocks base method.
-func (m *MockTestWorkflowExecutor) Schedule(arg0 *testworkflowprocessor.Bundle, arg1 testkube.TestWorkflowExecution) {
+func (m *MockTestWorkflowExecutor) Schedule(arg0 context.Context, arg1 *testworkflowprocessor.Bundle, arg2 testkube.TestWorkflowExecution) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Schedule", arg0, arg1)
+	m.ctrl.Call(m, "Schedule", arg0, arg1, arg2)
 }
 
 // Schedule indicates an expected call of Schedule.
-func (mr *MockTestWorkflowExecutorMockRecorder) Schedule(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTestWorkflowExecutorMockRecorder) Schedule(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockTestWorkflowExecutor)(nil).Schedule), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockTestWorkflowExecutor)(nil).Schedule), arg0, arg1, arg2)
 }
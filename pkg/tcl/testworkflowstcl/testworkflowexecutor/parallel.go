@@ -0,0 +1,103 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowexecutor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	testworkflowmappers "github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowresolver"
+	"github.com/kubeshop/testkube/pkg/tracing"
+)
+
+// ExecuteParallel schedules the child execution for a single combination of the parallel step
+func (e *executor) ExecuteParallel(ctx context.Context, parent testkube.TestWorkflowExecution, ref string,
+	request testkube.TestWorkflowParallelExecutionRequest) (execution testkube.TestWorkflowExecution, err error) {
+	ctx, span := tracing.Start(ctx, "TestWorkflowExecutor.ExecuteParallel", tracing.ExecutionIDKey.String(parent.Id))
+	defer tracing.End(span, err)
+
+	if parent.ResolvedWorkflow == nil || parent.ResolvedWorkflow.Spec == nil {
+		return execution, errors.New("parent execution has no resolved test workflow")
+	}
+	workflow := buildParallelWorkflow(*testworkflowmappers.MapAPIToKube(parent.ResolvedWorkflow), request.Steps)
+
+	// Ensure it is unique name
+	executionName := fmt.Sprintf("%s-%s-%d", parent.Name, ref, request.Index+1)
+	next, _ := e.repository.GetByNameAndTestWorkflow(ctx, executionName, workflow.Name)
+	if next.Name == executionName {
+		return execution, errors.Wrap(errors.New(executionName), "execution name already exists")
+	}
+
+	// Build the execution with the combination values available for the expressions
+	combination := testworkflowresolver.ParallelCombination{
+		Index:      int(request.Index),
+		Matrix:     request.Matrix,
+		ShardIndex: int(request.ShardIndex),
+		ShardCount: int(request.ShardCount),
+	}
+	childRequest := testkube.TestWorkflowExecutionRequest{Name: executionName, Config: parent.Config}
+	execution, bundle, err := e.prepare(ctx, workflow, childRequest, executionName, parent.Number, combination.Machine())
+	if err != nil {
+		return execution, err
+	}
+	execution.ParentExecutionId = parent.Id
+
+	err = e.repository.Insert(ctx, execution)
+	if err != nil {
+		return execution, errors.Wrap(err, "inserting execution to storage")
+	}
+
+	// Schedule the execution
+	e.Schedule(ctx, bundle, execution)
+	return execution, nil
+}
+
+// buildParallelWorkflow builds the TestWorkflow to run the parallel steps,
+// with the same configuration of the content, containers and pod as the parent one
+func buildParallelWorkflow(parent testworkflowsv1.TestWorkflow, steps []testkube.TestWorkflowStep) testworkflowsv1.TestWorkflow {
+	return testworkflowsv1.TestWorkflow{
+		TypeMeta: parent.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      parent.Name,
+			Namespace: parent.Namespace,
+			Labels:    parent.Labels,
+		},
+		Description: parent.Description,
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: parent.Spec.TestWorkflowSpecBase,
+			Steps:                common.MapSlice(steps, testworkflowmappers.MapStepAPIToKube),
+		},
+	}
+}
+
+// abortChildren stops the executions spawned by the parallel steps, that are still running
+func (e *executor) abortChildren(ctx context.Context, execution testkube.TestWorkflowExecution) {
+	list, err := e.repository.GetRunning(ctx)
+	if err != nil {
+		log.DefaultLogger.Errorw("failed to get running TestWorkflow executions", "id", execution.Id, "error", err)
+		return
+	}
+	for _, child := range list {
+		if child.ParentExecutionId != execution.Id {
+			continue
+		}
+		err = e.Abort(ctx, child)
+		if err != nil {
+			log.DefaultLogger.Errorw("failed to abort parallel TestWorkflow execution", "id", child.Id, "parentId", execution.Id, "error", err)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowexecutor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestBuildParallelWorkflow(t *testing.T) {
+	parent := testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "example",
+			Namespace:   "testkube",
+			Labels:      map[string]string{"team": "qa"},
+			Annotations: map[string]string{"some": "annotation"},
+		},
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Container: &testworkflowsv1.ContainerConfig{Image: "node:21"},
+				Pod:       &testworkflowsv1.PodConfig{ServiceAccountName: "runner"},
+			},
+			Setup: []testworkflowsv1.Step{{StepBase: testworkflowsv1.StepBase{Shell: "npm ci"}}},
+			Steps: []testworkflowsv1.Step{{Parallel: &testworkflowsv1.StepParallel{}}},
+			After: []testworkflowsv1.Step{{StepBase: testworkflowsv1.StepBase{Shell: "cleanup"}}},
+		},
+	}
+	steps := []testkube.TestWorkflowStep{{Shell: "npm test -- --browser {{matrix.browser}}"}}
+	want := testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example",
+			Namespace: "testkube",
+			Labels:    map[string]string{"team": "qa"},
+		},
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: parent.Spec.TestWorkflowSpecBase,
+			Steps:                []testworkflowsv1.Step{{StepBase: testworkflowsv1.StepBase{Shell: "npm test -- --browser {{matrix.browser}}"}}},
+		},
+	}
+
+	got := buildParallelWorkflow(parent, steps)
+
+	assert.Equal(t, want, got)
+}
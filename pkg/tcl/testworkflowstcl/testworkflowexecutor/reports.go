@@ -0,0 +1,66 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowexecutor
+
+import (
+	"encoding/json"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/reports"
+)
+
+// appendReport adds the test report emitted by the step to the list,
+// the next parts of the same report are merged into the previous one
+func appendReport(list []testkube.TestWorkflowReport, output testkube.TestWorkflowOutput) ([]testkube.TestWorkflowReport, error) {
+	b, err := json.Marshal(output.Value)
+	if err != nil {
+		return list, err
+	}
+	var report reports.Report
+	err = json.Unmarshal(b, &report)
+	if err != nil {
+		return list, err
+	}
+	next := mapReport(output.Ref, report)
+	last := len(list) - 1
+	if report.Part > 0 && last >= 0 && list[last].Ref == next.Ref && list[last].File == next.File {
+		list[last].Cases = append(list[last].Cases, next.Cases...)
+		list[last].Summary.Add(next.Summary)
+		return list, nil
+	}
+	return append(list, next), nil
+}
+
+// mapReport converts the test report into the API model
+func mapReport(ref string, report reports.Report) testkube.TestWorkflowReport {
+	cases := make([]testkube.TestWorkflowReportCase, len(report.Cases))
+	for i, c := range report.Cases {
+		cases[i] = testkube.TestWorkflowReportCase{
+			Name:       c.Name,
+			Classname:  c.ClassName,
+			Status:     string(c.Status),
+			DurationMs: c.DurationMs,
+			Message:    c.Message,
+		}
+	}
+	return testkube.TestWorkflowReport{
+		Ref:  ref,
+		Kind: string(report.Format),
+		File: report.File,
+		Summary: &testkube.TestWorkflowReportSummary{
+			Tests:      int32(report.Summary.Tests),
+			Passed:     int32(report.Summary.Passed),
+			Failed:     int32(report.Summary.Failed),
+			Skipped:    int32(report.Summary.Skipped),
+			Errored:    int32(report.Summary.Errored),
+			DurationMs: report.Summary.DurationMs,
+		},
+		Cases: cases,
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowexecutor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/reports"
+)
+
+func TestAppendReport(t *testing.T) {
+	report := &reports.Report{Format: reports.FormatJUnit, File: "report.xml", Cases: []reports.Case{
+		{Name: "a", Status: reports.StatusPassed},
+		{Name: "b", Status: reports.StatusFailed},
+		{Name: "c", Status: reports.StatusPassed},
+	}}
+	report.Summary = reports.Summarize(report.Cases)
+
+	var err error
+	list := []testkube.TestWorkflowReport(nil)
+	for _, chunk := range report.Chunks(2) {
+		list, err = appendReport(list, testkube.TestWorkflowOutput{Ref: "r1", Name: "report", Value: map[string]interface{}{
+			"format": chunk.Format, "file": chunk.File, "part": chunk.Part, "summary": chunk.Summary, "cases": chunk.Cases,
+		}})
+		assert.NoError(t, err)
+	}
+	list, err = appendReport(list, testkube.TestWorkflowOutput{Ref: "r2", Name: "report", Value: map[string]interface{}{
+		"format": "tap", "file": "report.tap",
+	}})
+	assert.NoError(t, err)
+
+	assert.Len(t, list, 2)
+	assert.Len(t, list[0].Cases, 3)
+	assert.Equal(t, &testkube.TestWorkflowReportSummary{Tests: 3, Passed: 2, Failed: 1}, list[0].Summary)
+	assert.Equal(t, "r2", list[1].Ref)
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+)
+
+const (
+	// ApprovalDecisionsAnnotationName is the pod annotation with JSON map of step references and approval decisions
+	ApprovalDecisionsAnnotationName = "testworkflows.testkube.io/approval-decisions"
+	// ApprovalOutputName is the name of the output emitted by the step, when it starts waiting for the approval
+	ApprovalOutputName = "approval"
+
+	ApprovalActionApprove = string(testworkflowsv1.ApprovalActionApprove)
+	ApprovalActionReject  = string(testworkflowsv1.ApprovalActionReject)
+)
+
+// ApprovalInput is the value collected from the approver, available later as "approval.<name>"
+type ApprovalInput = testworkflowsv1.ApprovalInput
+
+// ApprovalRequest is the output emitted by the step waiting for the approval
+type ApprovalRequest struct {
+	Message  string          `json:"message,omitempty"`
+	Default  string          `json:"default"`
+	Deadline *time.Time      `json:"deadline,omitempty"`
+	Inputs   []ApprovalInput `json:"inputs,omitempty"`
+}
+
+// approvalCategory is the category of the stage waiting for the approval
+const approvalCategory = "Wait for approval"
+
+func ProcessApproval(_ InternalProcessor, layer Intermediate, container Container, step testworkflowsv1.Step) (Stage, error) {
+	if step.Approval == nil {
+		return nil, nil
+	}
+	approval := step.Approval
+	action := string(approval.Default)
+	if action == "" {
+		action = ApprovalActionReject
+	}
+	if action != ApprovalActionApprove && action != ApprovalActionReject {
+		return nil, fmt.Errorf("approval: default action should be either %s or %s", ApprovalActionApprove, ApprovalActionReject)
+	}
+	if approval.Timeout != "" {
+		if _, err := time.ParseDuration(approval.Timeout); err != nil {
+			return nil, fmt.Errorf("approval: invalid timeout: %s", err.Error())
+		}
+	}
+	for i, input := range approval.Inputs {
+		if input.Name == "" {
+			return nil, fmt.Errorf("approval.inputs.%d: name is required", i)
+		}
+	}
+
+	container = container.CreateChild()
+	stage := NewContainerStage(layer.NextRef(), container)
+	stage.SetCategory(approvalCategory)
+
+	inputs, _ := json.Marshal(approval.Inputs)
+	args := []string{"--default", action, "--inputs", expressionstcl.Escape(string(inputs))}
+	if approval.Message != "" {
+		args = append(args, "--message", expressionstcl.Escape(approval.Message))
+	}
+	if approval.Timeout != "" {
+		args = append(args, "--timeout", approval.Timeout)
+	}
+	container.
+		SetImage(defaultToolkitImage).
+		SetImagePullPolicy(corev1.PullIfNotPresent).
+		SetCommand("/toolkit", "approval").
+		SetArgs(args...).
+		EnableToolkit(stage.Ref())
+
+	return stage, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/constants"
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+func TestProcessApproval(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Name: "Smoke test", Shell: "./smoke.sh"}},
+				{StepBase: testworkflowsv1.StepBase{
+					Name:  "Deploy production",
+					Shell: "./deploy.sh",
+					Approval: &testworkflowsv1.StepApproval{
+						Message: "Canary {{ looks }} fine?",
+						Timeout: "1h",
+						Inputs:  []testworkflowsv1.ApprovalInput{{Name: "version", Default: common.Ptr("latest")}},
+					},
+				}},
+			},
+		},
+	}
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
+
+	assert.Len(t, res.Signature, 2)
+	assert.Equal(t, "Deploy production", res.Signature[1].Name())
+	assert.Len(t, res.Signature[1].Children(), 2)
+	assert.Equal(t, "Wait for approval", res.Signature[1].Children()[0].Category())
+	podSpec := res.Job.Spec.Template.Spec
+	approval := podSpec.InitContainers[2]
+	assert.Equal(t, defaultToolkitImage, approval.Image)
+	assert.Equal(t, []string{"/toolkit", "approval", "--default", "reject", "--inputs", `[{"name":"version","default":"latest"}]`,
+		"--message", `Canary {{"{{"}} looks }} fine?`, "--timeout", "1h"}, approval.Args)
+	assert.True(t, hasVolumeMount(approval.VolumeMounts, constants.ControlDirPath))
+	assert.Contains(t, strings.Join(approval.Command, " "), constants.ArgAlias+" deploy_production")
+	assert.NotContains(t, podSpec.Containers[0].Command, constants.ArgAlias)
+
+	var files []string
+	for _, v := range podSpec.Volumes {
+		if v.DownwardAPI != nil {
+			for _, item := range v.DownwardAPI.Items {
+				files = append(files, item.Path)
+			}
+		}
+	}
+	assert.Equal(t, []string{constants.ControlFileName, constants.ApprovalsFileName}, files)
+}
+
+func TestProcessApproval_Standalone(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{
+					Name:     "Go/no-go",
+					Approval: &testworkflowsv1.StepApproval{Default: testworkflowsv1.ApprovalActionApprove},
+				}},
+			},
+		},
+	}
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
+
+	assert.Len(t, res.Signature, 1)
+	assert.Equal(t, "Go/no-go", res.Signature[0].Name())
+	assert.Equal(t, []string{"/toolkit", "approval", "--default", "approve", "--inputs", "null"},
+		res.Job.Spec.Template.Spec.Containers[0].Args)
+	assert.Contains(t, strings.Join(res.Job.Spec.Template.Spec.Containers[0].Command, " "), constants.ArgAlias+" go_no_go")
+}
+
+func TestProcessApproval_Invalid(t *testing.T) {
+	cases := map[string]testworkflowsv1.StepApproval{
+		"default action":   {Default: "maybe"},
+		"invalid timeout":  {Timeout: "1 hour"},
+		"name is required": {Inputs: []testworkflowsv1.ApprovalInput{{Default: common.Ptr("a")}}},
+	}
+	for message, approval := range cases {
+		wf := &testworkflowsv1.TestWorkflow{
+			Spec: testworkflowsv1.TestWorkflowSpec{
+				Steps: []testworkflowsv1.Step{
+					{StepBase: testworkflowsv1.StepBase{Name: "Deploy", Shell: "./deploy.sh", Approval: common.Ptr(approval)}},
+				},
+			},
+		}
+		_, err := proc.Bundle(context.Background(), wf, execMachine)
+		assert.ErrorContains(t, err, message, message)
+	}
+}
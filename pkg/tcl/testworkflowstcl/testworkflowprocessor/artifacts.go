@@ -0,0 +1,22 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+const (
+	// ArtifactsOutputName is the name of the output emitted by the step, after it has uploaded the artifacts
+	ArtifactsOutputName = "artifacts"
+)
+
+// ArtifactsSummary describes the artifacts uploaded by the step
+type ArtifactsSummary struct {
+	// number of uploaded files
+	Files uint32 `json:"files"`
+	// total size of uploaded files in bytes
+	Size uint64 `json:"size"`
+}
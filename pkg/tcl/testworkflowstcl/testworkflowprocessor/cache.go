@@ -0,0 +1,191 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+const (
+	// CacheRestoreStepName is the name of the step restoring the cache
+	CacheRestoreStepName = "Restore cache"
+	// CacheSaveStepName is the name of the step saving the cache
+	CacheSaveStepName = "Save cache"
+
+	defaultCacheMaxSize = "1Gi"
+	defaultCacheTtl     = "168h"
+)
+
+// normalizeCache validates the cache configuration and applies the defaults
+func normalizeCache(specs []testworkflowsv1.CacheSpec, path string) ([]testworkflowsv1.CacheSpec, error) {
+	result := make([]testworkflowsv1.CacheSpec, len(specs))
+	for i, spec := range specs {
+		if spec.Key == "" {
+			return nil, fmt.Errorf("%s.%d: key is required", path, i)
+		}
+		if len(spec.Paths) == 0 {
+			return nil, fmt.Errorf("%s.%d: there needs to be at least one path to cache", path, i)
+		}
+		if spec.MaxSize == "" {
+			spec.MaxSize = defaultCacheMaxSize
+		}
+		if _, err := resource.ParseQuantity(spec.MaxSize); err != nil {
+			return nil, fmt.Errorf("%s.%d: invalid max size: %s", path, i, err.Error())
+		}
+		if spec.Ttl == "" {
+			spec.Ttl = defaultCacheTtl
+		}
+		if _, err := time.ParseDuration(spec.Ttl); err != nil {
+			return nil, fmt.Errorf("%s.%d: invalid ttl: %s", path, i, err.Error())
+		}
+		spec.Paths = slices.Clone(spec.Paths)
+		for j := range spec.Paths {
+			if !filepath.IsAbs(spec.Paths[j]) {
+				spec.Paths[j] = filepath.Join(defaultDataPath, spec.Paths[j])
+			}
+			spec.Paths[j] = filepath.Clean(spec.Paths[j])
+		}
+		result[i] = spec
+	}
+	return result, nil
+}
+
+// getCacheVolumePaths returns the cached paths, that are not on a volume shared between the containers
+func getCacheVolumePaths(specs []testworkflowsv1.CacheSpec) []string {
+	paths := make([]string, 0)
+	for _, spec := range specs {
+		for _, path := range spec.Paths {
+			if !isPathIn(path, []string{defaultDataPath, defaultInternalPath}) && !slices.Contains(paths, path) {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+func isPathIn(path string, dirs []string) bool {
+	for _, dir := range dirs {
+		if path == dir || strings.HasPrefix(path, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func buildCacheStep(name, action string, spec testworkflowsv1.CacheSpec) testworkflowsv1.Step {
+	names := make([]string, 0, len(toolkitEnv))
+	for name := range toolkitEnv {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	env := make([]corev1.EnvVar, 0, len(toolkitEnv))
+	for _, name := range names {
+		env = append(env, corev1.EnvVar{Name: name, Value: toolkitEnv[name]})
+	}
+	args := []string{"--key", spec.Key, "--ttl", spec.Ttl}
+	if action == "save" {
+		args = append(args, "--max-size", spec.MaxSize)
+	}
+	return testworkflowsv1.Step{
+		StepBase: testworkflowsv1.StepBase{
+			Name:     name,
+			Optional: true,
+			Run: &testworkflowsv1.StepRun{
+				ContainerConfig: testworkflowsv1.ContainerConfig{
+					Image:           defaultToolkitImage,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         common.Ptr([]string{"/toolkit", "cache", action}),
+					Args:            common.Ptr(append(args, spec.Paths...)),
+					Env:             env,
+				},
+			},
+		},
+	}
+}
+
+// wrapWithCache surrounds the steps with restoring and saving the cache
+func wrapWithCache(steps []testworkflowsv1.Step, spec testworkflowsv1.CacheSpec) []testworkflowsv1.Step {
+	return append(append([]testworkflowsv1.Step{buildCacheStep(CacheRestoreStepName, "restore", spec)}, steps...),
+		buildCacheStep(CacheSaveStepName, "save", spec))
+}
+
+// applyCacheToSteps wraps the steps that have the cache configured
+func applyCacheToSteps(steps []testworkflowsv1.Step, path string) ([]testworkflowsv1.Step, []testworkflowsv1.CacheSpec, error) {
+	if len(steps) == 0 {
+		return steps, nil, nil
+	}
+	result := make([]testworkflowsv1.Step, 0, len(steps))
+	caches := make([]testworkflowsv1.CacheSpec, 0)
+	for i, step := range steps {
+		var nested []testworkflowsv1.CacheSpec
+		var err error
+		step.Setup, nested, err = applyCacheToSteps(step.Setup, fmt.Sprintf("%s.%d.setup", path, i))
+		if err != nil {
+			return nil, nil, err
+		}
+		caches = append(caches, nested...)
+		step.Steps, nested, err = applyCacheToSteps(step.Steps, fmt.Sprintf("%s.%d.steps", path, i))
+		if err != nil {
+			return nil, nil, err
+		}
+		caches = append(caches, nested...)
+
+		specs, err := normalizeCache(step.Cache, fmt.Sprintf("%s.%d.cache", path, i))
+		if err != nil {
+			return nil, nil, err
+		}
+		step.Cache = nil
+		wrapped := []testworkflowsv1.Step{step}
+		for _, spec := range specs {
+			wrapped = wrapWithCache(wrapped, spec)
+		}
+		result = append(result, wrapped...)
+		caches = append(caches, specs...)
+	}
+	return result, caches, nil
+}
+
+// applyCache injects the steps for restoring and saving the cache into the TestWorkflow,
+// and returns all the cache configurations
+func applyCache(workflow *testworkflowsv1.TestWorkflow) (*testworkflowsv1.TestWorkflow, []testworkflowsv1.CacheSpec, error) {
+	workflow = workflow.DeepCopy()
+	caches, err := normalizeCache(workflow.Spec.Cache, "spec.cache")
+	if err != nil {
+		return nil, nil, err
+	}
+	workflow.Spec.Cache = nil
+
+	// Apply the cache configured for specific steps
+	var setupCaches, stepsCaches, afterCaches []testworkflowsv1.CacheSpec
+	if workflow.Spec.Setup, setupCaches, err = applyCacheToSteps(workflow.Spec.Setup, "spec.setup"); err != nil {
+		return nil, nil, err
+	}
+	if workflow.Spec.Steps, stepsCaches, err = applyCacheToSteps(workflow.Spec.Steps, "spec.steps"); err != nil {
+		return nil, nil, err
+	}
+	if workflow.Spec.After, afterCaches, err = applyCacheToSteps(workflow.Spec.After, "spec.after"); err != nil {
+		return nil, nil, err
+	}
+
+	// Wrap all the steps with the global cache
+	for _, spec := range caches {
+		workflow.Spec.Steps = wrapWithCache(workflow.Spec.Steps, spec)
+	}
+	return workflow, append(append(append(caches, setupCaches...), stepsCaches...), afterCaches...), nil
+}
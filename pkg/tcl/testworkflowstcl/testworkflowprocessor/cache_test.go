@@ -0,0 +1,159 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+)
+
+func hasVolumeMount(mounts []corev1.VolumeMount, path string) bool {
+	for _, m := range mounts {
+		if m.MountPath == path {
+			return true
+		}
+	}
+	return false
+}
+
+func TestProcessCache(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Cache: []testworkflowsv1.CacheSpec{
+					{Key: `npm-{{sha256(file("package-lock.json"))}}`, Paths: []string{"node_modules", "/root/.npm"}},
+				},
+			},
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Name: "Install", Shell: "npm ci"}},
+				{StepBase: testworkflowsv1.StepBase{Name: "Test", Shell: "npm test", Reports: []testworkflowsv1.StepReport{
+					{Format: "junit", Path: "report.xml"},
+				}}},
+			},
+		},
+	}
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
+
+	podSpec := res.Job.Spec.Template.Spec
+	assert.Len(t, podSpec.InitContainers, 4)
+	restore := podSpec.InitContainers[1]
+	save := podSpec.Containers[0]
+	assert.Equal(t, defaultToolkitImage, restore.Image)
+	assert.Equal(t, []string{"/toolkit", "cache", "restore", "--key", `npm-{{sha256(file("package-lock.json"))}}`, "--ttl", "168h", "/data/node_modules", "/root/.npm"}, restore.Args)
+	assert.Equal(t, []string{"/toolkit", "cache", "save", "--key", `npm-{{sha256(file("package-lock.json"))}}`, "--ttl", "168h", "--max-size", "1Gi", "/data/node_modules", "/root/.npm"}, save.Args)
+	assert.Contains(t, podSpec.InitContainers[3].Command, "junit=report.xml")
+	assert.NotContains(t, save.Command, "junit=report.xml")
+
+	for _, c := range append(podSpec.InitContainers[1:], podSpec.Containers...) {
+		assert.True(t, hasVolumeMount(c.VolumeMounts, "/root/.npm"), c.Name)
+		assert.False(t, hasVolumeMount(c.VolumeMounts, "/data/node_modules"), c.Name)
+	}
+
+	assert.Len(t, res.Signature, 4)
+	assert.Equal(t, CacheRestoreStepName, res.Signature[0].Name())
+	assert.True(t, res.Signature[0].Optional())
+	assert.Equal(t, CacheSaveStepName, res.Signature[3].Name())
+}
+
+func TestProcessCacheStep(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Name: "Lint", Shell: "shell-test"}},
+				{StepBase: testworkflowsv1.StepBase{Name: "Group"}, Steps: []testworkflowsv1.Step{
+					{StepBase: testworkflowsv1.StepBase{Name: "Build", Shell: "go build", Cache: []testworkflowsv1.CacheSpec{
+						{Key: "go", Paths: []string{".cache/go"}, MaxSize: "500Mi", Ttl: "24h"},
+					}}},
+				}},
+			},
+		},
+	}
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
+
+	podSpec := res.Job.Spec.Template.Spec
+	assert.Len(t, podSpec.InitContainers, 4)
+	assert.Equal(t, []string{"/toolkit", "cache", "restore", "--key", "go", "--ttl", "24h", "/data/.cache/go"}, podSpec.InitContainers[2].Args)
+	assert.Equal(t, []string{"/toolkit", "cache", "save", "--key", "go", "--ttl", "24h", "--max-size", "500Mi", "/data/.cache/go"}, podSpec.Containers[0].Args)
+}
+
+func TestProcessCacheInvalid(t *testing.T) {
+	tests := map[string]testworkflowsv1.CacheSpec{
+		"spec.cache.0: key is required":                     {Paths: []string{"node_modules"}},
+		"spec.cache.0: there needs to be at least one path": {Key: "abc"},
+		"spec.cache.0: invalid max size":                    {Key: "abc", Paths: []string{"node_modules"}, MaxSize: "lots"},
+		"spec.cache.0: invalid ttl":                         {Key: "abc", Paths: []string{"node_modules"}, Ttl: "forever"},
+	}
+	for expected, cache := range tests {
+		wf := &testworkflowsv1.TestWorkflow{
+			Spec: testworkflowsv1.TestWorkflowSpec{
+				TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+					Cache: []testworkflowsv1.CacheSpec{cache},
+				},
+				Steps: []testworkflowsv1.Step{
+					{StepBase: testworkflowsv1.StepBase{Shell: "shell-test"}},
+				},
+			},
+		}
+
+		_, err := proc.Bundle(context.Background(), wf, execMachine)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), expected)
+	}
+}
+
+func TestProcessCacheInvalidStep(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Shell: "shell-test"}, Steps: []testworkflowsv1.Step{
+					{StepBase: testworkflowsv1.StepBase{Shell: "shell-test", Cache: []testworkflowsv1.CacheSpec{{Key: "abc"}}}},
+				}},
+			},
+		},
+	}
+
+	_, err := proc.Bundle(context.Background(), wf, execMachine)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.steps.0.steps.0.cache.0: there needs to be at least one path")
+}
+
+func TestProcessCacheFilesystemStorage(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Cache: []testworkflowsv1.CacheSpec{{Key: "abc", Paths: []string{"node_modules"}}},
+			},
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Shell: "shell-test"}},
+			},
+		},
+	}
+	storageMachine := expressionstcl.NewMachine().Register("internal.storage.type", storage.TypeFilesystem)
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine, storageMachine)
+
+	// The toolkit skips the cache with a warning, as the filesystem storage can't keep it
+	assert.NoError(t, err)
+	assert.Len(t, res.Signature, 3)
+	assert.Equal(t, CacheRestoreStepName, res.Signature[0].Name())
+	assert.Equal(t, CacheSaveStepName, res.Signature[2].Name())
+}
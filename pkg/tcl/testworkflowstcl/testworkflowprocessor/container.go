@@ -329,28 +329,9 @@ func (c *container) ToKubernetesTemplate() (corev1.Container, error) {
 	if cr.WorkingDir != nil {
 		workingDir = *cr.WorkingDir
 	}
-	resources := corev1.ResourceRequirements{}
-	if cr.Resources != nil {
-		if len(cr.Resources.Requests) > 0 {
-			resources.Requests = make(corev1.ResourceList)
-		}
-		if len(cr.Resources.Limits) > 0 {
-			resources.Limits = make(corev1.ResourceList)
-		}
-		for k, v := range cr.Resources.Requests {
-			var err error
-			resources.Requests[k], err = quantity.ParseQuantity(v.String())
-			if err != nil {
-				return corev1.Container{}, errors.Wrap(err, "parsing resources")
-			}
-		}
-		for k, v := range cr.Resources.Limits {
-			var err error
-			resources.Limits[k], err = quantity.ParseQuantity(v.String())
-			if err != nil {
-				return corev1.Container{}, errors.Wrap(err, "parsing resources")
-			}
-		}
+	resources, err := buildResources(cr.Resources)
+	if err != nil {
+		return corev1.Container{}, err
 	}
 	return corev1.Container{
 		Image:           cr.Image,
@@ -366,14 +347,37 @@ func (c *container) ToKubernetesTemplate() (corev1.Container, error) {
 	}, nil
 }
 
+// buildResources converts the TestWorkflow resources into the Kubernetes resource requirements
+func buildResources(r *testworkflowsv1.Resources) (resources corev1.ResourceRequirements, err error) {
+	if r == nil {
+		return resources, nil
+	}
+	if len(r.Requests) > 0 {
+		resources.Requests = make(corev1.ResourceList)
+	}
+	if len(r.Limits) > 0 {
+		resources.Limits = make(corev1.ResourceList)
+	}
+	for k, v := range r.Requests {
+		resources.Requests[k], err = quantity.ParseQuantity(v.String())
+		if err != nil {
+			return resources, errors.Wrap(err, "parsing resources")
+		}
+	}
+	for k, v := range r.Limits {
+		resources.Limits[k], err = quantity.ParseQuantity(v.String())
+		if err != nil {
+			return resources, errors.Wrap(err, "parsing resources")
+		}
+	}
+	return resources, nil
+}
+
 func (c *container) ApplyImageData(image *imageinspector.Info) error {
 	if image == nil {
 		return nil
 	}
-	err := c.Resolve(expressionstcl.NewMachine().
-		Register("image.command", image.Entrypoint).
-		Register("image.args", image.Cmd).
-		Register("image.workingDir", image.WorkingDir))
+	err := c.Resolve(CreateImageMachine(image))
 	if err != nil {
 		return err
 	}
@@ -392,28 +396,18 @@ func (c *container) ApplyImageData(image *imageinspector.Info) error {
 	return nil
 }
 
+// CreateImageMachine exposes the image details as "image.command", "image.args" and "image.workingDir" variables
+func CreateImageMachine(image *imageinspector.Info) expressionstcl.Machine {
+	return expressionstcl.NewMachine().
+		Register("image.command", image.Entrypoint).
+		Register("image.args", image.Cmd).
+		Register("image.workingDir", image.WorkingDir)
+}
+
 func (c *container) EnableToolkit(ref string) Container {
-	return c.AppendEnvMap(map[string]string{
-		"TK_REF":                ref,
-		"TK_NS":                 "{{internal.namespace}}",
-		"TK_WF":                 "{{workflow.name}}",
-		"TK_EX":                 "{{execution.id}}",
-		"TK_C_URL":              "{{internal.cloud.api.url}}",
-		"TK_C_KEY":              "{{internal.cloud.api.key}}",
-		"TK_C_TLS_INSECURE":     "{{internal.cloud.api.tlsInsecure}}",
-		"TK_C_SKIP_VERIFY":      "{{internal.cloud.api.skipVerify}}",
-		"TK_OS_ENDPOINT":        "{{internal.storage.url}}",
-		"TK_OS_ACCESSKEY":       "{{internal.storage.accessKey}}",
-		"TK_OS_SECRETKEY":       "{{internal.storage.secretKey}}",
-		"TK_OS_REGION":          "{{internal.storage.region}}",
-		"TK_OS_TOKEN":           "{{internal.storage.token}}",
-		"TK_OS_BUCKET":          "{{internal.storage.bucket}}",
-		"TK_OS_SSL":             "{{internal.storage.ssl}}",
-		"TK_OS_SSL_SKIP_VERIFY": "{{internal.storage.skipVerify}}",
-		"TK_OS_CERT_FILE":       "{{internal.storage.certFile}}",
-		"TK_OS_KEY_FILE":        "{{internal.storage.keyFile}}",
-		"TK_OS_CA_FILE":         "{{internal.storage.caFile}}",
-	})
+	return c.
+		AppendEnvMap(map[string]string{"TK_REF": ref}).
+		AppendEnvMap(toolkitEnv)
 }
 
 func (c *container) Resolve(m ...expressionstcl.Machine) error {
@@ -11,6 +11,7 @@ package testworkflowprocessor
 import (
 	"github.com/pkg/errors"
 
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
 	"github.com/kubeshop/testkube/pkg/imageinspector"
 	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
 )
@@ -19,11 +20,14 @@ type containerStage struct {
 	stageMetadata
 	stageLifecycle
 	container Container
+	reports   []testworkflowsv1.StepReport
 }
 
 type ContainerStage interface {
 	Stage
 	Container() Container
+	Reports() []testworkflowsv1.StepReport
+	SetReports(reports []testworkflowsv1.StepReport) ContainerStage
 }
 
 func NewContainerStage(ref string, container Container) ContainerStage {
@@ -75,3 +79,12 @@ func (s *containerStage) Resolve(m ...expressionstcl.Machine) error {
 func (s *containerStage) Container() Container {
 	return s.container
 }
+
+func (s *containerStage) Reports() []testworkflowsv1.StepReport {
+	return s.reports
+}
+
+func (s *containerStage) SetReports(reports []testworkflowsv1.StepReport) ContainerStage {
+	s.reports = reports
+	return s
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/constants"
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+const (
+	// ControlAnnotationName is the pod annotation with JSON map of step references and requested control actions
+	ControlAnnotationName = "testworkflows.testkube.io/control"
+
+	controlVolumeName = "tktw-control"
+	// gracePeriodBuffer is the additional time for the pod termination, to let the init process finish the step
+	gracePeriodBuffer = 5 * time.Second
+)
+
+// getMaxGracePeriod finds the longest grace period of the steps, so the pod termination waits for any of them
+func getMaxGracePeriod(stage Stage) (time.Duration, error) {
+	var grace time.Duration
+	if stage.GracePeriod() != "" {
+		v, err := time.ParseDuration(stage.GracePeriod())
+		if err != nil {
+			return 0, fmt.Errorf("%s: %s: invalid grace period: %s", stage.Ref(), stage.Name(), err.Error())
+		}
+		if v < 0 {
+			return 0, fmt.Errorf("%s: %s: invalid grace period: %s: it can't be negative", stage.Ref(), stage.Name(), v)
+		}
+		grace = v
+	}
+	if group, ok := stage.(GroupStage); ok {
+		for _, ch := range group.Children() {
+			v, err := getMaxGracePeriod(ch)
+			if err != nil {
+				return 0, err
+			}
+			grace = max(grace, v)
+		}
+	}
+	return grace, nil
+}
+
+// buildControlVolume creates the volume that projects the control annotations of the pod into the containers.
+// The kubelet updates the Downward API files on its periodic sync, so the changes may be visible after about a minute.
+func buildControlVolume() (corev1.Volume, corev1.VolumeMount) {
+	volume := corev1.Volume{
+		Name: controlVolumeName,
+		VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{
+			Items: []corev1.DownwardAPIVolumeFile{
+				{
+					Path:     constants.ControlFileName,
+					FieldRef: &corev1.ObjectFieldSelector{FieldPath: fmt.Sprintf("metadata.annotations['%s']", ControlAnnotationName)},
+				},
+				{
+					Path:     constants.ApprovalsFileName,
+					FieldRef: &corev1.ObjectFieldSelector{FieldPath: fmt.Sprintf("metadata.annotations['%s']", ApprovalDecisionsAnnotationName)},
+				},
+			},
+		}},
+	}
+	return volume, corev1.VolumeMount{Name: controlVolumeName, MountPath: constants.ControlDirPath, ReadOnly: true}
+}
+
+// buildTerminationGracePeriod computes the pod termination time, so the init process may finish the step gracefully
+func buildTerminationGracePeriod(grace time.Duration) *int64 {
+	return common.Ptr(int64(math.Ceil((grace + gracePeriodBuffer).Seconds())))
+}
@@ -129,6 +129,9 @@ func (s *groupStage) Flatten() []Stage {
 		if first.Timeout() == "" {
 			first.SetTimeout(s.timeout)
 		}
+		if first.GracePeriod() == "" {
+			first.SetGracePeriod(s.grace)
+		}
 		if s.negative {
 			first.SetNegative(!first.Negative())
 		}
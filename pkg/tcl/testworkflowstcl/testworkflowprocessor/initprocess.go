@@ -12,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -23,6 +24,7 @@ import (
 
 type initProcess struct {
 	ref        string
+	alias      string
 	init       []string
 	params     []string
 	retry      map[string]testworkflowsv1.RetryPolicy
@@ -30,6 +32,7 @@ type initProcess struct {
 	args       []string
 	envs       []string
 	results    []string
+	reports    []string
 	conditions map[string][]string
 	negative   bool
 	errors     []error
@@ -55,7 +58,7 @@ func (p *initProcess) SetRef(ref string) *initProcess {
 }
 
 func (p *initProcess) Command() []string {
-	args := p.params
+	args := slices.Clone(p.params)
 
 	// TODO: Support nested retries
 	policy, ok := p.retry[p.ref]
@@ -65,6 +68,9 @@ func (p *initProcess) Command() []string {
 	if p.negative {
 		args = append(args, constants.ArgNegative, "true")
 	}
+	if p.alias != "" {
+		args = append(args, constants.ArgAlias, p.alias)
+	}
 	if len(p.init) > 0 {
 		args = append(args, constants.ArgInit, strings.Join(p.init, "&&"))
 	}
@@ -79,6 +85,9 @@ func (p *initProcess) Command() []string {
 	for _, r := range p.results {
 		args = append(args, constants.ArgResult, r)
 	}
+	for _, r := range p.reports {
+		args = append(args, constants.ArgReport, r)
+	}
 	return append([]string{defaultInitPath, p.ref}, append(args, constants.ArgSeparator)...)
 }
 
@@ -120,6 +129,10 @@ func (p *initProcess) SetArgs(args ...string) *initProcess {
 	return p
 }
 
+func (p *initProcess) SetGracePeriod(duration string) *initProcess {
+	return p.param(constants.ArgGracePeriod, duration)
+}
+
 func (p *initProcess) AddTimeout(duration string, refs ...string) *initProcess {
 	return p.param(constants.ArgTimeout, fmt.Sprintf("%s=%s", strings.Join(refs, ","), duration))
 }
@@ -146,6 +159,19 @@ func (p *initProcess) AddComputedEnvs(names ...string) *initProcess {
 	return p
 }
 
+func (p *initProcess) SetAlias(alias string) *initProcess {
+	p.alias = alias
+	return p
+}
+
+func (p *initProcess) SetReports(reports []testworkflowsv1.StepReport) *initProcess {
+	p.reports = nil
+	for _, r := range reports {
+		p.reports = append(p.reports, fmt.Sprintf("%s=%s", r.Format, r.Path))
+	}
+	return p
+}
+
 func (p *initProcess) SetNegative(negative bool) *initProcess {
 	p.negative = negative
 	return p
@@ -194,7 +220,7 @@ func (p *initProcess) AddRetryPolicy(policy testworkflowsv1.RetryPolicy, ref str
 func (p *initProcess) Children(ref string) *initProcess {
 	return &initProcess{
 		ref:        ref,
-		params:     p.params,
+		params:     slices.Clone(p.params),
 		retry:      maps.Clone(p.retry),
 		command:    p.command,
 		args:       p.args,
@@ -138,6 +138,20 @@ func (mr *MockStageMockRecorder) GetImages() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImages", reflect.TypeOf((*MockStage)(nil).GetImages))
 }
 
+// GracePeriod mocks base method.
+func (m *MockStage) GracePeriod() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GracePeriod")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GracePeriod indicates an expected call of GracePeriod.
+func (mr *MockStageMockRecorder) GracePeriod() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GracePeriod", reflect.TypeOf((*MockStage)(nil).GracePeriod))
+}
+
 // Len mocks base method.
 func (m *MockStage) Len() int {
 	m.ctrl.T.Helper()
@@ -268,6 +282,20 @@ func (mr *MockStageMockRecorder) SetCondition(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCondition", reflect.TypeOf((*MockStage)(nil).SetCondition), arg0)
 }
 
+// SetGracePeriod mocks base method.
+func (m *MockStage) SetGracePeriod(arg0 string) StageLifecycle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGracePeriod", arg0)
+	ret0, _ := ret[0].(StageLifecycle)
+	return ret0
+}
+
+// SetGracePeriod indicates an expected call of SetGracePeriod.
+func (mr *MockStageMockRecorder) SetGracePeriod(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGracePeriod", reflect.TypeOf((*MockStage)(nil).SetGracePeriod), arg0)
+}
+
 // SetName mocks base method.
 func (m *MockStage) SetName(arg0 string) StageMetadata {
 	m.ctrl.T.Helper()
@@ -311,7 +339,7 @@ func (mr *MockStageMockRecorder) SetOptional(arg0 interface{}) *gomock.Call {
 }
 
 // SetRetryPolicy mocks base method.
-func (m *MockStage) SetRetryPolicy(arg0 v1.RetryPolicy) StageLifecycle {
+func (m *MockStage) SetRetryPolicy(arg0 *v1.RetryPolicy) StageLifecycle {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetRetryPolicy", arg0)
 	ret0, _ := ret[0].(StageLifecycle)
@@ -20,7 +20,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
 	"github.com/kubeshop/testkube/pkg/tcl/mapperstcl/testworkflows"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowresolver"
 )
 
 func ProcessDelay(_ InternalProcessor, layer Intermediate, container Container, step testworkflowsv1.Step) (Stage, error) {
@@ -47,6 +49,7 @@ func ProcessShellCommand(_ InternalProcessor, layer Intermediate, container Cont
 	stage := NewContainerStage(layer.NextRef(), shell)
 	stage.SetCategory("Run shell command")
 	stage.SetRetryPolicy(step.Retry)
+	stage.SetReports(step.Reports)
 	return stage, nil
 }
 
@@ -58,6 +61,10 @@ func ProcessRunCommand(_ InternalProcessor, layer Intermediate, container Contai
 	stage := NewContainerStage(layer.NextRef(), container)
 	stage.SetRetryPolicy(step.Retry)
 	stage.SetCategory("Run")
+	if step.Shell == "" {
+		// Otherwise, the reports are parsed after the shell command
+		stage.SetReports(step.Reports)
+	}
 	return stage, nil
 }
 
@@ -138,6 +145,39 @@ func ProcessExecute(_ InternalProcessor, layer Intermediate, container Container
 	return stage, nil
 }
 
+func ProcessParallel(_ InternalProcessor, layer Intermediate, container Container, step testworkflowsv1.Step) (Stage, error) {
+	if step.Parallel == nil {
+		return nil, nil
+	}
+	container = container.CreateChild()
+	stage := NewContainerStage(layer.NextRef(), container)
+	stage.SetRetryPolicy(step.Retry)
+
+	// Fail early if the combinations are invalid
+	if len(step.Parallel.Steps) == 0 {
+		return nil, errors.New("no steps provided to the 'parallel' step")
+	}
+	_, err := testworkflowresolver.ParallelCombinations(step.Parallel.Matrix, int(step.Parallel.Shards))
+	if err != nil {
+		return nil, errors.Wrap(err, "parallel")
+	}
+
+	// Pass the steps as they are, so they will be resolved separately for each combination
+	spec, err := json.Marshal(testworkflows.MapStepParallelKubeToAPI(*step.Parallel))
+	if err != nil {
+		return nil, errors.Wrap(err, "parallel: serializing the steps")
+	}
+	container.
+		SetImage(defaultToolkitImage).
+		SetImagePullPolicy(corev1.PullIfNotPresent).
+		SetCommand("/toolkit", "parallel").
+		SetArgs(expressionstcl.Escape(string(spec))).
+		EnableToolkit(stage.Ref())
+	stage.SetCategory("Run in parallel")
+
+	return stage, nil
+}
+
 func ProcessContentFiles(_ InternalProcessor, layer Intermediate, container Container, step testworkflowsv1.Step) (Stage, error) {
 	if step.Content == nil {
 		return nil, nil
@@ -0,0 +1,44 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	// ParallelStartOutputName is the name of the output emitted by the parallel step, when it has scheduled the execution
+	ParallelStartOutputName = "parallel-start"
+	// ParallelEndOutputName is the name of the output emitted by the parallel step, when the execution has finished
+	ParallelEndOutputName = "parallel-end"
+)
+
+// ParallelExecution describes the execution spawned by the parallel step
+type ParallelExecution struct {
+	Id     string            `json:"id"`
+	Name   string            `json:"name,omitempty"`
+	Index  int               `json:"index"`
+	Label  string            `json:"label,omitempty"`
+	Matrix map[string]string `json:"matrix,omitempty"`
+	Status string            `json:"status,omitempty"`
+}
+
+// ToInternal maps the execution to its result in the parallel step
+func (p ParallelExecution) ToInternal() testkube.TestWorkflowParallelExecutionResult {
+	result := testkube.TestWorkflowParallelExecutionResult{
+		Id:    p.Id,
+		Name:  p.Name,
+		Index: int32(p.Index),
+	}
+	if p.Status != "" {
+		result.Status = common.Ptr(testkube.TestWorkflowStatus(p.Status))
+	}
+	return result
+}
@@ -51,12 +51,14 @@ func New(inspector imageinspector.Inspector) Processor {
 func NewFullFeatured(inspector imageinspector.Inspector) Processor {
 	return New(inspector).
 		Register(ProcessDelay).
+		Register(ProcessApproval).
 		Register(ProcessContentFiles).
 		Register(ProcessContentGit).
 		Register(ProcessNestedSetupSteps).
 		Register(ProcessRunCommand).
 		Register(ProcessShellCommand).
 		Register(ProcessExecute).
+		Register(ProcessParallel).
 		Register(ProcessNestedSteps).
 		Register(ProcessArtifacts)
 }
@@ -73,10 +75,16 @@ func (p *processor) process(layer Intermediate, container Container, step testwo
 	}
 	container.ApplyCR(step.Container)
 
+	// Validate the step options
+	err := validateReports(step)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build an initial group for the inner items
 	self := NewGroupStage(ref, false)
 	self.SetName(step.Name)
-	self.SetOptional(step.Optional).SetNegative(step.Negative).SetTimeout(step.Timeout)
+	self.SetOptional(step.Optional).SetNegative(step.Negative).SetTimeout(step.Timeout).SetGracePeriod(step.GracePeriod)
 	if step.Condition != "" {
 		self.SetCondition(step.Condition)
 	} else {
@@ -100,6 +108,19 @@ func (p *processor) Process(layer Intermediate, container Container, step testwo
 }
 
 func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWorkflow, machines ...expressionstcl.Machine) (bundle *Bundle, err error) {
+	// Load the services
+	services, err := GetServices(workflow)
+	if err != nil {
+		return nil, err
+	}
+	machines = append(machines, CreateServicesMachine(services))
+
+	// Load the cache configuration
+	workflow, caches, err := applyCache(workflow)
+	if err != nil {
+		return nil, err
+	}
+
 	// Initialize intermediate layer
 	layer := NewIntermediate().
 		AppendPodConfig(workflow.Spec.Pod).
@@ -108,6 +129,12 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 		ApplyCR(defaultContainerConfig.DeepCopy()).
 		AppendVolumeMounts(layer.AddEmptyDirVolume(nil, defaultInternalPath)).
 		AppendVolumeMounts(layer.AddEmptyDirVolume(nil, defaultDataPath))
+	for _, path := range getCacheVolumePaths(caches) {
+		layer.ContainerDefaults().AppendVolumeMounts(layer.AddEmptyDirVolume(nil, path))
+	}
+	controlVolume, controlVolumeMount := buildControlVolume()
+	layer.AddVolume(controlVolume)
+	layer.ContainerDefaults().AppendVolumeMounts(controlVolumeMount)
 
 	// Process steps
 	rootStep := testworkflowsv1.Step{
@@ -198,8 +225,15 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 		return nil, errors.Wrap(err, "applying image data")
 	}
 
+	// Ensure the step outputs are accessible without ambiguity
+	aliases, err := resolveStepAliases(root)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving step aliases")
+	}
+
 	// Build list of the containers
-	containers, err := buildKubernetesContainers(root, NewInitProcess().SetRef(root.Ref()), machines...)
+	init := NewInitProcess().SetRef(root.Ref())
+	containers, err := buildKubernetesContainers(root, init, aliases, machines...)
 	if err != nil {
 		return nil, errors.Wrap(err, "building Kubernetes containers")
 	}
@@ -246,6 +280,13 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 			},
 		},
 	}
+	gracePeriod, err := getMaxGracePeriod(root)
+	if err != nil {
+		return nil, err
+	}
+	if gracePeriod > 0 {
+		podSpec.Spec.TerminationGracePeriodSeconds = buildTerminationGracePeriod(gracePeriod)
+	}
 	AnnotateControlledBy(&podSpec, "{{execution.id}}")
 	err = expressionstcl.FinalizeForce(&podSpec, machines...)
 	if err != nil {
@@ -266,8 +307,20 @@ func (p *processor) Bundle(ctx context.Context, workflow *testworkflowsv1.TestWo
 	if err != nil {
 		return nil, errors.Wrap(err, "finalizing container's resources")
 	}
-	podSpec.Spec.InitContainers = append([]corev1.Container{initContainer}, containers[:len(containers)-1]...)
+	for name, service := range services {
+		err = expressionstcl.FinalizeForce(&service, machines...)
+		if err != nil {
+			return nil, errors.Wrap(err, "finalizing service: "+name)
+		}
+		services[name] = service
+	}
+	serviceContainers, err := buildServiceContainers(services, layer.ContainerDefaults().VolumeMounts())
+	if err != nil {
+		return nil, err
+	}
+	podSpec.Spec.InitContainers = append(append([]corev1.Container{initContainer}, serviceContainers...), containers[:len(containers)-1]...)
 	podSpec.Spec.Containers = containers[len(containers)-1:]
+	podSpec.Spec.HostAliases = buildServiceHostAliases(services)
 
 	// Build job spec
 	jobSpec := batchv1.Job{
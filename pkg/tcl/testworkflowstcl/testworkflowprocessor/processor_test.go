@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/constants"
 	"github.com/kubeshop/testkube/internal/common"
 	"github.com/kubeshop/testkube/pkg/imageinspector"
 	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
@@ -133,12 +134,14 @@ func TestProcessBasic(t *testing.T) {
 
 	assert.Equal(t, want, res.Job)
 
-	assert.Equal(t, 2, len(volumeMounts))
-	assert.Equal(t, 2, len(volumes))
+	assert.Equal(t, 3, len(volumeMounts))
+	assert.Equal(t, 3, len(volumes))
 	assert.Equal(t, defaultInternalPath, volumeMounts[0].MountPath)
 	assert.Equal(t, defaultDataPath, volumeMounts[1].MountPath)
+	assert.Equal(t, constants.ControlDirPath, volumeMounts[2].MountPath)
 	assert.True(t, volumeMounts[0].Name == volumes[0].Name)
 	assert.True(t, volumeMounts[1].Name == volumes[1].Name)
+	assert.True(t, volumeMounts[2].Name == volumes[2].Name)
 }
 
 func TestProcessBasicEnvReference(t *testing.T) {
@@ -353,6 +356,7 @@ func TestProcessNestedSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[0].Ref(),
+					"--alias", "a",
 					"-c", fmt.Sprintf("%s,%s,%s,%s=passed", sig[0].Ref(), sig[1].Children()[0].Ref(), sig[1].Children()[1].Ref(), sig[2].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s&&%s", sig[0].Ref(), sig[1].Ref(), sig[2].Ref()),
 					"--",
@@ -374,6 +378,7 @@ func TestProcessNestedSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[1].Children()[0].Ref(),
+					"--alias", "c",
 					"-i", fmt.Sprintf("%s", sig[1].Ref()),
 					"-c", fmt.Sprintf("%s,%s,%s=passed", sig[1].Ref(), sig[1].Children()[0].Ref(), sig[1].Children()[1].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s&&%s", sig[0].Ref(), sig[1].Ref(), sig[2].Ref()),
@@ -397,6 +402,7 @@ func TestProcessNestedSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[1].Children()[1].Ref(),
+					"--alias", "d",
 					"-i", fmt.Sprintf("%s", sig[1].Ref()),
 					"-c", fmt.Sprintf("%s=passed", sig[1].Children()[1].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s&&%s", sig[0].Ref(), sig[1].Ref(), sig[2].Ref()),
@@ -422,6 +428,7 @@ func TestProcessNestedSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[2].Ref(),
+					"--alias", "e",
 					"-c", fmt.Sprintf("%s=passed", sig[2].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s&&%s", sig[0].Ref(), sig[1].Ref(), sig[2].Ref()),
 					"--",
@@ -491,6 +498,7 @@ func TestProcessOptionalSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[0].Ref(),
+					"--alias", "a",
 					"-c", fmt.Sprintf("%s,%s,%s,%s=passed", sig[0].Ref(), sig[1].Children()[0].Ref(), sig[1].Children()[1].Ref(), sig[2].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s", sig[0].Ref(), sig[2].Ref()),
 					"--",
@@ -512,6 +520,7 @@ func TestProcessOptionalSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[1].Children()[0].Ref(),
+					"--alias", "c",
 					"-i", fmt.Sprintf("%s", sig[1].Ref()),
 					"-c", fmt.Sprintf("%s,%s,%s=passed", sig[1].Ref(), sig[1].Children()[0].Ref(), sig[1].Children()[1].Ref()),
 					"-r", fmt.Sprintf("%s=%s&&%s", sig[1].Ref(), sig[1].Children()[0].Ref(), sig[1].Children()[1].Ref()),
@@ -534,6 +543,7 @@ func TestProcessOptionalSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[1].Children()[1].Ref(),
+					"--alias", "d",
 					"-i", fmt.Sprintf("%s", sig[1].Ref()),
 					"-c", fmt.Sprintf("%s=passed", sig[1].Children()[1].Ref()),
 					"-r", fmt.Sprintf("%s=%s&&%s", sig[1].Ref(), sig[1].Children()[0].Ref(), sig[1].Children()[1].Ref()),
@@ -558,6 +568,7 @@ func TestProcessOptionalSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[2].Ref(),
+					"--alias", "e",
 					"-c", fmt.Sprintf("%s=passed", sig[2].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s", sig[0].Ref(), sig[2].Ref()),
 					"--",
@@ -627,6 +638,7 @@ func TestProcessNegativeSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[0].Ref(),
+					"--alias", "a",
 					"-c", fmt.Sprintf("%s,%s,%s,%s=passed", sig[0].Ref(), sig[1].Children()[0].Ref(), sig[1].Children()[1].Ref(), sig[2].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s&&%s", sig[0].Ref(), sig[1].Ref(), sig[2].Ref()),
 					"--",
@@ -648,6 +660,7 @@ func TestProcessNegativeSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[1].Children()[0].Ref(),
+					"--alias", "c",
 					"-i", fmt.Sprintf("%s.v", sig[1].Ref()),
 					"-c", fmt.Sprintf("%s,%s,%s,%s.v=passed", sig[1].Ref(), sig[1].Children()[0].Ref(), sig[1].Children()[1].Ref(), sig[1].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s&&%s", sig[0].Ref(), sig[1].Ref(), sig[2].Ref()),
@@ -672,6 +685,7 @@ func TestProcessNegativeSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[1].Children()[1].Ref(),
+					"--alias", "d",
 					"-i", fmt.Sprintf("%s.v", sig[1].Ref()),
 					"-c", fmt.Sprintf("%s=passed", sig[1].Children()[1].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s&&%s", sig[0].Ref(), sig[1].Ref(), sig[2].Ref()),
@@ -698,6 +712,7 @@ func TestProcessNegativeSteps(t *testing.T) {
 				Command: []string{
 					"/.tktw/init",
 					sig[2].Ref(),
+					"--alias", "e",
 					"-c", fmt.Sprintf("%s=passed", sig[2].Ref()),
 					"-r", fmt.Sprintf("=%s&&%s&&%s", sig[0].Ref(), sig[1].Ref(), sig[2].Ref()),
 					"--",
@@ -986,14 +1001,14 @@ func TestProcessLocalContent(t *testing.T) {
 	}
 
 	assert.Equal(t, want, res.Job.Spec.Template.Spec)
-	assert.Equal(t, 2, len(volumeMounts))
-	assert.Equal(t, 3, len(volumeMountsWithContent))
-	assert.Equal(t, volumeMounts, volumeMountsWithContent[:2])
-	assert.Equal(t, "/some/path", volumeMountsWithContent[2].MountPath)
+	assert.Equal(t, 3, len(volumeMounts))
+	assert.Equal(t, 4, len(volumeMountsWithContent))
+	assert.Equal(t, volumeMounts, volumeMountsWithContent[:3])
+	assert.Equal(t, "/some/path", volumeMountsWithContent[3].MountPath)
 	assert.Equal(t, 1, len(res.ConfigMaps))
-	assert.Equal(t, volumeMountsWithContent[2].Name, volumes[2].Name)
-	assert.Equal(t, volumes[2].ConfigMap.Name, res.ConfigMaps[0].Name)
-	assert.Equal(t, "some-{{content", res.ConfigMaps[0].Data[volumeMountsWithContent[2].SubPath])
+	assert.Equal(t, volumeMountsWithContent[3].Name, volumes[3].Name)
+	assert.Equal(t, volumes[3].ConfigMap.Name, res.ConfigMaps[0].Name)
+	assert.Equal(t, "some-{{content", res.ConfigMaps[0].Data[volumeMountsWithContent[3].SubPath])
 }
 
 func TestProcessGlobalContent(t *testing.T) {
@@ -1088,10 +1103,123 @@ func TestProcessGlobalContent(t *testing.T) {
 	}
 
 	assert.Equal(t, want, res.Job.Spec.Template.Spec)
-	assert.Equal(t, 3, len(volumeMounts))
-	assert.Equal(t, "/some/path", volumeMounts[2].MountPath)
+	assert.Equal(t, 4, len(volumeMounts))
+	assert.Equal(t, "/some/path", volumeMounts[3].MountPath)
 	assert.Equal(t, 1, len(res.ConfigMaps))
-	assert.Equal(t, volumeMounts[2].Name, volumes[2].Name)
-	assert.Equal(t, volumes[2].ConfigMap.Name, res.ConfigMaps[0].Name)
-	assert.Equal(t, "some-{{content", res.ConfigMaps[0].Data[volumeMounts[2].SubPath])
+	assert.Equal(t, volumeMounts[3].Name, volumes[3].Name)
+	assert.Equal(t, volumes[3].ConfigMap.Name, res.ConfigMaps[0].Name)
+	assert.Equal(t, "some-{{content", res.ConfigMaps[0].Data[volumeMounts[3].SubPath])
+}
+
+func TestProcessGracePeriod(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{
+					StepBase: testworkflowsv1.StepBase{GracePeriod: "30s"},
+					Steps: []testworkflowsv1.Step{
+						{StepBase: testworkflowsv1.StepBase{Shell: "shell-test"}},
+						{StepBase: testworkflowsv1.StepBase{Shell: "shell-test-2", GracePeriod: "1m"}},
+					},
+				},
+			},
+		},
+	}
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
+
+	spec := res.Job.Spec.Template.Spec
+	assert.Equal(t, common.Ptr(int64(65)), spec.TerminationGracePeriodSeconds)
+	assert.Subset(t, spec.InitContainers[1].Command, []string{constants.ArgGracePeriod, "30s"})
+	assert.NotContains(t, spec.InitContainers[1].Command, "1m")
+	assert.Equal(t, []string{constants.ArgGracePeriod, "1m"}, lastGracePeriodArgs(spec.Containers[0].Command))
+
+	wf.Spec.Steps[0].GracePeriod = "-5s"
+	_, err = proc.Bundle(context.Background(), wf, execMachine)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid grace period")
+}
+
+// lastGracePeriodArgs finds the grace period passed to the init process, where the last one is applied
+func lastGracePeriodArgs(command []string) []string {
+	var args []string
+	for i := 0; i+1 < len(command); i++ {
+		if command[i] == constants.ArgGracePeriod {
+			args = command[i : i+2]
+		}
+	}
+	return args
+}
+
+func TestProcessParallel(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{
+					StepBase: testworkflowsv1.StepBase{Name: "Run tests"},
+					Parallel: &testworkflowsv1.StepParallel{
+						StepParallelBase: testworkflowsv1.StepParallelBase{
+							Parallelism: 2,
+							Matrix:      map[string][]string{"browser": {"chrome", "firefox"}},
+						},
+						Steps: []testworkflowsv1.Step{
+							{StepBase: testworkflowsv1.StepBase{Shell: "npm test -- --browser {{ matrix.browser }} --id {{ execution.id }}"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
+
+	assert.Len(t, res.Signature, 1)
+	assert.Equal(t, "Run tests", res.Signature[0].Name())
+	container := res.Job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, defaultToolkitImage, container.Image)
+	wantSpec := `{"parallelism":2,"matrix":{"browser":["chrome","firefox"]},"steps":[{"shell":"npm test -- --browser {{ matrix.browser }} --id {{ execution.id }}"}]}`
+	assert.Equal(t, []string{"/toolkit", "parallel", expressionstcl.Escape(wantSpec)}, container.Args)
+
+	wf.Spec.Steps[0].Parallel.Matrix["browser"] = nil
+	_, err = proc.Bundle(context.Background(), wf, execMachine)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "matrix.browser")
+}
+
+func TestGetStepAlias(t *testing.T) {
+	assert.Equal(t, "get_token", GetStepAlias("Get token"))
+	assert.Equal(t, "deploy_v2_app", GetStepAlias("  Deploy (v2) app!"))
+	assert.Equal(t, "", GetStepAlias(""))
+	assert.Equal(t, "", GetStepAlias("Run {{matrix.browser}}"))
+	assert.Equal(t, "", GetStepAlias("1st step"))
+}
+
+func TestProcessDuplicateStepAlias(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Name: "Get token", Shell: "shell-test"}},
+				{StepBase: testworkflowsv1.StepBase{Name: "get-token!", Shell: "shell-test-2"}},
+			},
+		},
+	}
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
+	for _, c := range append(res.Job.Spec.Template.Spec.InitContainers, res.Job.Spec.Template.Spec.Containers...) {
+		assert.NotContains(t, c.Command, constants.ArgAlias)
+	}
+
+	wf.Spec.Steps = append(wf.Spec.Steps, testworkflowsv1.Step{
+		StepBase: testworkflowsv1.StepBase{Name: "Use token", Shell: "echo {{steps.get_token.outputs.token}}"},
+	})
+	_, err = proc.Bundle(context.Background(), wf, execMachine)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "step alias 'get_token' is ambiguous")
+
+	wf.Spec.Steps[1].Name = "Check token"
+	_, err = proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
 }
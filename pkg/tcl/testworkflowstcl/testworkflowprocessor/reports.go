@@ -0,0 +1,38 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"fmt"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/reports"
+)
+
+// ReportOutputName is the name of the step output with the parsed test report
+const ReportOutputName = "report"
+
+// validateReports ensures that the step may produce the test reports
+func validateReports(step testworkflowsv1.Step) error {
+	if len(step.Reports) == 0 {
+		return nil
+	}
+	if step.Shell == "" && step.Run == nil {
+		return fmt.Errorf("%s: reports: step has nothing to run", step.Name)
+	}
+	for i, r := range step.Reports {
+		if !reports.IsSupported(reports.Format(r.Format)) {
+			return fmt.Errorf("%s: reports.%d: unsupported format: %s", step.Name, i, r.Format)
+		}
+		if r.Path == "" {
+			return fmt.Errorf("%s: reports.%d: path is required", step.Name, i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/constants"
+)
+
+func TestProcessReports(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Name: "Unit tests", Shell: "shell-test", Reports: []testworkflowsv1.StepReport{
+					{Format: "junit", Path: "reports/*.xml"},
+				}}},
+				{StepBase: testworkflowsv1.StepBase{Name: "E2E", Shell: "shell-test-2", Reports: []testworkflowsv1.StepReport{
+					{Format: "tap", Path: "results.tap"},
+				}}},
+			},
+		},
+	}
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
+
+	podSpec := res.Job.Spec.Template.Spec
+	first := podSpec.InitContainers[1].Command
+	last := podSpec.Containers[0].Command
+	assert.Contains(t, first, "junit=reports/*.xml")
+	assert.NotContains(t, first, "tap=results.tap")
+	assert.Equal(t, constants.ArgSeparator, first[len(first)-1])
+	assert.Equal(t, []string{constants.ArgReport, "tap=results.tap", constants.ArgSeparator}, last[len(last)-3:])
+}
+
+func TestProcessReportsInvalid(t *testing.T) {
+	tests := map[string]testworkflowsv1.StepBase{
+		"unsupported format":      {Shell: "shell-test", Reports: []testworkflowsv1.StepReport{{Format: "xml", Path: "report.xml"}}},
+		"path is required":        {Shell: "shell-test", Reports: []testworkflowsv1.StepReport{{Format: "junit"}}},
+		"step has nothing to run": {Reports: []testworkflowsv1.StepReport{{Format: "junit", Path: "report.xml"}}},
+	}
+	for expected, step := range tests {
+		wf := &testworkflowsv1.TestWorkflow{
+			Spec: testworkflowsv1.TestWorkflowSpec{
+				Steps: []testworkflowsv1.Step{{StepBase: step}},
+			},
+		}
+
+		_, err := proc.Bundle(context.Background(), wf, execMachine)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), expected)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowresolver"
+)
+
+// ServiceContainerPrefix is the prefix for the names of the service containers
+const ServiceContainerPrefix = testworkflowresolver.ServiceContainerPrefix
+
+// GetServices reads the services from the TestWorkflow specification
+func GetServices(workflow *testworkflowsv1.TestWorkflow) (map[string]testworkflowsv1.ServiceSpec, error) {
+	if workflow == nil || len(workflow.Spec.Services) == 0 {
+		return nil, nil
+	}
+	services := make(map[string]testworkflowsv1.ServiceSpec, len(workflow.Spec.Services))
+	for name, service := range workflow.Spec.Services {
+		if err := testworkflowresolver.ValidateServiceName(name); err != nil {
+			return nil, fmt.Errorf("services.%s: %s", name, err)
+		}
+		if service.Image == "" {
+			return nil, fmt.Errorf("services.%s: image is required", name)
+		}
+		services[name] = *service.DeepCopy()
+	}
+	return services, nil
+}
+
+// GetServiceName returns the service name for the service container
+func GetServiceName(containerName string) (string, bool) {
+	if !strings.HasPrefix(containerName, ServiceContainerPrefix) {
+		return "", false
+	}
+	return containerName[len(ServiceContainerPrefix):], true
+}
+
+// buildServiceContainers builds sidecar containers, that are started before the steps
+// and blocking the steps until they are ready
+func buildServiceContainers(services map[string]testworkflowsv1.ServiceSpec, volumeMounts []corev1.VolumeMount) ([]corev1.Container, error) {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	containers := make([]corev1.Container, 0, len(services))
+	for _, name := range names {
+		service := services[name]
+		container := corev1.Container{
+			Name:            ServiceContainerPrefix + name,
+			Image:           service.Image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         service.Command,
+			Args:            service.Args,
+			Env:             service.Env,
+			VolumeMounts:    volumeMounts,
+			RestartPolicy:   common.Ptr(corev1.ContainerRestartPolicyAlways),
+			ReadinessProbe:  service.ReadinessProbe,
+		}
+		for _, port := range service.Ports {
+			container.Ports = append(container.Ports, corev1.ContainerPort{ContainerPort: port})
+		}
+		if container.ReadinessProbe == nil && len(service.Ports) > 0 {
+			container.ReadinessProbe = &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{
+					TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(service.Ports[0])},
+				},
+				PeriodSeconds:    1,
+				FailureThreshold: 60,
+			}
+		}
+		// The startup probe is blocking the next containers until the service is ready
+		container.StartupProbe = container.ReadinessProbe
+		resources, err := buildResources(service.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("services.%s: %s", name, err)
+		}
+		container.Resources = resources
+		containers = append(containers, container)
+	}
+	return containers, nil
+}
+
+// buildServiceHostAliases makes the services reachable by their names from all the containers
+func buildServiceHostAliases(services map[string]testworkflowsv1.ServiceSpec) []corev1.HostAlias {
+	if len(services) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return []corev1.HostAlias{{IP: "127.0.0.1", Hostnames: names}}
+}
+
+// CreateServicesMachine exposes the services' addresses as "services.<name>.host" and "services.<name>.port" variables
+func CreateServicesMachine(services map[string]testworkflowsv1.ServiceSpec) expressionstcl.Machine {
+	machine := expressionstcl.NewMachine()
+	for name, service := range services {
+		machine.Register("services."+name+".host", name)
+		if len(service.Ports) > 0 {
+			machine.Register("services."+name+".port", service.Ports[0])
+		}
+	}
+	return machine
+}
+
+// ServiceLogId returns the identifier of the log stream for the execution's service
+func ServiceLogId(executionId, name string) string {
+	return executionId + "-service-" + name
+}
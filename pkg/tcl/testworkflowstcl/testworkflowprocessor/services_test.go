@@ -0,0 +1,100 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+)
+
+func TestProcessServices(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Services: map[string]testworkflowsv1.ServiceSpec{
+					"redis": {Image: "redis:7", Ports: []int32{6379}},
+					"postgres": {
+						Image: "postgres:16",
+						Env:   []corev1.EnvVar{{Name: "POSTGRES_PASSWORD", Value: "{{execution.id}}"}},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: []string{"pg_isready"}}},
+						},
+						Resources: &testworkflowsv1.Resources{
+							Limits: map[corev1.ResourceName]intstr.IntOrString{corev1.ResourceMemory: intstr.FromString("256Mi")},
+						},
+					},
+				},
+			},
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Shell: "redis-cli -h {{ services.redis.host }} -p {{ services.redis.port }}"}},
+			},
+		},
+	}
+
+	res, err := proc.Bundle(context.Background(), wf, execMachine)
+	assert.NoError(t, err)
+
+	podSpec := res.Job.Spec.Template.Spec
+	assert.Len(t, podSpec.InitContainers, 3)
+	assert.Equal(t, "tktw-init", podSpec.InitContainers[0].Name)
+
+	postgres := podSpec.InitContainers[1]
+	assert.Equal(t, ServiceContainerPrefix+"postgres", postgres.Name)
+	assert.Equal(t, "postgres:16", postgres.Image)
+	assert.Equal(t, common.Ptr(corev1.ContainerRestartPolicyAlways), postgres.RestartPolicy)
+	assert.Equal(t, []corev1.EnvVar{{Name: "POSTGRES_PASSWORD", Value: "dummy-id"}}, postgres.Env)
+	assert.Equal(t, []string{"pg_isready"}, postgres.StartupProbe.Exec.Command)
+	assert.Equal(t, "256Mi", postgres.Resources.Limits.Memory().String())
+
+	redis := podSpec.InitContainers[2]
+	assert.Equal(t, ServiceContainerPrefix+"redis", redis.Name)
+	assert.Equal(t, []corev1.ContainerPort{{ContainerPort: 6379}}, redis.Ports)
+	assert.Equal(t, intstr.FromInt32(6379), redis.StartupProbe.TCPSocket.Port)
+	assert.Equal(t, redis.StartupProbe, redis.ReadinessProbe)
+
+	assert.Equal(t, []corev1.HostAlias{{IP: "127.0.0.1", Hostnames: []string{"postgres", "redis"}}}, podSpec.HostAliases)
+	assert.Len(t, res.Signature, 1)
+	assert.Contains(t, podSpec.Containers[0].Args, "redis-cli -h redis -p 6379")
+}
+
+func TestProcessServicesInvalid(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Services: map[string]testworkflowsv1.ServiceSpec{
+					"Invalid_Name": {Image: "redis"},
+				},
+			},
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Shell: "shell-test"}},
+			},
+		},
+	}
+
+	_, err := proc.Bundle(context.Background(), wf, execMachine)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid name")
+}
+
+func TestGetServiceName(t *testing.T) {
+	name, ok := GetServiceName(ServiceContainerPrefix + "redis")
+	assert.True(t, ok)
+	assert.Equal(t, "redis", name)
+
+	_, ok = GetServiceName("tktw-init")
+	assert.False(t, ok)
+}
@@ -25,6 +25,7 @@ type StageLifecycle interface {
 	Condition() string
 	RetryPolicy() testworkflowsv1.RetryPolicy
 	Timeout() string
+	GracePeriod() string
 
 	SetNegative(negative bool) StageLifecycle
 	SetOptional(optional bool) StageLifecycle
@@ -32,6 +33,7 @@ type StageLifecycle interface {
 	AppendConditions(expr ...string) StageLifecycle
 	SetRetryPolicy(policy *testworkflowsv1.RetryPolicy) StageLifecycle
 	SetTimeout(tpl string) StageLifecycle
+	SetGracePeriod(tpl string) StageLifecycle
 }
 
 type stageLifecycle struct {
@@ -40,6 +42,7 @@ type stageLifecycle struct {
 	condition string
 	retry     testworkflowsv1.RetryPolicy
 	timeout   string
+	grace     string
 }
 
 func NewStageLifecycle() StageLifecycle {
@@ -69,6 +72,10 @@ func (s *stageLifecycle) Timeout() string {
 	return s.timeout
 }
 
+func (s *stageLifecycle) GracePeriod() string {
+	return s.grace
+}
+
 func (s *stageLifecycle) SetNegative(negative bool) StageLifecycle {
 	s.negative = negative
 	return s
@@ -112,3 +119,8 @@ func (s *stageLifecycle) SetTimeout(tpl string) StageLifecycle {
 	s.timeout = tpl
 	return s
 }
+
+func (s *stageLifecycle) SetGracePeriod(tpl string) StageLifecycle {
+	s.grace = tpl
+	return s
+}
@@ -10,6 +10,9 @@ package testworkflowprocessor
 
 import (
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 	"strings"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -20,6 +23,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
 )
 
+var stepAliasInvalidCharsRe = regexp.MustCompile(`[^a-z0-9_]+`)
+
 func AnnotateControlledBy(obj metav1.Object, testWorkflowId string) {
 	labels := obj.GetLabels()
 	if labels == nil {
@@ -42,10 +47,128 @@ func isNotOptional(stage Stage) bool {
 	return !stage.Optional()
 }
 
-func buildKubernetesContainers(stage Stage, init *initProcess, machines ...expressionstcl.Machine) (containers []corev1.Container, err error) {
+// GetStepAlias builds the identifier to access the step outputs by its name,
+// i.e. "Get token" step outputs are available as "steps.get_token.outputs.<name>"
+func GetStepAlias(name string) string {
+	if name == "" || strings.Contains(name, "{{") {
+		return ""
+	}
+	alias := strings.Trim(stepAliasInvalidCharsRe.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if alias == "" || alias[0] >= '0' && alias[0] <= '9' {
+		return ""
+	}
+	return alias
+}
+
+// resolveStepAliases assigns the aliases to the steps, so their outputs may be accessed by the step name.
+// Multiple steps may share the same name, but then their alias is ambiguous and can't be used to access the outputs.
+func resolveStepAliases(root Stage) (map[string]string, error) {
+	stages := []Stage{root}
+	if group, ok := root.(GroupStage); ok {
+		stages = group.RecursiveChildren()
+	}
+	aliases := make(map[string]string)
+	refs := make(map[string][]string)
+	assign := func(name string, ref string) {
+		alias := GetStepAlias(name)
+		if alias == "" {
+			return
+		}
+		if !slices.Contains(refs[alias], ref) {
+			refs[alias] = append(refs[alias], ref)
+		}
+		aliases[ref] = alias
+	}
+	for _, stage := range stages {
+		assign(stage.Name(), stage.Ref())
+	}
+
+	// Only the containers are aware of their alias, so the step group passes it down to its approval
+	for _, group := range getGroupStages(root) {
+		if approval := findApprovalStage(group); approval != nil {
+			assign(group.Name(), approval.Ref())
+		}
+	}
+
+	// Ensure the ambiguous aliases are not used to access the step outputs
+	used := getUsedStepAliases(stages)
+	for ref, alias := range aliases {
+		if len(refs[alias]) == 1 {
+			continue
+		}
+		if _, ok := used[alias]; ok {
+			return nil, fmt.Errorf("step alias '%s' is ambiguous, as it's used by steps %s, rename the steps to access their outputs", alias, strings.Join(refs[alias], ", "))
+		}
+		delete(aliases, ref)
+	}
+	return aliases, nil
+}
+
+// getGroupStages lists the stage and its descendants that are groups
+func getGroupStages(stage Stage) []GroupStage {
+	group, ok := stage.(GroupStage)
+	if !ok {
+		return nil
+	}
+	groups := []GroupStage{group}
+	for _, ch := range group.Children() {
+		groups = append(groups, getGroupStages(ch)...)
+	}
+	return groups
+}
+
+// findApprovalStage finds the unnamed stage waiting for the approval directly in the group
+func findApprovalStage(group GroupStage) Stage {
+	for _, ch := range group.Children() {
+		if _, ok := ch.(ContainerStage); ok && ch.Category() == approvalCategory && ch.Name() == "" {
+			return ch
+		}
+	}
+	return nil
+}
+
+// getUsedStepAliases finds the step aliases (or references) accessed in "steps.<alias>" expressions of the stages
+func getUsedStepAliases(stages []Stage) map[string]struct{} {
+	accessors := make(map[string]struct{})
+	appendAccessors := func(expr expressionstcl.Expression, err error) {
+		if err == nil {
+			maps.Copy(accessors, expr.Accessors())
+		}
+	}
+	for _, stage := range stages {
+		appendAccessors(expressionstcl.Compile(stage.Condition()))
+		appendAccessors(expressionstcl.Compile(stage.RetryPolicy().Until))
+		c, ok := stage.(ContainerStage)
+		if !ok {
+			continue
+		}
+		templates := append(append([]string{c.Container().WorkingDir()}, c.Container().Command()...), c.Container().Args()...)
+		for _, env := range c.Container().Env() {
+			templates = append(templates, env.Value)
+		}
+		for _, tpl := range templates {
+			appendAccessors(expressionstcl.CompileTemplate(tpl))
+		}
+	}
+
+	used := make(map[string]struct{})
+	for name := range accessors {
+		if !strings.HasPrefix(name, "steps.") {
+			continue
+		}
+		alias, _, _ := strings.Cut(name[len("steps."):], ".")
+		used[alias] = struct{}{}
+	}
+	return used
+}
+
+func buildKubernetesContainers(stage Stage, init *initProcess, aliases map[string]string, machines ...expressionstcl.Machine) (containers []corev1.Container, err error) {
 	if stage.Timeout() != "" {
 		init.AddTimeout(stage.Timeout(), stage.Ref())
 	}
+	if stage.GracePeriod() != "" {
+		init.SetGracePeriod(stage.GracePeriod())
+	}
 	if stage.Ref() != "" {
 		init.AddCondition(stage.Condition(), stage.Ref())
 	}
@@ -84,7 +207,7 @@ func buildKubernetesContainers(stage Stage, init *initProcess, machines ...expre
 				init.ResetCondition()
 			}
 			// Pass down to another group or container
-			sub, serr := buildKubernetesContainers(ch, init.Children(ch.Ref()), machines...)
+			sub, serr := buildKubernetesContainers(ch, init.Children(ch.Ref()), aliases, machines...)
 			if serr != nil {
 				return nil, fmt.Errorf("%s: %s: resolving children: %s", stage.Ref(), stage.Name(), serr.Error())
 			}
@@ -112,7 +235,9 @@ func buildKubernetesContainers(stage Stage, init *initProcess, machines ...expre
 	}
 
 	init.
+		SetAlias(aliases[c.Ref()]).
 		SetNegative(c.Negative()).
+		SetReports(c.Reports()).
 		AddRetryPolicy(c.RetryPolicy(), c.Ref()).
 		SetCommand(cr.Command...).
 		SetArgs(cr.Args...)
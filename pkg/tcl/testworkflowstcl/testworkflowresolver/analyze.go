@@ -37,6 +37,11 @@ func listStepTemplates(cr testworkflowsv1.Step) map[string]struct{} {
 	for i := range cr.Steps {
 		maps.Copy(v, listStepTemplates(cr.Steps[i]))
 	}
+	if cr.Parallel != nil {
+		for i := range cr.Parallel.Steps {
+			maps.Copy(v, listStepTemplates(cr.Parallel.Steps[i]))
+		}
+	}
 	return v
 }
 
@@ -10,7 +10,9 @@ package testworkflowresolver
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -58,6 +60,8 @@ func InjectTemplate(workflow *testworkflowsv1.TestWorkflow, template testworkflo
 	// Apply top-level configuration
 	workflow.Spec.Pod = MergePodConfig(template.Spec.Pod, workflow.Spec.Pod)
 	workflow.Spec.Job = MergeJobConfig(template.Spec.Job, workflow.Spec.Job)
+	workflow.Spec.Services = MergeServices(maps.Clone(template.Spec.Services), workflow.Spec.Services)
+	workflow.Spec.Cache = append(slices.Clone(template.Spec.Cache), workflow.Spec.Cache...)
 
 	// Apply basic configuration
 	workflow.Spec.Content = MergeContent(template.Spec.Content, workflow.Spec.Content)
@@ -81,6 +85,7 @@ func InjectStepTemplate(step *testworkflowsv1.Step, template testworkflowsv1.Tes
 	// Apply basic configuration
 	step.Content = MergeContent(template.Spec.Content, step.Content)
 	step.Container = MergeContainerConfig(template.Spec.Container, step.Container)
+	step.Cache = append(slices.Clone(template.Spec.Cache), step.Cache...)
 
 	// Fast-track when the template doesn't contain any steps to run
 	if len(template.Spec.Setup) == 0 && len(template.Spec.Steps) == 0 && len(template.Spec.After) == 0 {
@@ -149,6 +154,15 @@ func applyTemplatesToStep(step testworkflowsv1.Step, templates map[string]testwo
 			return step, errors.Wrap(err, fmt.Sprintf(".steps[%d]", i))
 		}
 	}
+	if step.Parallel != nil {
+		for i := range step.Parallel.Steps {
+			step.Parallel.Steps[i], err = applyTemplatesToStep(step.Parallel.Steps[i], templates)
+			if err != nil {
+				return step, errors.Wrap(err, fmt.Sprintf(".parallel.steps[%d]", i))
+			}
+		}
+		step.Parallel.Steps = FlattenStepList(step.Parallel.Steps)
+	}
 
 	return step, nil
 }
@@ -280,6 +280,41 @@ func TestApplyTemplatesMergeTopLevelSteps(t *testing.T) {
 	assert.Equal(t, want, wf)
 }
 
+func TestApplyTemplatesMergeServices(t *testing.T) {
+	tpls := map[string]testworkflowsv1.TestWorkflowTemplate{
+		"db": {
+			Spec: testworkflowsv1.TestWorkflowTemplateSpec{
+				TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+					Services: map[string]testworkflowsv1.ServiceSpec{
+						"db":    {Image: "postgres:15"},
+						"cache": {Image: "redis:7"},
+					},
+				},
+			},
+		},
+	}
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Use: []testworkflowsv1.TemplateRef{{Name: "db"}},
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Services: map[string]testworkflowsv1.ServiceSpec{
+					"db": {Image: "postgres:16"},
+				},
+			},
+		},
+	}
+	err := ApplyTemplates(wf, tpls)
+
+	want := map[string]testworkflowsv1.ServiceSpec{
+		"db":    {Image: "postgres:16"},
+		"cache": {Image: "redis:7"},
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, wf.Spec.Services)
+	assert.Equal(t, "postgres:15", tpls["db"].Spec.Services["db"].Image)
+}
+
 func TestApplyTemplatesMergeMultipleTopLevelSteps(t *testing.T) {
 	wf := workflowSteps.DeepCopy()
 	wf.Spec.Use = []testworkflowsv1.TemplateRef{tplStepsRef, tplStepsConfigRef}
@@ -557,3 +592,58 @@ func TestApplyTemplatesConfigOverflow(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, wf)
 }
+
+func TestApplyTemplatesStepParallel(t *testing.T) {
+	s := testworkflowsv1.Step{
+		Parallel: &testworkflowsv1.StepParallel{
+			Steps: []testworkflowsv1.Step{
+				{Use: []testworkflowsv1.TemplateRef{tplStepsRef}},
+			},
+		},
+	}
+	s, err := applyTemplatesToStep(s, templates)
+
+	want := testworkflowsv1.Step{
+		Parallel: &testworkflowsv1.StepParallel{
+			Steps: []testworkflowsv1.Step{
+				ConvertIndependentStepToStep(tplSteps.Spec.Setup[0]),
+				ConvertIndependentStepToStep(tplSteps.Spec.Steps[0]),
+				ConvertIndependentStepToStep(tplSteps.Spec.After[0]),
+			},
+		},
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, s)
+}
+
+func TestApplyTemplatesMergeCache(t *testing.T) {
+	tpls := map[string]testworkflowsv1.TestWorkflowTemplate{
+		"npm": {
+			Spec: testworkflowsv1.TestWorkflowTemplateSpec{
+				TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+					Cache: []testworkflowsv1.CacheSpec{{Key: "npm", Paths: []string{"node_modules"}}},
+				},
+			},
+		},
+	}
+	wf := &testworkflowsv1.TestWorkflow{
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Use: []testworkflowsv1.TemplateRef{{Name: "npm"}},
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Cache: []testworkflowsv1.CacheSpec{{Key: "go", Paths: []string{".cache/go"}}},
+			},
+			Steps: []testworkflowsv1.Step{
+				{Use: []testworkflowsv1.TemplateRef{{Name: "npm"}}},
+			},
+		},
+	}
+	err := ApplyTemplates(wf, tpls)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []testworkflowsv1.CacheSpec{
+		{Key: "npm", Paths: []string{"node_modules"}},
+		{Key: "go", Paths: []string{".cache/go"}},
+	}, wf.Spec.Cache)
+	assert.Equal(t, []testworkflowsv1.CacheSpec{{Key: "npm", Paths: []string{"node_modules"}}}, wf.Spec.Steps[0].Cache)
+}
@@ -61,6 +61,17 @@ func MergeJobConfig(dst, include *testworkflowsv1.JobConfig) *testworkflowsv1.Jo
 	return dst
 }
 
+func MergeServices(dst, include map[string]testworkflowsv1.ServiceSpec) map[string]testworkflowsv1.ServiceSpec {
+	if len(include) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = map[string]testworkflowsv1.ServiceSpec{}
+	}
+	maps.Copy(dst, include)
+	return dst
+}
+
 func MergeContentGit(dst, include *testworkflowsv1.ContentGit) *testworkflowsv1.ContentGit {
 	if dst == nil {
 		return include
@@ -141,5 +152,11 @@ func ConvertIndependentStepToStep(step testworkflowsv1.IndependentStep) (res tes
 	res.StepBase = step.StepBase
 	res.Setup = common.MapSlice(step.Setup, ConvertIndependentStepToStep)
 	res.Steps = common.MapSlice(step.Steps, ConvertIndependentStepToStep)
+	if step.Parallel != nil {
+		res.Parallel = &testworkflowsv1.StepParallel{
+			StepParallelBase: step.Parallel.StepParallelBase,
+			Steps:            common.MapSlice(step.Parallel.Steps, ConvertIndependentStepToStep),
+		}
+	}
 	return res
 }
@@ -0,0 +1,110 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//     https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowresolver
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+)
+
+// MaxParallelCombinations is the maximum number of pods that may be created for a single parallel step
+const MaxParallelCombinations = 100
+
+// ParallelCombination is a single item of the fanned-out parallel step
+type ParallelCombination struct {
+	Index      int
+	Matrix     map[string]string
+	ShardIndex int
+	ShardCount int
+}
+
+// ParallelCombinations builds the list of all matrix combinations multiplied by the shards
+func ParallelCombinations(matrix map[string][]string, shards int) ([]ParallelCombination, error) {
+	if shards < 0 {
+		return nil, errors.New("shards cannot be negative")
+	}
+	if shards == 0 {
+		shards = 1
+	}
+
+	keys := sortedKeys(matrix)
+	matrices := []map[string]string{{}}
+	for _, k := range keys {
+		if len(matrix[k]) == 0 {
+			return nil, fmt.Errorf("matrix.%s: no values provided", k)
+		}
+		next := make([]map[string]string, 0, len(matrices)*len(matrix[k]))
+		for _, m := range matrices {
+			for _, v := range matrix[k] {
+				c := maps.Clone(m)
+				c[k] = v
+				next = append(next, c)
+			}
+		}
+		matrices = next
+		if len(matrices)*shards > MaxParallelCombinations {
+			break
+		}
+	}
+
+	if len(matrices)*shards > MaxParallelCombinations {
+		return nil, fmt.Errorf("too many combinations: maximum is %d", MaxParallelCombinations)
+	}
+
+	result := make([]ParallelCombination, 0, len(matrices)*shards)
+	for _, m := range matrices {
+		for i := 0; i < shards; i++ {
+			result = append(result, ParallelCombination{
+				Index:      len(result),
+				Matrix:     m,
+				ShardIndex: i,
+				ShardCount: shards,
+			})
+		}
+	}
+	return result, nil
+}
+
+// Name returns human-readable description of the combination
+func (c ParallelCombination) Name() string {
+	parts := make([]string, 0, len(c.Matrix)+1)
+	for _, k := range sortedKeys(c.Matrix) {
+		parts = append(parts, fmt.Sprintf("%s: %s", k, c.Matrix[k]))
+	}
+	if c.ShardCount > 1 {
+		parts = append(parts, fmt.Sprintf("shard: %d/%d", c.ShardIndex+1, c.ShardCount))
+	}
+	if len(parts) == 0 {
+		return fmt.Sprintf("#%d", c.Index+1)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Machine exposes the combination as "matrix.<name>", "shard.index" and "shard.count" to expressions
+func (c ParallelCombination) Machine() expressionstcl.Machine {
+	return expressionstcl.NewMachine().
+		RegisterStringMap("matrix", c.Matrix).
+		Register("shard.index", c.ShardIndex).
+		Register("shard.count", c.ShardCount)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//     https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowresolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+)
+
+func TestParallelCombinations(t *testing.T) {
+	matrix := map[string][]string{
+		"os":      {"linux"},
+		"browser": {"chrome", "firefox"},
+	}
+	want := []ParallelCombination{
+		{Index: 0, Matrix: map[string]string{"browser": "chrome", "os": "linux"}, ShardIndex: 0, ShardCount: 2},
+		{Index: 1, Matrix: map[string]string{"browser": "chrome", "os": "linux"}, ShardIndex: 1, ShardCount: 2},
+		{Index: 2, Matrix: map[string]string{"browser": "firefox", "os": "linux"}, ShardIndex: 0, ShardCount: 2},
+		{Index: 3, Matrix: map[string]string{"browser": "firefox", "os": "linux"}, ShardIndex: 1, ShardCount: 2},
+	}
+
+	got, err := ParallelCombinations(matrix, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, "browser: firefox, os: linux, shard: 2/2", got[3].Name())
+}
+
+func TestParallelCombinationsShardsOnly(t *testing.T) {
+	got, err := ParallelCombinations(nil, 3)
+
+	assert.NoError(t, err)
+	assert.Len(t, got, 3)
+	assert.Equal(t, "shard: 3/3", got[2].Name())
+}
+
+func TestParallelCombinationsEmptyMatrix(t *testing.T) {
+	_, err := ParallelCombinations(map[string][]string{"browser": {}}, 0)
+
+	assert.Error(t, err)
+}
+
+func TestParallelCombinationsTooMany(t *testing.T) {
+	matrix := map[string][]string{"a": {"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}}
+
+	_, err := ParallelCombinations(matrix, 10)
+
+	assert.Error(t, err)
+}
+
+func TestParallelCombinationsNegativeShards(t *testing.T) {
+	_, err := ParallelCombinations(nil, -1)
+
+	assert.Error(t, err)
+}
+
+func TestParallelCombinationMachine(t *testing.T) {
+	combination := ParallelCombination{Matrix: map[string]string{"browser": "chrome"}, ShardIndex: 1, ShardCount: 3}
+
+	got, err := expressionstcl.EvalTemplate("{{matrix.browser}}-{{shard.index + 1}}/{{shard.count}}", combination.Machine())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "chrome-2/3", got)
+}
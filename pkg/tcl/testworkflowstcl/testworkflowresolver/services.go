@@ -0,0 +1,31 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//     https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowresolver
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ServiceContainerPrefix is the prefix for the names of the service containers
+const ServiceContainerPrefix = "tktw-svc-"
+
+// ValidateServiceName ensures that the service name may be used both as a host name,
+// and as a part of the service container name
+func ValidateServiceName(name string) error {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("invalid name: %s", strings.Join(errs, ", "))
+	}
+	if errs := validation.IsDNS1123Label(ServiceContainerPrefix + name); len(errs) > 0 {
+		return fmt.Errorf("invalid name: the container name '%s%s' is too long: %s", ServiceContainerPrefix, name, strings.Join(errs, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,425 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//     https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowresolver
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/cmd/tcl/testworkflow-init/data"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+)
+
+type ValidationSeverity string
+
+const (
+	ValidationSeverityError   ValidationSeverity = "error"
+	ValidationSeverityWarning ValidationSeverity = "warning"
+)
+
+// ValidationIssue is a problem found in the TestWorkflow, with the YAML path to the faulty property
+type ValidationIssue struct {
+	Severity ValidationSeverity
+	Path     string
+	Message  string
+}
+
+// durationRe is the same pattern as the one used for timeout and delay in the CRD
+var durationRe = regexp.MustCompile(`^((0|[1-9][0-9]*)h)?((0|[1-9][0-9]*)m)?((0|[1-9][0-9]*)s)?((0|[1-9][0-9]*)ms)?$`)
+
+// knownVariables are the step status aliases that are available at run time
+var knownVariables = map[string]struct{}{
+	"always": {}, "never": {}, "passed": {}, "failed": {}, "error": {}, "success": {}, "status": {},
+	"self.status": {}, "self.passed": {}, "self.failed": {}, "self.error": {}, "self.success": {},
+}
+
+// runtimeMachines are the machines available in the execution pod, aside of the ones used for processing
+var runtimeMachines = []expressionstcl.Machine{
+	data.StateMachine,
+	data.EnvMachine,
+	ParallelCombination{}.Machine(),
+}
+
+// variablesLister is implemented by the machines that know which variables they provide
+type variablesLister interface {
+	Variables() []string
+}
+
+// runtimeFunctions are the functions available at run time, aside of the standard library
+var runtimeFunctions = map[string]struct{}{
+	"file": {},
+}
+
+var conditionAliases = expressionstcl.NewMachine().
+	Register("always", true).
+	Register("never", false)
+
+type validator struct {
+	config    map[string]testworkflowsv1.ParameterSchema
+	templates map[string]testworkflowsv1.TestWorkflowTemplate
+	machine   expressionstcl.Machine
+	issues    []ValidationIssue
+
+	// variables and namespaces are registered in the machines available while running the workflow
+	variables  map[string]struct{}
+	namespaces []string
+
+	// validated holds the names of the templates that have been already checked
+	validated map[string]struct{}
+}
+
+// ValidateWorkflow checks the TestWorkflow statically, without running it.
+// It type-checks the expressions, resolves the templates and verifies the step properties,
+// so the problems that would be found only at run time are reported early.
+// The variables are known when they are registered in the provided machines used for processing, or in the runtime ones.
+func ValidateWorkflow(t *testworkflowsv1.TestWorkflow, templates map[string]testworkflowsv1.TestWorkflowTemplate, machines ...expressionstcl.Machine) []ValidationIssue {
+	if t == nil {
+		return nil
+	}
+	v := &validator{config: t.Spec.Config, templates: templates, validated: map[string]struct{}{}}
+	v.registerVariables(append(slices.Clone(runtimeMachines), machines...))
+	if t.Name == "" {
+		v.error("metadata.name", "name is required")
+	}
+
+	// Build the machine with default configuration values, to type-check them along with the expressions
+	machine, err := createConfigMachine(nil, t.Spec.Config)
+	if err != nil {
+		v.error("spec.config", "%s", err)
+		machine = expressionstcl.NewMachine()
+	}
+	v.machine = machine
+
+	v.walk(reflect.ValueOf(t.Spec), "", "include", "spec")
+	v.validateServices("spec.services", t.Spec.Services)
+	for i := range t.Spec.Use {
+		v.validateTemplateRef(fmt.Sprintf("spec.use[%d]", i), t.Spec.Use[i])
+	}
+	v.validateSteps("spec.setup", t.Spec.Setup)
+	v.validateSteps("spec.steps", t.Spec.Steps)
+	v.validateSteps("spec.after", t.Spec.After)
+
+	// Ensure the templates may be applied, when all of them are available
+	if !v.hasErrors() {
+		if err = ApplyTemplates(t.DeepCopy(), templates); err != nil {
+			v.error("spec", "applying templates: %s", err)
+		}
+	}
+	return v.issues
+}
+
+func (v *validator) registerVariables(machines []expressionstcl.Machine) {
+	v.variables = maps.Clone(knownVariables)
+	for _, machine := range machines {
+		lister, ok := machine.(variablesLister)
+		if !ok {
+			continue
+		}
+		for _, name := range lister.Variables() {
+			if strings.HasSuffix(name, ".") {
+				v.namespaces = append(v.namespaces, name)
+			} else {
+				v.variables[name] = struct{}{}
+			}
+		}
+	}
+}
+
+func (v *validator) isKnownVariable(name string) bool {
+	if _, ok := v.variables[name]; ok {
+		return true
+	}
+	for _, namespace := range v.namespaces {
+		if strings.HasPrefix(name, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *validator) error(path, format string, args ...interface{}) {
+	v.issues = append(v.issues, ValidationIssue{Severity: ValidationSeverityError, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) warn(path, format string, args ...interface{}) {
+	v.issues = append(v.issues, ValidationIssue{Severity: ValidationSeverityWarning, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) hasErrors() bool {
+	for i := range v.issues {
+		if v.issues[i].Severity == ValidationSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *validator) validateSteps(path string, steps []testworkflowsv1.Step) {
+	for i := range steps {
+		v.validateStep(fmt.Sprintf("%s[%d]", path, i), steps[i])
+	}
+}
+
+func (v *validator) validateStep(path string, step testworkflowsv1.Step) {
+	hasChildren := step.Template != nil || len(step.Use) > 0 || len(step.Setup) > 0 || len(step.Steps) > 0 || step.Parallel != nil
+	v.validateStepBase(path, step.StepBase, hasChildren)
+
+	if step.Template != nil {
+		v.validateTemplateRef(path+".template", *step.Template)
+	}
+	for i := range step.Use {
+		v.validateTemplateRef(fmt.Sprintf("%s.use[%d]", path, i), step.Use[i])
+	}
+
+	v.validateSteps(path+".setup", step.Setup)
+	v.validateSteps(path+".steps", step.Steps)
+	if step.Parallel != nil {
+		v.validateParallelBase(path+".parallel", step.Parallel.StepParallelBase, len(step.Parallel.Steps))
+		v.validateSteps(path+".parallel.steps", step.Parallel.Steps)
+	}
+}
+
+func (v *validator) validateIndependentSteps(path string, steps []testworkflowsv1.IndependentStep) {
+	for i := range steps {
+		v.validateIndependentStep(fmt.Sprintf("%s[%d]", path, i), steps[i])
+	}
+}
+
+func (v *validator) validateIndependentStep(path string, step testworkflowsv1.IndependentStep) {
+	hasChildren := len(step.Setup) > 0 || len(step.Steps) > 0 || step.Parallel != nil
+	v.validateStepBase(path, step.StepBase, hasChildren)
+
+	v.validateIndependentSteps(path+".setup", step.Setup)
+	v.validateIndependentSteps(path+".steps", step.Steps)
+	if step.Parallel != nil {
+		v.validateParallelBase(path+".parallel", step.Parallel.StepParallelBase, len(step.Parallel.Steps))
+		v.validateIndependentSteps(path+".parallel.steps", step.Parallel.Steps)
+	}
+}
+
+func (v *validator) validateStepBase(path string, step testworkflowsv1.StepBase, hasChildren bool) {
+	v.validateDuration(path+".timeout", step.Timeout)
+	v.validateDuration(path+".delay", step.Delay)
+	v.validateDuration(path+".gracePeriod", step.GracePeriod)
+	if step.Retry != nil && step.Retry.Count < 1 {
+		v.error(path+".retry.count", "retry count has to be at least 1")
+	}
+	if step.Approval != nil {
+		v.validateDuration(path+".approval.timeout", step.Approval.Timeout)
+		switch step.Approval.Default {
+		case "", testworkflowsv1.ApprovalActionApprove, testworkflowsv1.ApprovalActionReject:
+		default:
+			v.error(path+".approval.default", "default action should be either %s or %s", testworkflowsv1.ApprovalActionApprove, testworkflowsv1.ApprovalActionReject)
+		}
+		for i, input := range step.Approval.Inputs {
+			if input.Name == "" {
+				v.error(fmt.Sprintf("%s.approval.inputs.%d.name", path, i), "name is required")
+			}
+		}
+	}
+
+	if step.Condition != "" {
+		expr, err := expressionstcl.CompileAndResolve(step.Condition, v.machine, conditionAliases)
+		if err == nil && expr.Static() != nil {
+			if ok, err := expr.Static().BoolValue(); err == nil && !ok {
+				v.warn(path+".condition", "the step is unreachable, as the condition is always false")
+			}
+		}
+	}
+
+	if !hasChildren && step.Shell == "" && step.Run == nil && step.Execute == nil && step.Approval == nil && step.Artifacts == nil && step.Content == nil {
+		v.warn(path, "the step has nothing to run")
+	}
+}
+
+func (v *validator) validateParallelBase(path string, parallel testworkflowsv1.StepParallelBase, stepsCount int) {
+	if parallel.Parallelism < 0 {
+		v.error(path+".parallelism", "parallelism cannot be negative")
+	}
+	if _, err := ParallelCombinations(parallel.Matrix, int(parallel.Shards)); err != nil {
+		v.error(path, "%s", err)
+	}
+	if stepsCount == 0 {
+		v.warn(path+".steps", "the parallel step has nothing to run")
+	}
+}
+
+func (v *validator) validateServices(path string, services map[string]testworkflowsv1.ServiceSpec) {
+	for _, name := range sortedKeys(services) {
+		if err := ValidateServiceName(name); err != nil {
+			v.error(path+"."+name, "%s", err)
+		}
+		if services[name].Image == "" {
+			v.error(path+"."+name+".image", "image is required")
+		}
+	}
+}
+
+func (v *validator) validateDuration(path, duration string) {
+	if duration != "" && !durationRe.MatchString(duration) {
+		v.error(path, "invalid duration '%s', expected format like 1h2m3s4ms", duration)
+	}
+}
+
+func (v *validator) validateTemplateRef(path string, ref testworkflowsv1.TemplateRef) {
+	tpl, err := getTemplate(ref.Name, v.templates)
+	if err != nil {
+		v.error(path+".name", "%s", err)
+		return
+	}
+	for _, k := range sortedKeys(ref.Config) {
+		schema, ok := tpl.Spec.Config[k]
+		if !ok {
+			v.error(path+".config."+k, "template '%s' has no '%s' parameter", ref.Name, k)
+			continue
+		}
+		if _, err := castParameter(ref.Config[k], schema); err != nil {
+			v.error(path+".config."+k, "invalid value for '%s' parameter: %s", k, err)
+		}
+	}
+	for _, k := range sortedKeys(tpl.Spec.Config) {
+		if _, ok := ref.Config[k]; !ok && tpl.Spec.Config[k].Default == nil {
+			v.error(path+".config", "missing required '%s' parameter of template '%s'", k, ref.Name)
+		}
+	}
+	v.validateTemplate(GetDisplayTemplateName(ref.Name), tpl)
+}
+
+// validateTemplate checks the template's own expressions and steps against its configuration, once per template
+func (v *validator) validateTemplate(name string, tpl testworkflowsv1.TestWorkflowTemplate) {
+	if _, ok := v.validated[name]; ok {
+		return
+	}
+	v.validated[name] = struct{}{}
+
+	path := "templates." + name + ".spec"
+	sub := &validator{config: tpl.Spec.Config, templates: v.templates, validated: v.validated}
+	machine, err := createConfigMachine(nil, tpl.Spec.Config)
+	if err != nil {
+		sub.error(path+".config", "%s", err)
+		machine = expressionstcl.NewMachine()
+	}
+	sub.machine = machine
+
+	sub.walk(reflect.ValueOf(tpl.Spec), "", "include", path)
+	sub.validateServices(path+".services", tpl.Spec.Services)
+	sub.validateIndependentSteps(path+".setup", tpl.Spec.Setup)
+	sub.validateIndependentSteps(path+".steps", tpl.Spec.Steps)
+	sub.validateIndependentSteps(path+".after", tpl.Spec.After)
+	v.issues = append(v.issues, sub.issues...)
+}
+
+// walk goes through the properties marked with `expr` tag, like expressionstcl.Simplify does
+func (v *validator) walk(value reflect.Value, key, kind, path string) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() || value.IsZero() {
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		if vv, ok := value.Interface().(intstr.IntOrString); ok {
+			if vv.Type == intstr.String {
+				v.validateString(vv.StrVal, kind, path)
+			}
+			return
+		}
+		if kind != "include" {
+			return
+		}
+		t := value.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("expr")
+			if !f.IsExported() || tag == "" || tag == "-" {
+				continue
+			}
+			fieldKey, fieldKind, ok := strings.Cut(tag, ",")
+			if !ok {
+				fieldKey, fieldKind = "", fieldKey
+			}
+			fieldPath := path
+			if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" {
+				fieldPath += "." + name
+			}
+			v.walk(value.Field(i), fieldKey, fieldKind, fieldPath)
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			v.walk(value.Index(i), key, kind, fmt.Sprintf("%s[%d]", path, i))
+		}
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+		for _, k := range keys {
+			itemPath := path + "." + k.String()
+			if k.Kind() == reflect.String {
+				v.validateString(k.String(), key, itemPath)
+			}
+			v.walk(value.MapIndex(k), "", kind, itemPath)
+		}
+	case reflect.String:
+		v.validateString(value.String(), kind, path)
+	}
+}
+
+func (v *validator) validateString(value, kind, path string) {
+	var expr expressionstcl.Expression
+	var err error
+	switch kind {
+	case "expression":
+		expr, err = expressionstcl.Compile(value)
+	case "template":
+		if expressionstcl.IsTemplateStringWithoutExpressions(value) {
+			return
+		}
+		expr, err = expressionstcl.CompileTemplate(value)
+	default:
+		return
+	}
+	if err != nil {
+		v.error(path, "invalid expression: %s", err)
+		return
+	}
+
+	for _, name := range sortedKeys(expr.Functions()) {
+		if _, ok := runtimeFunctions[name]; !ok && !expressionstcl.IsStdFunction(name) {
+			v.error(path, "unknown function '%s'", name)
+		}
+	}
+	for _, name := range sortedKeys(expr.Accessors()) {
+		if strings.HasPrefix(name, "config.") {
+			if _, ok := v.config[name[7:]]; !ok {
+				v.error(path, "unknown config parameter '%s'", name[7:])
+			}
+		} else if !v.isKnownVariable(name) {
+			v.warn(path, "unknown variable '%s'", name)
+		}
+	}
+
+	// Resolve the static parts, to detect type mismatches
+	if _, err = expr.Resolve(v.machine); err != nil {
+		v.error(path, "%s", err)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//     https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowresolver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/internal/common"
+	"github.com/kubeshop/testkube/pkg/tcl/expressionstcl"
+)
+
+var validationTemplates = map[string]testworkflowsv1.TestWorkflowTemplate{
+	"official--k6": {
+		ObjectMeta: metav1.ObjectMeta{Name: "official--k6"},
+		Spec: testworkflowsv1.TestWorkflowTemplateSpec{
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Config: map[string]testworkflowsv1.ParameterSchema{
+					"version": {Type: testworkflowsv1.ParameterTypeString},
+					"vus":     {Type: testworkflowsv1.ParameterTypeInteger, Default: &intstr.IntOrString{Type: intstr.Int, IntVal: 1}},
+				},
+			},
+			Steps: []testworkflowsv1.IndependentStep{
+				{StepBase: testworkflowsv1.StepBase{Shell: "k6 run"}},
+			},
+		},
+	},
+	"broken": {
+		ObjectMeta: metav1.ObjectMeta{Name: "broken"},
+		Spec: testworkflowsv1.TestWorkflowTemplateSpec{
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Config: map[string]testworkflowsv1.ParameterSchema{
+					"name": {Type: testworkflowsv1.ParameterTypeString, Default: &intstr.IntOrString{Type: intstr.String, StrVal: "world"}},
+				},
+			},
+			Setup: []testworkflowsv1.IndependentStep{
+				{StepBase: testworkflowsv1.StepBase{Shell: "echo {{ config.name }}", Condition: "passed &&"}},
+			},
+			Steps: []testworkflowsv1.IndependentStep{
+				{StepBase: testworkflowsv1.StepBase{Shell: "echo {{ config.missing }}", Timeout: "1 minute"}},
+				{Parallel: &testworkflowsv1.IndependentStepParallel{
+					Steps: []testworkflowsv1.IndependentStep{{StepBase: testworkflowsv1.StepBase{Name: "empty"}}},
+				}},
+			},
+		},
+	},
+}
+
+func TestValidateWorkflow_Valid(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Config: map[string]testworkflowsv1.ParameterSchema{
+					"url": {Type: testworkflowsv1.ParameterTypeString, Default: &intstr.IntOrString{Type: intstr.String, StrVal: "http://localhost"}},
+				},
+			},
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Shell: "curl {{ config.url }}/{{ env.PATH_SUFFIX }}", Timeout: "1m30s"}},
+				{StepBase: testworkflowsv1.StepBase{Approval: &testworkflowsv1.StepApproval{Message: "Continue {{ now }}?", Timeout: "1h"}}},
+				{StepBase: testworkflowsv1.StepBase{Shell: "curl {{ services.api.host }}:{{ services.api.port }} -d '{{ approval.continue.reason }}'"}},
+				{
+					StepBase: testworkflowsv1.StepBase{Condition: "always", Delay: "500ms"},
+					Template: &testworkflowsv1.TemplateRef{Name: "official/k6", Config: map[string]intstr.IntOrString{
+						"version": {Type: intstr.String, StrVal: "0.49.0"},
+					}},
+				},
+			},
+		},
+	}
+
+	servicesMachine := expressionstcl.NewMachine().
+		Register("services.api.host", "api").
+		Register("services.api.port", 8080)
+	assert.Empty(t, ValidateWorkflow(wf, validationTemplates, servicesMachine))
+}
+
+func TestValidateWorkflow_Issues(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Use: []testworkflowsv1.TemplateRef{{Name: "unknown"}},
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{
+					Condition: "passed &&",
+					Shell:     "echo {{ config.missing }} {{ unknown_fn(1) }}",
+					Timeout:   "10 minutes",
+				}},
+				{StepBase: testworkflowsv1.StepBase{
+					Condition: "never",
+					Shell:     "echo",
+					Container: &testworkflowsv1.ContainerConfig{WorkingDir: common.Ptr(`{{ "a" * 2 }}`)},
+				}},
+				{
+					StepBase: testworkflowsv1.StepBase{Name: "{{ someVariable }}"},
+					Template: &testworkflowsv1.TemplateRef{Name: "official/k6", Config: map[string]intstr.IntOrString{
+						"vus":   {Type: intstr.String, StrVal: "many"},
+						"other": {Type: intstr.String, StrVal: "value"},
+					}},
+				},
+				{StepBase: testworkflowsv1.StepBase{Name: "empty"}},
+				{StepBase: testworkflowsv1.StepBase{Approval: &testworkflowsv1.StepApproval{
+					Timeout: "1 hour",
+					Default: "maybe",
+					Inputs:  []testworkflowsv1.ApprovalInput{{Description: "unnamed"}},
+				}}},
+			},
+		},
+	}
+
+	issues := ValidateWorkflow(wf, validationTemplates)
+
+	type issue struct {
+		severity ValidationSeverity
+		path     string
+	}
+	var got []issue
+	for _, v := range issues {
+		got = append(got, issue{v.Severity, v.Path})
+	}
+	assert.ElementsMatch(t, []issue{
+		{ValidationSeverityError, "spec.steps[0].condition"},
+		{ValidationSeverityError, "spec.steps[0].shell"},
+		{ValidationSeverityError, "spec.steps[0].shell"},
+		{ValidationSeverityError, "spec.steps[0].timeout"},
+		{ValidationSeverityError, "spec.steps[1].container.workingDir"},
+		{ValidationSeverityWarning, "spec.steps[1].condition"},
+		{ValidationSeverityWarning, "spec.steps[2].name"},
+		{ValidationSeverityError, "spec.steps[2].template.config.other"},
+		{ValidationSeverityError, "spec.steps[2].template.config.vus"},
+		{ValidationSeverityError, "spec.steps[2].template.config"},
+		{ValidationSeverityWarning, "spec.steps[3]"},
+		{ValidationSeverityError, "spec.steps[4].approval.timeout"},
+		{ValidationSeverityError, "spec.steps[4].approval.default"},
+		{ValidationSeverityError, "spec.steps[4].approval.inputs.0.name"},
+		{ValidationSeverityError, "spec.use[0].name"},
+	}, got)
+}
+
+func TestValidateWorkflow_Parallel(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{Parallel: &testworkflowsv1.StepParallel{
+					StepParallelBase: testworkflowsv1.StepParallelBase{
+						Matrix: map[string][]string{"browser": {"chrome", "firefox"}},
+					},
+					Steps: []testworkflowsv1.Step{
+						{StepBase: testworkflowsv1.StepBase{Shell: "run --browser {{ matrix.browser }} --shard {{ shard.index }}"}},
+					},
+				}},
+				{Parallel: &testworkflowsv1.StepParallel{
+					StepParallelBase: testworkflowsv1.StepParallelBase{
+						Matrix: map[string][]string{"browser": {}},
+					},
+					Steps: []testworkflowsv1.Step{
+						{StepBase: testworkflowsv1.StepBase{Shell: "echo", Timeout: "1 minute"}},
+					},
+				}},
+			},
+		},
+	}
+
+	issues := ValidateWorkflow(wf, validationTemplates)
+
+	var got []string
+	for _, v := range issues {
+		got = append(got, v.Path)
+	}
+	assert.ElementsMatch(t, []string{"spec.steps[1].parallel", "spec.steps[1].parallel.steps[0].timeout"}, got)
+}
+
+func TestValidateWorkflow_Services(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			TestWorkflowSpecBase: testworkflowsv1.TestWorkflowSpecBase{
+				Services: map[string]testworkflowsv1.ServiceSpec{
+					"db":           {Image: "postgres:16"},
+					"Invalid_Name": {Image: "redis:7"},
+					"a-very-long-service-name-that-does-not-fit-the-container": {Image: "redis:7"},
+					"no-image": {},
+				},
+			},
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Shell: "psql -h db"}},
+			},
+		},
+	}
+
+	issues := ValidateWorkflow(wf, validationTemplates)
+
+	var got []string
+	for _, v := range issues {
+		got = append(got, v.Path)
+	}
+	assert.ElementsMatch(t, []string{
+		"spec.services.Invalid_Name",
+		"spec.services.a-very-long-service-name-that-does-not-fit-the-container",
+		"spec.services.no-image.image",
+	}, got)
+}
+
+func TestValidateWorkflow_Templates(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Use: []testworkflowsv1.TemplateRef{{Name: "broken"}},
+			Steps: []testworkflowsv1.Step{
+				{Template: &testworkflowsv1.TemplateRef{Name: "broken"}},
+			},
+		},
+	}
+
+	issues := ValidateWorkflow(wf, validationTemplates)
+
+	var got []string
+	for _, v := range issues {
+		got = append(got, v.Path)
+	}
+	assert.ElementsMatch(t, []string{
+		"templates.broken.spec.setup[0].condition",
+		"templates.broken.spec.steps[0].shell",
+		"templates.broken.spec.steps[0].timeout",
+		"templates.broken.spec.steps[1].parallel.steps[0]",
+	}, got)
+}
+
+func TestValidateServiceName(t *testing.T) {
+	assert.NoError(t, ValidateServiceName("db"))
+	assert.NoError(t, ValidateServiceName(strings.Repeat("a", 63-len(ServiceContainerPrefix))))
+	assert.Error(t, ValidateServiceName(strings.Repeat("a", 64-len(ServiceContainerPrefix))))
+	assert.Error(t, ValidateServiceName("db.local"))
+}
+
+func TestValidateWorkflow_Variables(t *testing.T) {
+	wf := &testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: testworkflowsv1.TestWorkflowSpec{
+			Steps: []testworkflowsv1.Step{
+				{StepBase: testworkflowsv1.StepBase{Shell: "echo {{ execution.id }} {{ steps.build.outputs.version }} {{ output.name }}"}},
+				{StepBase: testworkflowsv1.StepBase{Shell: "echo {{ execution.name }} {{ unknown.name }}"}},
+			},
+		},
+	}
+	machine := expressionstcl.NewMachine().RegisterStringMap("execution", map[string]string{"id": ""})
+
+	issues := ValidateWorkflow(wf, nil, machine)
+
+	assert.Equal(t, []ValidationIssue{
+		{Severity: ValidationSeverityWarning, Path: "spec.steps[1].shell", Message: "unknown variable 'unknown.name'"},
+	}, issues)
+
+	issues = ValidateWorkflow(wf, nil)
+
+	assert.Len(t, issues, 3)
+	assert.Equal(t, "unknown variable 'execution.id'", issues[0].Message)
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowscheduler
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	// CatchUpSkip ignores the runs missed while the scheduler was not running
+	CatchUpSkip = testworkflowsv1.ScheduleCatchUpSkip
+	// CatchUpLatest executes only the latest missed run
+	CatchUpLatest = testworkflowsv1.ScheduleCatchUpLatest
+	// CatchUpAll executes all the missed runs, up to the MaxCatchUpRuns
+	CatchUpAll = testworkflowsv1.ScheduleCatchUpAll
+
+	// MissedRunDeadline is the time after which the run is considered missed
+	MissedRunDeadline = time.Minute
+	// MaxCatchUpRuns is the maximum number of missed runs executed for a single schedule at once
+	MaxCatchUpRuns = 10
+	// MaxCatchUpWindow is how far back the missed runs are searched
+	MaxCatchUpWindow = 24 * time.Hour
+
+	// DefaultNextRunsCount is the default number of the upcoming runs in the preview
+	DefaultNextRunsCount = 10
+	// MaxNextRunsCount is the maximum number of the upcoming runs in the preview
+	MaxNextRunsCount = 100
+
+	defaultScheduleName = "default"
+)
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ScheduleSpec describes when the TestWorkflow should be executed automatically
+type ScheduleSpec = testworkflowsv1.Schedule
+
+// Schedule is the parsed ScheduleSpec
+type Schedule struct {
+	ScheduleSpec
+	schedule cron.Schedule
+	location *time.Location
+	jitter   time.Duration
+}
+
+// GetSchedules reads the cron schedules from the TestWorkflow specification
+func GetSchedules(workflow *testworkflowsv1.TestWorkflow) ([]Schedule, error) {
+	if workflow == nil || len(workflow.Spec.Schedules) == 0 {
+		return nil, nil
+	}
+	var err error
+	specs := workflow.Spec.Schedules
+	schedules := make([]Schedule, len(specs))
+	names := make([]string, 0, len(specs))
+	for i := range specs {
+		schedules[i], err = NewSchedule(specs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "schedules.%d", i)
+		}
+		if slices.Contains(names, schedules[i].Name) {
+			return nil, fmt.Errorf("schedules.%d: duplicated name: %s", i, schedules[i].Name)
+		}
+		names = append(names, schedules[i].Name)
+	}
+	return schedules, nil
+}
+
+// NewSchedule validates and parses the schedule specification
+func NewSchedule(spec ScheduleSpec) (s Schedule, err error) {
+	s.ScheduleSpec = spec
+	if s.Name == "" {
+		s.Name = defaultScheduleName
+	}
+	if s.Cron == "" {
+		return s, errors.New("cron: expression is required")
+	}
+	s.schedule, err = cronParser.Parse(s.Cron)
+	if err != nil {
+		return s, errors.Wrap(err, "cron")
+	}
+	s.location, err = time.LoadLocation(s.Timezone)
+	if err != nil {
+		return s, errors.Wrap(err, "timezone")
+	}
+	if s.Jitter != "" {
+		s.jitter, err = time.ParseDuration(s.Jitter)
+		if err != nil {
+			return s, errors.Wrap(err, "jitter")
+		}
+		if s.jitter < 0 {
+			return s, fmt.Errorf("jitter: must not be negative: %s", s.Jitter)
+		}
+	}
+	switch s.CatchUp {
+	case "":
+		s.CatchUp = CatchUpSkip
+	case CatchUpSkip, CatchUpLatest, CatchUpAll:
+	default:
+		return s, fmt.Errorf("catchUp: unknown policy: %s", s.CatchUp)
+	}
+	return s, nil
+}
+
+// Next returns the next slot of the schedule after the provided time, or zero time when there is none
+func (s *Schedule) Next(after time.Time) time.Time {
+	next := s.schedule.Next(after.In(s.location))
+	if next.IsZero() {
+		return next
+	}
+	return next.UTC()
+}
+
+// Delay returns the jitter for the run. It is stable, so all the replicas compute the same run time.
+func (s *Schedule) Delay(workflowName string, slot time.Time) time.Duration {
+	if s.jitter <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%s/%s/%d", workflowName, s.Name, slot.Unix())
+	return time.Duration(h.Sum64() % uint64(s.jitter))
+}
+
+// DueRuns returns the slots that should be executed now, according to the catch-up policy,
+// along with the latest slot that is already due.
+func (s *Schedule) DueRuns(workflowName string, last, now time.Time) (runs []time.Time, latest time.Time) {
+	latest = last
+	if last.Before(now.Add(-MaxCatchUpWindow)) {
+		last = now.Add(-MaxCatchUpWindow)
+	}
+	var missed []time.Time
+	for slot := s.Next(last); !slot.IsZero(); slot = s.Next(slot) {
+		runAt := slot.Add(s.Delay(workflowName, slot))
+		if runAt.After(now) {
+			break
+		}
+		latest = slot
+		if now.Sub(runAt) <= MissedRunDeadline {
+			runs = append(runs, slot)
+			continue
+		}
+		missed = append(missed, slot)
+		if len(missed) > MaxCatchUpRuns {
+			missed = missed[1:]
+		}
+	}
+
+	switch s.CatchUp {
+	case CatchUpLatest:
+		if len(runs) == 0 && len(missed) > 0 {
+			runs = missed[len(missed)-1:]
+		}
+	case CatchUpAll:
+		runs = append(missed, runs...)
+	}
+	return runs, latest
+}
+
+// NextRuns returns the upcoming runs of all enabled schedules, ordered by the run time
+func NextRuns(workflowName string, schedules []Schedule, from time.Time, count int) []testkube.TestWorkflowScheduledRun {
+	result := make([]testkube.TestWorkflowScheduledRun, 0)
+	for i := range schedules {
+		if schedules[i].Disabled {
+			continue
+		}
+		slot := from
+		for j := 0; j < count; j++ {
+			slot = schedules[i].Next(slot)
+			if slot.IsZero() {
+				break
+			}
+			result = append(result, testkube.TestWorkflowScheduledRun{
+				Schedule:    schedules[i].Name,
+				ScheduledAt: slot,
+				RunAt:       slot.Add(schedules[i].Delay(workflowName, slot)),
+				Config:      schedules[i].Config,
+			})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].RunAt.Before(result[j].RunAt)
+	})
+	if len(result) > count {
+		result = result[:count]
+	}
+	return result
+}
+
+// GetScheduleStatus reads the last handled run of each schedule from the TestWorkflow status
+func GetScheduleStatus(workflow *testworkflowsv1.TestWorkflow) map[string]time.Time {
+	status := make(map[string]time.Time)
+	if workflow != nil {
+		for _, s := range workflow.Status.Schedules {
+			status[s.Name] = s.LastScheduleTime.UTC()
+		}
+	}
+	return status
+}
+
+// SetScheduleStatus stores the last handled run of each schedule in the TestWorkflow status
+func SetScheduleStatus(workflow *testworkflowsv1.TestWorkflow, status map[string]time.Time) {
+	names := make([]string, 0, len(status))
+	for name := range status {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	workflow.Status.Schedules = make([]testworkflowsv1.ScheduleStatus, len(names))
+	for i, name := range names {
+		workflow.Status.Schedules[i] = testworkflowsv1.ScheduleStatus{Name: name, LastScheduleTime: metav1.NewTime(status[name])}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowscheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+)
+
+func workflowWithSchedules(schedules ...ScheduleSpec) *testworkflowsv1.TestWorkflow {
+	return &testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly"},
+		Spec:       testworkflowsv1.TestWorkflowSpec{Schedules: schedules},
+	}
+}
+
+func mustSchedule(t *testing.T, spec ScheduleSpec) Schedule {
+	s, err := NewSchedule(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestGetSchedules(t *testing.T) {
+	schedules, err := GetSchedules(workflowWithSchedules(
+		ScheduleSpec{Name: "nightly", Cron: "0 2 * * *", Timezone: "Europe/Warsaw", Jitter: "5m", Config: map[string]string{"env": "staging"}},
+		ScheduleSpec{Cron: "@hourly", CatchUp: CatchUpLatest},
+	))
+
+	assert.NoError(t, err)
+	assert.Len(t, schedules, 2)
+	assert.Equal(t, "nightly", schedules[0].Name)
+	assert.Equal(t, CatchUpSkip, schedules[0].CatchUp)
+	assert.Equal(t, map[string]string{"env": "staging"}, schedules[0].Config)
+	assert.Equal(t, defaultScheduleName, schedules[1].Name)
+	assert.Equal(t, CatchUpLatest, schedules[1].CatchUp)
+}
+
+func TestGetSchedules_Invalid(t *testing.T) {
+	cases := map[string][]ScheduleSpec{
+		"missing cron":     {{Name: "a"}},
+		"invalid cron":     {{Cron: "* * *"}},
+		"invalid timezone": {{Cron: "@daily", Timezone: "Mars/Olympus"}},
+		"invalid jitter":   {{Cron: "@daily", Jitter: "soon"}},
+		"negative jitter":  {{Cron: "@daily", Jitter: "-1m"}},
+		"invalid catch-up": {{Cron: "@daily", CatchUp: "sometimes"}},
+		"duplicated name":  {{Cron: "@daily"}, {Cron: "@hourly"}},
+	}
+	for name, value := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := GetSchedules(workflowWithSchedules(value...))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSchedule_NextTimezone(t *testing.T) {
+	s := mustSchedule(t, ScheduleSpec{Cron: "0 2 * * *", Timezone: "Europe/Warsaw"})
+
+	// Warsaw is UTC+1 in winter and UTC+2 in summer
+	assert.Equal(t, time.Date(2024, 1, 10, 1, 0, 0, 0, time.UTC), s.Next(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC), s.Next(time.Date(2024, 7, 9, 23, 0, 0, 0, time.UTC)))
+}
+
+func TestSchedule_Delay(t *testing.T) {
+	s := mustSchedule(t, ScheduleSpec{Name: "nightly", Cron: "@daily", Jitter: "10m"})
+	slot := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	delay := s.Delay("workflow", slot)
+	assert.Equal(t, delay, s.Delay("workflow", slot))
+	assert.GreaterOrEqual(t, delay, time.Duration(0))
+	assert.Less(t, delay, 10*time.Minute)
+
+	noJitter := mustSchedule(t, ScheduleSpec{Cron: "@daily"})
+	assert.Equal(t, time.Duration(0), noJitter.Delay("workflow", slot))
+}
+
+func TestSchedule_DueRuns(t *testing.T) {
+	last := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	onTime := time.Date(2024, 1, 10, 5, 0, 30, 0, time.UTC)
+
+	cases := map[testworkflowsv1.ScheduleCatchUpPolicy][]time.Time{
+		CatchUpSkip: {
+			time.Date(2024, 1, 10, 5, 0, 0, 0, time.UTC),
+		},
+		CatchUpLatest: {
+			time.Date(2024, 1, 10, 5, 0, 0, 0, time.UTC),
+		},
+		CatchUpAll: {
+			time.Date(2024, 1, 10, 1, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 10, 2, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 10, 3, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 10, 4, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 10, 5, 0, 0, 0, time.UTC),
+		},
+	}
+	for policy, want := range cases {
+		t.Run(string(policy), func(t *testing.T) {
+			s := mustSchedule(t, ScheduleSpec{Cron: "@hourly", CatchUp: policy})
+			runs, latest := s.DueRuns("workflow", last, onTime)
+			assert.Equal(t, want, runs)
+			assert.Equal(t, time.Date(2024, 1, 10, 5, 0, 0, 0, time.UTC), latest)
+		})
+	}
+}
+
+func TestSchedule_DueRunsMissed(t *testing.T) {
+	last := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2024, 1, 10, 5, 30, 0, 0, time.UTC)
+
+	skip := mustSchedule(t, ScheduleSpec{Cron: "@hourly"})
+	runs, latest := skip.DueRuns("workflow", last, late)
+	assert.Empty(t, runs)
+	assert.Equal(t, time.Date(2024, 1, 10, 5, 0, 0, 0, time.UTC), latest)
+
+	latestOnly := mustSchedule(t, ScheduleSpec{Cron: "@hourly", CatchUp: CatchUpLatest})
+	runs, _ = latestOnly.DueRuns("workflow", last, late)
+	assert.Equal(t, []time.Time{time.Date(2024, 1, 10, 5, 0, 0, 0, time.UTC)}, runs)
+
+	all := mustSchedule(t, ScheduleSpec{Cron: "*/5 * * * *", CatchUp: CatchUpAll})
+	runs, _ = all.DueRuns("workflow", last, late)
+	// the missed runs are limited, and the current one is executed too
+	assert.Len(t, runs, MaxCatchUpRuns+1)
+	assert.Equal(t, time.Date(2024, 1, 10, 5, 30, 0, 0, time.UTC), runs[len(runs)-1])
+}
+
+func TestSchedule_DueRunsNotYet(t *testing.T) {
+	s := mustSchedule(t, ScheduleSpec{Cron: "@hourly"})
+	last := time.Date(2024, 1, 10, 5, 0, 0, 0, time.UTC)
+
+	runs, latest := s.DueRuns("workflow", last, last.Add(30*time.Minute))
+	assert.Empty(t, runs)
+	assert.Equal(t, last, latest)
+}
+
+func TestNextRuns(t *testing.T) {
+	schedules := []Schedule{
+		mustSchedule(t, ScheduleSpec{Name: "daily", Cron: "0 12 * * *", Config: map[string]string{"env": "prod"}}),
+		mustSchedule(t, ScheduleSpec{Name: "hourly", Cron: "0 10-11 * * *"}),
+		mustSchedule(t, ScheduleSpec{Name: "paused", Cron: "@hourly", Disabled: true}),
+	}
+	from := time.Date(2024, 1, 10, 9, 30, 0, 0, time.UTC)
+
+	runs := NextRuns("workflow", schedules, from, 4)
+
+	assert.Len(t, runs, 4)
+	assert.Equal(t, "hourly", runs[0].Schedule)
+	assert.Equal(t, time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC), runs[0].RunAt)
+	assert.Equal(t, "hourly", runs[1].Schedule)
+	assert.Equal(t, time.Date(2024, 1, 10, 11, 0, 0, 0, time.UTC), runs[1].RunAt)
+	assert.Equal(t, "daily", runs[2].Schedule)
+	assert.Equal(t, time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC), runs[2].ScheduledAt)
+	assert.Equal(t, map[string]string{"env": "prod"}, runs[2].Config)
+	assert.Equal(t, "hourly", runs[3].Schedule)
+	assert.Equal(t, time.Date(2024, 1, 11, 10, 0, 0, 0, time.UTC), runs[3].RunAt)
+}
+
+func TestScheduleStatus(t *testing.T) {
+	workflow := &testworkflowsv1.TestWorkflow{}
+	assert.Empty(t, GetScheduleStatus(workflow))
+
+	status := map[string]time.Time{
+		"nightly": time.Date(2024, 1, 10, 2, 0, 0, 0, time.UTC),
+		"hourly":  time.Date(2024, 1, 10, 5, 0, 0, 0, time.UTC),
+	}
+	SetScheduleStatus(workflow, status)
+
+	assert.Equal(t, "hourly", workflow.Status.Schedules[0].Name)
+	assert.Empty(t, workflow.Annotations)
+	assert.Equal(t, status, GetScheduleStatus(workflow))
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Testkube.
+//
+// Licensed as a Testkube Pro file under the Testkube Community
+// License (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//	https://github.com/kubeshop/testkube/blob/main/licenses/TCL.txt
+
+package testworkflowscheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
+	testworkflowsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowexecutor"
+	"github.com/kubeshop/testkube/pkg/triggers"
+	"github.com/kubeshop/testkube/pkg/utils"
+)
+
+const (
+	defaultCheckInterval = 10 * time.Second
+	// defaultClusterID is shared with the triggers service, so both are run by the same leader
+	defaultClusterID        = "testkube-api"
+	defaultIdentifierFormat = "testkube-api-%s"
+)
+
+type Scheduler struct {
+	testWorkflowsClient  testworkflowsclientv1.Interface
+	testWorkflowExecutor testworkflowexecutor.TestWorkflowExecutor
+	leaseBackend         triggers.LeaseBackend
+	logger               *zap.SugaredLogger
+	identifier           string
+	clusterID            string
+	checkInterval        time.Duration
+	now                  func() time.Time
+}
+
+type Option func(*Scheduler)
+
+func New(
+	testWorkflowsClient testworkflowsclientv1.Interface,
+	testWorkflowExecutor testworkflowexecutor.TestWorkflowExecutor,
+	leaseBackend triggers.LeaseBackend,
+	logger *zap.SugaredLogger,
+	opts ...Option,
+) *Scheduler {
+	s := &Scheduler{
+		testWorkflowsClient:  testWorkflowsClient,
+		testWorkflowExecutor: testWorkflowExecutor,
+		leaseBackend:         leaseBackend,
+		logger:               logger,
+		identifier:           fmt.Sprintf(defaultIdentifierFormat, utils.RandAlphanum(10)),
+		clusterID:            defaultClusterID,
+		checkInterval:        defaultCheckInterval,
+		now:                  time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func WithIdentifier(id string) Option {
+	return func(s *Scheduler) {
+		s.identifier = id
+	}
+}
+
+func WithHostnameIdentifier() Option {
+	return func(s *Scheduler) {
+		identifier, err := os.Hostname()
+		if err == nil {
+			s.identifier = identifier
+		}
+	}
+}
+
+func WithClusterID(id string) Option {
+	return func(s *Scheduler) {
+		s.clusterID = id
+	}
+}
+
+func WithCheckInterval(interval time.Duration) Option {
+	return func(s *Scheduler) {
+		s.checkInterval = interval
+	}
+}
+
+// Run executes the scheduled TestWorkflows until the context is cancelled.
+// Only the instance holding the lease is executing them, to avoid double runs.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+
+	s.logger.Info("test workflow scheduler: starting")
+	for {
+		s.iteration(ctx)
+		select {
+		case <-ctx.Done():
+			s.logger.Info("test workflow scheduler: stopping")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) iteration(ctx context.Context) {
+	leased, err := s.leaseBackend.TryAcquire(ctx, s.identifier, s.clusterID)
+	if err != nil {
+		s.logger.Errorw("test workflow scheduler: error checking lease", "error", err)
+		return
+	}
+	if !leased {
+		return
+	}
+
+	workflows, err := s.testWorkflowsClient.List("")
+	if err != nil {
+		s.logger.Errorw("test workflow scheduler: error listing test workflows", "error", err)
+		return
+	}
+	now := s.now()
+	for i := range workflows.Items {
+		s.schedule(ctx, &workflows.Items[i], now)
+	}
+}
+
+type scheduledRun struct {
+	schedule Schedule
+	slot     time.Time
+}
+
+func (s *Scheduler) schedule(ctx context.Context, workflow *testworkflowsv1.TestWorkflow, now time.Time) {
+	schedules, err := GetSchedules(workflow)
+	if err != nil {
+		s.logger.Warnw("test workflow scheduler: invalid schedules", "testWorkflow", workflow.Name, "error", err)
+		return
+	}
+	if len(schedules) == 0 {
+		return
+	}
+
+	// Compute the runs, starting from now for the newly added schedules
+	status := GetScheduleStatus(workflow)
+	nextStatus := make(map[string]time.Time, len(schedules))
+	changed := len(status) != len(schedules)
+	var runs []scheduledRun
+	for i := range schedules {
+		last, ok := status[schedules[i].Name]
+		if !ok {
+			nextStatus[schedules[i].Name] = now
+			changed = true
+			continue
+		}
+		slots, latest := schedules[i].DueRuns(workflow.Name, last, now)
+		nextStatus[schedules[i].Name] = latest
+		if !latest.Equal(last) {
+			changed = true
+		}
+		if schedules[i].Disabled {
+			continue
+		}
+		for _, slot := range slots {
+			runs = append(runs, scheduledRun{schedule: schedules[i], slot: slot})
+		}
+	}
+	if !changed {
+		return
+	}
+
+	// Store the status before executing, so the conflict with other instance will stop the execution
+	next := workflow.DeepCopy()
+	SetScheduleStatus(next, nextStatus)
+	err = s.testWorkflowsClient.UpdateStatus(next)
+	if err != nil {
+		s.logger.Errorw("test workflow scheduler: error updating schedules status", "testWorkflow", workflow.Name, "error", err)
+		return
+	}
+
+	for _, run := range runs {
+		execution, err := s.testWorkflowExecutor.Execute(ctx, *next.DeepCopy(), testkube.TestWorkflowExecutionRequest{
+			Config: run.schedule.Config,
+		})
+		if err != nil {
+			s.logger.Errorw("test workflow scheduler: error executing test workflow",
+				"testWorkflow", workflow.Name, "schedule", run.schedule.Name, "scheduledAt", run.slot, "error", err)
+			continue
+		}
+		s.logger.Infow("test workflow scheduler: executed test workflow",
+			"testWorkflow", workflow.Name, "schedule", run.schedule.Name, "scheduledAt", run.slot, "executionId", execution.Id)
+	}
+}
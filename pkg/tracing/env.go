@@ -0,0 +1,116 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// TraceParentEnvName is the environment variable with the W3C trace context of the parent span
+	TraceParentEnvName = "TRACEPARENT"
+	// TraceStateEnvName is the environment variable with the W3C trace state
+	TraceStateEnvName = "TRACESTATE"
+)
+
+// exporterEnvNames are the exporter settings passed to the other processes.
+// The headers are not passed, as they may contain the credentials, that would be visible in the pod specification.
+var exporterEnvNames = []string{
+	"OTEL_EXPORTER_OTLP_ENDPOINT",
+	"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+	"OTEL_EXPORTER_OTLP_PROTOCOL",
+	"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL",
+}
+
+// envCarrier propagates the trace context through the environment variables, i.e. TRACEPARENT
+type envCarrier map[string]string
+
+func (c envCarrier) Get(key string) string {
+	return c[strings.ToUpper(key)]
+}
+
+func (c envCarrier) Set(key, value string) {
+	c[strings.ToUpper(key)] = value
+}
+
+func (c envCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Env returns the environment variables to continue the trace in another process,
+// along with the exporter endpoint and protocol, so the process may export its own spans.
+func Env(ctx context.Context) map[string]string {
+	env := envCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, env)
+	if len(env) == 0 {
+		return env
+	}
+	for _, name := range exporterEnvNames {
+		if value, ok := os.LookupEnv(name); ok {
+			env[name] = value
+		}
+	}
+	return env
+}
+
+// ContextFromEnv continues the trace passed to the process through the environment variables
+func ContextFromEnv(ctx context.Context) context.Context {
+	env := envCarrier{}
+	for _, item := range os.Environ() {
+		name, value, _ := strings.Cut(item, "=")
+		env[name] = value
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, env)
+}
+
+// SetEnv exposes the trace context to the sub-processes, so they may attach their child spans
+func SetEnv(ctx context.Context) {
+	env := envCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, env)
+	for name, value := range env {
+		_ = os.Setenv(name, value)
+	}
+}
+
+// InjectPodSpec passes the trace context to all the containers of the pod.
+// The variables already defined in the container are not overridden.
+func InjectPodSpec(ctx context.Context, spec *corev1.PodSpec) {
+	env := Env(ctx)
+	if len(env) == 0 {
+		return
+	}
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	inject := func(container *corev1.Container) {
+		for _, name := range names {
+			exists := false
+			for _, v := range container.Env {
+				if v.Name == name {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				container.Env = append(container.Env, corev1.EnvVar{Name: name, Value: env[name]})
+			}
+		}
+	}
+	for i := range spec.InitContainers {
+		inject(&spec.InitContainers[i])
+	}
+	for i := range spec.Containers {
+		inject(&spec.Containers[i])
+	}
+}
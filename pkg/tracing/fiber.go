@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware starts the span for each HTTP request, continuing the trace passed in the request headers.
+// The span is available for the handlers in c.UserContext().
+func Middleware(skipPaths ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		for _, path := range skipPaths {
+			if c.Path() == path {
+				return c.Next()
+			}
+		}
+
+		carrier := propagation.MapCarrier{}
+		c.Request().Header.VisitAll(func(key, value []byte) {
+			carrier[strings.ToLower(string(key))] = string(value)
+		})
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), carrier)
+		ctx, span := Tracer().Start(ctx, c.Method(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.method", c.Method()),
+				attribute.String("http.target", string(c.Request().RequestURI())),
+			))
+		defer span.End()
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		if fiberErr, ok := err.(*fiber.Error); ok {
+			status = fiberErr.Code
+		} else if err != nil {
+			status = http.StatusInternalServerError
+		}
+		span.SetName(c.Method() + " " + c.Route().Path)
+		span.SetAttributes(
+			attribute.String("http.route", c.Route().Path),
+			attribute.Int("http.status_code", status),
+		)
+		if err != nil {
+			span.RecordError(err)
+		}
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		return err
+	}
+}
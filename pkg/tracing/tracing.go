@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name of the Testkube spans
+const TracerName = "github.com/kubeshop/testkube"
+
+const (
+	ExecutionIDKey      = attribute.Key("testkube.execution.id")
+	ExecutionNameKey    = attribute.Key("testkube.execution.name")
+	ExecutionStatusKey  = attribute.Key("testkube.execution.status")
+	TestNameKey         = attribute.Key("testkube.test.name")
+	TestTypeKey         = attribute.Key("testkube.test.type")
+	TestSuiteNameKey    = attribute.Key("testkube.testsuite.name")
+	TestWorkflowNameKey = attribute.Key("testkube.testworkflow.name")
+	StepRefKey          = attribute.Key("testkube.step.ref")
+	StepStatusKey       = attribute.Key("testkube.step.status")
+)
+
+// Enabled checks if the OTLP exporter is configured with the standard OpenTelemetry environment variables
+func Enabled() bool {
+	if os.Getenv("OTEL_SDK_DISABLED") == "true" {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Init configures the global tracer provider, exporting the spans via OTLP.
+// The trace context is always propagated, even if the exporter is not configured.
+func Init(ctx context.Context, serviceName, serviceVersion string) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", serviceVersion),
+		),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer for the Testkube spans
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// Start creates the span as a child of the span in the context
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End finishes the span, marking it as failed when there is an error
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Fail marks the span in the context as failed, when the error is not returned from the traced function
+func Fail(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
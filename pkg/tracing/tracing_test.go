@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return recorder
+}
+
+func TestEnv_Empty(t *testing.T) {
+	setupRecorder(t)
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://collector:4317")
+
+	assert.Empty(t, Env(context.Background()))
+}
+
+func TestEnv_Roundtrip(t *testing.T) {
+	recorder := setupRecorder(t)
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://collector:4317")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "grpc")
+	t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "authorization=Bearer secret")
+
+	ctx, parent := Start(context.Background(), "parent")
+	env := Env(ctx)
+	parent.End()
+
+	assert.Equal(t, "http://collector:4317", env["OTEL_EXPORTER_OTLP_ENDPOINT"])
+	assert.Equal(t, "grpc", env["OTEL_EXPORTER_OTLP_PROTOCOL"])
+	assert.NotContains(t, env, "OTEL_EXPORTER_OTLP_HEADERS")
+	require.NotEmpty(t, env[TraceParentEnvName])
+
+	t.Setenv(TraceParentEnvName, env[TraceParentEnvName])
+	_, child := Start(ContextFromEnv(context.Background()), "child")
+	child.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+}
+
+func TestInjectPodSpec(t *testing.T) {
+	setupRecorder(t)
+	ctx, span := Start(context.Background(), "job")
+	defer span.End()
+
+	spec := corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "init"}},
+		Containers: []corev1.Container{
+			{Name: "main", Env: []corev1.EnvVar{{Name: TraceParentEnvName, Value: "custom"}}},
+		},
+	}
+	InjectPodSpec(ctx, &spec)
+
+	require.Len(t, spec.InitContainers[0].Env, 1)
+	assert.Equal(t, TraceParentEnvName, spec.InitContainers[0].Env[0].Name)
+	assert.Equal(t, []corev1.EnvVar{{Name: TraceParentEnvName, Value: "custom"}}, spec.Containers[0].Env)
+}
+
+func TestInjectPodSpec_NoTrace(t *testing.T) {
+	setupRecorder(t)
+	spec := corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}}
+	InjectPodSpec(context.Background(), &spec)
+
+	assert.Empty(t, spec.Containers[0].Env)
+}
+
+func TestMiddleware(t *testing.T) {
+	recorder := setupRecorder(t)
+	var handlerSpan trace.SpanContext
+	app := fiber.New()
+	app.Use(Middleware("/health"))
+	app.Get("/health", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+	app.Get("/tests/:id", func(c *fiber.Ctx) error {
+		handlerSpan = trace.SpanFromContext(c.UserContext()).SpanContext()
+		return errors.New("broken")
+	})
+
+	ctx, parent := Start(context.Background(), "client")
+	req := httptest.NewRequest("GET", "/tests/example", nil)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	_, err := app.Test(req)
+	require.NoError(t, err)
+	parent.End()
+
+	_, err = app.Test(httptest.NewRequest("GET", "/health", nil))
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	server := spans[0]
+	assert.Equal(t, "GET /tests/:id", server.Name())
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind())
+	assert.Equal(t, codes.Error, server.Status().Code)
+	assert.Equal(t, parent.SpanContext().SpanID(), server.Parent().SpanID())
+	assert.Equal(t, server.SpanContext().SpanID(), handlerSpan.SpanID())
+}
@@ -11,11 +11,14 @@ import (
 	v1 "github.com/kubeshop/testkube-operator/api/executor/v1"
 	testsv3 "github.com/kubeshop/testkube-operator/api/tests/v3"
 	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
 	executorsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/executors/v1"
 	testsclientv3 "github.com/kubeshop/testkube-operator/pkg/client/tests/v3"
 	testsourcesv1 "github.com/kubeshop/testkube-operator/pkg/client/testsources/v1"
 	testsuiteexecutionsv1 "github.com/kubeshop/testkube-operator/pkg/client/testsuiteexecutions/v1"
 	testsuitesv3 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v3"
+	testworkflowsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
+	"github.com/kubeshop/testkube-operator/pkg/validation/tests/v1/testtrigger"
 	"github.com/kubeshop/testkube/internal/app/api/metrics"
 	"github.com/kubeshop/testkube/internal/featureflags"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -30,6 +33,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/repository/testresult"
 	"github.com/kubeshop/testkube/pkg/scheduler"
 	"github.com/kubeshop/testkube/pkg/secret"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowexecutor"
 )
 
 func TestExecute(t *testing.T) {
@@ -175,3 +179,66 @@ func TestExecute(t *testing.T) {
 	err := s.execute(ctx, &watcherEvent{}, &testTrigger)
 	assert.NoError(t, err)
 }
+
+func TestExecuteTestWorkflow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockTestWorkflowsClient := testworkflowsclientv1.NewMockInterface(mockCtrl)
+	mockTestWorkflowExecutor := testworkflowexecutor.NewMockTestWorkflowExecutor(mockCtrl)
+
+	mockTestWorkflow := testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "some-test-workflow"},
+	}
+	mockTestWorkflowsClient.EXPECT().Get("some-test-workflow").Return(&mockTestWorkflow, nil).AnyTimes()
+	mockTestWorkflowExecutor.EXPECT().Execute(gomock.Any(), mockTestWorkflow, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ testworkflowsv1.TestWorkflow, request testkube.TestWorkflowExecutionRequest) (testkube.TestWorkflowExecution, error) {
+			assert.Equal(t, "test-deployment", request.Config["WATCHER_EVENT_NAME"])
+			assert.Equal(t, "testkube", request.Config["WATCHER_EVENT_NAMESPACE"])
+			assert.Equal(t, "deployment", request.Config["WATCHER_EVENT_RESOURCE"])
+			assert.Equal(t, "modified", request.Config["WATCHER_EVENT_EVENT_TYPE"])
+			assert.Equal(t, "deployment-image-update", request.Config["WATCHER_EVENT_CAUSES"])
+			return testkube.TestWorkflowExecution{Id: "test-workflow-execution-1"}, nil
+		})
+
+	s := &Service{
+		triggerStatus:        make(map[statusKey]*triggerStatus),
+		testWorkflowsClient:  mockTestWorkflowsClient,
+		testWorkflowExecutor: mockTestWorkflowExecutor,
+		logger:               log.DefaultLogger,
+	}
+
+	testTrigger := testtriggersv1.TestTrigger{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "test-trigger-1"},
+		Spec: testtriggersv1.TestTriggerSpec{
+			Resource:          "deployment",
+			ResourceSelector:  testtriggersv1.TestTriggerSelector{Name: "test-deployment"},
+			Event:             "modified",
+			Action:            "run",
+			Execution:         "testworkflow",
+			ConcurrencyPolicy: "allow",
+			TestSelector:      testtriggersv1.TestTriggerSelector{Name: "some-test-workflow"},
+		},
+	}
+
+	s.addTrigger(&testTrigger)
+
+	key := newStatusKey(testTrigger.Namespace, testTrigger.Name)
+	assert.Contains(t, s.triggerStatus, key)
+
+	event := &watcherEvent{
+		resource:  "deployment",
+		name:      "test-deployment",
+		namespace: "testkube",
+		eventType: "modified",
+		causes:    []testtrigger.Cause{testtrigger.CauseDeploymentImageUpdate},
+	}
+	err := s.execute(ctx, event, &testTrigger)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test-workflow-execution-1"}, s.triggerStatus[key].getTestWorkflowExecutionIDs())
+	assert.True(t, s.triggerStatus[key].hasActiveTests())
+}
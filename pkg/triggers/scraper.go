@@ -25,6 +25,7 @@ func (s *Service) runExecutionScraper(ctx context.Context) {
 				if status.hasActiveTests() {
 					s.checkForRunningTestExecutions(ctx, status)
 					s.checkForRunningTestSuiteExecutions(ctx, status)
+					s.checkForRunningTestWorkflowExecutions(ctx, status)
 					if !status.hasActiveTests() {
 						s.logger.Debugf("marking status as finished for testtrigger %s", triggerName)
 						status.done()
@@ -75,10 +76,31 @@ func (s *Service) checkForRunningTestSuiteExecutions(ctx context.Context, status
 	}
 }
 
+func (s *Service) checkForRunningTestWorkflowExecutions(ctx context.Context, status *triggerStatus) {
+	testWorkflowExecutionIDs := status.getTestWorkflowExecutionIDs()
+
+	for _, id := range testWorkflowExecutionIDs {
+		execution, err := s.testWorkflowResultsRepository.Get(ctx, id)
+		if err == mongo.ErrNoDocuments {
+			s.logger.Warnf("trigger service: execution scraper component: no testworkflow execution found for id %s", id)
+			status.removeTestWorkflowExecutionID(id)
+			continue
+		} else if err != nil {
+			s.logger.Errorf("trigger service: execution scraper component: error fetching testworkflow execution result: %v", err)
+			continue
+		}
+		if execution.Result != nil && execution.Result.IsFinished() {
+			s.logger.Debugf("trigger service: execution scraper component: testworkflow execution %s is finished", id)
+			status.removeTestWorkflowExecutionID(id)
+		}
+	}
+}
+
 func (s *Service) abortExecutions(ctx context.Context, testTriggerName string, status *triggerStatus) {
 	s.logger.Debugf("trigger service: abort executions")
 	s.abortRunningTestExecutions(ctx, status)
 	s.abortRunningTestSuiteExecutions(ctx, status)
+	s.abortRunningTestWorkflowExecutions(ctx, status)
 	if !status.hasActiveTests() {
 		s.logger.Debugf("marking status as finished for testtrigger %s", testTriggerName)
 		status.done()
@@ -137,3 +159,29 @@ func (s *Service) abortRunningTestSuiteExecutions(ctx context.Context, status *t
 		}
 	}
 }
+
+func (s *Service) abortRunningTestWorkflowExecutions(ctx context.Context, status *triggerStatus) {
+	testWorkflowExecutionIDs := status.getTestWorkflowExecutionIDs()
+
+	for _, id := range testWorkflowExecutionIDs {
+		execution, err := s.testWorkflowResultsRepository.Get(ctx, id)
+		if err == mongo.ErrNoDocuments {
+			s.logger.Warnf("trigger service: execution scraper component: no testworkflow execution found for id %s", id)
+			status.removeTestWorkflowExecutionID(id)
+			continue
+		} else if err != nil {
+			s.logger.Errorf("trigger service: execution scraper component: error fetching testworkflow execution result: %v", err)
+			continue
+		}
+		if execution.Result != nil && !execution.Result.IsFinished() {
+			err = s.testWorkflowExecutor.Abort(context.Background(), execution)
+			if err != nil {
+				s.logger.Errorf("trigger service: execution scraper component: error aborting testworkflow execution: %v", err)
+				continue
+			}
+
+			s.logger.Debugf("trigger service: execution scraper component: testworkflow execution %s is aborted", id)
+			status.removeTestWorkflowExecutionID(id)
+		}
+	}
+}
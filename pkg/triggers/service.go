@@ -16,6 +16,7 @@ import (
 	executorsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/executors/v1"
 	testsclientv3 "github.com/kubeshop/testkube-operator/pkg/client/tests/v3"
 	testsuitesclientv3 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v3"
+	testworkflowsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
 	testkubeclientsetv1 "github.com/kubeshop/testkube-operator/pkg/clientset/versioned"
 	"github.com/kubeshop/testkube/internal/app/api/metrics"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -26,6 +27,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/repository/result"
 	"github.com/kubeshop/testkube/pkg/repository/testresult"
 	"github.com/kubeshop/testkube/pkg/scheduler"
+	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowexecutor"
 	"github.com/kubeshop/testkube/pkg/telemetry"
 	"github.com/kubeshop/testkube/pkg/utils"
 	"github.com/kubeshop/testkube/pkg/version"
@@ -63,13 +66,16 @@ type Service struct {
 	testKubeClientset             testkubeclientsetv1.Interface
 	testSuitesClient              testsuitesclientv3.Interface
 	testsClient                   testsclientv3.Interface
+	testWorkflowsClient           testworkflowsclientv1.Interface
 	resultRepository              result.Repository
 	testResultRepository          testresult.Repository
+	testWorkflowResultsRepository testworkflow.Repository
 	logger                        *zap.SugaredLogger
 	configMap                     config.Repository
 	executorsClient               executorsclientv1.Interface
 	httpClient                    http.HttpClient
 	testExecutor                  client.Executor
+	testWorkflowExecutor          testworkflowexecutor.TestWorkflowExecutor
 	eventsBus                     bus.Bus
 	metrics                       metrics.Metrics
 	testkubeNamespace             string
@@ -84,13 +90,16 @@ func NewService(
 	testKubeClientset testkubeclientsetv1.Interface,
 	testSuitesClient testsuitesclientv3.Interface,
 	testsClient testsclientv3.Interface,
+	testWorkflowsClient testworkflowsclientv1.Interface,
 	resultRepository result.Repository,
 	testResultRepository testresult.Repository,
+	testWorkflowResultsRepository testworkflow.Repository,
 	leaseBackend LeaseBackend,
 	logger *zap.SugaredLogger,
 	configMap config.Repository,
 	executorsClient executorsclientv1.Interface,
 	testExecutor client.Executor,
+	testWorkflowExecutor testworkflowexecutor.TestWorkflowExecutor,
 	eventsBus bus.Bus,
 	metrics metrics.Metrics,
 	opts ...Option,
@@ -111,13 +120,16 @@ func NewService(
 		testKubeClientset:             testKubeClientset,
 		testSuitesClient:              testSuitesClient,
 		testsClient:                   testsClient,
+		testWorkflowsClient:           testWorkflowsClient,
 		resultRepository:              resultRepository,
 		testResultRepository:          testResultRepository,
+		testWorkflowResultsRepository: testWorkflowResultsRepository,
 		leaseBackend:                  leaseBackend,
 		logger:                        logger,
 		configMap:                     configMap,
 		executorsClient:               executorsClient,
 		testExecutor:                  testExecutor,
+		testWorkflowExecutor:          testWorkflowExecutor,
 		eventsBus:                     eventsBus,
 		metrics:                       metrics,
 		httpClient:                    http.NewClient(),
@@ -14,12 +14,15 @@ import (
 	executorv1 "github.com/kubeshop/testkube-operator/api/executor/v1"
 	testsv3 "github.com/kubeshop/testkube-operator/api/tests/v3"
 	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	testworkflowsv1 "github.com/kubeshop/testkube-operator/api/testworkflows/v1"
 	executorsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/executors/v1"
 	testsclientv3 "github.com/kubeshop/testkube-operator/pkg/client/tests/v3"
 	testsourcesv1 "github.com/kubeshop/testkube-operator/pkg/client/testsources/v1"
 	testsuiteexecutionsv1 "github.com/kubeshop/testkube-operator/pkg/client/testsuiteexecutions/v1"
 	testsuitesv3 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v3"
+	testworkflowsclientv1 "github.com/kubeshop/testkube-operator/pkg/client/testworkflows/v1"
 	faketestkube "github.com/kubeshop/testkube-operator/pkg/clientset/versioned/fake"
+	"github.com/kubeshop/testkube-operator/pkg/validation/tests/v1/testtrigger"
 	"github.com/kubeshop/testkube/internal/app/api/metrics"
 	"github.com/kubeshop/testkube/internal/featureflags"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -34,6 +37,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/repository/testresult"
 	"github.com/kubeshop/testkube/pkg/scheduler"
 	"github.com/kubeshop/testkube/pkg/secret"
+	"github.com/kubeshop/testkube/pkg/tcl/repositorytcl/testworkflow"
+	"github.com/kubeshop/testkube/pkg/tcl/testworkflowstcl/testworkflowexecutor"
 )
 
 func TestService_Run(t *testing.T) {
@@ -58,6 +63,9 @@ func TestService_Run(t *testing.T) {
 	configMapConfig := config.NewMockRepository(mockCtrl)
 	mockConfigMapClient := configmap.NewMockInterface(mockCtrl)
 	mockTestSuiteExecutionsClient := testsuiteexecutionsv1.NewMockInterface(mockCtrl)
+	mockTestWorkflowsClient := testworkflowsclientv1.NewMockInterface(mockCtrl)
+	mockTestWorkflowResultsRepository := testworkflow.NewMockRepository(mockCtrl)
+	mockTestWorkflowExecutor := testworkflowexecutor.NewMockTestWorkflowExecutor(mockCtrl)
 
 	mockExecutor := client.NewMockExecutor(mockCtrl)
 
@@ -158,13 +166,16 @@ func TestService_Run(t *testing.T) {
 		fakeTestkubeClientset,
 		mockTestSuitesClient,
 		mockTestsClient,
+		mockTestWorkflowsClient,
 		mockResultRepository,
 		mockTestResultRepository,
+		mockTestWorkflowResultsRepository,
 		mockLeaseBackend,
 		testLogger,
 		configMapConfig,
 		mockExecutorsClient,
 		mockExecutor,
+		mockTestWorkflowExecutor,
 		eventBus,
 		metrics,
 		WithClusterID(testClusterID),
@@ -207,6 +218,107 @@ func TestService_Run(t *testing.T) {
 	<-ctx.Done()
 }
 
+func TestService_Run_TestWorkflow(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockResultRepository := result.NewMockRepository(mockCtrl)
+	mockTestResultRepository := testresult.NewMockRepository(mockCtrl)
+	mockExecutorsClient := executorsclientv1.NewMockInterface(mockCtrl)
+	mockTestsClient := testsclientv3.NewMockInterface(mockCtrl)
+	mockTestSuitesClient := testsuitesv3.NewMockInterface(mockCtrl)
+	configMapConfig := config.NewMockRepository(mockCtrl)
+	mockTestWorkflowsClient := testworkflowsclientv1.NewMockInterface(mockCtrl)
+	mockTestWorkflowResultsRepository := testworkflow.NewMockRepository(mockCtrl)
+	mockTestWorkflowExecutor := testworkflowexecutor.NewMockTestWorkflowExecutor(mockCtrl)
+	mockExecutor := client.NewMockExecutor(mockCtrl)
+
+	mockTestWorkflow := testworkflowsv1.TestWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "some-test-workflow"},
+	}
+	mockTestWorkflowsClient.EXPECT().Get("some-test-workflow").Return(&mockTestWorkflow, nil).AnyTimes()
+	mockTestWorkflowResultsRepository.EXPECT().Get(gomock.Any(), "test-workflow-execution-1").
+		Return(testkube.TestWorkflowExecution{Id: "test-workflow-execution-1"}, nil).AnyTimes()
+
+	executed := make(chan testkube.TestWorkflowExecutionRequest, 1)
+	mockTestWorkflowExecutor.EXPECT().Execute(gomock.Any(), mockTestWorkflow, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ testworkflowsv1.TestWorkflow, request testkube.TestWorkflowExecutionRequest) (testkube.TestWorkflowExecution, error) {
+			executed <- request
+			return testkube.TestWorkflowExecution{Id: "test-workflow-execution-1"}, nil
+		})
+
+	mockLeaseBackend := NewMockLeaseBackend(mockCtrl)
+	testClusterID := "testkube-api"
+	testIdentifier := "test-host-1"
+	mockLeaseBackend.EXPECT().TryAcquire(gomock.Any(), testIdentifier, testClusterID).Return(true, nil).AnyTimes()
+
+	fakeTestkubeClientset := faketestkube.NewSimpleClientset()
+	fakeClientset := fake.NewSimpleClientset()
+	s := NewService(
+		nil,
+		fakeClientset,
+		fakeTestkubeClientset,
+		mockTestSuitesClient,
+		mockTestsClient,
+		mockTestWorkflowsClient,
+		mockResultRepository,
+		mockTestResultRepository,
+		mockTestWorkflowResultsRepository,
+		mockLeaseBackend,
+		log.DefaultLogger,
+		configMapConfig,
+		mockExecutorsClient,
+		mockExecutor,
+		mockTestWorkflowExecutor,
+		bus.NewEventBusMock(),
+		metrics.NewMetrics(),
+		WithClusterID(testClusterID),
+		WithIdentifier(testIdentifier),
+		WithScraperInterval(50*time.Millisecond),
+		WithLeaseCheckerInterval(50*time.Millisecond),
+	)
+
+	s.Run(ctx)
+
+	time.Sleep(100 * time.Millisecond)
+
+	testNamespace := "testkube"
+	testTrigger := testtriggersv1.TestTrigger{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-trigger-1"},
+		Spec: testtriggersv1.TestTriggerSpec{
+			Resource:          "pod",
+			ResourceSelector:  testtriggersv1.TestTriggerSelector{Name: "test-pod"},
+			Event:             "created",
+			Action:            "run",
+			Execution:         testtriggersv1.TestTriggerExecutionTestWorkflow,
+			ConcurrencyPolicy: "allow",
+			TestSelector:      testtriggersv1.TestTriggerSelector{Name: "some-test-workflow"},
+		},
+	}
+	assert.Contains(t, testtrigger.GetSupportedExecutions(), string(testTrigger.Spec.Execution))
+	_, err := fakeTestkubeClientset.TestsV1().TestTriggers(testNamespace).Create(ctx, &testTrigger, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	time.Sleep(10 * time.Millisecond)
+
+	testPod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-pod", CreationTimestamp: metav1.Now()}}
+	_, err = fakeClientset.CoreV1().Pods(testNamespace).Create(ctx, &testPod, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	select {
+	case request := <-executed:
+		assert.Equal(t, "test-pod", request.Config["WATCHER_EVENT_NAME"])
+		assert.Equal(t, "created", request.Config["WATCHER_EVENT_EVENT_TYPE"])
+	case <-ctx.Done():
+		t.Fatal("testworkflow was not executed by the trigger")
+	}
+}
+
 func TestService_addTrigger(t *testing.T) {
 	t.Parallel()
 
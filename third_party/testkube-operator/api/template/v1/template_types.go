@@ -0,0 +1,79 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// TemplateType defines template type by purpose
+// +kubebuilder:validation:Enum=job;container;cronjob;scraper;pvc;webhook;pod
+type TemplateType string
+
+const (
+	JOB_TemplateType       TemplateType = "job"
+	CONTAINER_TemplateType TemplateType = "container"
+	CRONJOB_TemplateType   TemplateType = "cronjob"
+	SCRAPER_TemplateType   TemplateType = "scraper"
+	PVC_TemplateType       TemplateType = "pvc"
+	WEBHOOK_TemplateType   TemplateType = "webhook"
+	POD_TemplateType       TemplateType = "pod"
+)
+
+// TemplateSpec defines the desired state of Template
+type TemplateSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Type_ *TemplateType `json:"type"`
+	// template body to use
+	Body string `json:"body"`
+}
+
+// TemplateStatus defines the observed state of Template
+type TemplateStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// Template is the Schema for the Templates API
+type Template struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TemplateSpec   `json:"spec,omitempty"`
+	Status TemplateStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TemplateList contains a list of Template
+type TemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Template `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Template{}, &TemplateList{})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	commonv1 "github.com/kubeshop/testkube-operator/api/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// TestSpec defines the desired state of Test
+type TestSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// test type
+	Type_ string `json:"type,omitempty"`
+	// test execution custom name
+	Name string `json:"name,omitempty"`
+	// DEPRECATED execution params passed to executor
+	Params map[string]string `json:"params,omitempty"`
+	// Variables are new params with secrets attached
+	Variables map[string]Variable `json:"variables,omitempty"`
+	// test content object
+	Content *TestContent `json:"content,omitempty"`
+	// schedule in cron job format for scheduled test execution
+	Schedule string `json:"schedule,omitempty"`
+	// additional executor binary arguments
+	ExecutorArgs []string `json:"executorArgs,omitempty"`
+}
+
+type Variable commonv1.Variable
+
+// TestContent defines test content
+type TestContent struct {
+	// test type
+	Type_ string `json:"type,omitempty"`
+	// repository of test content
+	Repository *Repository `json:"repository,omitempty"`
+	// test content body
+	Data string `json:"data,omitempty"`
+	// uri of test content
+	Uri string `json:"uri,omitempty"`
+}
+
+// Repository represents VCS repo, currently we're handling Git only
+type Repository struct {
+	// VCS repository type
+	Type_ string `json:"type"`
+	// uri of content file or git directory
+	Uri string `json:"uri"`
+	// branch/tag name for checkout
+	Branch string `json:"branch,omitempty"`
+	// commit id (sha) for checkout
+	Commit string `json:"commit,omitempty"`
+	// if needed we can checkout particular path (dir or file) in case of BIG/mono repositories
+	Path string `json:"path,omitempty"`
+	// git auth username for private repositories
+	Username string `json:"username,omitempty"`
+	// git auth token for private repositories
+	Token string `json:"token,omitempty"`
+}
+
+// TestStatus defines the observed state of Test
+type TestStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	LastExecution   metav1.Time `json:"last_execution,omitempty"`
+	ExecutionsCount int         `json:"executions_count,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// Test is the Schema for the tests API
+type Test struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TestSpec   `json:"spec,omitempty"`
+	Status TestStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TestList contains a list of Test
+type TestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Test `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Test{}, &TestList{})
+}
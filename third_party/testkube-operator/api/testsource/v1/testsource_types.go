@@ -0,0 +1,125 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// TestSourceSpec defines the desired state of TestSource
+type TestSourceSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Type_ TestSourceType `json:"type,omitempty"`
+	// repository of test content
+	Repository *Repository `json:"repository,omitempty"`
+	// test content body
+	Data string `json:"data,omitempty"`
+	// uri of test content
+	Uri string `json:"uri,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=string;file-uri;git-file;git-dir;git
+type TestSourceType string
+
+const (
+	TestSourceTypeString  TestSourceType = "string"
+	TestSourceTypeFileURI TestSourceType = "file-uri"
+	// Deprecated: use git instead
+	TestSourceTypeGitFile TestSourceType = "git-file"
+	// Deprecated: use git instead
+	TestSourceTypeGitDir TestSourceType = "git-dir"
+	TestSourceTypeGit    TestSourceType = "git"
+)
+
+// SecretRef is the Testkube internal reference for secret storage in Kubernetes secrets
+type SecretRef struct {
+	// object kubernetes namespace
+	Namespace string `json:"-"`
+	// object name
+	Name string `json:"name"`
+	// object key
+	Key string `json:"key"`
+}
+
+// Repository represents VCS repo, currently we're handling Git only
+type Repository struct {
+	// VCS repository type
+	Type_ string `json:"type"`
+	// uri of content file or git directory
+	Uri string `json:"uri"`
+	// branch/tag name for checkout
+	Branch string `json:"branch,omitempty"`
+	// commit id (sha) for checkout
+	Commit string `json:"commit,omitempty"`
+	// If specified, does a sparse checkout of the repository at the given path
+	Path           string     `json:"path,omitempty"`
+	UsernameSecret *SecretRef `json:"usernameSecret,omitempty"`
+	TokenSecret    *SecretRef `json:"tokenSecret,omitempty"`
+	// git auth certificate secret for private repositories
+	CertificateSecret string `json:"certificateSecret,omitempty"`
+	// if provided we checkout the whole repository and run test from this directory
+	WorkingDir string `json:"workingDir,omitempty"`
+	// auth type for git requests
+	AuthType GitAuthType `json:"authType,omitempty"`
+}
+
+// GitAuthType defines git auth type
+// +kubebuilder:validation:Enum=basic;header
+type GitAuthType string
+
+const (
+	// GitAuthTypeBasic for git basic auth requests
+	GitAuthTypeBasic GitAuthType = "basic"
+	// GitAuthTypeHeader for git header auth requests
+	GitAuthTypeHeader GitAuthType = "header"
+)
+
+// TestSourceStatus defines the observed state of TestSource
+type TestSourceStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// TestSource is the Schema for the testsources API
+type TestSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TestSourceSpec   `json:"spec,omitempty"`
+	Status TestSourceStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TestSourceList contains a list of TestSource
+type TestSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TestSource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TestSource{}, &TestSourceList{})
+}
@@ -0,0 +1,808 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactRequest) DeepCopyInto(out *ArtifactRequest) {
+	*out = *in
+	if in.Dirs != nil {
+		in, out := &in.Dirs, &out.Dirs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Masks != nil {
+		in, out := &in.Masks, &out.Masks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactRequest.
+func (in *ArtifactRequest) DeepCopy() *ArtifactRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssertionResult) DeepCopyInto(out *AssertionResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssertionResult.
+func (in *AssertionResult) DeepCopy() *AssertionResult {
+	if in == nil {
+		return nil
+	}
+	out := new(AssertionResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Execution) DeepCopyInto(out *Execution) {
+	*out = *in
+	if in.Envs != nil {
+		in, out := &in.Envs, &out.Envs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]Variable, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(TestContent)
+		(*in).DeepCopyInto(*out)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.ExecutionResult != nil {
+		in, out := &in.ExecutionResult, &out.ExecutionResult
+		*out = new(ExecutionResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Uploads != nil {
+		in, out := &in.Uploads, &out.Uploads
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArtifactRequest != nil {
+		in, out := &in.ArtifactRequest, &out.ArtifactRequest
+		*out = new(ArtifactRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunningContext != nil {
+		in, out := &in.RunningContext, &out.RunningContext
+		*out = new(RunningContext)
+		**out = **in
+	}
+	if in.SlavePodRequest != nil {
+		in, out := &in.SlavePodRequest, &out.SlavePodRequest
+		*out = new(PodRequest)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Execution.
+func (in *Execution) DeepCopy() *Execution {
+	if in == nil {
+		return nil
+	}
+	out := new(Execution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionResult) DeepCopyInto(out *ExecutionResult) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ExecutionStatus)
+		**out = **in
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ExecutionStepResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Reports != nil {
+		in, out := &in.Reports, &out.Reports
+		*out = new(ExecutionResultReports)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionResult.
+func (in *ExecutionResult) DeepCopy() *ExecutionResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionResultReports) DeepCopyInto(out *ExecutionResultReports) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionResultReports.
+func (in *ExecutionResultReports) DeepCopy() *ExecutionResultReports {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionResultReports)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionStepResult) DeepCopyInto(out *ExecutionStepResult) {
+	*out = *in
+	if in.AssertionResults != nil {
+		in, out := &in.AssertionResults, &out.AssertionResults
+		*out = make([]AssertionResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionStepResult.
+func (in *ExecutionStepResult) DeepCopy() *ExecutionStepResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionStepResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectRef) DeepCopyInto(out *ObjectRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectRef.
+func (in *ObjectRef) DeepCopy() *ObjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodRequest) DeepCopyInto(out *PodRequest) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(PodResourcesRequest)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodRequest.
+func (in *PodRequest) DeepCopy() *PodRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PodRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodResourcesRequest) DeepCopyInto(out *PodResourcesRequest) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(ResourceRequest)
+		**out = **in
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(ResourceRequest)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodResourcesRequest.
+func (in *PodResourcesRequest) DeepCopy() *PodResourcesRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PodResourcesRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Repository) DeepCopyInto(out *Repository) {
+	*out = *in
+	if in.UsernameSecret != nil {
+		in, out := &in.UsernameSecret, &out.UsernameSecret
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.TokenSecret != nil {
+		in, out := &in.TokenSecret, &out.TokenSecret
+		*out = new(SecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Repository.
+func (in *Repository) DeepCopy() *Repository {
+	if in == nil {
+		return nil
+	}
+	out := new(Repository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryParameters) DeepCopyInto(out *RepositoryParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryParameters.
+func (in *RepositoryParameters) DeepCopy() *RepositoryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRequest) DeepCopyInto(out *ResourceRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequest.
+func (in *ResourceRequest) DeepCopy() *ResourceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunningContext) DeepCopyInto(out *RunningContext) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunningContext.
+func (in *RunningContext) DeepCopy() *RunningContext {
+	if in == nil {
+		return nil
+	}
+	out := new(RunningContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRef) DeepCopyInto(out *SecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRef.
+func (in *SecretRef) DeepCopy() *SecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuiteExecution) DeepCopyInto(out *SuiteExecution) {
+	*out = *in
+	if in.TestSuite != nil {
+		in, out := &in.TestSuite, &out.TestSuite
+		*out = new(ObjectRef)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(SuiteExecutionStatus)
+		**out = **in
+	}
+	if in.Envs != nil {
+		in, out := &in.Envs, &out.Envs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]Variable, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.StepResults != nil {
+		in, out := &in.StepResults, &out.StepResults
+		*out = make([]TestSuiteStepExecutionResultV2, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExecuteStepResults != nil {
+		in, out := &in.ExecuteStepResults, &out.ExecuteStepResults
+		*out = make([]TestSuiteBatchStepExecutionResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RunningContext != nil {
+		in, out := &in.RunningContext, &out.RunningContext
+		*out = new(RunningContext)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuiteExecution.
+func (in *SuiteExecution) DeepCopy() *SuiteExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(SuiteExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestContent) DeepCopyInto(out *TestContent) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(Repository)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestContent.
+func (in *TestContent) DeepCopy() *TestContent {
+	if in == nil {
+		return nil
+	}
+	out := new(TestContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestContentRequest) DeepCopyInto(out *TestContentRequest) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(RepositoryParameters)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestContentRequest.
+func (in *TestContentRequest) DeepCopy() *TestContentRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(TestContentRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteBatchStep) DeepCopyInto(out *TestSuiteBatchStep) {
+	*out = *in
+	if in.Execute != nil {
+		in, out := &in.Execute, &out.Execute
+		*out = make([]TestSuiteStep, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteBatchStep.
+func (in *TestSuiteBatchStep) DeepCopy() *TestSuiteBatchStep {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteBatchStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteBatchStepExecutionResult) DeepCopyInto(out *TestSuiteBatchStepExecutionResult) {
+	*out = *in
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(TestSuiteBatchStep)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Execute != nil {
+		in, out := &in.Execute, &out.Execute
+		*out = make([]TestSuiteStepExecutionResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteBatchStepExecutionResult.
+func (in *TestSuiteBatchStepExecutionResult) DeepCopy() *TestSuiteBatchStepExecutionResult {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteBatchStepExecutionResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteExecution) DeepCopyInto(out *TestSuiteExecution) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteExecution.
+func (in *TestSuiteExecution) DeepCopy() *TestSuiteExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TestSuiteExecution) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteExecutionList) DeepCopyInto(out *TestSuiteExecutionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TestSuiteExecution, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteExecutionList.
+func (in *TestSuiteExecutionList) DeepCopy() *TestSuiteExecutionList {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteExecutionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TestSuiteExecutionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteExecutionRequest) DeepCopyInto(out *TestSuiteExecutionRequest) {
+	*out = *in
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]Variable, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExecutionLabels != nil {
+		in, out := &in.ExecutionLabels, &out.ExecutionLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ContentRequest != nil {
+		in, out := &in.ContentRequest, &out.ContentRequest
+		*out = new(TestContentRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunningContext != nil {
+		in, out := &in.RunningContext, &out.RunningContext
+		*out = new(RunningContext)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteExecutionRequest.
+func (in *TestSuiteExecutionRequest) DeepCopy() *TestSuiteExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteExecutionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteExecutionSpec) DeepCopyInto(out *TestSuiteExecutionSpec) {
+	*out = *in
+	if in.TestSuite != nil {
+		in, out := &in.TestSuite, &out.TestSuite
+		*out = new(ObjectRef)
+		**out = **in
+	}
+	if in.ExecutionRequest != nil {
+		in, out := &in.ExecutionRequest, &out.ExecutionRequest
+		*out = new(TestSuiteExecutionRequest)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteExecutionSpec.
+func (in *TestSuiteExecutionSpec) DeepCopy() *TestSuiteExecutionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteExecutionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteExecutionStatus) DeepCopyInto(out *TestSuiteExecutionStatus) {
+	*out = *in
+	if in.LatestExecution != nil {
+		in, out := &in.LatestExecution, &out.LatestExecution
+		*out = new(SuiteExecution)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteExecutionStatus.
+func (in *TestSuiteExecutionStatus) DeepCopy() *TestSuiteExecutionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteExecutionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteStep) DeepCopyInto(out *TestSuiteStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteStep.
+func (in *TestSuiteStep) DeepCopy() *TestSuiteStep {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteStepDelayV2) DeepCopyInto(out *TestSuiteStepDelayV2) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteStepDelayV2.
+func (in *TestSuiteStepDelayV2) DeepCopy() *TestSuiteStepDelayV2 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteStepDelayV2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteStepExecuteTestV2) DeepCopyInto(out *TestSuiteStepExecuteTestV2) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteStepExecuteTestV2.
+func (in *TestSuiteStepExecuteTestV2) DeepCopy() *TestSuiteStepExecuteTestV2 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteStepExecuteTestV2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteStepExecutionResult) DeepCopyInto(out *TestSuiteStepExecutionResult) {
+	*out = *in
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(TestSuiteStep)
+		**out = **in
+	}
+	if in.Test != nil {
+		in, out := &in.Test, &out.Test
+		*out = new(ObjectRef)
+		**out = **in
+	}
+	if in.Execution != nil {
+		in, out := &in.Execution, &out.Execution
+		*out = new(Execution)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteStepExecutionResult.
+func (in *TestSuiteStepExecutionResult) DeepCopy() *TestSuiteStepExecutionResult {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteStepExecutionResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteStepExecutionResultV2) DeepCopyInto(out *TestSuiteStepExecutionResultV2) {
+	*out = *in
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(TestSuiteStepV2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Test != nil {
+		in, out := &in.Test, &out.Test
+		*out = new(ObjectRef)
+		**out = **in
+	}
+	if in.Execution != nil {
+		in, out := &in.Execution, &out.Execution
+		*out = new(Execution)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteStepExecutionResultV2.
+func (in *TestSuiteStepExecutionResultV2) DeepCopy() *TestSuiteStepExecutionResultV2 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteStepExecutionResultV2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestSuiteStepV2) DeepCopyInto(out *TestSuiteStepV2) {
+	*out = *in
+	if in.Execute != nil {
+		in, out := &in.Execute, &out.Execute
+		*out = new(TestSuiteStepExecuteTestV2)
+		**out = **in
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(TestSuiteStepDelayV2)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteStepV2.
+func (in *TestSuiteStepV2) DeepCopy() *TestSuiteStepV2 {
+	if in == nil {
+		return nil
+	}
+	out := new(TestSuiteStepV2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Variable) DeepCopyInto(out *Variable) {
+	*out = *in
+	in.ValueFrom.DeepCopyInto(&out.ValueFrom)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable.
+func (in *Variable) DeepCopy() *Variable {
+	if in == nil {
+		return nil
+	}
+	out := new(Variable)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,26 @@
+package v1
+
+type TestWorkflowSpecBase struct {
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// make the instance configurable with some input data for scheduling it
+	Config map[string]ParameterSchema `json:"config,omitempty" expr:"include"`
+
+	// global content that should be fetched into all containers
+	Content *Content `json:"content,omitempty" expr:"include"`
+
+	// defaults for the containers for all the TestWorkflow steps
+	Container *ContainerConfig `json:"container,omitempty" expr:"include"`
+
+	// configuration for the scheduled job
+	Job *JobConfig `json:"job,omitempty" expr:"include"`
+
+	// configuration for the scheduled pod
+	Pod *PodConfig `json:"pod,omitempty" expr:"include"`
+
+	// services to run next to the steps, reachable by their names
+	Services map[string]ServiceSpec `json:"services,omitempty" expr:"include"`
+
+	// paths to restore before the steps, and save after they succeed
+	Cache []CacheSpec `json:"cache,omitempty" expr:"include"`
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// TestWorkflowSpec defines the desired state of TestWorkflow
+type TestWorkflowSpec struct {
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// templates to include at a top-level of workflow
+	Use []TemplateRef `json:"use,omitempty" expr:"include"`
+
+	TestWorkflowSpecBase `json:",inline" expr:"include"`
+
+	// steps for setting up the workflow
+	Setup []Step `json:"setup,omitempty" expr:"include"`
+
+	// steps to execute in the workflow
+	Steps []Step `json:"steps,omitempty" expr:"include"`
+
+	// steps to run at the end of the workflow
+	After []Step `json:"after,omitempty" expr:"include"`
+
+	// cron schedules to execute the workflow automatically
+	Schedules []Schedule `json:"schedules,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=skip;latest;all
+type ScheduleCatchUpPolicy string
+
+const (
+	// ScheduleCatchUpSkip ignores the runs missed while the scheduler was not running
+	ScheduleCatchUpSkip ScheduleCatchUpPolicy = "skip"
+	// ScheduleCatchUpLatest executes only the latest missed run
+	ScheduleCatchUpLatest ScheduleCatchUpPolicy = "latest"
+	// ScheduleCatchUpAll executes all the missed runs
+	ScheduleCatchUpAll ScheduleCatchUpPolicy = "all"
+)
+
+// Schedule describes when the workflow should be executed automatically
+type Schedule struct {
+	// unique name of the schedule, defaults to "default"
+	Name string `json:"name,omitempty"`
+
+	// cron expression, i.e. "0 2 * * *" or "@daily"
+	// +kubebuilder:validation:Required
+	Cron string `json:"cron"`
+
+	// IANA time zone for the cron expression, defaults to UTC
+	Timezone string `json:"timezone,omitempty"`
+
+	// maximum random delay added to each run, to spread the load
+	// +kubebuilder:validation:Pattern=^((0|[1-9][0-9]*)h)?((0|[1-9][0-9]*)m)?((0|[1-9][0-9]*)s)?((0|[1-9][0-9]*)ms)?$
+	Jitter string `json:"jitter,omitempty"`
+
+	// config values passed to the execution
+	Config map[string]string `json:"config,omitempty"`
+
+	// policy for the runs missed while the scheduler was not running, defaults to "skip"
+	CatchUp ScheduleCatchUpPolicy `json:"catchUp,omitempty"`
+
+	// pause scheduling, the missed runs are not caught up after enabling it back
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// TestWorkflowStatus defines the observed state of TestWorkflow
+type TestWorkflowStatus struct {
+	// last handled run of each schedule
+	Schedules []ScheduleStatus `json:"schedules,omitempty"`
+}
+
+// ScheduleStatus is the observed state of the workflow schedule
+type ScheduleStatus struct {
+	// name of the schedule
+	Name string `json:"name"`
+
+	// time resulting from the cron expression for the last handled run
+	LastScheduleTime metav1.Time `json:"lastScheduleTime"`
+}
+
+// TemplateRef is the reference for the template inclusion
+type TemplateRef struct {
+	// name of the template to include
+	Name string `json:"name"`
+	// trait configuration values if needed
+	Config map[string]intstr.IntOrString `json:"config,omitempty" expr:"template"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// TestWorkflow is the Schema for the workflows API
+type TestWorkflow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// TestWorkflow readable description
+	Description string `json:"description,omitempty"`
+
+	// TestWorkflow specification
+	Spec TestWorkflowSpec `json:"spec" expr:"include"`
+
+	// TestWorkflow status
+	Status TestWorkflowStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TestWorkflowList contains a list of TestWorkflow
+type TestWorkflowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TestWorkflow `json:"items" expr:"include"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TestWorkflow{}, &TestWorkflowList{})
+}
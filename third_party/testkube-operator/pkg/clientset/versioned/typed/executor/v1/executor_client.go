@@ -0,0 +1,111 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"net/http"
+
+	"github.com/kubeshop/testkube-operator/pkg/clientset/versioned/scheme"
+
+	executorv1 "github.com/kubeshop/testkube-operator/api/executor/v1"
+	"k8s.io/client-go/rest"
+)
+
+type ExecutorV1Interface interface {
+	RESTClient() rest.Interface
+	ExecutorGetter
+	WebhookGetter
+}
+
+// ExecutorV1Client is used to interact with features provided by the executor.testkube.io group.
+type ExecutorV1Client struct {
+	restClient rest.Interface
+}
+
+func (c *ExecutorV1Client) Executor(namespace string) ExecutorInterface {
+	return newExecutor(c, namespace)
+}
+
+func (c *ExecutorV1Client) Webhook(namespace string) WebhookInterface {
+	return newWebhook(c, namespace)
+}
+
+// NewForConfig creates a new ExecutorV1Client for the given config.
+// NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewForConfig(c *rest.Config) (*ExecutorV1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewForConfigAndClient(&config, httpClient)
+}
+
+// NewForConfigAndClient creates a new ExecutorV1Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewForConfigAndClient(c *rest.Config, h *http.Client) (*ExecutorV1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecutorV1Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new ExecutorV1Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *ExecutorV1Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new ExecutorV1Client for the given RESTClient.
+func New(c rest.Interface) *ExecutorV1Client {
+	return &ExecutorV1Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := executorv1.GroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *ExecutorV1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	testsourcev1 "github.com/kubeshop/testkube-operator/api/testsource/v1"
+	"github.com/kubeshop/testkube-operator/pkg/clientset/versioned/scheme"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+)
+
+// TestSourceGetter has a method to return a TestSourceInterface.
+// A group's client should implement this interface.
+type TestSourceGetter interface {
+	TestSource(namespace string) TestSourceInterface
+}
+
+// TestSourceInterface has methods to work with TestSource resources.
+type TestSourceInterface interface {
+	Create(ctx context.Context, testSource *testsourcev1.TestSource, opts v1.CreateOptions) (*testsourcev1.TestSource, error)
+	Update(ctx context.Context, testSource *testsourcev1.TestSource, opts v1.UpdateOptions) (*testsourcev1.TestSource, error)
+	UpdateStatus(ctx context.Context, testSource *testsourcev1.TestSource, opts v1.UpdateOptions) (*testsourcev1.TestSource, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*testsourcev1.TestSource, error)
+	List(ctx context.Context, opts v1.ListOptions) (*testsourcev1.TestSourceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *testsourcev1.TestSource, err error)
+	TestSourceExpansion
+}
+
+// testSource implements TestSourceInterface
+type testSource struct {
+	client rest.Interface
+	ns     string
+}
+
+// newTestSource returns a TestSource
+func newTestSource(c *TestsV1Client, namespace string) *testSource {
+	return &testSource{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the testSource, and returns the corresponding testSource object, and an error if there is any.
+func (c *testSource) Get(ctx context.Context, name string, options v1.GetOptions) (result *testsourcev1.TestSource, err error) {
+	result = &testsourcev1.TestSource{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("testsources").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of TestSource that match those selectors.
+func (c *testSource) List(ctx context.Context, opts v1.ListOptions) (result *testsourcev1.TestSourceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &testsourcev1.TestSourceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("testsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested testSource.
+func (c *testSource) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("testsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a testSource and creates it.  Returns the server's representation of the testSource, and an error, if there is any.
+func (c *testSource) Create(ctx context.Context, testSource *testsourcev1.TestSource, opts v1.CreateOptions) (result *testsourcev1.TestSource, err error) {
+	result = &testsourcev1.TestSource{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("testsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(testSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a testSource and updates it. Returns the server's representation of the testSource, and an error, if there is any.
+func (c *testSource) Update(ctx context.Context, testSource *testsourcev1.TestSource, opts v1.UpdateOptions) (result *testsourcev1.TestSource, err error) {
+	result = &testsourcev1.TestSource{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("testsources").
+		Name(testSource.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(testSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *testSource) UpdateStatus(ctx context.Context, testSource *testsourcev1.TestSource, opts v1.UpdateOptions) (result *testsourcev1.TestSource, err error) {
+	result = &testsourcev1.TestSource{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("testsources").
+		Name(testSource.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(testSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the testSource and deletes it. Returns an error if one occurs.
+func (c *testSource) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("testsources").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *testSource) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("testsources").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched testSource.
+func (c *testSource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *testsourcev1.TestSource, err error) {
+	result = &testsourcev1.TestSource{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("testsources").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
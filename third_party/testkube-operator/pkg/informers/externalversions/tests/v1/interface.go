@@ -0,0 +1,52 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "github.com/kubeshop/testkube-operator/pkg/informers/externalversions/internalinterfaces"
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// TestTriggers returns a TestTriggerInformer.
+	TestTriggers() TestTriggerInformer
+	// TestSource returns a TestSourceInformer.
+	TestSource() TestSourceInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(
+	f internalinterfaces.SharedInformerFactory,
+	namespace string,
+	tweakListOptions internalinterfaces.TweakListOptionsFunc,
+) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// TestTriggers returns a TestTriggerInformer.
+func (v *version) TestTriggers() TestTriggerInformer {
+	return &testTriggerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TestSource returns a TestTriggerInformer.
+func (v *version) TestSource() TestSourceInformer {
+	return &testSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
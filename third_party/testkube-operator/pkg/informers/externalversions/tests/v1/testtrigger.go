@@ -0,0 +1,93 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube-operator/pkg/clientset/versioned"
+	"github.com/kubeshop/testkube-operator/pkg/informers/externalversions/internalinterfaces"
+	testtriggerslisterv1 "github.com/kubeshop/testkube-operator/pkg/listers/tests/v1"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TestTriggerInformer provides access to a shared informer and lister for TestTrigger.
+type TestTriggerInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() testtriggerslisterv1.TestTriggerLister
+}
+
+type testTriggerInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewTestTriggerInformer constructs a new informer for TestTrigger type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory print and number of connections to the server.
+func NewTestTriggerInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredTestTriggerInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredTestTriggerInformer constructs a new informer for TestTrigger type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory print and number of connections to the server.
+func NewFilteredTestTriggerInformer(
+	client versioned.Interface,
+	namespace string,
+	resyncPeriod time.Duration,
+	indexers cache.Indexers,
+	tweakListOptions internalinterfaces.TweakListOptionsFunc,
+) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.TestsV1().TestTriggers(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.TestsV1().TestTriggers(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&testtriggersv1.TestTrigger{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *testTriggerInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredTestTriggerInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *testTriggerInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&testtriggersv1.TestTrigger{}, f.defaultInformer)
+}
+
+func (f *testTriggerInformer) Lister() testtriggerslisterv1.TestTriggerLister {
+	return testtriggerslisterv1.NewTestTriggerLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+// TestListerExpansion allows custom methods to be added to TestLister.
+type TestListerExpansion interface{}
+
+// TestNamespaceListerExpansion allows custom methods to be added to
+// TestNamespaceLister.
+type TestNamespaceListerExpansion interface{}
+
+// TestSuiteListerExpansion allows custom methods to be added to TestSuiteLister.
+type TestSuiteListerExpansion interface{}
+
+// TestSuiteNamespaceListerExpansion allows custom methods to be added to
+// TestSuiteNamespaceLister.
+type TestSuiteNamespaceListerExpansion interface{}